@@ -159,6 +159,7 @@ func (g *CommentGroup) Text() string {
 type Field struct {
 	Doc     *CommentGroup // associated documentation; or nil
 	Names   []*Ident      // field/method/parameter names; or nil if anonymous field
+	Val     token.Pos     // position of "val" for read-only parameters; or token.NoPos
 	Type    Expr          // field/method/parameter type
 	Tag     *BasicLit     // field tag; or nil
 	Comment *CommentGroup // line comments; or nil
@@ -279,6 +280,15 @@ type (
 		Rparen token.Pos // position of ")"
 	}
 
+	// A TupleExpr node represents a tuple literal such as (1, "a") or a tuple
+	// type such as (int, string). Like a StarExpr, which one it is depends on
+	// the context. A tuple always has at least two elements.
+	TupleExpr struct {
+		Lparen token.Pos // position of "("
+		Elts   []Expr    // list of elements or element types
+		Rparen token.Pos // position of ")"
+	}
+
 	// A SelectorExpr node represents an expression followed by a selector.
 	SelectorExpr struct {
 		X   Expr   // expression
@@ -408,7 +418,7 @@ type (
 	// An InterfaceType node represents an interface type.
 	InterfaceType struct {
 		Interface  token.Pos  // position of "interface" keyword
-		Methods    *FieldList // list of methods
+		Methods    *FieldList // list of methods; each type in a type list is a field named "type"
 		Incomplete bool       // true if (source) methods are missing in the Methods list
 	}
 
@@ -430,14 +440,42 @@ type (
 
 type (
 	// TypeParamDecl is a list of type parameter names used in function or type
-	// declarations.
+	// declarations. The trailing type parameters may have default type
+	// arguments (e.g. [K, V = interface{}]). A type parameter declared with
+	// "const" and a type (e.g. [T, const N int]) takes a constant value instead
+	// of a type, and a type parameter followed by an interface type (e.g.
+	// [T Number]) is constrained by that interface.
 	TypeParamDecl struct {
-		Lbrack token.Pos // position of "["
-		Names  []*Ident  // list of type parameter names
-		Rbrack token.Pos // position of "]"
+		Lbrack      token.Pos // position of "["
+		Names       []*Ident  // list of type parameter names
+		Defaults    []Expr    // default type arguments of the last len(Defaults) names; or nil
+		Consts      []*Field  // constant type parameters; their names are also in Names
+		Constraints []*Field  // constrained type parameters; their names are also in Names
+		Rbrack      token.Pos // position of "]"
 	}
 )
 
+// ConstType returns the type of the constant type parameter name, or nil if
+// name is not a constant type parameter of l.
+func (l *TypeParamDecl) ConstType(name *Ident) Expr {
+	return fieldType(l.Consts, name)
+}
+
+// Constraint returns the constraint of the type parameter name, or nil if name
+// is not a constrained type parameter of l.
+func (l *TypeParamDecl) Constraint(name *Ident) Expr {
+	return fieldType(l.Constraints, name)
+}
+
+func fieldType(fields []*Field, name *Ident) Expr {
+	for _, f := range fields {
+		if f.Names[0] == name {
+			return f.Type
+		}
+	}
+	return nil
+}
+
 func (l *TypeParamDecl) Pos() token.Pos {
 	if l.Lbrack.IsValid() {
 		return l.Lbrack
@@ -466,6 +504,7 @@ func (x *CompositeLit) Pos() token.Pos {
 	return x.Lbrace
 }
 func (x *ParenExpr) Pos() token.Pos      { return x.Lparen }
+func (x *TupleExpr) Pos() token.Pos      { return x.Lparen }
 func (x *SelectorExpr) Pos() token.Pos   { return x.X.Pos() }
 func (x *IndexExpr) Pos() token.Pos      { return x.X.Pos() }
 func (x *SliceExpr) Pos() token.Pos      { return x.X.Pos() }
@@ -500,6 +539,7 @@ func (x *BasicLit) End() token.Pos       { return token.Pos(int(x.ValuePos) + le
 func (x *FuncLit) End() token.Pos        { return x.Body.End() }
 func (x *CompositeLit) End() token.Pos   { return x.Rbrace + 1 }
 func (x *ParenExpr) End() token.Pos      { return x.Rparen + 1 }
+func (x *TupleExpr) End() token.Pos      { return x.Rparen + 1 }
 func (x *SelectorExpr) End() token.Pos   { return x.Sel.End() }
 func (x *IndexExpr) End() token.Pos      { return x.Rbrack + 1 }
 func (x *SliceExpr) End() token.Pos      { return x.Rbrack + 1 }
@@ -532,6 +572,7 @@ func (*BasicLit) exprNode()       {}
 func (*FuncLit) exprNode()        {}
 func (*CompositeLit) exprNode()   {}
 func (*ParenExpr) exprNode()      {}
+func (*TupleExpr) exprNode()      {}
 func (*SelectorExpr) exprNode()   {}
 func (*IndexExpr) exprNode()      {}
 func (*SliceExpr) exprNode()      {}
@@ -600,7 +641,7 @@ type (
 
 	// A DeclStmt node represents a declaration in a statement list.
 	DeclStmt struct {
-		Decl Decl // *GenDecl with CONST, TYPE, or VAR token
+		Decl Decl // *GenDecl with CONST, TYPE, or VAR token, or *FuncDecl with type parameters
 	}
 
 	// An EmptyStmt node represents an empty statement.
@@ -955,11 +996,12 @@ type (
 	//	token.CONST   *ValueSpec
 	//	token.TYPE    *TypeSpec
 	//	token.VAR     *ValueSpec
+	//	token.VAL     *ValueSpec
 	//
 	GenDecl struct {
 		Doc    *CommentGroup // associated documentation; or nil
 		TokPos token.Pos     // position of Tok
-		Tok    token.Token   // IMPORT, CONST, TYPE, VAR
+		Tok    token.Token   // IMPORT, CONST, TYPE, VAR, VAL
 		Lparen token.Pos     // position of '(', if any
 		Specs  []Spec
 		Rparen token.Pos // position of ')', if any
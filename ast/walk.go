@@ -95,6 +95,13 @@ func Walk(v Visitor, node Node) {
 		for _, f := range n.Names {
 			Walk(v, f)
 		}
+		walkExprList(v, n.Defaults)
+		for _, f := range n.Consts {
+			Walk(v, f.Type)
+		}
+		for _, f := range n.Constraints {
+			Walk(v, f.Type)
+		}
 
 	case *Ellipsis:
 		if n.Elt != nil {
@@ -114,6 +121,9 @@ func Walk(v Visitor, node Node) {
 	case *ParenExpr:
 		Walk(v, n.X)
 
+	case *TupleExpr:
+		walkExprList(v, n.Elts)
+
 	case *SelectorExpr:
 		Walk(v, n.X)
 		Walk(v, n.Sel)
@@ -122,6 +132,10 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.X)
 		Walk(v, n.Index)
 
+	case *TypeArgExpr:
+		Walk(v, n.X)
+		walkExprList(v, n.Types)
+
 	case *SliceExpr:
 		Walk(v, n.X)
 		if n.Low != nil {
@@ -323,6 +337,9 @@ func Walk(v Visitor, node Node) {
 			Walk(v, n.Doc)
 		}
 		Walk(v, n.Name)
+		if n.TypeParams != nil {
+			Walk(v, n.TypeParams)
+		}
 		Walk(v, n.Type)
 		if n.Comment != nil {
 			Walk(v, n.Comment)
@@ -347,6 +364,9 @@ func Walk(v Visitor, node Node) {
 			Walk(v, n.Recv)
 		}
 		Walk(v, n.Name)
+		if n.TypeParams != nil {
+			Walk(v, n.TypeParams)
+		}
 		Walk(v, n.Type)
 		if n.Body != nil {
 			Walk(v, n.Body)
@@ -27,6 +27,7 @@ func Clone(node ast.Node) ast.Node {
 		return &ast.Field{
 			Doc:     cloneCommentGroup(n.Doc),
 			Names:   cloneIdentList(n.Names),
+			Val:     n.Val,
 			Type:    cloneExpr(n.Type),
 			Tag:     tag,
 			Comment: cloneCommentGroup(n.Comment),
@@ -83,6 +84,13 @@ func Clone(node ast.Node) ast.Node {
 			Rparen: n.Rparen,
 		}
 
+	case *ast.TupleExpr:
+		return &ast.TupleExpr{
+			Lparen: n.Lparen,
+			Elts:   cloneExprList(n.Elts),
+			Rparen: n.Rparen,
+		}
+
 	case *ast.SelectorExpr:
 		return &ast.SelectorExpr{
 			X:   cloneExpr(n.X),
@@ -109,10 +117,14 @@ func Clone(node ast.Node) ast.Node {
 		}
 
 	case *ast.TypeParamDecl:
+		names := cloneIdentList(n.Names)
 		return &ast.TypeParamDecl{
-			Lbrack: n.Lbrack,
-			Names:  cloneIdentList(n.Names),
-			Rbrack: n.Rbrack,
+			Lbrack:      n.Lbrack,
+			Names:       names,
+			Defaults:    cloneExprList(n.Defaults),
+			Consts:      cloneTypeParamFields(n.Consts, n.Names, names),
+			Constraints: cloneTypeParamFields(n.Constraints, n.Names, names),
+			Rbrack:      n.Rbrack,
 		}
 
 	case *ast.TypeArgExpr:
@@ -389,12 +401,17 @@ func Clone(node ast.Node) ast.Node {
 		}
 
 	case *ast.TypeSpec:
+		var typeParams *ast.TypeParamDecl
+		if n.TypeParams != nil {
+			typeParams = Clone(n.TypeParams).(*ast.TypeParamDecl)
+		}
 		return &ast.TypeSpec{
-			Doc:     cloneCommentGroup(n.Doc),
-			Name:    cloneIdent(n.Name),
-			Assign:  n.Assign,
-			Type:    cloneExpr(n.Type),
-			Comment: cloneCommentGroup(n.Comment),
+			Doc:        cloneCommentGroup(n.Doc),
+			Name:       cloneIdent(n.Name),
+			TypeParams: typeParams,
+			Assign:     n.Assign,
+			Type:       cloneExpr(n.Type),
+			Comment:    cloneCommentGroup(n.Comment),
 		}
 
 	case *ast.BadDecl:
@@ -498,6 +515,24 @@ func Clone(node ast.Node) ast.Node {
 }
 
 // Helper functions for common nodes
+// cloneTypeParamFields clones the fields of a TypeParamDecl. The names of the
+// fields must stay shared with the Names of the TypeParamDecl, so names are the
+// clones of oldNames.
+func cloneTypeParamFields(fields []*ast.Field, oldNames, names []*ast.Ident) []*ast.Field {
+	var result []*ast.Field
+	for _, f := range fields {
+		for i, name := range oldNames {
+			if f.Names[0] == name {
+				result = append(result, &ast.Field{
+					Names: []*ast.Ident{names[i]},
+					Type:  cloneExpr(f.Type),
+				})
+			}
+		}
+	}
+	return result
+}
+
 func cloneIdentList(list []*ast.Ident) []*ast.Ident {
 	if list == nil {
 		return nil
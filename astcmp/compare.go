@@ -86,6 +86,12 @@ func Equal(x, y ast.Node, mode Mode) bool {
 
 	case *ast.Field:
 		y := y.(*ast.Field)
+		if x.Val.IsValid() != y.Val.IsValid() {
+			return false
+		}
+		if mode&IgnorePos == 0 && x.Val != y.Val {
+			return false
+		}
 		if !Equal(x.Doc, y.Doc, mode) {
 			return false
 		}
@@ -162,6 +168,12 @@ func Equal(x, y ast.Node, mode Mode) bool {
 		}
 		if !compareIdents(x.Names, y.Names, mode) {
 			return false
+		} else if !compareExprs(x.Defaults, y.Defaults, mode) {
+			return false
+		} else if !compareFields(x.Consts, y.Consts, mode) {
+			return false
+		} else if !compareFields(x.Constraints, y.Constraints, mode) {
+			return false
 		}
 
 	case *ast.TypeArgExpr:
@@ -229,6 +241,19 @@ func Equal(x, y ast.Node, mode Mode) bool {
 			return false
 		}
 
+	case *ast.TupleExpr:
+		y := y.(*ast.TupleExpr)
+		if mode&IgnorePos == 0 {
+			if x.Lparen != y.Lparen {
+				return false
+			} else if x.Rparen != y.Rparen {
+				return false
+			}
+		}
+		if !compareExprs(x.Elts, y.Elts, mode) {
+			return false
+		}
+
 	case *ast.SelectorExpr:
 		y := y.(*ast.SelectorExpr)
 		if !Equal(x.X, y.X, mode) {
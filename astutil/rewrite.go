@@ -245,6 +245,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.ParenExpr:
 		a.apply(n, "X", nil, n.X)
 
+	case *ast.TupleExpr:
+		a.applyList(n, "Elts")
+
 	case *ast.SelectorExpr:
 		a.apply(n, "X", nil, n.X)
 		a.apply(n, "Sel", nil, n.Sel)
@@ -261,6 +264,13 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 
 	case *ast.TypeParamDecl:
 		a.applyList(n, "Names")
+		a.applyList(n, "Defaults")
+		for _, f := range n.Consts {
+			a.apply(f, "Type", nil, f.Type)
+		}
+		for _, f := range n.Constraints {
+			a.apply(f, "Type", nil, f.Type)
+		}
 
 	case *ast.TypeArgExpr:
 		a.apply(n, "X", nil, n.X)
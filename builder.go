@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	gobuild "go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/internal/buildcache"
+	"github.com/albrow/fo/internal/gcimporter"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/token"
+)
+
+// compiledPackage is the result of compiling a Fo package. It is what the
+// build cache stores for each package.
+type compiledPackage struct {
+	Name           string
+	ExportData     []byte
+	Outputs        [][]byte
+	TestOutputs    [][]byte
+	Instantiations *instantiationReport
+}
+
+// builder compiles Fo packages, skipping the ones whose results are already in
+// the build cache. Packages compiled earlier by the same builder can be
+// imported by the ones compiled later from their export data in memory.
+type builder struct {
+	// cache is nil if the build cache is turned off.
+	cache     *buildcache.Cache
+	typeNames bool
+
+	packages map[string]*compiledPackage
+	// keys holds the cache key of each compiled package. Since a key covers
+	// everything the package was compiled from, including the keys of the Fo
+	// packages it imports, it is used as the package's export fingerprint.
+	keys map[string]buildcache.Key
+	// fingerprints holds the fingerprint of each imported package which the
+	// builder doesn't compile (see fingerprint), which are the same for every
+	// package.
+	fingerprints map[string][]byte
+}
+
+// newBuilder returns a builder which uses the default build cache (see
+// buildcache.Default).
+func newBuilder(typeNames bool) (*builder, error) {
+	cache, err := buildcache.Default()
+	if err != nil {
+		return nil, err
+	}
+	return &builder{
+		cache:        cache,
+		typeNames:    typeNames,
+		packages:     map[string]*compiledPackage{},
+		keys:         map[string]buildcache.Key{},
+		fingerprints: map[string][]byte{},
+	}, nil
+}
+
+// compile compiles the package in dir with the given import path, which is
+// made of the Fo files in filenames and the tests in testFilenames (see
+// compiler.Compile). Imported Fo packages which the builder hasn't compiled are
+// found relative to dir.
+func (b *builder) compile(dir, importPath string, filenames, testFilenames []string) (*compiledPackage, error) {
+	inputs, err := readInputs(filenames, testFilenames)
+	if err != nil {
+		return nil, err
+	}
+	key, err := b.key(dir, importPath, inputs)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &compiledPackage{}
+	if b.cache == nil || !b.cache.Get(key, pkg) {
+		pkg, err = b.compileSources(dir, importPath, inputs)
+		if err != nil {
+			return nil, err
+		}
+		if b.cache != nil {
+			if err := b.cache.Put(key, pkg); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b.packages[importPath] = pkg
+	b.keys[importPath] = key
+	return pkg, nil
+}
+
+func (b *builder) compileSources(dir, importPath string, inputs []compiler.Input) (*compiledPackage, error) {
+	result, err := compiler.Compile(&compiler.Config{
+		ImportPath: importPath,
+		Lookup: func(path string) (io.ReadCloser, error) {
+			if pkg, found := b.packages[path]; found {
+				return ioutil.NopCloser(bytes.NewReader(pkg.ExportData)), nil
+			}
+			return foexport.FindExportData(path, dir)
+		},
+		RuntimeTypeNames: b.typeNames,
+	}, inputs)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Diagnostics) > 0 {
+		return nil, diagnosticsError(result.Diagnostics)
+	}
+	return &compiledPackage{
+		Name:           result.Name,
+		ExportData:     result.ExportData,
+		Outputs:        goSources(result.Files),
+		TestOutputs:    goSources(result.TestFiles),
+		Instantiations: &instantiationReport{Rows: result.Instantiations},
+	}, nil
+}
+
+// readInputs reads each of the given Fo files.
+func readInputs(filenames ...[]string) ([]compiler.Input, error) {
+	var inputs []compiler.Input
+	for _, group := range filenames {
+		for _, filename := range group {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			inputs = append(inputs, compiler.Input{Name: filename, Src: src})
+		}
+	}
+	return inputs, nil
+}
+
+// goSources returns the source code of each of the given files.
+func goSources(files []compiler.File) [][]byte {
+	var sources [][]byte
+	for _, f := range files {
+		sources = append(sources, f.Src)
+	}
+	return sources
+}
+
+// diagnosticsError is the error returned for a package with errors in its
+// source code. It lists every diagnostic, one per line.
+type diagnosticsError []compiler.Diagnostic
+
+func (diags diagnosticsError) Error() string {
+	lines := make([]string, len(diags))
+	for i, d := range diags {
+		lines[i] = d.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// key returns the cache key for a package made of the given inputs. It covers
+// the version of the compiler, the options, the names and contents of the Fo
+// files, and the export fingerprint of each imported package.
+func (b *builder) key(dir, importPath string, inputs []compiler.Input) (buildcache.Key, error) {
+	h := buildcache.NewHash()
+	compilerID, err := buildcache.ExecutableID()
+	if err != nil {
+		return buildcache.Key{}, err
+	}
+	h.Add("compiler", compilerID)
+	h.Add("importpath", []byte(importPath))
+	h.Add("typenames", []byte(strconv.FormatBool(b.typeNames)))
+
+	imports := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, input := range inputs {
+		// Whether a file holds tests depends on its name alone.
+		h.Add("file "+input.Name, input.Src)
+		f, err := parser.ParseFile(fset, input.Name, input.Src, parser.ImportsOnly)
+		if err != nil {
+			return buildcache.Key{}, err
+		}
+		for _, spec := range f.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return buildcache.Key{}, err
+			}
+			imports[path] = true
+		}
+	}
+	// The tests import the package itself, which is already covered by the
+	// other inputs.
+	delete(imports, importPath)
+
+	var paths []string
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fingerprint, err := b.fingerprint(dir, path)
+		if err != nil {
+			return buildcache.Key{}, err
+		}
+		h.Add("import "+path, fingerprint)
+	}
+	return h.Sum(), nil
+}
+
+// fingerprint returns the export fingerprint of the package with the given
+// import path. For Fo packages which the builder hasn't compiled, it is the
+// result of foFingerprint for the export data found relative to dir. For Go
+// packages, it is the result of goFingerprint.
+func (b *builder) fingerprint(dir, path string) ([]byte, error) {
+	if key, found := b.keys[path]; found {
+		return key[:], nil
+	}
+	if fingerprint, found := b.fingerprints[path]; found {
+		return fingerprint, nil
+	}
+	var fingerprint []byte
+	rc, err := foexport.FindExportData(path, dir)
+	if os.IsNotExist(err) {
+		fingerprint, err = b.goFingerprint(dir, path)
+	} else if err == nil {
+		fingerprint, err = b.foFingerprint(dir, path, rc)
+		rc.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.fingerprints[path] = fingerprint
+	return fingerprint, nil
+}
+
+// foFingerprint returns a hash of the Fo export data read from rc, along with
+// the fingerprints of the packages it imports. Those are needed because the
+// export data only describes the package itself, and instantiating its generic
+// declarations may depend on the packages it imports.
+func (b *builder) foFingerprint(dir, path string, rc io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	imports, err := foexport.Imports(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	// The imports of the package are found relative to its own directory.
+	if pkg, err := gobuild.Import(path, dir, gobuild.FindOnly); err == nil && pkg.Dir != "" {
+		dir = pkg.Dir
+	}
+	h := sha256.New()
+	h.Write(data)
+	sort.Strings(imports)
+	for _, imp := range imports {
+		fingerprint, err := b.fingerprint(dir, imp)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(h, "import %s %d\n", imp, len(fingerprint))
+		h.Write(fingerprint)
+	}
+	return h.Sum(nil), nil
+}
+
+// goFingerprint returns a hash of what the Go package with the given import
+// path is imported from: its export data if the Go importer finds any, or else
+// its Go files. It is nil for packages which can't be found and for the
+// standard library when its types are embedded in fo, since they are covered
+// by the ID of the compiler.
+func (b *builder) goFingerprint(dir, path string) ([]byte, error) {
+	h := sha256.New()
+	if filename, _ := gcimporter.FindPkg(path, dir); filename != "" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		pkg, err := gobuild.Import(path, dir, 0)
+		if err != nil || pkg.Goroot {
+			return nil, nil
+		}
+		filenames := append(append([]string(nil), pkg.GoFiles...), pkg.CgoFiles...)
+		sort.Strings(filenames)
+		for _, name := range filenames {
+			src, err := ioutil.ReadFile(filepath.Join(pkg.Dir, name))
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(h, "%s %d\n", name, len(src))
+			h.Write(src)
+		}
+	}
+	return h.Sum(nil), nil
+}
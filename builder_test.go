@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	gobuild "go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/importer"
+	"github.com/albrow/fo/internal/buildcache"
+)
+
+// setTestGOPATH points go/build at a new, empty GOPATH in GOPATH mode. The
+// returned function undoes it.
+func setTestGOPATH(t *testing.T) (string, func()) {
+	t.Helper()
+	gopath, err := ioutil.TempDir("", "fo-builder-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldGOPATH, oldModule := gobuild.Default.GOPATH, os.Getenv("GO111MODULE")
+	gobuild.Default.GOPATH = gopath
+	os.Setenv("GO111MODULE", "off")
+	return gopath, func() {
+		gobuild.Default.GOPATH = oldGOPATH
+		os.Setenv("GO111MODULE", oldModule)
+		os.RemoveAll(gopath)
+	}
+}
+
+// writeTestFile writes a file to the given path in gopath/src.
+func writeTestFile(t *testing.T, gopath, name string, data []byte) {
+	t.Helper()
+	filename := filepath.Join(gopath, "src", filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testBuilderKey returns the cache key for inputs, computed by a new builder.
+func testBuilderKey(t *testing.T, dir string, inputs []compiler.Input) buildcache.Key {
+	t.Helper()
+	b := &builder{
+		packages:     map[string]*compiledPackage{},
+		keys:         map[string]buildcache.Key{},
+		fingerprints: map[string][]byte{},
+	}
+	key, err := b.key(dir, "main", inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestBuilderKeyGoImports(t *testing.T) {
+	gopath, cleanup := setTestGOPATH(t)
+	defer cleanup()
+
+	inputs := []compiler.Input{{Name: "main.fo", Src: []byte("package main\n\nimport \"example.com/dep\"\n\nfunc main() { dep.F() }\n")}}
+	writeTestFile(t, gopath, "example.com/dep/dep.go", []byte("package dep\n\nfunc F() {}\n"))
+	first := testBuilderKey(t, gopath, inputs)
+	if second := testBuilderKey(t, gopath, inputs); second != first {
+		t.Error("expected the same key for the same inputs")
+	}
+	writeTestFile(t, gopath, "example.com/dep/dep.go", []byte("package dep\n\nfunc F() int { return 1 }\n"))
+	if changed := testBuilderKey(t, gopath, inputs); changed == first {
+		t.Error("expected the key to change when an imported Go package changes")
+	}
+}
+
+func TestBuilderKeyFoImports(t *testing.T) {
+	gopath, cleanup := setTestGOPATH(t)
+	defer cleanup()
+
+	// Export data for example.com/lib, which imports example.com/dep. Both
+	// are on disk rather than compiled by the builder.
+	exportData := map[string][]byte{}
+	writeExportData := func(path, src string) {
+		result, err := compiler.Compile(&compiler.Config{
+			ImportPath: path,
+			Lookup: func(path string) (io.ReadCloser, error) {
+				if data, found := exportData[path]; found {
+					return ioutil.NopCloser(bytes.NewReader(data)), nil
+				}
+				return nil, os.ErrNotExist
+			},
+			Importer: importer.Embedded(),
+			Output:   compiler.ExportDataOnly,
+		}, []compiler.Input{{Name: filepath.Base(path) + ".fo", Src: []byte(src)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Diagnostics) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		exportData[path] = result.ExportData
+		writeTestFile(t, gopath, path+"/"+filepath.Base(path)+".foexport", result.ExportData)
+	}
+	writeExportData("example.com/dep", "package dep\n\ntype T struct{ X int }\n")
+	writeExportData("example.com/lib", "package lib\n\nimport \"example.com/dep\"\n\nfunc Get[U](t dep.T, u U) int { return t.X }\n")
+
+	inputs := []compiler.Input{{Name: "main.fo", Src: []byte("package main\n\nimport \"example.com/lib\"\n\nvar _ = lib.Get[int]\n")}}
+	first := testBuilderKey(t, gopath, inputs)
+	if second := testBuilderKey(t, gopath, inputs); second != first {
+		t.Error("expected the same key for the same inputs")
+	}
+	// The export data of lib stays the same, but the instantiations of its
+	// generic functions depend on dep.
+	writeExportData("example.com/dep", "package dep\n\ntype T struct{ X, Y int }\n")
+	if changed := testBuilderKey(t, gopath, inputs); changed == first {
+		t.Error("expected the key to change when a package imported by an imported Fo package changes")
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/importer"
+	"github.com/urfave/cli"
+)
+
+// checkFormats are the output formats supported by fo check.
+var checkFormats = []string{"text", "json", "sarif"}
+
+// check type-checks every Fo package in the main module without converting
+// anything into Go, and writes the errors it finds in the given format. It
+// exits with status 1 if there are any.
+func check(c *cli.Context) error {
+	format := c.String("format")
+	if !isCheckFormat(format) {
+		return fmt.Errorf("unknown format %q (expected one of %s)", format, strings.Join(checkFormats, ", "))
+	}
+	modPath, modDir, err := goModule()
+	if err != nil {
+		return err
+	}
+	var targets map[string]bool
+	if c.Args().Present() {
+		targets = map[string]bool{}
+		for _, arg := range c.Args() {
+			dir, err := filepath.Abs(arg)
+			if err != nil {
+				return err
+			}
+			importPath, err := moduleImportPath(modPath, modDir, dir)
+			if err != nil {
+				return err
+			}
+			targets[importPath] = true
+		}
+	}
+
+	w := &watcher{
+		modPath:    modPath,
+		modDir:     modDir,
+		goImporter: importer.Default(),
+		packages:   map[string]*watchedPackage{},
+		out:        ioutil.Discard,
+		checkOnly:  true,
+	}
+	if _, err := w.rebuild(); err != nil {
+		return err
+	}
+	var paths []string
+	for path := range w.packages {
+		if targets == nil || targets[path] {
+			paths = append(paths, path)
+		}
+		delete(targets, path)
+	}
+	for path := range targets {
+		return fmt.Errorf("no Fo package %s in the main module", path)
+	}
+	sort.Strings(paths)
+	var diags []compiler.Diagnostic
+	for _, path := range paths {
+		diags = append(diags, relativeDiagnostics(w.packages[path].diags)...)
+	}
+
+	switch format {
+	case "text":
+		for _, d := range diags {
+			fmt.Println(d)
+		}
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		for _, d := range diags {
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		}
+	case "sarif":
+		if err := writeSARIF(os.Stdout, diags, readSource); err != nil {
+			return err
+		}
+	}
+	if len(diags) > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+func isCheckFormat(name string) bool {
+	for _, format := range checkFormats {
+		if name == format {
+			return true
+		}
+	}
+	return false
+}
+
+// relativeDiagnostics returns a copy of diags in which the file names are
+// relative to the current directory if the files are inside of it.
+func relativeDiagnostics(diags []compiler.Diagnostic) []compiler.Diagnostic {
+	diags = append([]compiler.Diagnostic(nil), diags...)
+	wd, err := os.Getwd()
+	if err != nil {
+		return diags
+	}
+	for i, d := range diags {
+		rel, err := filepath.Rel(wd, d.File)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			diags[i].File = rel
+		}
+	}
+	return diags
+}
+
+// readSource returns the contents of the file with the given name, or nil if it
+// can't be read.
+func readSource(filename string) []byte {
+	src, _ := ioutil.ReadFile(filename)
+	return src
+}
+
+// writeSARIF writes diags to w as a SARIF log, the format used by code
+// scanning tools. src returns the source code of the file with the given name
+// (or nil if it is not available), which is needed to convert the columns of
+// the diagnostics, which count bytes, into the UTF-16 code units SARIF counts.
+func writeSARIF(w io.Writer, diags []compiler.Diagnostic, src func(filename string) []byte) error {
+	type region struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+		EndLine     int `json:"endLine"`
+		EndColumn   int `json:"endColumn"`
+	}
+	type location struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region region `json:"region"`
+		} `json:"physicalLocation"`
+	}
+	type message struct {
+		Text string `json:"text"`
+	}
+	type result struct {
+		RuleID    string     `json:"ruleId,omitempty"`
+		Level     string     `json:"level"`
+		Message   message    `json:"message"`
+		Locations []location `json:"locations,omitempty"`
+	}
+	type rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+	}
+	results := []result{}
+	lines := map[string][][]byte{}
+	column := func(filename string, line, col int) int {
+		if _, found := lines[filename]; !found {
+			lines[filename] = bytes.Split(src(filename), []byte("\n"))
+		}
+		if line < 1 || line > len(lines[filename]) {
+			return col
+		}
+		return utf16Column(lines[filename][line-1], col)
+	}
+	for _, d := range diags {
+		r := result{
+			RuleID:  d.Code,
+			Level:   d.Severity,
+			Message: message{d.Message},
+		}
+		if d.File != "" {
+			var loc location
+			loc.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(d.File)
+			loc.PhysicalLocation.Region = region{
+				StartLine:   d.Line,
+				StartColumn: column(d.File, d.Line, d.Col),
+				EndLine:     d.End.Line,
+				EndColumn:   column(d.File, d.End.Line, d.End.Col),
+			}
+			r.Locations = []location{loc}
+		}
+		results = append(results, r)
+	}
+	log := map[string]interface{}{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []interface{}{
+			map[string]interface{}{
+				"tool": map[string]interface{}{
+					"driver": map[string]interface{}{
+						"name":           "fo",
+						"informationUri": "https://github.com/albrow/fo",
+						"rules": []rule{
+							{"syntax", message{"Syntax error"}},
+							{"type", message{"Type error"}},
+						},
+					},
+				},
+				"columnKind": "utf16CodeUnits",
+				"results":    results,
+			},
+		},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// utf16Column converts col, the byte-based column of a position in line, into
+// the number of UTF-16 code units before the position plus one.
+func utf16Column(line []byte, col int) int {
+	if col < 1 || col-1 > len(line) {
+		return col
+	}
+	return len(utf16.Encode([]rune(string(line[:col-1])))) + 1
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/albrow/fo/compiler"
+)
+
+func TestRelativeDiagnostics(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		file     string
+		expected string
+	}{
+		{filepath.Join(wd, "main.fo"), "main.fo"},
+		{filepath.Join(wd, "a", "b.fo"), filepath.Join("a", "b.fo")},
+		{filepath.Join(wd, "..x", "b.fo"), filepath.Join("..x", "b.fo")},
+		{filepath.Join(filepath.Dir(wd), "b.fo"), filepath.Join(filepath.Dir(wd), "b.fo")},
+		{filepath.Join("a", "b.fo"), filepath.Join("a", "b.fo")},
+		{"", ""},
+	}
+	var diags []compiler.Diagnostic
+	for _, tc := range testCases {
+		diags = append(diags, compiler.Diagnostic{File: tc.file})
+	}
+	got := relativeDiagnostics(diags)
+	for i, tc := range testCases {
+		if got[i].File != tc.expected {
+			t.Errorf("expected %s to become %s but got %s", tc.file, tc.expected, got[i].File)
+		}
+		if diags[i].File != tc.file {
+			t.Errorf("relativeDiagnostics changed its argument: expected %s but got %s", tc.file, diags[i].File)
+		}
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	src := map[string][]byte{
+		"a/main.fo": []byte("package main\n\nvar s = \"héllo\" + x\n"),
+	}
+	diags := []compiler.Diagnostic{
+		{File: "a/main.fo", Line: 3, Col: 20, End: compiler.Pos{Line: 3, Col: 21}, Severity: "error", Message: "undeclared name: x", Code: "type"},
+		{File: "a/main.fo", Line: 1, Col: 1, End: compiler.Pos{Line: 1, Col: 8}, Severity: "error", Message: "oops", Code: "syntax"},
+		{Severity: "error", Message: "no file"},
+	}
+	buf := &bytes.Buffer{}
+	if err := writeSARIF(buf, diags, func(filename string) []byte { return src[filename] }); err != nil {
+		t.Fatal(err)
+	}
+	var log struct {
+		Version string
+		Runs    []struct {
+			ColumnKind string
+			Results    []struct {
+				RuleID    string
+				Level     string
+				Message   struct{ Text string }
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct{ URI string }
+						Region           struct{ StartLine, StartColumn, EndLine, EndColumn int }
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %s\n%s", err, buf)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 || log.Runs[0].ColumnKind != "utf16CodeUnits" {
+		t.Fatalf("unexpected log:\n%s", buf)
+	}
+	results := log.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results but got %d:\n%s", len(results), buf)
+	}
+	if r := results[0]; r.RuleID != "type" || r.Level != "error" || r.Message.Text != "undeclared name: x" || len(r.Locations) != 1 {
+		t.Errorf("wrong first result: %+v", r)
+	} else {
+		loc := r.Locations[0].PhysicalLocation
+		if loc.ArtifactLocation.URI != "a/main.fo" {
+			t.Errorf("expected URI a/main.fo but got %s", loc.ArtifactLocation.URI)
+		}
+		// é is two bytes in UTF-8 but one code unit in UTF-16.
+		expected := struct{ StartLine, StartColumn, EndLine, EndColumn int }{3, 19, 3, 20}
+		if !reflect.DeepEqual(loc.Region, expected) {
+			t.Errorf("expected region %+v but got %+v", expected, loc.Region)
+		}
+	}
+	if r := results[1]; len(r.Locations) != 1 {
+		t.Errorf("wrong second result: %+v", r)
+	} else {
+		expected := struct{ StartLine, StartColumn, EndLine, EndColumn int }{1, 1, 1, 8}
+		if region := r.Locations[0].PhysicalLocation.Region; !reflect.DeepEqual(region, expected) {
+			t.Errorf("expected region %+v but got %+v", expected, region)
+		}
+	}
+	if r := results[2]; r.RuleID != "" || len(r.Locations) != 0 {
+		t.Errorf("expected a result without a rule or location but got %+v", r)
+	}
+}
+
+func TestUTF16Column(t *testing.T) {
+	testCases := []struct {
+		line     string
+		col      int
+		expected int
+	}{
+		{"abc", 1, 1},
+		{"abc", 4, 4},
+		{"é = x", 4, 3},
+		{"😀 x", 6, 4},
+		{"abc", 10, 10},
+	}
+	for _, tc := range testCases {
+		if got := utf16Column([]byte(tc.line), tc.col); got != tc.expected {
+			t.Errorf("utf16Column(%q, %d): expected %d but got %d", tc.line, tc.col, tc.expected, got)
+		}
+	}
+}
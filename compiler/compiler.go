@@ -0,0 +1,349 @@
+// Package compiler converts Fo packages into Go. It is what the fo command is
+// built on. It works on source code in memory and never touches the file
+// system or runs other programs (unless the importers it is given do), so it
+// can be used wherever Go code runs, including in a browser with GOOS=js and
+// GOARCH=wasm.
+package compiler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/format"
+	"github.com/albrow/fo/importer"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/printer"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/transform"
+	"github.com/albrow/fo/types"
+)
+
+// An Input is a Fo file to compile.
+type Input struct {
+	// Name is the name of the file. It is used in positions, and files whose
+	// names end in _test.fo hold the tests for the package (see Compile).
+	Name string
+	Src  []byte
+}
+
+// A File is a Go file generated by Compile.
+type File struct {
+	// Name is the name of the input the file was generated from, with the .fo
+	// extension replaced by .go.
+	Name string
+	Src  []byte
+}
+
+// An OutputMode selects what Compile generates.
+type OutputMode int
+
+const (
+	// GoAndExportData generates Go code for every input as well as the export
+	// data for the package. It is the default.
+	GoAndExportData OutputMode = iota
+	// ExportDataOnly type-checks the package and generates its export data,
+	// but no Go code. It is enough to check packages which import the package
+	// (e.g. in an editor).
+	ExportDataOnly
+)
+
+// A FormatMode selects how the generated Go code is printed.
+type FormatMode int
+
+const (
+	// Gofmt prints the code the way gofmt would. It is the default.
+	Gofmt FormatMode = iota
+	// Raw prints the code without aligning anything, which is faster. It is
+	// useful when the code is compiled but never read.
+	Raw
+)
+
+// Config holds the options for Compile. The zero value is ready to use.
+type Config struct {
+	// ImportPath is the import path of the package. If it is empty, the name
+	// of the package is used.
+	ImportPath string
+	// Lookup finds the export data of the Fo packages imported by the package.
+	// If it is nil, only Go packages can be imported.
+	Lookup foexport.Lookup
+	// Importer imports Go packages, i.e. the packages for which Lookup finds no
+	// export data. If it is nil, importer.Default() is used.
+	Importer types.Importer
+	Output   OutputMode
+	Format   FormatMode
+	// RuntimeTypeNames makes the generated code register the Fo names of
+	// generic types with the fo/runtime package (see foruntime.TypeName).
+	RuntimeTypeNames bool
+}
+
+// Result is the result of compiling a package.
+type Result struct {
+	// Name is the name of the package, as declared by the first of the
+	// inputs (other than tests) which could be parsed. It is set even if the
+	// package has errors, unless none of those inputs could be parsed.
+	Name string
+	// Diagnostics holds the errors found in the inputs. If there are any, the
+	// fields below other than Timing are not set.
+	Diagnostics []Diagnostic
+	// ExportData is the Fo export data for the package, which other packages
+	// need to import it.
+	ExportData []byte
+	// Files and TestFiles hold the Go code generated for the inputs (other
+	// than tests) and the tests respectively, in the order they were given.
+	Files     []File
+	TestFiles []File
+	// Instantiations describes the code generated for each generic
+	// declaration in the package, not including the tests.
+	Instantiations []Instantiation
+	Timing         Timing
+}
+
+// Timing holds the time Compile spent in each phase.
+type Timing struct {
+	Parse time.Duration
+	// Check includes type-checking the tests and writing the export data.
+	Check     time.Duration
+	Transform time.Duration
+	Format    time.Duration
+}
+
+// Compile compiles the Fo package made of the given inputs, along with its
+// tests: the inputs whose names end in _test.fo. The tests must belong to the
+// external test package (e.g. package list_test), which imports the package
+// under test from its export data. This way the instantiations needed by the
+// tests are generated in the tests rather than in the package itself.
+//
+// Errors in the inputs, including soft errors such as unused variables, are
+// returned in Result.Diagnostics. The returned error is only non-nil if the
+// package can't be compiled for any other reason.
+//
+// Compile keeps no state between calls, so it may be called from several
+// goroutines at once. Calls which share a Config also share its Importer and
+// Lookup, which must then be safe for concurrent use. The importers returned
+// by the importer package are not, so each call should be given its own.
+func Compile(cfg *Config, inputs []Input) (*Result, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	c := &compilation{
+		cfg:     cfg,
+		fset:    token.NewFileSet(),
+		sources: map[string][]byte{},
+		result:  &Result{},
+	}
+	return c.compile(inputs)
+}
+
+// compilation holds the state of a call to Compile.
+type compilation struct {
+	cfg  *Config
+	fset *token.FileSet
+	// sources holds the source code of each input by name, which is needed to
+	// find the end of the token each diagnostic points to.
+	sources map[string][]byte
+	result  *Result
+}
+
+func (c *compilation) compile(inputs []Input) (*Result, error) {
+	start := time.Now()
+	var files, testFiles []*ast.File
+	hasFiles := false
+	for _, input := range inputs {
+		c.sources[input.Name] = input.Src
+		isTest := strings.HasSuffix(input.Name, "_test.fo")
+		hasFiles = hasFiles || !isTest
+		f, err := parser.ParseFile(c.fset, input.Name, input.Src, 0)
+		if err != nil {
+			c.report(err)
+			continue
+		}
+		if isTest {
+			testFiles = append(testFiles, f)
+		} else {
+			files = append(files, f)
+		}
+	}
+	c.result.Timing.Parse = time.Since(start)
+	if !hasFiles {
+		return nil, errors.New("no Fo files to compile (other than tests)")
+	}
+	if len(files) > 0 {
+		c.result.Name = files[0].Name.Name
+	}
+	if len(c.result.Diagnostics) > 0 {
+		return c.result, nil
+	}
+
+	start = time.Now()
+	importPath := c.cfg.ImportPath
+	if importPath == "" {
+		importPath = files[0].Name.Name
+	}
+	goImporter := c.cfg.Importer
+	if goImporter == nil {
+		goImporter = importer.Default()
+	}
+	lookup := c.cfg.Lookup
+	if lookup == nil {
+		lookup = noExportData
+	}
+	trans := c.check(importPath, files, lookup, goImporter)
+	if trans == nil {
+		c.result.Timing.Check = time.Since(start)
+		return c.result, nil
+	}
+	// The export data must be written before transforming, since the
+	// transformer modifies the files.
+	exportData := &bytes.Buffer{}
+	if err := foexport.Write(exportData, c.fset, trans.Pkg, files); err != nil {
+		c.report(err)
+		c.result.Timing.Check = time.Since(start)
+		return c.result, nil
+	}
+	var testTrans *transform.Transformer
+	if len(testFiles) > 0 {
+		testTrans = c.checkTests(importPath, trans.Pkg.Name(), testFiles, exportData.Bytes(), lookup, goImporter)
+	}
+	c.result.Timing.Check = time.Since(start)
+	if len(c.result.Diagnostics) > 0 {
+		return c.result, nil
+	}
+	c.result.ExportData = exportData.Bytes()
+	if c.cfg.Output == ExportDataOnly {
+		return c.result, nil
+	}
+
+	if err := c.transform(trans, files, testTrans, testFiles); err != nil {
+		c.result.ExportData = nil
+		c.result.Files, c.result.TestFiles = nil, nil
+		return nil, err
+	}
+	insts, err := instantiations(c.fset, trans)
+	if err != nil {
+		return nil, err
+	}
+	c.result.Instantiations = insts
+	return c.result, nil
+}
+
+// report adds the diagnostics for err to the result.
+func (c *compilation) report(err error) {
+	c.result.Diagnostics = append(c.result.Diagnostics, Diagnostics(err, func(filename string) []byte {
+		return c.sources[filename]
+	})...)
+}
+
+// check type-checks the files of the package with the given import path and
+// returns a transformer which can convert them into Go, or nil if there are
+// any errors.
+func (c *compilation) check(importPath string, files []*ast.File, lookup foexport.Lookup, goImporter types.Importer) *transform.Transformer {
+	imp := foexport.NewImporter(c.fset, lookup, goImporter)
+	failed := false
+	conf := types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			failed = true
+			c.report(err)
+		},
+	}
+	info := &types.Info{
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Uses:       map[*ast.Ident]types.Object{},
+	}
+	pkg, _ := conf.Check(importPath, c.fset, files, info)
+	if failed {
+		return nil
+	}
+	return &transform.Transformer{
+		Fset:    c.fset,
+		Pkg:     pkg,
+		Info:    info,
+		Imports: imp.Packages(),
+
+		RuntimeTypeNames: c.cfg.RuntimeTypeNames,
+	}
+}
+
+// checkTests type-checks the tests for the package with the given import path
+// and name, which are imported from exportData.
+func (c *compilation) checkTests(importPath, pkgName string, files []*ast.File, exportData []byte, lookup foexport.Lookup, goImporter types.Importer) *transform.Transformer {
+	for _, f := range files {
+		if f.Name.Name != pkgName+"_test" {
+			c.report(types.Error{
+				Fset: c.fset,
+				Pos:  f.Package,
+				Msg:  fmt.Sprintf("tests must be in the external test package %s_test (found package %s)", pkgName, f.Name.Name),
+			})
+			return nil
+		}
+	}
+	testLookup := func(path string) (io.ReadCloser, error) {
+		if path == importPath {
+			return ioutil.NopCloser(bytes.NewReader(exportData)), nil
+		}
+		return lookup(path)
+	}
+	return c.check(importPath+"_test", files, testLookup, goImporter)
+}
+
+// transform converts the files of the package and its tests into Go.
+func (c *compilation) transform(trans *transform.Transformer, files []*ast.File, testTrans *transform.Transformer, testFiles []*ast.File) (err error) {
+	// The transformer panics on code it can't handle, which should never
+	// happen once the code has been type-checked. If it does, it must not
+	// take down the program using the package (e.g. an editor).
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("internal error converting package %s: %v", trans.Pkg.Path(), r)
+		}
+	}()
+	c.result.Files, err = c.transformFiles(trans, files)
+	if err != nil || testTrans == nil {
+		return err
+	}
+	c.result.TestFiles, err = c.transformFiles(testTrans, testFiles)
+	return err
+}
+
+func (c *compilation) transformFiles(trans *transform.Transformer, files []*ast.File) ([]File, error) {
+	var outputs []File
+	for _, f := range files {
+		start := time.Now()
+		transformed, err := trans.File(f)
+		c.result.Timing.Transform += time.Since(start)
+		if err != nil {
+			return nil, err
+		}
+		start = time.Now()
+		buf := &bytes.Buffer{}
+		if c.cfg.Format == Raw {
+			cfg := printer.Config{Mode: printer.RawFormat, Tabwidth: 8}
+			err = cfg.Fprint(buf, c.fset, transformed)
+		} else {
+			err = format.Node(buf, c.fset, transformed)
+		}
+		c.result.Timing.Format += time.Since(start)
+		if err != nil {
+			return nil, err
+		}
+		name := c.fset.File(f.Pos()).Name()
+		outputs = append(outputs, File{
+			Name: strings.TrimSuffix(name, ".fo") + ".go",
+			Src:  buf.Bytes(),
+		})
+	}
+	return outputs, nil
+}
+
+// noExportData is a foexport.Lookup which finds no export data.
+func noExportData(path string) (io.ReadCloser, error) {
+	return nil, os.ErrNotExist
+}
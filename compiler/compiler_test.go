@@ -0,0 +1,216 @@
+package compiler
+
+import (
+	"bytes"
+	"go/format"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/albrow/fo/importer"
+)
+
+const boxSrc = `package main
+
+import "fmt"
+
+type Box[T] struct {
+	V T
+}
+
+func main() {
+	fmt.Println(Box[string]{V: "hello"}.V)
+}
+`
+
+func TestCompile(t *testing.T) {
+	cfg := &Config{Importer: importer.Embedded()}
+	result, err := Compile(cfg, []Input{{Name: "main.fo", Src: []byte(boxSrc)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+	if result.Name != "main" {
+		t.Errorf("expected package name main but got %q", result.Name)
+	}
+	if len(result.ExportData) == 0 {
+		t.Error("expected export data")
+	}
+	if len(result.Files) != 1 || result.Files[0].Name != "main.go" {
+		t.Fatalf("expected a single file named main.go but got %v", result.Files)
+	}
+	output := string(result.Files[0].Src)
+	for _, want := range []string{"type Box__string struct", `fmt.Println(Box__string{V: "hello"}.V)`} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, output)
+		}
+	}
+	wantInsts := []Instantiation{{Generic: "Box", Name: "Box[string]", Lines: 3, Bytes: 37, Sites: []string{"main.fo:10:14"}}}
+	if !reflect.DeepEqual(result.Instantiations, wantInsts) {
+		t.Errorf("wrong instantiations\nexpected: %v\n     got: %v", wantInsts, result.Instantiations)
+	}
+	if result.Timing.Parse == 0 || result.Timing.Check == 0 {
+		t.Errorf("expected the time spent parsing and checking to be measured but got %+v", result.Timing)
+	}
+}
+
+func TestCompileOptions(t *testing.T) {
+	inputs := []Input{{Name: "main.fo", Src: []byte(boxSrc)}}
+	result, err := Compile(&Config{Importer: importer.Embedded(), Output: ExportDataOnly}, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ExportData) == 0 || result.Files != nil || result.Instantiations != nil {
+		t.Errorf("expected only export data but got %+v", result)
+	}
+
+	gofmt, err := Compile(&Config{Importer: importer.Embedded()}, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := Compile(&Config{Importer: importer.Embedded(), Format: Raw}, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formatted, err := format.Source(raw.Files[0].Src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(formatted, gofmt.Files[0].Src) {
+		t.Errorf("expected the raw output to format to:\n%s\nbut got:\n%s", gofmt.Files[0].Src, formatted)
+	}
+}
+
+func TestCompileTests(t *testing.T) {
+	inputs := []Input{
+		{Name: "box.fo", Src: []byte("package box\n\ntype Box[T] struct {\n\tV T\n}\n")},
+		{Name: "box_test.fo", Src: []byte("package box_test\n\nimport \"example.com/box\"\n\nvar b = box.Box[int]{V: 1}\n")},
+	}
+	cfg := &Config{ImportPath: "example.com/box", Importer: importer.Embedded()}
+	result, err := Compile(cfg, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+	if len(result.TestFiles) != 1 || result.TestFiles[0].Name != "box_test.go" {
+		t.Fatalf("expected a single test file named box_test.go but got %v", result.TestFiles)
+	}
+	// The instantiation needed by the tests belongs in the tests.
+	if want := "type box_Box__int struct"; !strings.Contains(string(result.TestFiles[0].Src), want) {
+		t.Errorf("expected the tests to contain %q:\n%s", want, result.TestFiles[0].Src)
+	}
+	if strings.Contains(string(result.Files[0].Src), "Box__int") {
+		t.Errorf("expected the package not to instantiate Box[int]:\n%s", result.Files[0].Src)
+	}
+
+	inputs[1].Src = []byte("package box\n")
+	result, err = Compile(cfg, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Diagnostic{{File: "box_test.fo", Line: 1, Col: 1, End: Pos{1, 8}, Severity: "error", Message: "tests must be in the external test package box_test (found package box)", Code: "type"}}
+	if !reflect.DeepEqual(result.Diagnostics, want) {
+		t.Errorf("wrong diagnostics\nexpected: %v\n     got: %v", want, result.Diagnostics)
+	}
+
+	if _, err := Compile(cfg, inputs[1:]); err == nil {
+		t.Error("expected an error for a package with only tests")
+	}
+}
+
+// TestCompileConcurrent checks that Compile can be called from several
+// goroutines at once. Run it with -race.
+func TestCompileConcurrent(t *testing.T) {
+	packages := []struct {
+		importPath string
+		inputs     []Input
+	}{
+		{"main", []Input{{Name: "main.fo", Src: []byte(boxSrc)}}},
+		{"example.com/box", []Input{
+			{Name: "box.fo", Src: []byte("package box\n\ntype Box[T] struct {\n\tV T\n}\n")},
+			{Name: "box_test.fo", Src: []byte("package box_test\n\nimport \"example.com/box\"\n\nvar b = box.Box[int]{V: 1}\n")},
+		}},
+	}
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		pkg := packages[i%len(packages)]
+		want, err := Compile(&Config{ImportPath: pkg.importPath, Importer: importer.Embedded()}, pkg.inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			for j := 0; j < 10; j++ {
+				result, err := Compile(&Config{ImportPath: pkg.importPath, Importer: importer.Embedded()}, pkg.inputs)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if !reflect.DeepEqual(result.Files, want.Files) || !reflect.DeepEqual(result.TestFiles, want.TestFiles) || !reflect.DeepEqual(result.Instantiations, want.Instantiations) {
+					t.Errorf("expected every compilation to produce the same output but got:\n%s", result.Files[0].Src)
+					return
+				}
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+}
+
+func TestCompileDiagnostics(t *testing.T) {
+	testCases := []struct {
+		src   string
+		name  string
+		diags []Diagnostic
+	}{
+		{
+			src:  "package main\n\nfunc main() {\n\tx := 1\n\tvar y string = 2\n}\n",
+			name: "main",
+			diags: []Diagnostic{
+				{File: "main.fo", Line: 5, Col: 17, End: Pos{5, 18}, Severity: "error", Message: "cannot convert 2 (untyped int constant) to string", Code: "type"},
+				{File: "main.fo", Line: 4, Col: 2, End: Pos{4, 3}, Severity: "warning", Message: "x declared but not used", Code: "type"},
+				{File: "main.fo", Line: 5, Col: 6, End: Pos{5, 7}, Severity: "warning", Message: "y declared but not used", Code: "type"},
+			},
+		},
+		{
+			src: "package main\n\nvar 1x int\n",
+			diags: []Diagnostic{
+				{File: "main.fo", Line: 3, Col: 5, End: Pos{3, 6}, Severity: "error", Message: "expected 'IDENT', found 'INT' 1", Code: "syntax"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		result, err := Compile(&Config{Importer: importer.Embedded()}, []Input{{Name: "main.fo", Src: []byte(tc.src)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Files != nil || result.ExportData != nil {
+			t.Errorf("expected no output for a file with errors but got %+v", result)
+		}
+		if result.Name != tc.name {
+			t.Errorf("expected package name %q but got %q", tc.name, result.Name)
+		}
+		if !reflect.DeepEqual(result.Diagnostics, tc.diags) {
+			t.Errorf("wrong diagnostics for:\n%s\nexpected: %v\n     got: %v", tc.src, tc.diags, result.Diagnostics)
+		}
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	d := Diagnostic{File: "main.fo", Line: 3, Col: 7, Message: "oops"}
+	if got, want := d.String(), "main.fo:3:7: oops"; got != want {
+		t.Errorf("expected %q but got %q", want, got)
+	}
+	d.File = ""
+	if got, want := d.String(), "oops"; got != want {
+		t.Errorf("expected %q but got %q", want, got)
+	}
+}
@@ -0,0 +1,104 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/albrow/fo/scanner"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// A Diagnostic is an error found in Fo source code.
+type Diagnostic struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+	Col  int    `json:"col"`
+	// End is the position right after the token the diagnostic points to.
+	End      Pos    `json:"end"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// Code is "syntax" for syntax errors and "type" for type errors.
+	Code string `json:"code,omitempty"`
+}
+
+// Pos is a line and column in a file, both starting at 1.
+type Pos struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
+}
+
+func (d Diagnostic) String() string {
+	if d.File == "" {
+		return d.Message
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.File, d.Line, d.Col, d.Message)
+}
+
+// Diagnostics converts an error returned by the parser or the type checker into
+// diagnostics. Lists of syntax errors result in one diagnostic per error, and
+// other errors result in a diagnostic without a file. src returns the source
+// code of the file with the given name (or nil if it is not available), which
+// is used to find the end of the token each diagnostic points to.
+func Diagnostics(err error, src func(filename string) []byte) []Diagnostic {
+	switch err := err.(type) {
+	case scanner.ErrorList:
+		var diags []Diagnostic
+		for _, e := range err {
+			diags = append(diags, newDiagnostic(e.Pos, "error", e.Msg, "syntax", src))
+		}
+		return diags
+	case *scanner.Error:
+		return []Diagnostic{newDiagnostic(err.Pos, "error", err.Msg, "syntax", src)}
+	case types.Error:
+		// Soft errors don't prevent the rest of the package from being
+		// checked (e.g. unused variables).
+		severity := "error"
+		if err.Soft {
+			severity = "warning"
+		}
+		return []Diagnostic{newDiagnostic(err.Fset.Position(err.Pos), severity, err.Msg, "type", src)}
+	}
+	return []Diagnostic{{Severity: "error", Message: err.Error()}}
+}
+
+func newDiagnostic(pos token.Position, severity, msg, code string, src func(string) []byte) Diagnostic {
+	d := Diagnostic{
+		File:     pos.Filename,
+		Line:     pos.Line,
+		Col:      pos.Column,
+		End:      Pos{pos.Line, pos.Column},
+		Severity: severity,
+		Message:  msg,
+		Code:     code,
+	}
+	if end, ok := tokenEnd(src(pos.Filename), pos); ok {
+		d.End = end
+	}
+	return d
+}
+
+// tokenEnd returns the position right after the token at pos in src.
+func tokenEnd(src []byte, pos token.Position) (Pos, bool) {
+	if !pos.IsValid() || pos.Offset >= len(src) {
+		return Pos{}, false
+	}
+	rest := src[pos.Offset:]
+	var s scanner.Scanner
+	file := token.NewFileSet().AddFile("", -1, len(rest))
+	s.Init(file, rest, nil, scanner.ScanComments)
+	start, tok, lit := s.Scan()
+	if tok == token.EOF || tok == token.ILLEGAL || file.Offset(start) != 0 {
+		return Pos{}, false
+	}
+	text := lit
+	if text == "" || tok == token.SEMICOLON {
+		text = tok.String()
+	}
+	end := Pos{pos.Line, pos.Column + len(text)}
+	if i := strings.LastIndex(text, "\n"); i >= 0 {
+		end.Line += strings.Count(text, "\n")
+		end.Col = len(text) - i
+	}
+	return end, true
+}
@@ -0,0 +1,65 @@
+package compiler
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/format"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/transform"
+)
+
+// An Instantiation describes the code generated for a generic declaration.
+// Since every instantiation of a generic type or function is a copy of the
+// original declaration, it is a good way to find out where the size of the
+// output comes from.
+type Instantiation struct {
+	Generic string
+	// Name is empty for generic declarations which were never instantiated.
+	Name  string
+	Lines int
+	Bytes int
+	// Sites are the positions of the expressions which caused the
+	// instantiation.
+	Sites []string
+}
+
+// instantiations returns the declarations generated by trans, sorted by the
+// name of the generic declaration. It must be called after all files in the
+// package have been transformed.
+func instantiations(fset *token.FileSet, trans *transform.Transformer) ([]Instantiation, error) {
+	var insts []Instantiation
+	instantiated := map[string]bool{}
+	for _, inst := range trans.Instantiations() {
+		row := Instantiation{
+			Generic: inst.Generic,
+			Name:    inst.Name,
+		}
+		for _, decl := range inst.Decls {
+			if spec, ok := decl.(ast.Spec); ok {
+				decl = &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{spec}}
+			}
+			buf := &bytes.Buffer{}
+			if err := format.Node(buf, fset, decl); err != nil {
+				return nil, err
+			}
+			row.Bytes += buf.Len()
+			row.Lines += bytes.Count(buf.Bytes(), []byte("\n")) + 1
+		}
+		for _, pos := range inst.Sites {
+			row.Sites = append(row.Sites, fset.Position(pos).String())
+		}
+		insts = append(insts, row)
+		instantiated[inst.Generic] = true
+	}
+	for _, decl := range trans.Pkg.Generics() {
+		if name := trans.GenericName(decl); !instantiated[name] {
+			insts = append(insts, Instantiation{Generic: name})
+		}
+	}
+	sort.SliceStable(insts, func(i int, j int) bool {
+		return insts[i].Generic < insts[j].Generic
+	})
+	return insts, nil
+}
@@ -5,13 +5,13 @@ import (
 	"strconv"
 )
 
-func flip____byte__string____byte(f func([]byte, string) []byte) func(string, []byte) []byte {
+func flip__slice_byte__string__slice_byte(f func([]byte, string) []byte) func(string, []byte) []byte {
 	return func(p1 string, p0 []byte) []byte {
 		return f(p0, p1)
 	}
 }
 
-var appendQuote = flip____byte__string____byte(strconv.AppendQuote)
+var appendQuote = flip__slice_byte__string__slice_byte(strconv.AppendQuote)
 
 func main() {
 	fmt.Printf("original: %T\n", strconv.AppendQuote)
@@ -0,0 +1,170 @@
+// Package foexport reads and writes Fo export data.
+//
+// Go export data does not contain any information about generic declarations,
+// so once a Fo package has been compiled to Go there is no way for other
+// packages to instantiate its generic types and functions. Fo export data
+// fills in the missing information. By convention it is written next to the
+// generated Go code in a file named <package name>.foexport.
+//
+// The format consists of a short header followed by Fo source code for the
+// package:
+//
+//	fo export v1
+//	path github.com/foo/bar
+//
+//	package bar
+//	...
+//
+// Generic declarations (including methods with a generic receiver type) are
+// written in full so that importers can monomorphize them. All other functions
+// and methods are written without a body, which is enough to type-check code
+// that uses them.
+package foexport
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/format"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+const (
+	header     = "fo export v1"
+	pathPrefix = "path "
+)
+
+// Ext is the file extension used for Fo export data.
+const Ext = ".foexport"
+
+// Write writes export data for pkg to w. files must be the files that were
+// type-checked to produce pkg.
+func Write(w io.Writer, fset *token.FileSet, pkg *types.Package, files []*ast.File) error {
+	buf := &bytes.Buffer{}
+	WriteHeader(buf, pkg.Path())
+	fmt.Fprintf(buf, "\npackage %s\n", pkg.Name())
+
+	// Imports from all files are merged into one list. The same package may
+	// not be imported under two different names.
+	seen := map[string]string{}
+	for _, f := range files {
+		for _, spec := range f.Imports {
+			path := spec.Path.Value
+			name := ""
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			if prev, found := seen[path]; found {
+				if prev != name {
+					return fmt.Errorf("%s: package %s is imported under more than one name", fset.Position(spec.Pos()), path)
+				}
+				continue
+			}
+			seen[path] = name
+			if name != "" {
+				fmt.Fprintf(buf, "\nimport %s %s\n", name, path)
+			} else {
+				fmt.Fprintf(buf, "\nimport %s\n", path)
+			}
+		}
+	}
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				if decl.Tok == token.IMPORT {
+					continue
+				}
+				if err := writeDecl(buf, fset, decl); err != nil {
+					return err
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil && decl.Name.Name == "init" {
+					// init functions cannot be referred to.
+					continue
+				}
+				if !isGenericFunc(pkg, decl) {
+					stripped := *decl
+					stripped.Doc = nil
+					stripped.Body = nil
+					decl = &stripped
+				}
+				if err := writeDecl(buf, fset, decl); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteHeader writes the header for the export data of the package with the
+// given import path to w. The header must be followed by Fo source code for
+// the package. It is used by Write, and can be used to write export data for
+// packages which were not compiled from Fo files (e.g. the Go standard
+// library).
+func WriteHeader(w io.Writer, path string) error {
+	_, err := fmt.Fprintf(w, "%s\n%s%s\n", header, pathPrefix, path)
+	return err
+}
+
+func writeDecl(buf *bytes.Buffer, fset *token.FileSet, decl ast.Decl) error {
+	buf.WriteString("\n")
+	if err := format.Node(buf, fset, decl); err != nil {
+		return err
+	}
+	buf.WriteString("\n")
+	return nil
+}
+
+// isGenericFunc returns true if decl declares a generic function or a method
+// with a generic receiver type.
+func isGenericFunc(pkg *types.Package, decl *ast.FuncDecl) bool {
+	if decl.TypeParams != nil {
+		return true
+	}
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return false
+	}
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	switch recv := recv.(type) {
+	case *ast.TypeArgExpr:
+		return true
+	case *ast.Ident:
+		_, found := pkg.Generics()[recv.Name]
+		return found
+	}
+	return false
+}
+
+// readHeader reads the export data header from r and returns the import path
+// of the package it describes.
+func readHeader(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("could not read export data header: %s", err)
+	}
+	if strings.TrimSpace(line) != header {
+		return "", errors.New("not Fo export data (or unsupported version)")
+	}
+	line, err = r.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("could not read export data header: %s", err)
+	}
+	if !strings.HasPrefix(line, pathPrefix) {
+		return "", errors.New("export data is missing package path")
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, pathPrefix)), nil
+}
@@ -0,0 +1,186 @@
+package foexport
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+const libSrc = `package lib
+
+type Box[T] struct {
+	V T
+}
+
+func (b Box[T]) Get() T {
+	return b.V
+}
+
+func (b Box) Describe() string {
+	return Describe(b.V)
+}
+
+func Map[T, U](x T, f func(T) U) U {
+	return f(x)
+}
+
+func Describe(v interface{}) string {
+	return "a value"
+}
+
+func init() {
+	_ = Box[int]{}
+}
+`
+
+func writeTestExportData(t *testing.T, path string, src string) []byte {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "lib.fo", src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile returned error: %s", err)
+	}
+	pkg, err := (&types.Config{}).Check(path, fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatalf("conf.Check returned error: %s", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := Write(buf, fset, pkg, []*ast.File{f}); err != nil {
+		t.Fatalf("Write returned error: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func TestWrite(t *testing.T) {
+	data := string(writeTestExportData(t, "example.com/lib", libSrc))
+
+	expected := `fo export v1
+path example.com/lib
+
+package lib
+
+type Box [T]struct {
+	V T
+}
+
+func (b Box[T]) Get() T {
+	return b.V
+}
+
+func (b Box) Describe() string {
+	return Describe(b.V)
+}
+
+func Map[T, U](x T, f func(T) U) U {
+	return f(x)
+}
+
+func Describe(v interface{}) string
+`
+	if data != expected {
+		t.Errorf("wrong export data.\nexpected:\n%s\ngot:\n%s", expected, data)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := writeTestExportData(t, "example.com/lib", libSrc)
+	imp := NewImporter(token.NewFileSet(), func(path string) (io.ReadCloser, error) {
+		if path != "example.com/lib" {
+			return nil, os.ErrNotExist
+		}
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}, nil)
+	pkg, err := imp.Import("example.com/lib")
+	if err != nil {
+		t.Fatalf("Import returned error: %s", err)
+	}
+	if pkg.Name() != "lib" {
+		t.Errorf("wrong package name (expected lib but got %s)", pkg.Name())
+	}
+	for _, key := range []string{"Box", "Box.Get", "Map"} {
+		if _, found := pkg.Generics()[key]; !found {
+			t.Errorf("could not find generic declaration for %s", key)
+		}
+	}
+	if imp.Packages()["example.com/lib"] == nil {
+		t.Error("imported package was not recorded in imp.Packages()")
+	}
+	if _, err := imp.Import("example.com/other"); err == nil {
+		t.Error("expected error when importing a package without export data")
+	}
+}
+
+func TestReadInvalidHeader(t *testing.T) {
+	_, err := Read(token.NewFileSet(), strings.NewReader("package lib\n"), nil)
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+}
+
+func TestImportReadOnly(t *testing.T) {
+	data := writeTestExportData(t, "example.com/lib", "package lib\n\nval Limit = 3\n")
+	imp := NewImporter(token.NewFileSet(), func(path string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}, nil)
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	lib.Limit = 4
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "main.fo", src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile returned error: %s", err)
+	}
+	_, err = (&types.Config{Importer: imp}).Check("main", fset, []*ast.File{f}, nil)
+	if err == nil {
+		t.Fatal("expected an error but got none")
+	}
+	if !strings.Contains(err.Error(), "cannot assign to lib.Limit (Limit is read-only)") {
+		t.Errorf("wrong error: %s", err)
+	}
+}
+
+func TestImporterForget(t *testing.T) {
+	exports := map[string][]byte{
+		"example.com/lib": writeTestExportData(t, "example.com/lib", "package lib\n\nconst Version = 1\n"),
+	}
+	lookup := func(path string) (io.ReadCloser, error) {
+		if data, found := exports[path]; found {
+			return ioutil.NopCloser(bytes.NewReader(data)), nil
+		}
+		return nil, os.ErrNotExist
+	}
+	imp := NewImporter(token.NewFileSet(), lookup, nil)
+	version := func() string {
+		t.Helper()
+		pkg, err := imp.Import("example.com/lib")
+		if err != nil {
+			t.Fatalf("Import returned error: %s", err)
+		}
+		return pkg.Scope().Lookup("Version").(*types.Const).Val().String()
+	}
+	if got := version(); got != "1" {
+		t.Fatalf("wrong version (expected 1 but got %s)", got)
+	}
+
+	exports["example.com/lib"] = writeTestExportData(t, "example.com/lib", "package lib\n\nconst Version = 2\n")
+	if got := version(); got != "1" {
+		t.Errorf("package was imported again before being forgotten (got version %s)", got)
+	}
+	imp.Forget("example.com/lib")
+	if got := version(); got != "2" {
+		t.Errorf("package was not imported again after being forgotten (expected version 2 but got %s)", got)
+	}
+}
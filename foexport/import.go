@@ -0,0 +1,209 @@
+package foexport
+
+import (
+	"bufio"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// Package is a Fo package which has been loaded from export data.
+type Package struct {
+	Types *types.Package
+	// File holds the declarations from the export data. The bodies of generic
+	// declarations are used to instantiate them in importing packages.
+	File *ast.File
+	Info *types.Info
+	// Fset is the file set which File was parsed into. It is needed to find
+	// the positions in File, which are not in the file set of an importing
+	// package.
+	Fset *token.FileSet
+}
+
+// Read reads and type-checks export data from r. imp is used to resolve the
+// imports of the package.
+func Read(fset *token.FileSet, r io.Reader, imp types.Importer) (*Package, error) {
+	br := bufio.NewReader(r)
+	path, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	src, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, err
+	}
+	f, err := parser.ParseFile(fset, path+Ext, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	conf := types.Config{
+		Importer: imp,
+		// Non-generic functions are written without their bodies, so any
+		// imports which are only used inside of them will appear to be unused.
+		DisableUnusedImportCheck: true,
+	}
+	info := &types.Info{
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Uses:       map[*ast.Ident]types.Object{},
+	}
+	pkg, err := conf.Check(path, fset, []*ast.File{f}, info)
+	if err != nil {
+		return nil, err
+	}
+	return &Package{
+		Types: pkg,
+		File:  f,
+		Info:  info,
+		Fset:  fset,
+	}, nil
+}
+
+// Imports returns the import paths of the packages imported by the package
+// whose export data is read from r. Unlike Read, it doesn't type-check the
+// package, so it doesn't need to import them.
+func Imports(r io.Reader) ([]string, error) {
+	br := bufio.NewReader(r)
+	path, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	src, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, err
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), path+Ext, src, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(f.Imports))
+	for i, spec := range f.Imports {
+		if paths[i], err = strconv.Unquote(spec.Path.Value); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+// A Lookup function returns a reader to access export data for a given import
+// path. If there is no Fo export data for the path, the returned error must
+// satisfy os.IsNotExist.
+type Lookup func(path string) (io.ReadCloser, error)
+
+// Importer imports packages from Fo export data. Packages without Fo export
+// data (e.g. ordinary Go packages) are imported with a fallback importer.
+type Importer struct {
+	fset     *token.FileSet
+	lookup   Lookup
+	fallback types.Importer
+	packages map[string]*Package
+}
+
+// NewImporter returns a new Importer. If lookup is nil, export data is located
+// by searching the directory of each imported package (as determined by
+// go/build) for a file ending in .foexport.
+func NewImporter(fset *token.FileSet, lookup Lookup, fallback types.Importer) *Importer {
+	return &Importer{
+		fset:     fset,
+		lookup:   lookup,
+		fallback: fallback,
+		packages: map[string]*Package{},
+	}
+}
+
+// Import is a shortcut for ImportFrom(path, "", 0).
+func (imp *Importer) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, "", 0)
+}
+
+// ImportFrom imports the package with the given import path.
+func (imp *Importer) ImportFrom(path, srcDir string, mode types.ImportMode) (*types.Package, error) {
+	if pkg, found := imp.packages[path]; found {
+		return pkg.Types, nil
+	}
+	var rc io.ReadCloser
+	var err error
+	if imp.lookup != nil {
+		rc, err = imp.lookup(path)
+	} else {
+		rc, err = findExportData(path, srcDir)
+	}
+	if os.IsNotExist(err) {
+		return imp.importFallback(path, srcDir, mode)
+	} else if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	pkg, err := Read(imp.fset, rc, imp)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Fo export data for %s: %s", path, err)
+	}
+	if pkg.Types.Path() != path {
+		return nil, fmt.Errorf("export data for %s describes a different package: %s", path, pkg.Types.Path())
+	}
+	imp.packages[path] = pkg
+	return pkg.Types, nil
+}
+
+func (imp *Importer) importFallback(path, srcDir string, mode types.ImportMode) (*types.Package, error) {
+	if imp.fallback == nil {
+		return nil, fmt.Errorf("can't find import: %q", path)
+	}
+	if from, ok := imp.fallback.(types.ImporterFrom); ok {
+		return from.ImportFrom(path, srcDir, mode)
+	}
+	return imp.fallback.Import(path)
+}
+
+// Packages returns all the Fo packages which have been imported so far, keyed
+// by import path.
+func (imp *Importer) Packages() map[string]*Package {
+	return imp.packages
+}
+
+// Forget removes the package with the given import path from the packages
+// imported so far, so that it is read from its export data again the next time
+// it is imported. Packages which were imported before and depend on it keep
+// referring to the old package, so they should be forgotten as well.
+func (imp *Importer) Forget(path string) {
+	delete(imp.packages, path)
+}
+
+// FindExportData opens the export data file in the directory for the package
+// with the given import path, as seen from srcDir. It is what an Importer uses
+// when no Lookup is given to NewImporter.
+func FindExportData(path, srcDir string) (io.ReadCloser, error) {
+	return findExportData(path, srcDir)
+}
+
+// findExportData opens the export data file in the directory for the package
+// with the given import path.
+func findExportData(path, srcDir string) (io.ReadCloser, error) {
+	if abs, err := filepath.Abs(srcDir); err == nil {
+		srcDir = abs
+	}
+	bp, err := build.Import(path, srcDir, build.FindOnly)
+	if err != nil || bp.Dir == "" {
+		return nil, os.ErrNotExist
+	}
+	matches, err := filepath.Glob(filepath.Join(bp.Dir, "*"+Ext))
+	if err != nil {
+		return nil, err
+	}
+	switch len(matches) {
+	case 0:
+		return nil, os.ErrNotExist
+	case 1:
+		return os.Open(matches[0])
+	default:
+		return nil, fmt.Errorf("found more than one export data file for %s in %s", path, bp.Dir)
+	}
+}
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/token"
+	"github.com/urfave/cli"
+)
+
+// goCommands are the commands of the go tool which fo go supports. They all
+// accept the -overlay flag.
+var goCommands = []string{"build", "test", "vet"}
+
+// foPackage is a package of .fo files in the main module.
+type foPackage struct {
+	importPath    string
+	filenames     []string
+	testFilenames []string
+	// files and testFiles hold only the package clauses and imports of the
+	// files.
+	files     []*ast.File
+	testFiles []*ast.File
+}
+
+// goCommand converts every Fo package in the main module into Go and then runs
+// a command of the go tool (e.g. fo go build ./...). The generated files are
+// written to a cache directory and handed to the go tool with -overlay, so that
+// they appear to be next to the .fo files without ever being written there.
+func goCommand(c *cli.Context) error {
+	if !c.Args().Present() || !isGoCommand(c.Args().First()) {
+		return fmt.Errorf("go expects the go command to run (one of %s) followed by its arguments", strings.Join(goCommands, ", "))
+	}
+	modPath, modDir, err := goModule()
+	if err != nil {
+		return err
+	}
+	pkgs, err := findFoPackages(modPath, modDir)
+	if err != nil {
+		return err
+	}
+
+	// Read the imports of every package first, since the packages need to
+	// be compiled in dependency order. The tests are compiled along with
+	// their package, so their imports count too.
+	fset := token.NewFileSet()
+	for _, pkg := range pkgs {
+		for _, filename := range pkg.filenames {
+			f, err := parser.ParseFile(fset, filename, nil, parser.ImportsOnly)
+			if err != nil {
+				return err
+			}
+			pkg.files = append(pkg.files, f)
+		}
+		for _, filename := range pkg.testFilenames {
+			f, err := parser.ParseFile(fset, filename, nil, parser.ImportsOnly)
+			if err != nil {
+				return err
+			}
+			pkg.testFiles = append(pkg.testFiles, f)
+		}
+	}
+	pkgs, err = sortFoPackages(pkgs)
+	if err != nil {
+		return err
+	}
+
+	cacheDir, err := overlayCacheDir("overlay", modDir)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(cacheDir); err != nil {
+		return err
+	}
+
+	// Fo packages import each other through the builder, which holds their
+	// export data in memory.
+	b, err := newBuilder(c.Bool(typeNamesFlag.Name))
+	if err != nil {
+		return err
+	}
+	replace := map[string]string{}
+	for _, pkg := range pkgs {
+		compiled, err := b.compile(filepath.Dir(pkg.filenames[0]), pkg.importPath, pkg.filenames, pkg.testFilenames)
+		if err != nil {
+			return err
+		}
+		if err := writeOverlay(cacheDir, modDir, pkg.filenames, compiled.Outputs, replace); err != nil {
+			return err
+		}
+		if err := writeOverlay(cacheDir, modDir, pkg.testFilenames, compiled.TestOutputs, replace); err != nil {
+			return err
+		}
+	}
+	overlayName, err := writeOverlayFile(cacheDir, replace)
+	if err != nil {
+		return err
+	}
+
+	args := append([]string{c.Args().First(), "-overlay", overlayName}, c.Args().Tail()...)
+	code, err := runCommand(exec.Command("go", args...))
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		os.Exit(code)
+	}
+	return nil
+}
+
+func isGoCommand(name string) bool {
+	for _, cmd := range goCommands {
+		if name == cmd {
+			return true
+		}
+	}
+	return false
+}
+
+// goModule uses the go command to determine the path and the root directory of
+// the main module. If the go command isn't installed, it reads the go.mod file
+// in the current directory or the closest parent directory instead.
+func goModule() (path, dir string, err error) {
+	if _, err := exec.LookPath("go"); err != nil {
+		return readGoMod()
+	}
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Path}}\n{{.Dir}}").Output()
+	if err != nil {
+		return "", "", fmt.Errorf("could not determine the main module (fo go only works inside a module): %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) != 2 || lines[1] == "" {
+		return "", "", errors.New("fo go only works inside a module")
+	}
+	return lines[0], lines[1], nil
+}
+
+// readGoMod finds the go.mod file of the main module without the go command,
+// and returns the module path it declares and the directory it is in.
+func readGoMod() (path, dir string, err error) {
+	dir, err = os.Getwd()
+	if err != nil {
+		return "", "", err
+	}
+	for {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) < 2 || fields[0] != "module" {
+					continue
+				}
+				if path, err := strconv.Unquote(fields[1]); err == nil {
+					return path, dir, nil
+				}
+				return fields[1], dir, nil
+			}
+			return "", "", fmt.Errorf("%s does not declare a module path", filepath.Join(dir, "go.mod"))
+		} else if !os.IsNotExist(err) {
+			return "", "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", errors.New("fo go only works inside a module")
+		}
+		dir = parent
+	}
+}
+
+// findFoPackages returns every package in the module rooted at modDir which
+// contains .fo files.
+func findFoPackages(modPath, modDir string) ([]*foPackage, error) {
+	var pkgs []*foPackage
+	err := walkModule(modDir, func(dir string) error {
+		filenames, testFilenames, err := foFiles(dir)
+		if err != nil {
+			return err
+		}
+		if len(filenames) == 0 {
+			if len(testFilenames) > 0 {
+				return fmt.Errorf("%s contains _test.fo files but no other Fo files", dir)
+			}
+			return nil
+		}
+		importPath, err := moduleImportPath(modPath, modDir, dir)
+		if err != nil {
+			return err
+		}
+		pkgs = append(pkgs, &foPackage{
+			importPath:    importPath,
+			filenames:     filenames,
+			testFilenames: testFilenames,
+		})
+		return nil
+	})
+	return pkgs, err
+}
+
+// walkModule calls fn for every directory in the module rooted at modDir which
+// may contain a package. Like the go tool, it skips testdata directories,
+// directories beginning with "." or "_", and nested modules.
+func walkModule(modDir string, fn func(dir string) error) error {
+	return filepath.Walk(modDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != modDir {
+			name := info.Name()
+			if name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		return fn(path)
+	})
+}
+
+// moduleImportPath returns the import path of the package in dir, which is part
+// of the module with the given path rooted at modDir.
+func moduleImportPath(modPath, modDir, dir string) (string, error) {
+	rel, err := filepath.Rel(modDir, dir)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return modPath, nil
+	}
+	if strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s is outside of the main module (%s)", dir, modDir)
+	}
+	return modPath + "/" + filepath.ToSlash(rel), nil
+}
+
+// sortFoPackages sorts pkgs so that every package comes after the Fo packages
+// it and its tests import. Since a package is compiled along with its tests,
+// a test which imports a package that imports the package under test is an
+// import cycle, even though the go tool allows it.
+func sortFoPackages(pkgs []*foPackage) ([]*foPackage, error) {
+	byPath := map[string]*foPackage{}
+	for _, pkg := range pkgs {
+		byPath[pkg.importPath] = pkg
+	}
+	var sorted []*foPackage
+	done := map[*foPackage]bool{}
+	visiting := map[*foPackage]bool{}
+	var visit func(pkg *foPackage) error
+	visit = func(pkg *foPackage) error {
+		if done[pkg] {
+			return nil
+		}
+		if visiting[pkg] {
+			return fmt.Errorf("import cycle involving %s", pkg.importPath)
+		}
+		visiting[pkg] = true
+		imports, err := pkg.imports()
+		if err != nil {
+			return err
+		}
+		for _, path := range imports {
+			if dep, found := byPath[path]; found {
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+		visiting[pkg] = false
+		done[pkg] = true
+		sorted = append(sorted, pkg)
+		return nil
+	}
+	for _, pkg := range pkgs {
+		if err := visit(pkg); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// imports returns the sorted import paths of pkg and its tests. The tests
+// belong to an external test package, so their import of pkg itself is left
+// out.
+func (pkg *foPackage) imports() ([]string, error) {
+	seen := map[string]bool{}
+	var imports []string
+	for _, files := range [][]*ast.File{pkg.files, pkg.testFiles} {
+		for _, f := range files {
+			for _, spec := range f.Imports {
+				path, err := strconv.Unquote(spec.Path.Value)
+				if err != nil {
+					return nil, err
+				}
+				if !seen[path] {
+					seen[path] = true
+					imports = append(imports, path)
+				}
+			}
+		}
+		seen[pkg.importPath] = true
+	}
+	sort.Strings(imports)
+	return imports, nil
+}
+
+// overlayCacheDir returns the directory in the user's cache directory where
+// the generated files for the module rooted at modDir are kept. Each command
+// which generates files for the go tool uses a different kind of directory, so
+// that they can run at the same time.
+func overlayCacheDir(kind, modDir string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(modDir))
+	return filepath.Join(cacheDir, "fo", kind, hex.EncodeToString(sum[:8])), nil
+}
+
+// writeOverlayFile writes the overlay file for the go tool's -overlay flag to
+// the cache directory and returns its name.
+func writeOverlayFile(cacheDir string, replace map[string]string) (string, error) {
+	overlay, err := json.MarshalIndent(struct{ Replace map[string]string }{replace}, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	overlayName := filepath.Join(cacheDir, "overlay.json")
+	if err := ioutil.WriteFile(overlayName, overlay, 0644); err != nil {
+		return "", err
+	}
+	return overlayName, nil
+}
+
+// writeOverlay writes the Go code for each Fo file in filenames to the cache
+// directory and records it in replace, which maps the name of the .go file
+// the go tool should see to the name of the file in the cache.
+func writeOverlay(cacheDir, modDir string, filenames []string, outputs [][]byte, replace map[string]string) error {
+	for i, filename := range filenames {
+		goName := strings.TrimSuffix(filename, ".fo") + ".go"
+		rel, err := filepath.Rel(modDir, goName)
+		if err != nil {
+			return err
+		}
+		if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("%s is outside of the main module (%s)", filename, modDir)
+		}
+		cacheName := filepath.Join(cacheDir, rel)
+		if err := os.MkdirAll(filepath.Dir(cacheName), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(cacheName, outputs[i], 0644); err != nil {
+			return err
+		}
+		replace[goName] = cacheName
+	}
+	return nil
+}
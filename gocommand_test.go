@@ -0,0 +1,182 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/token"
+)
+
+// newTestFoPackage returns a package with the given import path whose files
+// have the given sources. Sources in the package name_test are tests.
+func newTestFoPackage(t *testing.T, importPath string, srcs ...string) *foPackage {
+	fset := token.NewFileSet()
+	pkg := &foPackage{importPath: importPath}
+	for i, src := range srcs {
+		filename := filepath.Join(importPath, string(rune('a'+i))+".fo")
+		f, err := parser.ParseFile(fset, filename, src, parser.ImportsOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasSuffix(f.Name.Name, "_test") {
+			pkg.testFilenames = append(pkg.testFilenames, strings.TrimSuffix(filename, ".fo")+"_test.fo")
+			pkg.testFiles = append(pkg.testFiles, f)
+		} else {
+			pkg.filenames = append(pkg.filenames, filename)
+			pkg.files = append(pkg.files, f)
+		}
+	}
+	return pkg
+}
+
+func importPaths(pkgs []*foPackage) []string {
+	var paths []string
+	for _, pkg := range pkgs {
+		paths = append(paths, pkg.importPath)
+	}
+	return paths
+}
+
+func TestSortFoPackages(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pkgs     map[string][]string
+		order    []string
+		expected []string
+	}{
+		{
+			name:     "independent",
+			pkgs:     map[string][]string{"m/a": {`package a; import "fmt"`}, "m/b": {`package b`}},
+			order:    []string{"m/b", "m/a"},
+			expected: []string{"m/b", "m/a"},
+		},
+		{
+			name: "chain",
+			pkgs: map[string][]string{
+				"m":   {`package main; import "m/a"`},
+				"m/a": {`package a; import "m/b"`, `package a; import ("fmt"; "m/c")`},
+				"m/b": {`package b; import "m/c"`},
+				"m/c": {`package c; import "strings"`},
+			},
+			order:    []string{"m", "m/a", "m/b", "m/c"},
+			expected: []string{"m/c", "m/b", "m/a", "m"},
+		},
+		{
+			name: "diamond",
+			pkgs: map[string][]string{
+				"m/a": {`package a; import ("m/b"; "m/c")`},
+				"m/b": {`package b; import "m/d"`},
+				"m/c": {`package c; import "m/d"`},
+				"m/d": {`package d`},
+			},
+			order:    []string{"m/a", "m/b", "m/c", "m/d"},
+			expected: []string{"m/d", "m/b", "m/c", "m/a"},
+		},
+		{
+			// The tests of m/a import m/a itself and m/b.
+			name: "tests",
+			pkgs: map[string][]string{
+				"m/a": {`package a`, `package a_test; import ("m/a"; "m/b")`},
+				"m/b": {`package b`},
+			},
+			order:    []string{"m/a", "m/b"},
+			expected: []string{"m/b", "m/a"},
+		},
+	}
+	for _, tc := range testCases {
+		var pkgs []*foPackage
+		for _, path := range tc.order {
+			pkgs = append(pkgs, newTestFoPackage(t, path, tc.pkgs[path]...))
+		}
+		sorted, err := sortFoPackages(pkgs)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if got := importPaths(sorted); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected %v but got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestSortFoPackagesCycle(t *testing.T) {
+	testCases := [][]*foPackage{
+		{
+			newTestFoPackage(t, "m/a", `package a; import "m/b"`),
+			newTestFoPackage(t, "m/b", `package b; import "m/c"`),
+			newTestFoPackage(t, "m/c", `package c; import "m/a"`),
+		},
+		{
+			newTestFoPackage(t, "m/a", `package a; import "m/a"`),
+		},
+		{
+			newTestFoPackage(t, "m/a", `package a; import "m/b"`),
+			newTestFoPackage(t, "m/b", `package b`, `package b_test; import ("m/a"; "m/b")`),
+		},
+	}
+	for _, pkgs := range testCases {
+		if sorted, err := sortFoPackages(pkgs); err == nil {
+			t.Errorf("expected an error for an import cycle but got %v", importPaths(sorted))
+		} else if !strings.Contains(err.Error(), "import cycle involving m/a") {
+			t.Errorf("expected an import cycle error but got %q", err)
+		}
+	}
+}
+
+func TestWriteOverlay(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "fo-overlay-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	modDir := filepath.Join(tempDir, "mod")
+	cacheDir := filepath.Join(tempDir, "cache")
+
+	filenames := []string{
+		filepath.Join(modDir, "main.fo"),
+		filepath.Join(modDir, "list", "list.fo"),
+		filepath.Join(modDir, "list", "list_test.fo"),
+	}
+	outputs := [][]byte{[]byte("package main\n"), []byte("package list\n"), []byte("package list_test\n")}
+	replace := map[string]string{}
+	if err := writeOverlay(cacheDir, modDir, filenames, outputs, replace); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		filepath.Join(modDir, "main.go"):              filepath.Join(cacheDir, "main.go"),
+		filepath.Join(modDir, "list", "list.go"):      filepath.Join(cacheDir, "list", "list.go"),
+		filepath.Join(modDir, "list", "list_test.go"): filepath.Join(cacheDir, "list", "list_test.go"),
+	}
+	if !reflect.DeepEqual(replace, expected) {
+		t.Errorf("wrong replacements\nexpected: %v\n     got: %v", expected, replace)
+	}
+	for i, filename := range filenames {
+		cacheName := expected[strings.TrimSuffix(filename, ".fo")+".go"]
+		if got, err := ioutil.ReadFile(cacheName); err != nil {
+			t.Error(err)
+		} else if string(got) != string(outputs[i]) {
+			t.Errorf("expected %s to contain %q but got %q", cacheName, outputs[i], got)
+		}
+	}
+
+	overlayName, err := writeOverlayFile(cacheDir, replace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overlayName != filepath.Join(cacheDir, "overlay.json") {
+		t.Errorf("expected the overlay file in the cache directory but got %s", overlayName)
+	}
+
+	outside := []string{filepath.Join(tempDir, "other", "main.fo")}
+	if err := writeOverlay(cacheDir, modDir, outside, outputs, replace); err == nil {
+		t.Error("expected an error for a file outside of the module")
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "other")); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be written outside of the cache directory (error: %v)", err)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 Alex Browne. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package importer
+
+//go:generate go run mkstdlib.go
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+var (
+	stdlibOnce     sync.Once
+	stdlibPackages map[string][]byte
+	stdlibErr      error
+)
+
+// Embedded returns an Importer for the Go standard library which uses type
+// information embedded in the binary, so it works without a Go installation.
+// The type information is generated by mkstdlib.go for GOOS=linux and
+// GOARCH=amd64, and leaves out generic declarations, which can't be used from
+// Fo. Packages outside of the standard library can't be imported.
+func Embedded() types.Importer {
+	return foexport.NewImporter(token.NewFileSet(), lookupEmbedded, unsafeImporter{})
+}
+
+func lookupEmbedded(path string) (io.ReadCloser, error) {
+	if err := loadStdlib(); err != nil {
+		return nil, err
+	}
+	data, found := stdlibPackages[path]
+	if !found {
+		return nil, os.ErrNotExist
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+// loadStdlib unpacks stdlibData the first time it is called.
+func loadStdlib() error {
+	stdlibOnce.Do(func() {
+		stdlibPackages = map[string][]byte{}
+		stdlibErr = readArchive(strings.NewReader(stdlibData), stdlibPackages)
+		if stdlibErr != nil {
+			stdlibErr = fmt.Errorf("could not read the embedded type information for the standard library: %s", stdlibErr)
+		}
+	})
+	return stdlibErr
+}
+
+// readArchive reads a gzipped tar archive from r and adds each file in it to
+// files, keyed by name.
+func readArchive(r io.Reader, files map[string][]byte) error {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		files[hdr.Name] = data
+	}
+}
+
+// unsafeImporter only imports package unsafe, which has no export data.
+type unsafeImporter struct{}
+
+func (unsafeImporter) Import(path string) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	return nil, fmt.Errorf("can't find import: %q", path)
+}
@@ -10,6 +10,7 @@ import (
 	"go/build"
 	"io"
 	"runtime"
+	"sync"
 
 	"github.com/albrow/fo/token"
 	"github.com/albrow/fo/types"
@@ -75,10 +76,36 @@ func For(compiler string, lookup Lookup) types.Importer {
 
 // Default returns an Importer for the compiler that built the running binary.
 // If available, the result implements types.ImporterFrom.
+//
+// If the compiler has no export data for the standard library (e.g. because Go
+// isn't installed, or because it is Go 1.20 or later, which no longer ships
+// with export data), Default returns Embedded instead.
 func Default() types.Importer {
+	if !haveExportData() {
+		return Embedded()
+	}
 	return For(runtime.Compiler, nil)
 }
 
+var (
+	exportDataOnce  sync.Once
+	exportDataFound bool
+)
+
+// haveExportData returns true if the compiler that built the running binary
+// can import the standard library from export data.
+func haveExportData() bool {
+	exportDataOnce.Do(func() {
+		imp := For(runtime.Compiler, nil)
+		if imp == nil {
+			return
+		}
+		_, err := imp.Import("errors")
+		exportDataFound = err == nil
+	})
+	return exportDataFound
+}
+
 // gc importer
 
 type gcimports struct {
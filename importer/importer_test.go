@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/albrow/fo/internal/testenv"
+	"github.com/albrow/fo/types"
 )
 
 func TestFor(t *testing.T) {
@@ -68,3 +69,50 @@ func TestFor(t *testing.T) {
 		}
 	})
 }
+
+func TestEmbedded(t *testing.T) {
+	if err := loadStdlib(); err != nil {
+		t.Fatal(err)
+	}
+	imp := Embedded()
+	for path := range stdlibPackages {
+		pkg, err := imp.Import(path)
+		if err != nil {
+			t.Errorf("%s: %s", path, err)
+			continue
+		}
+		if pkg.Path() != path {
+			t.Errorf("Path() = %q, want %q", pkg.Path(), path)
+		}
+	}
+
+	pkg, err := imp.Import("io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := pkg.Scope().Lookup("Reader")
+	if reader == nil {
+		t.Fatal("io.Reader not found")
+	}
+	file, err := imp.Import("os")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ptr := types.NewPointer(file.Scope().Lookup("File").Type())
+	if !types.Implements(ptr, reader.Type().Underlying().(*types.Interface)) {
+		t.Error("*os.File does not implement io.Reader")
+	}
+
+	fmtPkg, err := imp.Import("fmt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	println := fmtPkg.Scope().Lookup("Println").Type().String()
+	if expected := "func(a ...interface{}) (n int, err error)"; println != expected {
+		t.Errorf("expected the names of parameters and results to be kept: got %s, want %s", println, expected)
+	}
+
+	if _, err := imp.Import("github.com/albrow/fo/types"); err == nil {
+		t.Error("expected an error importing a package outside of the standard library")
+	}
+}
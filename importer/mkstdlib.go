@@ -0,0 +1,665 @@
+// Copyright 2018 Alex Browne. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+// +build ignore
+
+// mkstdlib generates zstdlib.go, which holds the type information for the Go
+// standard library that is used by Embedded.
+//
+// The packages are loaded from source with the go/types importer of the Go
+// installation running this program, and their APIs are written as Fo export
+// data: every declaration which can be referred to from another package,
+// without function bodies. Unexported struct fields are replaced by blank
+// fields, since all that matters to other packages is whether the struct is
+// comparable. Generic declarations can't be expressed in Fo, so they are left
+// out, along with anything which refers to them.
+//
+// Usage:
+//
+//	go run mkstdlib.go [-goos linux] [-goarch amd64] [-o zstdlib.go]
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/constant"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/albrow/fo/foexport"
+)
+
+var (
+	goos   = flag.String("goos", "linux", "target operating system")
+	goarch = flag.String("goarch", "amd64", "target architecture")
+	output = flag.String("o", "zstdlib.go", "output file")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mkstdlib: ")
+	flag.Parse()
+
+	build.Default.GOOS = *goos
+	build.Default.GOARCH = *goarch
+	build.Default.CgoEnabled = false
+	imp := importer.ForCompiler(token.NewFileSet(), "source", nil)
+
+	paths, err := stdPackages()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Packages which are only referred to by other packages (e.g. internal
+	// packages) are added to the queue as they are found.
+	var queue []*types.Package
+	for _, path := range paths {
+		pkg, err := imp.Import(path)
+		if err != nil {
+			log.Printf("skipping %s: %s", path, err)
+			continue
+		}
+		queue = append(queue, pkg)
+	}
+	written := map[*types.Package]bool{}
+	exportData := map[string][]byte{}
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		if written[pkg] {
+			continue
+		}
+		written[pkg] = true
+		w := newPackageWriter(pkg)
+		data, err := w.write()
+		if err != nil {
+			log.Fatal(err)
+		}
+		exportData[pkg.Path()] = data
+		queue = append(queue, w.importedPackages()...)
+	}
+
+	archive, err := writeArchive(exportData)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "// Code generated by mkstdlib.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(src, "package importer\n\n")
+	fmt.Fprintf(src, "// stdlibVersion is the version of Go that stdlibData was generated from.\n")
+	fmt.Fprintf(src, "const stdlibVersion = %q\n\n", runtime.Version())
+	fmt.Fprintf(src, "// stdlibData is a gzipped tar archive holding the Fo export data for %d\n", len(exportData))
+	fmt.Fprintf(src, "// packages in the Go standard library (GOOS=%s, GOARCH=%s).\n", *goos, *goarch)
+	fmt.Fprintf(src, "const stdlibData = \"\" +\n")
+	const lineLen = 4096
+	for i := 0; i < len(archive); i += lineLen {
+		end := i + lineLen
+		if end > len(archive) {
+			end = len(archive)
+		}
+		fmt.Fprintf(src, "\t%q", archive[i:end])
+		if end < len(archive) {
+			src.WriteString(" +")
+		}
+		src.WriteString("\n")
+	}
+	if err := ioutil.WriteFile(*output, src.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d packages (%d bytes compressed) to %s", len(exportData), len(archive), *output)
+}
+
+// stdPackages returns the import paths of the packages in the standard library
+// which can be imported by other packages.
+func stdPackages() ([]string, error) {
+	cmd := exec.Command("go", "list", "std")
+	cmd.Env = append(os.Environ(), "GOOS="+*goos, "GOARCH="+*goarch, "CGO_ENABLED=0")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list std: %s", err)
+	}
+	var paths []string
+	for _, path := range strings.Fields(string(output)) {
+		if path == "unsafe" || strings.HasPrefix(path, "vendor/") || isInternal(path) {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func isInternal(path string) bool {
+	return strings.HasPrefix(path, "internal/") || strings.Contains(path, "/internal/") || strings.HasSuffix(path, "/internal")
+}
+
+// writeArchive returns a gzipped tar archive holding a file named after each
+// import path.
+func writeArchive(exportData map[string][]byte) ([]byte, error) {
+	var paths []string
+	for path := range exportData {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	buf := &bytes.Buffer{}
+	zw, err := gzip.NewWriterLevel(buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	tw := tar.NewWriter(zw)
+	for _, path := range paths {
+		data := exportData[path]
+		if err := tw.WriteHeader(&tar.Header{Name: path, Mode: 0644, Size: int64(len(data))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// packageWriter writes the export data for a package.
+type packageWriter struct {
+	pkg *types.Package
+	// imports holds the name under which each imported package is referred
+	// to, and names holds the names which are taken.
+	imports map[*types.Package]string
+	names   map[string]bool
+	decls   []string
+	// queue holds the unexported types which the exported declarations refer
+	// to, which have to be declared as well.
+	queue    []*types.TypeName
+	declared map[*types.TypeName]bool
+}
+
+func newPackageWriter(pkg *types.Package) *packageWriter {
+	w := &packageWriter{
+		pkg:      pkg,
+		imports:  map[*types.Package]string{},
+		names:    map[string]bool{},
+		declared: map[*types.TypeName]bool{},
+	}
+	for _, name := range pkg.Scope().Names() {
+		w.names[name] = true
+	}
+	return w
+}
+
+func (w *packageWriter) write() ([]byte, error) {
+	scope := w.pkg.Scope()
+	for _, name := range scope.Names() {
+		if obj := scope.Lookup(name); obj.Exported() {
+			w.writeObject(obj)
+		}
+	}
+	for len(w.queue) > 0 {
+		obj := w.queue[0]
+		w.queue = w.queue[1:]
+		w.writeObject(obj)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := foexport.WriteHeader(buf, w.pkg.Path()); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(buf, "\npackage %s\n", w.pkg.Name())
+	var imports []string
+	for pkg, name := range w.imports {
+		if name == pkg.Name() {
+			imports = append(imports, strconv.Quote(pkg.Path()))
+		} else {
+			imports = append(imports, name+" "+strconv.Quote(pkg.Path()))
+		}
+	}
+	sort.Strings(imports)
+	for _, imp := range imports {
+		fmt.Fprintf(buf, "\nimport %s\n", imp)
+	}
+	for _, decl := range w.decls {
+		fmt.Fprintf(buf, "\n%s\n", decl)
+	}
+	return buf.Bytes(), nil
+}
+
+// importedPackages returns the packages the export data refers to, other than
+// unsafe.
+func (w *packageWriter) importedPackages() []*types.Package {
+	var pkgs []*types.Package
+	for pkg := range w.imports {
+		if pkg.Path() != "unsafe" {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path() < pkgs[j].Path() })
+	return pkgs
+}
+
+func (w *packageWriter) writeObject(obj types.Object) {
+	switch obj := obj.(type) {
+	case *types.Const:
+		w.writeConst(obj)
+	case *types.Var:
+		if expressible(obj.Type()) {
+			w.decls = append(w.decls, fmt.Sprintf("var %s %s", obj.Name(), w.typeString(obj.Type())))
+		}
+	case *types.Func:
+		sig := obj.Type().(*types.Signature)
+		if expressible(sig) {
+			w.decls = append(w.decls, fmt.Sprintf("func %s%s", obj.Name(), w.signature(sig)))
+		}
+	case *types.TypeName:
+		w.writeType(obj)
+	}
+}
+
+func (w *packageWriter) writeConst(obj *types.Const) {
+	typ := obj.Type()
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || !expressible(typ) && basic.Info()&types.IsUntyped == 0 {
+		return
+	}
+	val, ok := constString(obj.Val(), basic)
+	if !ok {
+		log.Printf("skipping %s.%s: can't write its value %s", w.pkg.Path(), obj.Name(), obj.Val())
+		return
+	}
+	if basic.Info()&types.IsUntyped != 0 {
+		w.decls = append(w.decls, fmt.Sprintf("const %s = %s", obj.Name(), val))
+	} else {
+		w.decls = append(w.decls, fmt.Sprintf("const %s %s = %s", obj.Name(), w.typeString(typ), val))
+	}
+}
+
+func (w *packageWriter) writeType(obj *types.TypeName) {
+	if w.declared[obj] {
+		return
+	}
+	w.declared[obj] = true
+	if obj.IsAlias() {
+		if alias, ok := obj.Type().(*types.Alias); ok && alias.TypeParams().Len() > 0 {
+			return
+		}
+		target := types.Unalias(obj.Type())
+		if expressible(target) {
+			w.decls = append(w.decls, fmt.Sprintf("type %s = %s", obj.Name(), w.typeString(target)))
+		}
+		return
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 {
+		return
+	}
+	var underlying string
+	switch u := named.Underlying().(type) {
+	case *types.Struct:
+		underlying = w.structType(u, true)
+	case *types.Interface:
+		if !u.IsMethodSet() {
+			// Constraint interfaces can only be used by generic declarations.
+			return
+		}
+		underlying = w.interfaceType(u, true)
+	default:
+		if !expressible(u) {
+			log.Printf("skipping %s.%s: can't write its type %s", w.pkg.Path(), obj.Name(), u)
+			return
+		}
+		underlying = w.typeString(u)
+	}
+	w.decls = append(w.decls, fmt.Sprintf("type %s %s", obj.Name(), underlying))
+
+	for i := 0; i < named.NumMethods(); i++ {
+		m := named.Method(i)
+		sig := m.Type().(*types.Signature)
+		if !expressible(sig) {
+			continue
+		}
+		recv := obj.Name()
+		if _, ok := sig.Recv().Type().(*types.Pointer); ok {
+			recv = "*" + recv
+		}
+		w.decls = append(w.decls, fmt.Sprintf("func (%s) %s%s", recv, m.Name(), w.signature(sig)))
+	}
+}
+
+// expressible returns true if t can be written in Fo.
+func expressible(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Basic:
+		return t.Kind() != types.Invalid && t.Info()&types.IsUntyped == 0
+	case *types.Alias:
+		return expressible(types.Unalias(t))
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			return obj.Name() == "error"
+		}
+		// Types declared inside of functions have no name which can be
+		// referred to.
+		return t.TypeParams().Len() == 0 && t.TypeArgs().Len() == 0 && obj.Parent() == obj.Pkg().Scope()
+	case *types.Pointer:
+		return expressible(t.Elem())
+	case *types.Slice:
+		return expressible(t.Elem())
+	case *types.Array:
+		return expressible(t.Elem())
+	case *types.Chan:
+		return expressible(t.Elem())
+	case *types.Map:
+		return expressible(t.Key()) && expressible(t.Elem())
+	case *types.Signature:
+		if t.TypeParams().Len() > 0 {
+			return false
+		}
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if !expressible(tuple.At(i).Type()) {
+					return false
+				}
+			}
+		}
+		return true
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if !expressible(t.Field(i).Type()) {
+				return false
+			}
+		}
+		return true
+	case *types.Interface:
+		if !t.IsMethodSet() {
+			return false
+		}
+		for i := 0; i < t.NumExplicitMethods(); i++ {
+			if !expressible(t.ExplicitMethod(i).Type()) {
+				return false
+			}
+		}
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			if !expressible(t.EmbeddedType(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	// Type parameters, unions, and tuples.
+	return false
+}
+
+// typeString returns t as it would be written in the package. t must be
+// expressible. Any unexported types it refers to are added to the queue.
+func (w *packageWriter) typeString(t types.Type) string {
+	switch t := t.(type) {
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer {
+			return w.importName(types.Unsafe) + ".Pointer"
+		}
+		return t.Name()
+	case *types.Alias:
+		return w.typeString(types.Unalias(t))
+	case *types.Named:
+		obj := t.Obj()
+		switch obj.Pkg() {
+		case nil:
+			return obj.Name()
+		case w.pkg:
+			if !obj.Exported() && !w.declared[obj] {
+				w.queue = append(w.queue, obj)
+			}
+			return obj.Name()
+		}
+		return w.importName(obj.Pkg()) + "." + obj.Name()
+	case *types.Pointer:
+		return "*" + w.typeString(t.Elem())
+	case *types.Slice:
+		return "[]" + w.typeString(t.Elem())
+	case *types.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), w.typeString(t.Elem()))
+	case *types.Map:
+		return fmt.Sprintf("map[%s]%s", w.typeString(t.Key()), w.typeString(t.Elem()))
+	case *types.Chan:
+		elem := w.typeString(t.Elem())
+		switch t.Dir() {
+		case types.SendOnly:
+			return "chan<- " + elem
+		case types.RecvOnly:
+			return "<-chan " + elem
+		}
+		if c, ok := t.Elem().(*types.Chan); ok && c.Dir() == types.RecvOnly {
+			return "chan (" + elem + ")"
+		}
+		return "chan " + elem
+	case *types.Signature:
+		return "func" + w.signature(t)
+	case *types.Struct:
+		return w.structType(t, false)
+	case *types.Interface:
+		return w.interfaceType(t, false)
+	}
+	panic(fmt.Sprintf("unexpected type %T", t))
+}
+
+// signature returns the parameters and results of sig. The names of the
+// parameters and results are kept, since they document what they are for
+// (e.g. in the REPL's :type command).
+func (w *packageWriter) signature(sig *types.Signature) string {
+	var params []string
+	for i := 0; i < sig.Params().Len(); i++ {
+		t := sig.Params().At(i).Type()
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			params = append(params, "..."+w.typeString(t.(*types.Slice).Elem()))
+		} else {
+			params = append(params, w.typeString(t))
+		}
+	}
+	s := "(" + w.tuple(sig.Params(), params) + ")"
+	var results []string
+	for i := 0; i < sig.Results().Len(); i++ {
+		results = append(results, w.typeString(sig.Results().At(i).Type()))
+	}
+	switch {
+	case len(results) == 0:
+		return s
+	case len(results) == 1 && sig.Results().At(0).Name() == "":
+		return s + " " + results[0]
+	}
+	return s + " (" + w.tuple(sig.Results(), results) + ")"
+}
+
+// tuple returns the list of parameters or results in t, whose types are
+// given by typs, with their names if they have any. Go requires either all of
+// them or none of them to have names.
+func (w *packageWriter) tuple(t *types.Tuple, typs []string) string {
+	list := make([]string, len(typs))
+	for i, typ := range typs {
+		list[i] = typ
+		if name := t.At(i).Name(); name != "" {
+			list[i] = name + " " + typ
+		}
+	}
+	return strings.Join(list, ", ")
+}
+
+// structType returns the struct type t. If hide is true, the fields which
+// can't be used by other packages are replaced by blank fields that keep the
+// struct comparable or not.
+func (w *packageWriter) structType(t *types.Struct, hide bool) string {
+	var fields []string
+	comparable, incomparable := false, false
+	for i := 0; i < t.NumFields(); i++ {
+		f := t.Field(i)
+		if hide && (!f.Embedded() && !f.Exported() || !expressible(f.Type())) {
+			if types.Comparable(f.Type()) {
+				comparable = true
+			} else {
+				incomparable = true
+			}
+			continue
+		}
+		field := w.typeString(f.Type())
+		if !f.Embedded() {
+			field = f.Name() + " " + field
+		}
+		if tag := t.Tag(i); tag != "" {
+			field += " " + strconv.Quote(tag)
+		}
+		fields = append(fields, field)
+	}
+	if comparable {
+		fields = append(fields, "_ struct{}")
+	}
+	if incomparable {
+		fields = append(fields, "_ [0]func()")
+	}
+	return "struct{" + strings.Join(fields, "; ") + "}"
+}
+
+// interfaceType returns the interface type t. If skip is true, the methods
+// which can't be expressed are left out.
+func (w *packageWriter) interfaceType(t *types.Interface, skip bool) string {
+	if w.overlaps(t) {
+		// Fo doesn't allow the embedded interfaces to have methods in common,
+		// so the whole method set is written instead.
+		var methods []string
+		for i := 0; i < t.NumMethods(); i++ {
+			m := t.Method(i)
+			if sig := m.Type().(*types.Signature); !skip || expressible(sig) {
+				methods = append(methods, m.Name()+w.signature(sig))
+			}
+		}
+		return "interface{" + strings.Join(methods, "; ") + "}"
+	}
+	var elems []string
+	for i := 0; i < t.NumEmbeddeds(); i++ {
+		if embedded := t.EmbeddedType(i); !skip || expressible(embedded) {
+			elems = append(elems, w.typeString(embedded))
+		}
+	}
+	for i := 0; i < t.NumExplicitMethods(); i++ {
+		m := t.ExplicitMethod(i)
+		if sig := m.Type().(*types.Signature); !skip || expressible(sig) {
+			elems = append(elems, m.Name()+w.signature(sig))
+		}
+	}
+	return "interface{" + strings.Join(elems, "; ") + "}"
+}
+
+// overlaps returns true if more than one of the embedded interfaces and
+// explicit methods of t declare the same method, and the method set of t can
+// be written without them (i.e. it has no unexported methods from other
+// packages).
+func (w *packageWriter) overlaps(t *types.Interface) bool {
+	seen := map[string]bool{}
+	overlap := false
+	add := func(m *types.Func) {
+		if seen[m.Name()] {
+			overlap = true
+		}
+		seen[m.Name()] = true
+	}
+	for i := 0; i < t.NumExplicitMethods(); i++ {
+		add(t.ExplicitMethod(i))
+	}
+	for i := 0; i < t.NumEmbeddeds(); i++ {
+		if embedded, ok := t.EmbeddedType(i).Underlying().(*types.Interface); ok {
+			for j := 0; j < embedded.NumMethods(); j++ {
+				add(embedded.Method(j))
+			}
+		}
+	}
+	if !overlap {
+		return false
+	}
+	for i := 0; i < t.NumMethods(); i++ {
+		if m := t.Method(i); !m.Exported() && m.Pkg() != w.pkg {
+			return false
+		}
+	}
+	return true
+}
+
+// importName returns the name under which pkg is referred to, and adds it to
+// the imports.
+func (w *packageWriter) importName(pkg *types.Package) string {
+	if name, found := w.imports[pkg]; found {
+		return name
+	}
+	name := pkg.Name()
+	for i := 2; w.names[name]; i++ {
+		name = fmt.Sprintf("%s%d", pkg.Name(), i)
+	}
+	w.names[name] = true
+	w.imports[pkg] = name
+	return name
+}
+
+// constString returns a constant expression for val, which is the value of a
+// constant of the given type.
+func constString(val constant.Value, typ *types.Basic) (string, bool) {
+	info := typ.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		return val.String(), true
+	case info&types.IsString != 0:
+		return strconv.Quote(constant.StringVal(val)), true
+	case info&types.IsComplex != 0:
+		re, ok := floatString(constant.Real(val))
+		if !ok {
+			return "", false
+		}
+		im, ok := floatString(constant.Imag(val))
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(%s + (%s)*1i)", re, im), true
+	case info&types.IsFloat != 0:
+		return floatString(val)
+	case info&types.IsInteger != 0:
+		val = constant.ToInt(val)
+		if val.Kind() != constant.Int {
+			return "", false
+		}
+		if typ.Kind() == types.UntypedRune {
+			if r, ok := constant.Int64Val(val); ok {
+				return strconv.QuoteRuneToASCII(rune(r)), true
+			}
+		}
+		return val.ExactString(), true
+	}
+	return "", false
+}
+
+// floatString returns val as the exact quotient of two integers, where the
+// dividend is a floating-point literal so that the division isn't truncated.
+func floatString(val constant.Value) (string, bool) {
+	val = constant.ToFloat(val)
+	num, denom := constant.Num(val), constant.Denom(val)
+	if num.Kind() != constant.Int || denom.Kind() != constant.Int {
+		return "", false
+	}
+	s := num.ExactString() + ".0"
+	if d := denom.ExactString(); d != "1" {
+		s = "(" + s + " / " + d + ")"
+	}
+	return s, true
+}
@@ -0,0 +1,45 @@
+// Code generated by mkstdlib.go. DO NOT EDIT.
+
+package importer
+
+// stdlibVersion is the version of Go that stdlibData was generated from.
+const stdlibVersion = "go1.27.1"
+
+// stdlibData is a gzipped tar archive holding the Fo export data for 190
+// packages in the Go standard library (GOOS=linux, GOARCH=amd64).
+const stdlibData = "" +
+	"\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xfdks\xdbH\x92?\n\xf7\xeb\xf9\x14\x88~\xb3\x94\xc3\x7f\x0f\x01\x82 yt\xe69A\x91\x94\xc41oKRn\xf7\xf4v( \xb2$aM\x02\x1c\x00\xb4\xa5\xfe\x87\xbf\xfb\x13u\x032\xeb\x02R\xb2=\xeaٕb\xa6MT\xfe2\xab*랕U\x15\xa6\xab\xfb\xe83\xf9k\x1e\xa6?\xfd\xa8\xbfz\xbd^\x0f|\x9f\xfd[\xafו\x7f]\xaf\xee\xb6d\x18\x0fw݆_\xffɩ\xff\xf4/\xf8\xdbg4\xeb\xf5\xfa\xf7\xc8$\xccܿ\xc9\xdfm␇]\x92\xe6\xceg\xf7/\xbb0\xbfw@\x8d\xf8\xcb_v\xe1\xeaSxG\x1c\xf6\x11m\x19\xf0\xe7(\xf9\x19~\xfc\xf56\x03\xdfy\xb4%?\xff\xe5/\x9f\xc3\xd4\x19\xa4\xe9yD6\xebe\x92\x8c\x92\xf8\xce!i\x9a\xa4\x05钄k\x92*\x81\xc38#\xab}Jf4%\x98\xf4K\x1a\xe5\xa4{\x9b\x93\xb4\xb7I2b\xa2*\x11\xdd\xee\xe3\x95s\x1em\xc80\xbeMxt\xb5\xdbȹ\xcd\xde\xc9\xc0\xb7\xce&\x8a?9Y\x9eF\xf1݉S{\xc3Qo\xb9\x84\x93\xbf\xfc%\x7fܑB\xc4$ܒ̉✤\xb7\xe1\x8a\xfc_ \xe8Թ\x88\xc3-\xa9\x9d85.L\x8a8u\xaē\xafRx\x92nÜJ\x15\t\xae\xf1\x90\x13\xe7>\xccj\xb7\x9e#?o\x92d#!od\xe06|<#\x00e\x02L\xe3\xcd!\xd0>\xcb'In\x04Î\xa5\xbev\"\x94\xf5\x97\xbf\xac\x928\xcb\x05\xfabr%~9\x7fsژ6\xeb~,i>\xa6]-\x96\xddyI\xf5\x14j\xfc)N\xbe\xc4%\xbd.t&\xaaN\x96\xa7\xfbU\xfe\x7f\x97\x8f;r\xbb\t\uf71bǜ\x9c:\xb4\x94D\"O\x9dQ\x14\x7f\x8aa\xc0\"\xfa\x83Pe\a\xfe\xa93N\xd6\xc5\xef\xabhM\x7f\x9e:\x17\xf2\xc7\x15\xe2\xbb@_\xe3d\xbd\x8c\xb6ġ\x95\xfd\x1d\xfdu\xeatW+\x92ejh\xef>\x8c\xef\x88\x1a\xda'\x9f\xb7\xe1\x7f'\xa9\x8c\x9c~Gq\xf9\xfd1\xcc\xf34s\xb6\xe1\xee7\x1e\xe3\xef2\xe2Y\xf7㜬\x92tm\xa4\nE\xf1\x7f\xbe\xca\x12\xe4\xda:q\xc2\xcd&\xf9B֜\x9a\xd1\x1ay\v\xf1o\x9d]\xf8p\xb96\xc5\xcb\xeal\xd1&D\xe5\x91be\x13\xa8\x9d\xc0\x96%`\x13\xf2e\xce\x1b^\xeaDɻ\xb9\xe0y\xc3\x7f\x94 \xd6|\xd3\xda\x17\n\xe2\xbfO\x9c7\xfc\x87(\xf39*\xf3k\xf9\xe3k\x91\xcd7R\xf8\x84<\xe45ScVp\xf1\x91\xb8\xfb0^oȜ\xdc\xed7aJ\xf3W\xbb_\xa7N\x91\x7f\xd8٨L\x8b]\x98fD\xe5y\xeb\xa4\xe1\x97K\xfa}\xb3IV\x9fl\"R\x12\xae/&W\\Ƭ\xfb\x913g8\xf6Z\xc6\xc8\xfd0\x0f3k\x0e\xa8$\u0380\xf2ˣ\xaf\xe4\x9an\xca$\x8c\xc3\x1d\xce\xc5\xcd\xe6S\x91\x85\xa3\xd2A\xff\xad\xdd8\xbf\xfdN[\xea\x89S\x8b\xe2܊\xfd\xc2;t\\%j\xac\x81\x94<\xbc\xb7\xa0\x1d\xc0\x19M\x86\xf37\xe7?\xfc\xff\x80\xc1\xbd\xfb0\xa5\xa1\r\x1c\x9aĴ;\xf9\x8f\x16\n\xedG\f\xdaD\x81\xe7\xd1mBC\x03\x14z1\xb9\xa2\x03\r\xed](\xf1\xbd\x89\xc8\xfa\xa1\xbf9\xff1R\x89\\\x9b\x94\xb4@$)\xcdE\xa1srG\x03\xebj`\x97\x86\xfe\xd7C\x1d\x13\x16\x8fۍ\x10\xe3!\xc2ǋMr\x13nD\xcf\xf97\xe7?\xee0\xb9$<\xfc\x87hq\\\xe9z\x8b;u\xae\x9d\xdf\xea\xbf\xd3\U000aa754\xcdO\x96\xd1\xf9f\x9f\xdd\xd7\xd4J-\xa9\xec_Q\x17+\x9a\x91\x84\xb3Z\xc0F\x89'\xf2\x14\xed\x05WYk\x17W)\xecbru|\xf49\xd9\xee6aN>\xb4f\x9b}\x86\xa3\xbf\xdd\xe6\x8b<\x15\xc3\b\xefvs\x11>\xd9o\x9dx\xbf\xbd!i\x11~\"Z\x979Q\xf3\xf0\v\xebX\xc0\xc0\xf4\xd6Y\x87yX|\x14\x83\xe2[\a\xf5\xf4\x95y\x9d\x87_D^\xcb\xe6\xfd\xd6Ɋa\x13\x88\xb5\xc9\xe9\xae\xd7\xe7\x8b\xdam\xf6\x98\xb1\x11aQY\x17\x0e\xf5\a\x12L{\xa3\xf34\xd9\xe2\x81D\xed\x10\x14&6S,\xeb\"\xab\xd6,G\xceoM\xd7c\xd1\x16<\xa2#˓\x0f\xadډ\xf3\xe6\x9e\xc5\xf0\xa1\xa5\xd3/&W%\xe0br\xa5#h}-!\xf4K\xc7\\aЕ\x19\xc5\xfb\n:\xebb?\xbai\x1a>\xaa\xa8;\x92\xf3r\xad\x9d\x88\x02V\x11Y\x81\xc0\xf5@ŭ\x92\xedn\x9f\x93\xde=Y}\xca\xf6[:X\xec\xe3,\xba\x8b\xc9Z\x16>\xfc\xd2\xf8S\x92\x91\xbc&\xa7\xcd`Dp~\xfb\x9d\x7f\r\xe2<}\x94t\xdc\n\x1c֡\xf0\xba\xf0V\x10\xa5(\xa5a`\xa8L\vC\xcab3\x14\xb0$\x9d8bF\xfe\x9b\x8d\xbeM֕\xf4}\xb4\xae\"\xdfU\x93i[:\x10;\x9d&VAV\xf7\xa2|\xac\b\xaa\x8c\xf3MxW\x85\xa1#\xc5\x04\xab\x02\xe8\x90\xce\xe9mJ\xbc\x98\\\x9d8\x9f\xcd\xed\x04 \xb6\xe1]\xb4\xb2\xa5\x80\xcb i\x16%q%f\x9f\x91tRQb\ft\x97&\xfb\xddAԚ|\x1eӉ\xf7AP\x14\x1f\x00\x85\xc5t\xbf\x12\xb6*\xe6\xff\x95\xb0\xac\xaa\x99\x03\\J\xc2\xcd\"\xfa\xc3Rbl1e+2J<Pf\x1cRYhBJu\xa9qСb㨃\xe5\xc6a\x87\n\xaeDE\xf1!\xd4.%\xb7\xd1C5\xe6p\xf1r\xdc\xe1\xf2\xe5\xb8<\r\xa3\rI\xcd\xe5vUYpWG\x94\xdc\xd5\x11EwuT\xd9]\x1dWxWG\x96\xde\xd5q\xc5wu\\\xf9]Y\n\x10\f7\xac\xe9(܀r\xe2\x10:\x02\xd5\":j\xc8\xe66ؐ\xad\x11\x1be\x83\x87\x9c\xc4k\xa2\xf7\xe7\b\xb7\r\x1f\xe8\xc0\x16\x11\xba\xaaf\x96\x1c\x90\"Jy\x94\x93\xe7\xe9\xedmFr\xb9\xce\x1f\x91\xf8.\xbf\xe7__\xb1d\xc6ES\xbb\xe6,\\p\xe0c\xd1\x1b\xb25\xa6\x8b\x12N\x9cDr\xda!\x1b\x96\x82\x12\xf2\xd3\xeb\xdf\xff\xae?i\xed\xfd#ڽ\x90\xfd\xbf\x1e4\xfc\xa6f\xff\x0f\x1a\xaf\xf6\xff\x97\xb5\xff\xff\x11\xedJ\xfb?\xfbx\x8a\xfd\x9f\xf5R\xbdd\xbbKI\x96%bƮ\xd8r\xc4o\xb6HSm\xee}\xb2R\x98ѺO\xfc\xe4\xacҐѧf\xe0\x9c8\xfb(\xce݀[\xa2\xc5\xf6@ws\x97\xa4Q~\xbfU\xb6\r\xe4RG\t\x16\x86\xd4\xc3{\x13\xc5\xfe\x80\xec\xe0\xe9o\xbe~\xa6f\x12\xddHI\xe9'\x0e]\x14\x15\xfdz-\x01\x83\x82\xd1\xd4ʙ\xa6;\x12\u05f8\xdeʼ\xbf\xb5c\xe7\xe1\x17\x00\xb7Bo\xa3x}\x96\xac\x1f\xcb\xf4(k\xea\"\x8f\xd8\xec\x8e\xec\xec\xbdd\xbb%q^|O\x92\xf8jy\xdef\x9b\x16\xa7N/%a\x9e\xa4\x1f\xf8\xd4C\x94ϩ0骡tՒ\x15_c\x92\xdf'\xeb\xf23YG\xb7\x11YCk\xba\f\xa3_\x1a\xb0_V\x88S\xa77\xef5<\xf6\xd5\xf0N\x8b\xdaI\xd6lS@\x06_\xc5+3\x01\xe3\x03\xdfًa\\\xe5\x00\xa4\xc1C\x9e\x86bpe_$\x8d\xc3M\x97\x99\xf7\xb9\\\\5\x8e2\xaac\xe8\xb8X.\x16:1\xe2\x16$\x97м\x84\x9e\xd8dR\x815\xba\x10\x96\t\xa0a'\x15\x92ɑ\xe8\xfb0\xa3տO\xb2U\x1a\xedr6\xdb\x03{[\x95\xfbu'H\x96R\xa1\x8d{\r\xdd\x1c\x1a\xafN\n\x03\xb3\xce[\xb9\x05\xc1@\xacQ\xf1\x18\x80\xd1M\xca4vc%A6\x9byE\xe7P\xa2ͦ+\xbc\aº\x9d\xdf~g\n\xd1[\xe0A{mѕ\xc6Q^K\xd7\x15:3oO\xcc\xc9]\x94\xe5$\x85=um\v\x9b\xeb[gMI\xa8/\xd7\r\xfdk+\xff\t\xe24&\x9c\xe6}\x14e\xcc褐Yw\x89\xcbI\xd4$\xeb\x9eC\xb2#\xf1(I>\xedw\x98\xf1ͭ\x88F\x98\xaf\fl\xf4w?Jk\xeb(-\xd8~\xfb\xdd\xc4'\xf5ֳj\x8d)\xad\xa7\xa9\xec\xd9\n\xe7\xa3\xe3\"OR06ֿ\xcdʿ \xb9\x180b\xd5\x18x\xdcF\xc0\x82\xe4\xa2\xca\xd6V\xa8\xea\xda\x18\x94\x06\xa1R\xe9\x10C\x94\xf2.Z\xb2\xd5@\xbcK\xc9.LI\xed\xf6\xdeA\x9dTU$\xb2gRX\x8e\x88\x8d\xf3\xd3q\xf99\xcc\xc9\xee\xb1v\xeb\x88Qۜ\xc0g\u05ed\xa7\x1b\xef퍶\a\x9a,\xabb\xa8\x15T\xed\xe0\" \xf54`6\xedڭ\xe8\xfd\xfbQ\xca(\x9a\x8a\x14>a\x90\x90\x8e\nf\x900\xa3\x89E\xb5\x19#\x86A0\xa4ِt\x0f\xed8\xe40\xebGʐ\xa7\xa7\xedQ\x18\x12\x84\xa3\xc9\u05ca\x04Z\xc7~5^>\x9b\xa8!\xef\x97j=j.\x1fEi\xc2\xd2\x00鬈\xe3\x14e\xfcԔ\xf2S\x83\xbeOqa\x9e\xa2b;\xd5\x15uj(\x89\xaf\xafV\r\xfb\xdf\xcd\xfe6J~p\x1c\a\xd6\xffͦ\xd7P\xd6\xffu\xcfw_\xd7\xff/\xb3\xfeg5\xa2\\\xf9\x8bO\xb4\xf6\x97\x8b\xea\xf5\xe70^Q\xaf\xbb\xf3Pu\xe4;\v\xd7l6\x9b\xeccu\x1d}\xb6\xbf\xbd%\xe9\xf9~\xb3QW\xddQ\x1cn\x96\xc9'\x12k+\xef\xcf\xe1&Z_\xc5t\x13\xf8\xec1'U\xf4\xf9>V\xe9\x13r\x17\xe6\xd1g\"\x12l\xa1\x9aҪx\x14\xf2\x99\xd48|X\xac\u0098\xa5\x94\xad\x10\xff\xe6\x04\xcdf#\xc0\xeb\x10\xb1\x9eH\x9db\xc5\xf1\xc5)F\xcf7%B_\xbd\xac+]\xa5\xf87\xeb\a!\xb2\x9c\xb2\x1bxhrc\xcd\tK\x84>e\tT\x80X\xfc\x00\x88\xa2\xd7\x1c\xb7\xf0$\xf3\x8d\\\x03\t\xe0\xd7\x03.^\a\x160\xe5\x90`X\xa3\xd0\x19*̵\x06\xe1{\xd67\xfb[G\xee'W\xc2o\xa3ͦf\xf6\x95\x1a\xa4iͶX\x9a\x11\xf2\x89ϔO\x9cb\xe3ڲ\n\xe9G\xd9*L\xd7\x05|Ϳ\xf9\xae\xbb\xd1>\x84\xfc\xabv\xa5?E|\x14\amPtԮLT\xd9\xf6j\xf6\x05!o|TV\xea\xa4\xfb\x98\x94\x95\xa22\x11e\xbb\xb5\xca\xe6}\x06Y[\xcb9\\/6ъ\xd4\xd6d\x13m\x85wJm\x13\xc5\xc4\x01ڮ\xd2\xc2(\xe2)G<Q6c{Ml\xd2R)b\x95l6d\x95\x9f\xa7\xe1\x1d]\xcbd8!\xb7\xfb͆g\x81\xbaBH鷴\xbf\x93\x1cE\x9cy\x92\x87\x9b\x119\xbe씸\x0e\xd4.\xa6*>\xb1Cl\xd8;Yc\xfb\xc5\xe8\x8e\x17W\x18-\x91#\xdf\xd9\xfe\xb6ړ\x8f1\xd1\x0eI\xe4(\xcc\xc3B#a>\x98\x9e\xb3\"8qj\xa1\xe8Ùvr6XXK\x98ʣ\xe5\xfa=\xe5\xcd\xf7\xdfW\xde/ԙ\xf7\xdb\xe5\xb1\x0eTt\xe8G\xf7\xa0\x02\x7f☺\xae\x82\xc8KDے-\xe8K\xeeG\xab,\xb7\n2\xfd\xa1\xaew\n\xa2ȝ\xec\xeb̠\x8c\xe44\x81\x86:V\xa6\x915.ԍo\xc3\a&Ԑ\xa2\xdd&\xcak\x19\xfd/\xff}\xbe\x8fW\x85\x0ee\x00\xdfu\xf8>\x05\xf3\\#\x8b>\xb2\x95+}:l\xc1&\xf5D\xfbK\xf7s\x18m\u009b\x8d-\x82\x82.T\xab\x95\xbf\x04Z\xbaf\xec\xe4\a\a%{φx\xd8X\xb3\xaat4d\xff\xb2q\x83\x8f7\xb4\x1b\xab\x1ap\x10\x9f\xe8\x043`.\xaap?\x9c\x9b\xdd\x0fM\x1d\xe0\xbf\xd7\xfa\x8f6\xee\x17]\xff\xb9t\x03X[\xff\x05\xde\xeb\xfa\xef\x85\xd6\x7f\xb4F\x80\xf5\x1f\xff4\xee\xfd\xee\xe3h\x95\xac\x8b\xed^\xde\x13\x1c\xdd\xcbq\xb8u|\x91\xe4\x83\x1d\x91\x04j\xe6*\x85~\xcc\x1c\\b\xc9v\x97?\xaa\xa3\x96$\x8eH\xactv\x92\xd2\vw\x16\x8a\xad\xbb\x95\xf4e\xba\x8fŴ\x8d\a-I\x9fK\xd7Z%<O\x1f/\xd2\xe4\xcb\xd9\xe3\x9cdl\x02,3\xc8:36Ti<wi\xf2E\xe2Li\xb9(\xe9\x1aM\xefέ\xbd-b\xd1{\xdbÌO\xe8s\x8dq>i\xba\x8a8+\xc6\x1e\x84\xc3c\xcfq9z\x9a\xf2\xd8a&QV\x96z\x7fp\r\a\x81OZ\xa0IƊ\x05\x1a\x86\x18ׇj:\xb3\xa7-\xd2${\xfa\xbc5\x1e\x8cݴ\xeea\xfc\xd6cn\xbdM\x12\xc3#\n\xa8\b\xe8FG\x98\x92Z9A,\x81e\xbb\xa2\x87\x8d\xc2(\xce\n)o\x9dl\x7f\xc3\x1ak\x81\x06\u074c\x84w\xe3G\xc0\xb1\xba\x0fӲ\xed\x18\xe0t\xc6\n\xf0\xb7\xc2g\x86UL>a50\xb1\x12-\x99dEF\xc8}\x9cײ2\xe5dg\xca\xe2ކ\xa9ݐ\xdb$-\v(\xbc\xcdIZ\xa62\xd9\xc7k\xd4I\xf5\xf6\xf9(̾\xa34\xbe~\a\xf2\xb8\xf3h)\xf2\b\x19\x8b\xfd-\x96\x91\xb1\x00k\xb2t!\x83\x7f\xeeÍ\xb1\x9e\x00]3\xd0y\xb2Y\x83\x98r\x05\b̒azG\x94\x93\xced\xb3\xcej\x19\xa8\xac\xa8\xbar:\xab(YUEQ\xd8.\xc3\xec\x90\x12A&.\xc3쐺\x00z\x18\xaf\xc9\xc3\xc1\xea\xc5P\xb4=d\x96\xf6\xa0@Y/\x04ڎc\x14hQ\x84\x03U\xa1\xb0\xb0\xf6\x92i\xed\xa5D\xfd=\x89\xe2\x1a4\xf1\xc0\f!\xad\xd2Z~\\\xee\v\xe4q\x1a(\xe0L\vY\x85\x16\n\xe4\xd34A\x8f\x81n\xc3\xdd.\x8a\xef0P\f(Z~\x85\xd1<b>\x12\xceߜ\xa6\xeb\x95\xd6dm\xe1~\xe2\x88.[\xc5h3\b\r'\xec\xe7e\xe3*\xec\xe0\xdfb^\xd6g{\xba\xe19\xf05\xa2z\xd6\xf58{^7\a\x157\xb9\xbd-ܖ^Δ\xbb\xba\xffζ\xdc\x05\x9d\x87c\x9f\xc7/\xf7D\x98R\xacG\xfa\xbe\xdd\x10ɧ\xd0E\x91\x14^5;\x12B\xad\xaf\xd86\x90>ᚓ\xdd&\\\xc1&\x95l\xd6\xc5\xef\x98|)\x7f۹\xbb\x9b\xcdA\x01*'38\xc2fE\x8bC\x10\x85)\xcb1\xf77H\x0e\x83\xb2\xfb3\x9e\x8a\x9f\x18\x19@>u\xbe\xa7\xb1,\xa3|Cj\x999\xff\xcbd\x94|!\xe9\x01\xf2bGVQ\xb8\xa9\xad\x1c\xb1(}'Bzaf\xec\x95\x04\xf3\x81\xa8\x19\xf9\xb9\xb2\xafv\xbb\xaat3\xf2se\x7f`\x1b\x9e\xcb\xf36\x1c\x8fx\x1d\x03\xbb\t*[\x1am\x01~\xb5\xcfi\x1b,\xdd\xd5\x14\xe81\xe3\x82\xc64\"\xb7\xf9\x13\xe2\xa0\xf0g\xc5shN\xa21̣\xbb\xfb\xa7\xa4\x8c៕\xb4\xc5\x0e\xf6\x13&\xc0\x81\x19ҿ\xf00\xcej\xbb\xfb\xe1q\x1c\xf0\xff\xa8\xd7}\xc5\xff\xa3\xdej\xd5\xfdW\xfb\xdf\xcb\xd8\xffV[p\xee\x83~\xfc\xf4\xfa\xf7?\xf7O\xbaq\xfe\xf5\xe6\x8fh\xe7\xbdL\xfb\xf7\x9au\xc5\xfe\xef\xd5\xeb\xc1k\xfb\x7f\xa1\xf6\x8fj\x04\xd8\b\xe0\x9fh#\xa0ꎧ\xe2\xa7\xdcXe˭}\x1an\x06i\x9a\xa4\x8a\x95^\xa1\xb2-iv4D\xc0^[\xea\x0fn\xff\xec\xe8܋\xb4\x7f\xb7\xd5jj\xed\xbf\xf5z\xff\xe3K\xb7\x7fV#\xca\xf6/>Q\xfb禝3\x92\xe5\xd2\xe9?Jb\xe7oN\a\x92\x16;B\xa8\xdd\xc7-N\x85\xa6\xe9~\x97\x0f\xe3\xdd>\xe7]\x01\xb2\xa0hd\xad/(\x8f{\x86\xfb\x8d\x12\xf3\xffq%\xf9r\x7f{\xbb\rcz\xfb!\r\xf7D\xecØ\x9f\xc13uB\x88\xa6G{Do'\x0f\xa4bh?Z!/ķ\xce:Z\x95k4\v\xaf\xee\x8d\xf9\xd6ِ\xcfd#\xec$o\x8c\aF\nF\x16\xa9\x99Y\x89_\x97\xc458I\xb0n\xeb\xc0\x90V\xa8O\xbf\xd5` M0ؖfQ*4͕\x8e\xfc\x85\xaaNi\x06\xa8\xad\x8a\x7f\x96\xae\xa2\x19\xc9sĢ\xf9z*\xb9\x94I*\x1dr\x9e\x96\x87\x92Œ\t\xd5ɧ±\x87\xfd\v}\x8b*-|\xc79\xf4T\x9f\x8f\xe2\xdaYg9\xf4\x18\x82\xfd\xffݏ\xbb\x01\xe0@\xff\ufe6e:\xffs[\xde\xeb\xfc\xef\xa5\xfb\xff;t\x03\xc0\x9d\xfd\n\x00q\xe4\xff\xa9\x83\xc1\x81^\xbc\xfa\x84>\xbe=\xd8p+\xacz\x1e\x16\x9f\xc46\\\xe0\x8aγO\x17l\xbb\xe4\xab}0\xa9\x1a\f\xbe\xe5\x90q\x01\x1a\xd1\xde\xfa\t\xbd\xff\xe1>\xbb" +
+	"T\xce\xe5\x81C\xc8f\xdfy\x8b=\x7f\xbc\xdf\xe4Q\x96\xa7$\xdc֒O\x8a\xcf\tt\x90/\x1ct\x0e9\x1b\xe3\xfbJ\xe9\r\x8a\xe0\xc0\xf7\xf7s~7\x1d\xb1Ɲx\x95\x9ed\xf1\xb1\xf3Ӗbz\x8e\xdb\xe6\x17递\x81\xed\xa3\x8a\v\x1buϚC\x9e\x8fPKU>\x8fO\x19o~z\xfd\xfb\xb7\xfc+z\xfb\xcd\x1f_~z\x99\xf1\xdfmy\xda\xf8\xdfl\xb5^\xc7\xff\x17\x1e\xff7\x7f|)\x87\x7f\xf6aX\xfb\x8d\x16g\xce4\xe5W\a\u05cb\xbd~\x10\xe8V\r\x95o\x9d\x84\xc1\x18\x98^Ɵ\xff\x12\xad\xf9\x8dhOZ\x16UK\x01\xb7\f\x89^\x9e'\xae\xbc\xa9홮\x01t\x9c\x1a-\xce\xd8=\xa9\xe2|}ň6>\n\xf9\x94;\xb2\xd7d\xc5NL\x1f\x1aٌ#{\x96\xae\x8e+\b\xf3\x8d!O`\xff\x96#\x10l\x88\xa3:^\x89\xfbr*\xc7\xc2\xf1\x11\xc0(^]F\xd5\xf7S\x1f7\x8dx\xf6\xc2\xeb(e\xa3\xe9\xc5\xf1\xec\xcf\xee\xff\xff\xd8D7/\xd4\xff7=__\xff5_\xfb\xff\x17\xee\xffi\x8d\x007\xc0\xb1\xafo\xb2\xfe}ӂ\x8f\x9a\xb3\xa2$\x0e\xd3\xc7ʕೖkU7\xaa\x1dk\xc4;$\xe4\a\xae\xfc\f\xdc?\xd2\xf8\xf7\x1d,oO\x1f\a\x8e]8\x95\xcbƧ\xac\x82\xbe\xaf\xed\xed\xa7\x7f\xa3\xbf\x15w\x05'\xe9_\xefI\xb8{\x91\xfe\xbf\xee\x1b\xf6\x7f\x1a\xaf\xfd\xff\x8b\xf5\xff\xb0F\x94\x03\x00\xff\x92\x03@\x96\xa4\xf9υc\xf9C\xed\xde\x19\xca\xfe\xe0\xad\x13\xc1\xa9̐N`\x00\xf9\x04n\xc4\xd0\x00ГP\xa9\xef\n©3Kv\xea\xfd>3\xda\x0e\x1f`X\xd1[P4\x8c\xc8p\x83\x12\xe3FiŢ\no\xcdm\xf2\x99\x982\x85d\xfe\xf4\xef\xffW\x96\xf6&\xca\xf2\x17i\xff^=hh\xed?x\xb5\xff\xbfx\xfb\xa75\x02X\x00\xd8\x17k\xba\xf4\xeepa[\xa7c\xf7\x87p\xb3'\xb8\x95\x9a\f\xa6\x82\xabx\xa0K\x06\xe8\x80YJ>#\x00\x8b\x95\xdeLVu{\x1c\xa5\x9f\xf0\xee\xe6\xc4a_\nI\xf7\xe4\xe7\xe1\xe7i\x12\x1b\x13\xc4\xc9g\xe1ꓝ\xba\t\xffx\xe4q*\x84(\xceH\x9a\xd7H\xc1H\xef\x0fp\xcaLZ\xe4q6\xa6\xd2\xdag\xa8\xd4\xe3\xd8S\xdes\x95\xb1\xaa\xc9R\xc8X\xaa\x82\x9dk\u008c\x97\xd2q0\xedZ\xb9&Q\xb2\xad)\xa5x\xa6\xda\xe3\xe0C\xa6\x973v\xd2JU\xcc6L?\x1dV\r\x97\xc0=\xe0\x9f%`\x9c|&˄\xe7Ѯ`\x8eb9\xab\x06\x89\xbc\xc0\xb2\xc0\xe90\xf0\xf0\xd4\x1f\xcd\"uL\xbfjI~OR\xde0Nleg\x03Nȗ\xa2Q\xfd\xf4\xfa\xf7?\xe7\xaf\xec\xed\xe9\xe6ы\x8c\xff\xf4\x02@m\xfco\xbe\xde\xff\xff\xe2\xe3?\xf7m\x91\xe3?\xf6\x81\x92g\xd2\xdf́\x17\x0f=\x12\xf8\xb49\xc1\\\x9c^\xe4c\xf6\x1c^\xdb\xc0Ir\xae` \xc9Y\x82\x81D\xbbJ%\x85\x88N\x9fZ\xace\x8e\xf82!\xaeb\xfa:V\xa5\f\xfd\\ \v\xef'5qL\x04\x0ek\x7f\xd6kahi\x93\x87\xfc\x87\xc6qp\xfe\xef\x06\xea\xfd/\x81\xfb\xda\xfe_\xb0\xfd\x93\a0\xf1/\x02\x14\x7f\x1fV\xebل\x84\x9d\x92Z\xe5\x0f\xecb\x01\xf2\x90\x17\a\xa5N\x98\xafG\xb2\x13_\xc87\x84\xceK\xe8\xfbQ\xf1\xbav\"\x19\xa5\x87h\x18\xafȦ\x17\xee3R\xdeĵ\xa2\x9f\xf8Z-\x8e+!'\xdc(̃\xc9\x1a\x19똴\xdaJƄ\xbd>D \xe8\xaf\xfa$\\oĕ\x80k\xf1\xbb\xf4\x16z\xebHG\x97S\xa7\x9f0\xd4\xff\xfb\x7f\xe8[`\xc0\x9c\t\xeeT;u\xf8|\xfe\x13y\xc4s]\xf0\xf1\x95\xa7]\xc6;xX\x11\xb2V\xf2\xb0\x9c\xf6\xa7PY,\xf0\x97(\xbf\xe79\xae\xed\u0094.ˊ,\xe22Y1\x10Pډ&\x81+\xe9\tb\x8a2\x82\xb2\n\xddaAo\x1d\xf0lǉS+\x82\xcd)\x92RLiB\xa2\xde:\xb0j\x1c\x94KY\x92}\xaeI\xccy8\x97\xdbߧ!\xddn8Z\x9c9\x95F\x99OL/\xaf:\xaad\xa5*\xbdu>\x87\x1b\\\xb7\xf4Z\u0092i\xac(\x12\xfb\xd3\xeb\xdf\xff\xb2\xbfU\xfa\xb8\xcb\x7f\xf0\x05\xe0\a\xc6\xff\x86\xebi\xe3\xbf\xdf|\xbd\xff\xed\xa5\xc6\x7fV#\xc0\xf0/\xbe\xe5\xe8\x7f\x1ff\xf7?\x9bw\x82G\xdd\xf7\x03\xef\xe6\xdak\x06\xf4\x1e\x98{\xba\xeb\xdbR\x89\x8d\xb6_\x10\xdb*\xb1\xe9z\x05\xb1\x83\x89\x19\x12\x1b\x94τ\x85\xbbl\xbf\t\xf3$E\xa3\xb7\f&\xb5U\xb4\xbb')\x1bދ\xfd\xbe\xec>L\xc9\xfa=y4\xdc\x1cu\xea\f\xe2Rh\xed\x04}~-\xe3\xa5j!J\xa44\xac\x96\x861\xba\x97{\x9b\xdd\x15\xd1$\xbb<+\x99\xa7\xbb<;qj\xbbM\x18\xc5 \x8185\xb3\xfd͆\xbd\xea\xc9\x7f\xbc'\x8fZ\"\xa8\x1cl\x99\xe3f\xdaب\x1c|\xdf\x1e\xcc.\xa9\x19u\xa3kP\xc6\xc0\x8ac\x0f\xd6@4\xe0\x84\x05\x9f\xf3\x19\x1f\xfd\x89\x89\x96k\xfa\x04Q\xbb\xf1\x94\x87s\xfb\x13\xadz\xeft\x89\xf0n=~\xb9\x90pC\xeb\x97U\xad\fk\xca0\x0f\x84-.\xbb\xae\f/j\xe5x\xd4_t\xc7\xfb\x02/\xb7\xc0\xc7$\xcb\xc2;\xb2\xa0OaC\xc5\xf2\x00\xfaF\xc5],0\x87+\x03g\x125\x81\xbe\xae\x1d\xe6\xfb\xd4t\xa3\x99\xd4\xf9,\x8d>\x879y\x8f'\x00\x92(\xeb\b\xa6\xf1\xfċ\xb3\xc1\xb8\xef\x06u\x99\xa3\x8e\xf2\xb2\x10\r\xae\xdd3j9\x85/\xb5^>%\x04\xb4\xd5\x00\x81\x9eW(܇\xc1e\xc3m\x82`\xd0\x13\x040\xf8\x1a\x88q\xeb\x98\x02\xba\x00\x17Q`\xb7\xe2!\n\xecS`bAx\v\a\xa3\x04\xf8*\r$\xa1)\xaf<\x01\x05\x9e\xf1Z\xc1\x8b\xf5\xad\xf3\xfd\xca_\x1e\xa3Uk\x9d\xde?\xf0\x1a\xa8U\xbdutG\xb2\xfc\x1bk_\xc9\x00\x92\xa0\xb4\xf7\xd7\aW\xfe\xdd\xe6\x7f\x7f\r\x7f\xe4%\xc0\x87쿍\xba\xf6\xfe\xab\xe7\xbe\xee\xff\xbe\xe8\xfc\x8fֈr\x0e\x18\xc2+\x80\x05\x80\xcf\n\xca\xc9\xdf&Y}\x12\xaf\xa1\x14ӳ\xf7\xe4\x91\x06\x15\xa7|\xe5\xb8\r\xc3\xed\xe7l{,\x06f5)fn<\xd6w,\xb6\xb7\xff\x967n\xff)\xdb?W\xeb˴\x7f\xcfo\x04\xba\xff\xef\xeb\xf9\x8f\x97m\xff\xbcF\x80e\xa0\xf8F\xeb>\xd6Ȼ\x83n\x1fL\x06&I\xbc\"\xe5<\xfe\x94?Ĺ\x063\x8f\x98\"쫋\xb7N\xb8^G\xcc\xd9w\xd3GG\xa4\xf1\xa5ݧ\xce\xf43I\xef\xe9y\t\x11ӂ\x84\x9b\x8a\x98\xf4u\x96%\"\xfe\xaf\x9c\xf2\xb0\xbe\x06\xae\x9fdO'\xa3\x95\v?\x18s\x96\xae\xa48\xb6ª\x02\xa0\x88\xe8\x8buU\x91\xf5\xa8$\x16\x9aU\xc8+\xbaг^\xb1J\xac\xdd8\xa2ی>\x17Y-\xe2D<\x83\xf8\xa9<\xe7g \x1e\xa60\x9do\xc1Έ\"&\x10ѱL\xcb\xf9\xd1؋\xdeX\f\x18\x1c|\xe2\xd4he\xd5=\xa7/zcj -\xab.\xe4\x82O[U\xf3\xcf\xc3x\x9dl\x99\x94'Ż\f\xef\f\xb1\xe6<\xb42\xe2\xe9\xf9Y\xed\xa6B\x13\xf2\xe6\x1d\x12nA\xa5\xfa8\x9d\xd3\x11\x98\x05\x1f\xaa\x94\x1c\x85\x0fH-D\xe8\xa93/\x17\x18_\xc1U>\x05\x878\xcfT\xc6av\xb6\x061a\xff\xf02\xa6_J\xd7o\xd3\xf5\f\x90Y:x\x83\xdcT\xb8xc\xce\xffm\xa7ZEo\xbf~\xd1\xf9\x7f\xab\xa5\xcd\xff\x1b\xaf\xf6ߗ\x1d\xff\xd7p\xfe\xbf~\xda\xfc\xbf\xfd\xaf\x9d\xfe\x17\x1c\xcb4\xdamH\x7f\xb0x]9<\xb5\xfdgድ\x7f\xb7\xe1k\xf3\x7f\xcf{\xf5\xffx\xe1\xf6\x9f\x85\xa0\xfdga\xd9\xfe\xd1\xdd/\xdb0\xbf\xff\xebMt\xf7\xb3\xfa\x18ki\x89\x86\x1e\f\x17$&)\xb7^\xd7vi\xf4\xd9yS\x9a\xb3Uc鉑s\x16\xa6\xe1\x96\xe4$\xcd\xe8.v\xb8͜7e\x90no\xa5S\xb7\xcc)\x10\xb4\xdb\xc9N\xf0a\xc1\x11}nxB\xcd\xe2\x18\x06O\xb4\x8f\xbc\xbaߞP\xbb\xb0\x86q\x15L3\xd01\x85EzԨ\xb7<3\xa6!M\xf8\x98P\x1eR/\xb3)gG3\xe7\xcdMtG\x0f̜:\xff\t~_\x14\xbf\r\x9b\x06\x92\xb7\xb4\x16\x7f\xd4\xe1\x92X\xa0\x8b\xb8O\x9d_!\xbc\xb0\x7f3ss\xb2\x85\xba\xd7\v\x98n%\x94=rZ\b\xa2\x17]\x97\xbf\xb59\xda\a\x92F\xb7\x8f\xb5\xdd\xfe\xc6yS$\rI{똅\x89\r\xa1\x9f^\xff\xaa\xfb\x7f\xb2Z߿X\xffO/{V\xfa\xffF\xf3\xf5\xfc\xdf\xcb\xf6\xff\xb4F\x94\x03\x00\xff\xc23\xc0\x9fMƠ\xde>\xfd\f\xed\x17\xb0\xc3W\xbb\xf7\x1a\xea\x1b\xa4QgB\xbe\x94\xc1x\ng\xc5\xcb>A\x85\x97}\x85Dӌ\xd46\xc9*\xdc(C\x0f\xd9&9\x01\u074bfn\xfaZNj\a\x0f\xd4\xd7\xef\x0emñ\x8cS\xe7<\xfa\xef\xa93\xe8\xf5/k\x15\xd2N\xcb>\x96\xfa0ý}~P\xd1k\x06R\x9a\fj\xb4}5\xa8\xe9\xb9e\x90\xa5\xa7?xƺd9\xe1\xe9>\xac\f#\xaf\xe5!?$\x9e=\x84\xf4 *\xd9;HBO\xedA\x02Ҭ\x11aVe\x05\xb4vR\xa4\xa0D\x9bG\xbe\xc3\xda+\x95dS@\x89P\xf3_Rp\xf6\xcbpS\xee?zͦ\xdb)\x02\xbfO\xff\xff\xe3V\x00\x87\xec\xff\r\xc3\xfdO\xde\xeb\xfd\xff/\xde\xff\xc3\x15\x80\xf8\xb4\x8e\x00`\xd40\x84n6\xd1.\x8fV?\x1f^Dh\xab\x84\x95#\xb9߱ڎ\xdf\xe77\x8e\t\xb2s\fӌ\xccC8\x9a\xac\xa8\x04M \xbe\x87\xf5\x80\xc0\xabX^\x90B\xca5Α\x82\xd5\xe1\xe8\x98\xc9y_\x9bm\x1b:l*\x9d\xaa\xfe\x9d5\xedG\xf7\x82ߩӖ]\xe11\xe3\x86e\xf9`\xf2W\x11I@n+j\f\xe6~\x1c\x97\r\\\xf4\x98V4\xb8\xd3\xc6*\xd6K\xf1{\xf4\xf3\xd5\n3:\xf4|\xb7\xf5\x15\x95\xde]L\xdcZ\xfa\x14\xf1Ƅ~\xf3R\r\x8aai\xaa\x14\x95Ew\xf8]\xc1\x9f^\xff\x9e5\xfe\xafٔ\xe2E\xc6\x7f7hj\xeb?\xbf\xf9:\xfe\xbf\xf0\xf8\xcfk\x04\x98\x01Ȁ\xeaU\xa06\x80\xab\x1d\xfb\x89S\x03\xad\xd9:`Mȗ\xf7\xe4\x91>\xff\xbc d]\xcb\bY\x17-\xbdd\x92\xf79\xee\xe8.~a\x17c\xfe\x99\xa2륿O\x8b\x03^|\x8f\xa1\xec\xe5\x05#rY\x06\x8c\xfa\x00\x8d\xa7\xf6O\x1aS\x9f1\xa4\xa2Q\x92\xaa\xe1\xa4\"\x01\xc61b\xcb\xddR\x0f\x8f\xa2ծ\xa7\xdczY\xc6&6z\x02_j\xa8\x18n\x95\xf4\x1d?$\x8a(d\xb0\x88\xa1Q:\xf3\x12\xb2\x06\x81尸+K\a\xd6&\x9cs\xf59҅\xcc.ȉ2\x80\x89\f\x81ʪ*S\x1b}\xa0\b\xba\xb1/\xaa\xd7S\xa5\x89b*k\xe7\x8fۍVf\xe8/\xd1\xff7|_;\xff\xef\x05\xaf￼p\xff/j\x04\x18\x00\x8a\x90C\x8b8\xa3%\xb0\xbb^\xd7\x1e\\0\xf5{\x84\x1f\x0f\x1e\xa4\x94\x1f'N\xed\x01RJ\x02=y\xbb\xa7\x87>lB\xabX\x87\xd94f\t\xb4AX{>\xe5{.tZ\xfe\x86\xa1\xf9穳X\x85\x9b0=\v3B/\xbf\xaf}*'\xc5\xd6\x189\v\x83۵pX\xd0W\xa8]\x9e\x1c\xd3n\xd0\x04\xfc>\x83;C\x0f\xf0\xe3\x11\xa2\xa2\\z\x1b\xa1\xb7\x10ʱ\x12DybV\x8c\x19\xbaK6\x8fq\xb2\x8dX\xef_*X\xfe23\x1dW@f\xde\xf0\xf66\x8a\t\x9d:\xfc=\\%7Q\x18[\x84\xbcu\xfe@\xb5e\xba\xcf!\x0e~\x9e\x98\xa3zv\xa5.\xc3\x0f\xc4\x10\xae\xd7e.\xac1\xfdqL\xb4o\x9d?\x0e\xa4\xe1`j\x0e7\xb9\xa3E\xad\x99\xa8\xa7\x95\xd03R\f\xda\xdd\x19\x8c\xe3\xec\xd1\xdc\xee\x9e(\xd8\xd4\a\xd8E \xc3\x16\x15\xe6H\x9b\x96\xba5\xc2\x16\xdfr6`Ӎ\xb6\x90\x1f\x87ivO_\x13\x86\xb2m\r\b\xcd\xd4\x04g\xaf\xb0m=C\xc6\xcc\xf3\xb4\r\x8aglc\xb0\xa0\xabxk\xca\v\xb6\xa8YR\xa5\x8a\xb0e\xea8a?\xfd\x8f\xfe\x13\xa3\xfdm\xb4\xcb\\\xff\a\xcdZ\x0e\xbe\xff\xe9i\xfe\x7fA\xe3\xd5\xff\xffe\xe7\x7f\xa2F\x80\xf7\xffd\x00k\\\x83\x98\x1e\xb8]\xd7\xd08<\x88o\x93t\xa5\x86~ )\xbdCZ\xf5\xf0\x137R\b\x1ez{]\xad\xb85\xe6Ռ\xf7/n\xff\xf7\x9fַ?,\x8e\x83\xef\x7f\xeb\xf6\xbfF\xf0\xea\xff\xf7\xb2\xed\x9f\xd6\bp\xf93\xfdzm-\xffs\xdb\xff6\\\xbdX\xfb\xa7\xce\xfe\xaf\xfe_\x7f\xb6\xf6\xbf\r\x81\xed\x87\x7f)W\xc0\xf01\x9f\x99u\xb7\xe1\xca-\x96+\xdbp\xe5\x99\xec\xa2\xf4\x12\x8f{\xedV\t~\x97\x95\x84\x17\xc1\xaf\xbdͿ\xb4\xfd\xef>\x91\x97j\xff͆\xdf\xd0\xf6\xff\xea\xaf\xfb\x7f/\xdc\xfew\x9f\xc0\xe3\xdf\xfc\xeb\x90\xf7Oq$\xc8\xec\x15d:-<\xec\xd7N\x1c\xfe$ک\x13ҳ\x82\xa6C;\xf0\xf0\xe3)\xed/\xe0\x89\xd8\x18\x1f8\x96\x06\xcb\xee`\xe1zmz\x04\xf4\x84EZ\x06{\xcd@\v\xee݇\xbd\xfbЫϒͣۨ71\xb5\x7f\xf9~0\x96N>\xd4\t\x84Y\x10N\x9c\xf7\x83\xb1\xec\x06\x99\x12\xe9\x83C\x98\xf3\xf2}\xff\x9c_\x81S;q\xde\xf7\xcfq07\x85h\xc1Mד\xc1\xdc\xe5\xb4\x7fnS\xd9&\xbc\xa1W]\xf6I\x1a}&\xb5l\x1f\xe5d\xd8/z\xe2\x88>\xa2\x0e\xafrbpG>\xbf*n\xf6,\xa9$\xbe\xcb\xef\x85l\x83˪\x88l\xf0\xb0\v\xe3\xb5\x16\x19\xdf\xe8\xb5\xc7\x16ŷ\xc9ӣ\xca\xd3p\x95kqe\xe1&\xb7F\x83\xf2l\x90\x9d\xc4d\x91ӻz\xa4\x95?\x83\x95\x87k|0F7{Q\xed\xbe'\x8f\xb30Jkѧm)\xdb\xe4\x91\f\xad|\x16\b*C\xec\xf1\\)\x1a9;\x97H\x83\x9fs\xbcқ\xc4x\xf4~0\xa6\xa7]j\xb0\xea\x16\xa1\xc22\xa7PZA\xdb\x18(L{:\xa1\xf0N-I\x13\U00085d89Z\xb4.K\xdc|\xa0\x99\xb54\xa0\rf\aeM\x0e\xfa]\x1b\xb5\xa3\n)\xf4\xc4<\x88\xb0\xef\x96Ii%\xff\xe5\xe3M\x1a\xada*\xfe)\xb7\xad\xe1\x85so\x9d\xfc\xc9I\x13\xa2˴\x15\x92\a1\x96\xfc\xa4\x14\xbf\xef\x9f#\xe5\xbe\xef\x9f\x1b@\x831\x06\r\xc6:\x88\x95\xa2Z\x00\x86\xcc\x1f\xc8'\x17\x83\x8a\xc0\x90\xcf\xeaL\xcd\xc9*\xdaE\xd40D\xe2U\xd1\xda?\xa1]\xfeO\xeb[\x87e\x96\x8e\x1d\x0e\xafS\xa0\xa3\xe1oq\v1z\f\v\x12\xafIZ\xdb}\x82\xfb\xf2\x87E\xc2\xe4d\xce\x1b.\xc5`\x8eg7_<)\xbd\xa6\xcb\xf6l\x1e\x8e\xa6{\xe0,^\x84\xa7\xb4-\xf2\x16\x89\x0f\x1e\x00G\xd35-\\\x90\xb5\xe2*\xc0\x05Y\xa5$\xaf\xba\x91\xcep\xe9\x9cz\xc9 \x88\x9f\xd0\nP\xb3\x8a\x8fW\x15Q\x15e)7=߈1\f>\xce* '\\\xffa\xb8~\x92ru1|l\xaf\xf1y\x12I\xb1/Q1\x94\xf1k\x1a\xccޛ\x97\xdd\xf7\x03\xd7k\xa3\xb1\x9e\x05\xaa\xf3\x02v\x83ɓ\xaa\xa3~\xad\x89\xad\xc2\xf0jjW\x1c\xa7\x9f\xf04@\xad\x1d\x11\x81\"\xe2[4\xc6Һ*9\x8e:\x84!\xf0'NL\x1er~\x01G\xb19\xf5\xba\xc8;\xbc\xfec\r7\x0e7\xd2\xec\xff\xd7|\x93\xb9\x8d\x17;\xff\xe1\xfb\xee\xab\xfd\xe7e\xd7\x7f\xe6\x1aQ\xae\bŧb\x12\xe2\xf7Ά\xe9\xe6Qt\xebE\x0364Z\x80c\x0f|\xee\xb7\xcc\xdf\xed,\xa2\x9d\b\x1f\x9f\x94\x93T\x88\xa3\xb7\xa1\xdd3\vZ\xa6\xd4\xe7c\xc5)\xb5<\r\xe3l\x95F\xbb\x1c\xdc]Z)\xea2\x8c\xd7\xd9}\xf8\x89\b\t\x86\x91\xe9\xc4y\xa3\xa0̢\xd8\xc7@t|\xe30\xa3\a\xb9\xab\x92\xf5Ƅ\x95\x8a4\x90\xaa5j`8)\xc4ԎZ\xfdEp__\xdc\xf2\x8b\xf2]\x99\x02\x05+K\xa9\b~FIi\"\x17$" +
+	"\xfdL\xd2\"\xf8{\x88D\x05u\xe2\xbc1\x14\xc6х\x80\x95\xcf\xf3\xdf\xdd\xed6ъ]\xff\xff\x8c\xe4b\x89<\xfb\xdfSb\xd9\xf6\x8e\xa8\xb0\x95\x92\xbe\xbd\xe23\xb1K\x92\xe5Q|G\xcd)\x12%[\xa6c\xa9䯃\xfd\xb7\x8c\xff\xdbu\xf3\xc7\xc5q\xd8\xffC\xbb\xff\xc5}}\xff\xf7\x85\xc7\xff\xed\xbaY\x0e\xf6\xecC\x19\xea\xf5k\x9f\x02\x1fl\xf4\xa0\xdbڥ\xcf}q;,_\xec\xec\xb75\xe4\x83\xf5\x9b\x1b\xbc\xb6\xe2\x97i\xff\x9b\x17<\xff\xddjh\xfb?\xcd\xd7\xfdߗn\xff\x1bt\xfe[|>\xf1엸\xa1\xa9\xbc=\xa8\xf2|5{o\xc1\xa7&\xe8\x92\x01\xbd\xc5\xe0\xfb\xea\xf1 \xb7\xe1z\nD=\xd9\xe3\xf9^\x1dF\x104\xed\x11\x04M-\x82N\xd3S j\x04\x8dF\xbd\x03#h\xb7\xec\x11\xb4[j\x04^\xb3\xe3)\x105\x02?\xf0Ze\xcf\nm\xb3\xaaz\xdf:\xe8\x9c\\\x85\xae\xd1F\x82A\x0e\x89Wɚ\xbe\xe7x\xf8\xf8\xbar\xf8\xee\x1b\x8f\xdai\x97\\\x19\x9e\x8d\x84\xf5\t\xe9\x13?\x1d\x02aH\xa7\x150\xf5q\x92\x83w\xaa|}\xa1\x03\xf6O\xb8\xf3\xe4\x88{Y\xa8zj\xd7?\xe0\xe0\xa0%\xae>\xd5\xf76\x8a\xa3,\x8fV\xb5\x1f{H\xb1\xe1\xc9b\xb4\xdf\xed\xf2/\xbbΥ\xaclJ'\x81\x0e\x1f~B'\xde\r\a\x05\x15-<\xff\xb8`\xd1\xdb\x7f\"\xdb\x17\x1a\xff\x1bn\xe0\xea\xe3\xff\xeb\xfb\x0f/=\xfe\x7f\"[8\xfe\xb3Om\xfc\xe7m\xaeW\xec\xab\xd06G\xf7\x92\xd9\xf38A\xdb\fh\x05mJ\xaf\xb7\xdb\xda3^Q\x12\xbf'\x8fLBE#5\xe1\xad\xed\xd5\f\xfe\x96'\xc2\x0e\x88\x1e\xc48\x94\xf6ЃXG\x1e-\x86]\x8fk\xd8>\xb5h\x8f\xaa\xf7\t\xcak\x05\xed\xa3uǰ?DuL\xf21\x9ak\x05\xedc\x85\x1cЛ\xa9L\xaa\x14g\xc2[5g\x06?\xe3\xad5Kb\x0f\x14\xb2\x01~tR\x15->!\xa5\xbc\xb1\xab\xf2,}\x82\t&z\x06\xb7\xed\xeb\xab\t\xe1\xb8bn.\xca\xf4\x16\xb0q\xff\x15c]1x\x8e\x18Dה9\xf5\x11\xd1\x1b$\xd1d\x1c\x10dL\x91\xa9\x1eՈ\x12\b\x84\x9a\xf0\x87\xa5\xd2\xd4=A(J\xa9v=CP\xbe\x99&kM9\x15\xfb\xe9\xf5\xef\xcf\xf4\aG{\xfeߜd\xf9\xbft\xfeW\xf7[\xea\xfb_\x8df\xeb\xf5\xfe\x87?\xc1\xfc\xaf\xac\x11\xcaL\x90\a)n\xbe\x8cR\x9c\v(\xc7\x0f\xdee}r\xde0\xc0;s\x0f%\xae\t\xaa\x98D\x18\x1e\n\xd2\xe6\x15 V֥Y#e=ط\xc6\xf9?\xa7\xfd\xefn>\xado\xbd\x1f\x14\xc7\xc1\xf3\x7f\x81v\xffg\xe0\xbe\xfa\xff\xbfl\xfb\xe75\xa2l\xf6\xe2\xfb\xa7\u05ff\xffQ\x7f\xa2\xb4iO\xf8\xc3\xe288\xfe{\xda\xfeO\xa3\xf5z\xfe\xf7e\xdb?\xad\x11e\xeb\xe7_G]\xde;\x8c\rO\xa0\x87\xf0\xe6\x9fZ\\u\x87\xc9,\x8d\xb6\x04\xdfDz\x13\xf1\xe7\x86\xd1\xdd*\x88\x89\"k7\x95\xaf}\xd1\xe7I\xb8\xc0Rt\xe1n\xf2\x90\x976\xff\xff\x95\xed\x7f\xe5\xff\xf4gz\xff\xcb\xf5_\xef\xffx\xe1\xf6\xbf\xf2A\xf3_ɋ\x1e\xb9!\xb7\xca\xf2\xc4\x11̩\x8c\xfa\xd2i\xe1\xc7<>\xf8\xc3\xde\x0f\x13\xa9x}\xffKm\xff/\xf8\xfeW\xd3\xf5\xf5\xf7\xbf\xfd\xd7\xf7\xff^\xb8\xfdC\uf3f4\xd2\xf7C8\x84\x1dw+\xe4|\xf9!\xdc\xec\x89\xec8\x06\x0f;p\ta/!\xb7\xb7\xe0{\xae]\xcc.^\x99\x9dv\a\xb3\x1a\x8d\x17\xde#p\xcc\vT\x86\x05=\xf7\x8a\xae>\xda\"\xa2\x9d\xbd\xef-\xdc\xcfn\xb3\xf6\xac\xa8\x8e\x14\xbe Y&\xb6?\x9e\x99#\xd8\xe5\xc17\xd4\x06\U00053527\\\xbc\xbe\xcd\ue7a43\x10\x1b\xbc\v\xf7i\x11\x89\x9d\xed\xee`V\xecnW\xc6VUB\xd6h\f2\xc5cv\xa2h\xa2$\x96\x8a\x14\x841ߓ_&\xc9(\x89\xef\x14\"\xdb\xc6\x17\xce\xd9\xd6\xe7\xef\xf4\x04\xc2i\xae\xd5qG\x8a\xa0\x97\x0eFl\xael\x16\x16\xef(-\xe3\xf3\xe0C\x92\xb9\x1fO\xa9d\xfbE\xda\xe3\x8bs=p\x04jCq(P\x14\x84l\xb0XpY\xc7G$\x86\a\xafg\x8b\x85\n\r7\xf9\xa88\x9ap\xea\xa8\xd1\x03\u05cd\xc5⠓\x91\x01\x9a\x15\x11H\xbf \xe1H\xb2X\x94qw\xf7y\x02\x1f\x04DD\xe6\x06\x92\xb1\x84\xfd\xcd\xf9?\xae\xccJJ\xe8S!\xfb\x9c\xacY\x97W\xe4\xa8\x0f\xbb\xbc\xfe?\xc1\xc7\x7fF\xf1g\xf0);\xcb\xcc\xf9\xedw\xf9\x9b\x1e\x80\x03\x93\xae\xa7< r\xca\xd7VT\x1a\f*R\xa9\xa7\xf8\xa5\x9c\x9b\x8cW\x99?\xf7=\x10\x83|\xf9b\xbb\x1a\x85\xa1/\x85\xd1\b\xb6\"&\xfdA\xf9\x03\xaeO\x1f\xe8˘|G\x15v\v\x18\xb4+Ka\x98\xf5\x928\x8b\xb2\x9c\xc4y\xad\xca\x19\xac`\xa9\x9d\x1c\x90\xc9o$PʹRY%\xef\x88܅\xab\xc7\x03\x12̮V\xf0B\xe2\x01\xbc\x93\x00yG\xe9\xceyO\xf6\xb6\xa2U\xe1I\xc3\xf4\xbdҟ\xf0\x10\xb8Qk}\x91e\xb6X<3\nS]F\x9dR\xd5\xeb*E\xf6\x8cO\xa3س\x82/\xac\x87\x15P\xc8],\x8e\x10\xa9$]\xbf\xb5\x1ef\xe3\x7f\xcb3\xea\xff\xee\xeb\xbf\xec>t_j\xfdW\xf7\x1a\xda\xfe\x8f\xd7z\xb5\xff\xbc\xec\xfa\x8fֈr\x01ȿ\xbe\xd7\t \xafn=\x01\xe4\xd5_O\x00\xbdL\xfb\xf7\x9a\xc1\v\xb5\xffFS\xb3\xff\xfa\xc1\xeb\xf9\x9f\x17o\xff^3@=\x00\xfb\xfe\x86>@\x86\xf2k\xe2m\x9d\x03x\xf1'\xfa\x83x\xccm\xd0k\x97\x1d\x06\xe5V\xfa\x8c6\xf4g\xa4\x90f\xa0@\x1a\xdek\xb7r\xa0\xfd7~X\x1c\x87\xce\xff\xf9\xae~\xffk\xfd\xb5\xfd\xbfx\xfbo\xa0\xd6\xdf0\xdf\xffZ\xb4\xe77\xf4>C\x10\xd8\f\xf4@~\xc1\x9c\x12\xc8o;T\x02{ŝI\x93by\xb1(\xaf\xe2`T\x15M\xa3<\x06\r\xaec2\x92ʤ\xbf\x1f\x88\xf5,M\\զ\x17\xa5\xd3uk\x04w\xbcx\xe0/i\x94\x93ڮro\x1a1\xd0Iэ\xf6l\x19\x82\xa8{k\x82Q_=sB\xd1=\x1b\xa9\xe2鏳(\x0e\xd3Ǌ'[9\xba\xbbۑx-\xc0\xbb\xc37B1\xa6\xe2)\x0e\xc1\x87:gl\t\xe1\f\xbdM\x123S\x05\x1b%\xd8W\xaa^gE\x8b\xa7\xf2\"\x0e\x860\x95\xca\xfb\xc1S\x8b\x85r0\x1f\x83\xa71h\xa5\xf4~PY\x1a\xef\aO*\x8e\xf7\x83\xe7\x94\xc7\xfb\xc1\x13\n\xe4[\a\xdc\x02B\x9b>\x86\xf8\xaa\x14\xda\x11`H\xe0+\x90\xa2\xe9\x02\x9c\xf9\xe6\x1aġ\xa4\xcf\xc8\xf1\xbf}\xfco\xba/\xe5\xff\x19\xf8u\xfd\xfd\xd7W\xff\xaf\x17\x1f\xff\x9b\xae\x87f\x00\xec\xfb\xe0\xfc\xdf\xf5ڇ\x16\x00|\x1a` 4]\xef\xda\xf3\xaa\x88\xcd\x00\x13\xd1\xd2!\xf0\xcdK\a\x10\xd6\f\xb4%F\xa3Mq~\xfb{\xf7U23\a\xfaN\x99\xad\x97Y\xb0\xc8\xd2\xde\xdf\xe4\x1b\xf2R\xed\xbf\xa9\xcd\xff[M\xf7\xb5\xfd\xbfl\xfbg5\x02\xb4\x7f\xf1\xcdoK\xa7\x8d'\x8c\xf3e\xb4%\xf4P\xe1\xe0\x9f\xb5\av\xafp\x9b\xbe\x9d\xc6~\xc0\xb9\r\x84\xd3\xc7\xd8\u0094>\xeb(\xc7\xe2\xd2W\xc1Ʊ{\xac}\xe6\x93-\x03\x97\x81\x83%'\x8a\xf3\x86Gq\xec\x87M\xf8\x88d\xd94\x95\f\x02n\x03/Ȇ\xac\xf22-f\x0e\xea\xec\xd0\x0f\xf3p\x18\xaf\t\x9d\x9b\x11\xc6L\xaf\x95(߷\xe2ȏ\xd39?\x92\t\xdd\xe0l\x8a\xf9\xa1\xed?\xdfd?\xae\x8eU\xb7\x7f\xdf\r\xf4\xfb\x7f\xbc\xe0\xb5\xfd\xbfl\xfb\xcf7\x19\xba\xec\x13\xf8\x7f\xf1\v^~>\xfc\x1e\x84\xf9\x0eQ\x1d\xf7Ьw\x8e\xf4'\x8b\t\x8c8\x8f\xb6\xa4xXbCҜ\xbb\x8a\xb2\x0eH.y\xcap\xddU\x941\xf6H\x9asw\x9d\xc2+\r\x06\xfd\xf6\xbb\xbc\xc3\x1a\xb8Yh\xce\f\xa7\xceb\xbf\xa3\x89\xa2\xa7`\xc5\xf5\x1c\xdd\xcd]\x92F\xf9\xfd\x96z\\\x14\xa1\x8b\xd5=ْSg\xda[\xcc\x16y\xb8ې\xe2\x92l\x8a!k\x109\xedt\xb2<\xdc\xee2\x90\x8c\x11\to\x9d7Tg\xef\x00\x148\xe0\x96\x81'Ά\x84\xb7\xec\xf8\xb3\x8aWVӀ2'\xffܓ,\x1fƷ\x89\xd4Gw\xb5\"\xbb\x9c\xbe\xf8\xd7\xeb¤(\x992\xe6S<\xffW\xbcv`\xf4\x196\xc6~\"\x9f\xed\xa7\xae&\xfc\xd7;\x11r\x90S\x94F\x06赕\x83u#ֹj\xfe\xa1\xf7\x16\xafO\"\xc1W\xf1gFBEļiT\xe5\x9eҚ\xc6\xe5\x1b3\xa9Ea\xf1b>\xc4t\x15\x7fI\xd9]\xea(+̉e\xb1\x8f\xca\xfa<엏M\x94O;\x83:+\n\x89fF\x02\x87qFV\xfb\x940\xe7\n\x99\v \x9b\n\x82\xcf\t\xa0\x84\x03\x1c\xbfp\xe0\r\b\x91\x18v3jm\x95ı\x13\x13V\xb41\xbb\x95\xf66\xbas\xde\xf4ؿ'\xecG,s\xc68\xba\xfb\xfc~I?\x81\xf1\x04Sl\xf78q\xd4%\xd9l\x12X\xbbab\x81\x02\xf8=\xabf}\xb1\xf7_(\x98\xfd\x18\xf6\x01i\x96Dq.崏k#%s\x9a\xe4\t\x85\x1cSB\x83\x87\x9c\xc4j`\x0f\xaa\xf3\xd4a\x99\x9d\x93<}\x14\xcdC<ybl\x85X=G5?\x95\xe5\xc9\xed\x8e\t\x10\ue03dpu\x0f\x9f\x8c\xbf y-+<\x05E)\x9c82\xccy\x83\xb8\x179\xeb֒O,\x8b'\xf4\xa1\x03\x03\xfb[g\x95\x99\x18˗\xd55R\xe5i\a]\x10\xb8N\x97\x05\xd0\xee7\x8fV\x9f\xc0#\a\x19\x13\xfb\x06'\x1c\x1a\x12yJxS\x10\x91Α\xafکC\xc7\x06\xf9\x98\x1a\x1d\a\xdfрSG\xe9\x9aP\xa7D\xeb\xf22\x01A\xce6\xdc\xfd\xc6\xf5\xf2\xfb\x1b\x04\xbd 9ıx\xf4\xd2F\xfd\x13|\x00'\xe7P\x83\bK\x7f]!\x89i\xe1<I\xb9Ȋ\xa40`\xc9+\x9c\x9a\bI\xb5d\xa4\xe1\x17\x1aV\x0eeo\x9d\xa2k\xbf\x0f\xa3\x98\x13\xb4n]T])\x9a\xb60\xb2\xa2\xe5̥\x96ߋ\x1c\xa2\xe7I\x92\xd3q\xb3\x947cmpB\x1er\xbd\xc1\xeb\xbdNٹ)=\xa0$\x99\x84\xcb\xee{\xf1)\xda\xf1䊖o\xe9\xedf)\xb9%)\x8f\x1dA8\x97\xa8\xaaKV\x8f\xb3~\x94\xb1\xe7\x7fMDv|^\x98/NM\xed[\x0f:\x15\x03\x99\b\xe3ڌ\xe8\xc2)\xca\xc1Y\xfc\xcc\xd14\\\xd3\xdb\x10+\xfb_Ti\n\xe7[\xdc\xf8\f\x0f\xa6\x8c\xa3X\x9d\xb9\x8c\xc3\a5\x88\xbf\b\xcfTG\xe2\x15\x1e\x11\xfa\x8fq\xb8\x8dVs\xb2JRz5J\x14\xdf)z\x9b\x93\x98\xdc%y\xc4\x1d\xc5ї\xe8GO\xe9\x19\xa8Qr\xc7v+\xd8\xc1E\xfe\xebT\xfa\xbb\x935h\t\xbc\x05\x8c\xa2b5i\x86\xcd\xc9\x7fsU\x88zQYy/Hn\x92\xf1\x9e<f֦\xf8\xdb\xef\x16\x96R\xbdV\x99V^8fY^\x01\x91s\x86\\V\xc4\xf34\xd9\xf2\x15\xf6MQ'O\x1cv$\xac\xc0\x9ch\xecr\xf3I\x04ht\xba\xa1\xc4]aՊ?\x1f%\xabO\xf4\xfdk{\x92\xb2\xdaJ\xe9\xd0\n\xcco\xbf\x17(\x8d\x7fAr5\xb2\xac\xf6\x89+\xacș\xc6E\x9dSk'\xe5\xa8aH֖\xc0\xf1C\x03\xac\xe0D.$\xd9Eo̫{*j\xf1Iчh\xbcY1[Rg\x83\x87\x18\xe4d\xa7\x16eBI4\xaa\xb7N\x94\xfd\xe7հw(\xdem\xf8\xa0N\x9b*\x05YĬ\x94\xb6]\xfb\x8c\x1a\xffI\xd9\xd6m\x19\xc9\x18]\xe1\xa3\x16\x1e\xc1\xa7xt\x17\xe9\xdf\xe7\xfbps8\xe5o\x9d\x1d!\xa961<qj2&\x96?M\xfe\x1d\x1a\xdbk\xab\xb2\x999G\x0e\xf1\x9aȳ}\xb4Yk\xd3\vC;\xf8B\xbb/ާ\xa9\xcfQ\xb0\x98\xe7\xf0J\x16z\x9d-z\x82\x03o\x15K\x99\xa2\xc3\xe0\r\xa3\xa6\x0f\x13o\x9d,;\xd0\xdfk2\t\x92\xc9\xe7k\x92\xa5l˰\xc9\x1e\x96\xd9'P\xe67\x8co\x9a\xe45\x92Ld\az0\xc5\xe8\x99\x0f\x1a\xf9\xd1\x0f.Q\xf0\x893JVᦻ^Ӆ+]sП\ndN\xb6IN\xaa1\v\x92\xf7I\xb8\xdeD1\xa9\xe5egd(o\x0e\xa6\x9dٓ\x18ؠy<Ǆ\xcd:c\x91`\xbe\x00E\x80\x98=N\x97\xa4\xebK֭\U000b5ef2\x90\xa5\xc7ˊ\x15\v\x9d\xdbk\x1c'\x8aԔ\x84k\x0e\xaa\x99\xd3E\x01=\xf6̠\xe8QwdU\x01岦i\xaf\xb7\xa0Oq\x91U\xae2\x8b\x0e\xd0,\x80\xad\x19\x8b\x14=C@\xb8\xa6\xe3\xefU\x9cG\x1b|\x9fC\xccM\xe6&\xae\x8c\xc4kf\xb1\x13#)U\\H\xbf\x0f\xc0\x0f\x01\xb7\xe1\xc3,|\xdc$!\xbb\xa4\xee<I\xb9\xffG\xfe\xb8sR\x96An7\x80\xfe\x18\x9c\x91uXxs\xae\x16i\xf7OH\xf4\xedf\x9f\xdd\xd7*!L W\xaa\xc8#N\xc4[\xe7\xd8Ș\xa4\xe2)\x1bQN\xb4\xda\xdd\xcb0qJ\xf1\xad\x03\x9e`)\x7f\xf2wX\x0eE\x00\x8b\xfc`\xed,R\xc3g]\x15\x05\x8dе\x03\xe9\x13\xf6\x80\xaf\x96t\xee\xa57˥\x92q\xec\xf4Q\x15\x89\xae2cL\xbc\xd6\xdc\x1cS:T↠I\xbdEq\x1c9K\xb2\\K\x7f\x15\xc3{\xf2x\xb5c\xa7\xc9>\xc9_Λ\xe2\xe78\xbb33\xabW\xa5\xd8s\xd0\xdb$\x99-\t\x8cƵa\x06\xac(`\x92\xe4\xf4>k3\xa2,\xfd\x03ti\x86Z\xe5\x0f\xaa!ʮ\x9fÜ\xb5\x94䖌\xe3a\xb8\xa6\x85\xa8y\xc5T\xb9\b8\xc0E7\x00\xe6$\xdb%qF\xf4KY9\x86\xaf\xd0.\x93,\x8f\xa9\xb9\xf5>\xc9\xf2bP1\xf7\x87lp\xc4/B\xb1Ǔ\x9d7`*\xbf\x1c-\xdc\x06\xf5\x82\xfaL6\x0e\x9dO\x8a)T\x94\xc4#\x1a\xa6L\xbc\xde:\x1b\x96\xa5\x8a\xde>\x13\x83\xecw\x8eY-\x99]\x9a\xacH\x96\rz\x97`\xa2ZK\xf69I\x9d7`\";Ψ!hu_\xbd\xa4\xa4\xf3+\x95\xe9\rY\xdd\v+\b\xaf(\x96Ʊ\xa5\xd5\v\xa4\xc1(\x8b\xbeTN\xef\x14,\xf7\x892\x1e\x03笎a\x85_N\xb3Tb\x83\xfb!ggc\x1d\x9f<\xd6\xee\xf9\xe4\x1e\xa7\x0f\x1aR\x95Y&x\xc0\xef\r۳{\a\u07b8\xa3\xc7\xc9\xc5\v}U\xe7^E\x81E\xabO\xcb\xd1B.f2\xfet\x1bO\x0e\xf8\xb0\xf6V\xcc,\xf7(\n\x04.Z\xb0\x99Ӻ@\x88\xf9rG\xb5F\xd6Vi\xe4<\xcd\x18i,\x1dh\xc5\xe2\xf7U\xab\xfb7\xa0\xc3f\x0f\x0e\x835<\x1b\xab\xdf\xc4J\xe88\xbbc\x8d\xc4\xd6\xce\xd0\xdbw\xc7\xf7\x89l\xee\b\uaaf96=\xbb9\x88\x86ɬ\xaat\xd6'7wJ=:\xa6=\x00m2\x87\x15\xc4*\"\xf5\xa5\x10\xeb]B\x9f\x83?P\xdc\xff\xdcS\x9b\xdbS\xa6\"\x94C\xac*D\xbfU\xd5=\xb1.M.\xb0\x0f\xadOK\xe9\xacw\xfc6\xf1\x06\xc1Lj\x8f\x8aH\xa8\x9a\xaa$\xc3Y\xa5Qe\xd9~K\x84\xfa\xcd\x1dCE\xfe\xf2$=\xc0k\xd6ʒ\xceè\xa9\x04\xbc\x8b!^\xa3\xb1'\xf5\xc2\xccW\xb9\x00\x17\xac`N\xb1\xddm\x88j\xa2(tA\r\xa4d=\x90\xd5ψ\xfa%\x8c\xf2\xf3$e\x9by\x9b\x9a\xadJ\x97*`g\n\xc07Xv\x83I\x82\\x\xab\x96f-\xe5\u0086\u070fּ\xe8t\xf3>\xb6R\x0f\xfbNa\x9a\x9e\x889\xa9\xd8p\\%\x9bb\xb3A'\r\xb313H\x15\xc6~m\x83B\xd9c\xb1l\x89\x7f8b\x87\xe5X\x1f\b8w*l\xdd\xcb\xd1\xe2*\x8e\xfe\xb9/C\x06\xbdK\xee\xbaP\x98\xdcm\xfb\xa1̒av\xfb8\xca\x16\x82\xac6\xfc\x85\xca\xf7\xe41\x8a\xef\xc6aN\xd2(\xdc<\xe1\xf1U\xf3\x93ز\x10\xb1\xb5\xb2\xb0$j;\xde\xe2\xe1\rfĤn\xaf\x92\xffo\x8e\xd7\xc0T\xea\x00\v\xa8>\xa66=\x17R\x9b\xf2\xd2\"\x9a\xa7\x98\xe4_\x92\xf4S\x91" +
+	"\xabp\xbdNa\x16\xd1N>W\x94z\xf7Q\x14n\x98\xc3\\D\x17\xef\xb55\xfb\x87\x0e\x8a\xf9;\x1e\xf4\xd6\xf9\xa6H\xf8\x03\x19\\\xaa\xbc\x8a\x82\xe4}-\x9aS\xb9\xdb*$\x81\xe72\x18\xfd\xe4`\x86鉐\xc2\xf8\xa3>v!d\xc42j\xda\x17\x94\x91\x1b\xe3\xaaX\xbb\xbcu\x9e\x9a\x0e\xf1\xe2B\xaf\xbf\xe8Rm\xd3\nэ\u05cb\xcb.\xad\x19\x8a;\x02{\x9a\xc5k\xe9L\x8d\xb6ϙh\x8510y\xed\x86\xce\xd4\xf4\\\xce\xd4t=\x13S\xb3\xd1ј\x16\x97]׀m\xbaM\xf9\x18F\xef\xb2\xd8\xc4B.:\xacek\xdb`eaj\x8c\xba\xe3\x8dH\xcb\xdak6ݎ!\x15^\xbd\xd9,\xdf\xe40-+\xc0Kh\xb7\xc5\xed\x18\xc8}L\x06\xd1\xf7\xf2\xbb\x19K4r\xb3\x91\x9b\xb7Ǹь\xd8\xf50Z\xcd\xdcT7\x13ZE8'<X\xc5\x05&\xe1\xfac\xb3\xdeyO\x1ega\x94\xb29\xdcy\xb4!\x85\xb0O\xe4\x11|\x9f85\xe3l\x19=\xcag\xd2c\xa3\xdeV^\xd63\xa3:\xca\xf3xF\x94[/Q\xef\ac\xf6\xdcH\xd9k5]p(y4\xbfҷ\xa0k\xabp\x17\xae\xa89\x9eu\xc1:\x00\xf0\v\xb5բ8&\xa9\x835\xa9*\x1aRK\x11\xaa\x87\xc8a\xf7\x90\x13\xa3\xaf\x89\xaa\xedIR\xaetTo)xy\x15\xbd@\xa6\xb2\xa15LPk_\xd14\xa1\xad\x9d\x84kB[{\x87\x16\xb8q\xab2\x1d^\xbd\xe9\xe9Xs*\xbcz\xb3\xa1c\xcdi\xf0\xeaMYyfa\x9a\x11\xa8\x7f\xc5.\\\xb5YC\xe7\xe4\xa5\xc7\x1b\xab#,H\xba\xb9\x89\x0f\xe9\xeaV\xd2do\xb2\x1c-\xf0\x10E7ѩ7\x81\x88t\xf0\x99Ĺt\xd7P\xb6\xf1\x90S\xd8W\x1cE\\\xe5\xde$1t~\x11\xa6G\x99\xe0J\x16\xea\xdf\xc2RU;\xe1K\x12\xfaۀ3\xdb\x19K\xfa%[=?e}c\x15\xb5Ж\x98\xec\xce\"\n@\xc1\xca\x05F&u\x1c\\\xf1Xy\x8f7/\u0084\x1f\xb5V*\nVQ\x0f\xdc\xd80\x90\xad\xd3G\x03\x16<<o\x8c\xe9oN\xa3\x82\x9b-\xa9,|n\x05_\xb1\xfc\xb1\xf0z\x15\xbc\xc38ʣpc\xe1\xac#NZd\xac\x9e\x965\xf6}\x14\xafY?\a\xd5\xcb \xa2\xbd0\x00\x82\x9f:\\\xba!\xc6S\xa7_V\xd4S\a/\xd4`\xfd\xc1\xb5\t{\x14\xe3t\xb0\xf8\xc1Ņ\x94P諟\xc4D\xcbK\vB'\x899\xc3H1ڒX\x83\a\x18\x0e\xac\t\x1a\xb4\r\xfaD\xbe\x9c<\xbaO4\xb5\xd2\xe2.Yd-b\xfe\xb1\xc0Z\xf4\x15\xa6\x0f\x99{\xf4\xa2V\xa0\xc0v\xa3aQŃ\xbd\x82\x86\xec@\xa4\xa11k\f\xfe\x01\x06\xba\x82\x8d\xa8\x1f\x8e\xca\u0604\x8c|\xef\xdbTb\r\xa1WmcZ*t\\la\x9f\"\x90\xf3[S\xba\xf7)\x03\v\xefa4\x81\xb6Yv\xb9;F\xceSB6\x8f]\xe9\xecbr\x86\x83\xda\x06\x9c\x13\xf2\x99\xa46\x86\xba\x81a\x1a\xafȡ\x88\xdcB7\x062hk\u008aP5\xedr!6JI7^\v\xff\xd1\n&_c\xaa\x84\x97\aZɊ.\xeeR\x97M\x83\xe9sz\xe5,\xd8o\xb6[\x10\xd7h\xfb\xa9k\x9a.\xfbͶ|Ѻl\x9e\xc7:\xe9\x9b<\xa7qK<uԖz\xd0Ă\aV\xf9\x8e\xa1\xd9P\xa2N\xe1\xb0\xc1D\xa1ZG\xbe\xe5hq\xdd\x1d,\xae]\xaf}}\xd1\x1b_\x8by'\x17\xc6\xce\v\aM\x15\xeb5\x03\x89\xa5\xf3N\x88\r \xb6w\xd9\xed]v\xbd\xfa\xf5l:\xfa\x95\xdeuc\x92ނ\x1c\x83^\xffrp\xcd\x16\xc8\u05ff\f\x97\x97E\xd2zg=\xca\f8;n\xe0>\x85\x15\xc7\xdbq\xdb\xc7El\xd4I\xc7\xed4\x0frS-\x19\x93\xed\x1d\xc5jTp\xc7\xed\x04\x95ܚ\xcaK\xe6\xa6\xd7\xe84\x9eƬ\xe5\xfc\xb0\x8cy\xcfgzSs\xdd\xec\xe8ls\xc9\xd4\xe8\x0f\x16׃\xfe\xc0\xa8\xafV\xbd\x82\xb3\xaa~\xb4\xdc\xe3\x19\xb5\xf2=\x86\xd7V7:\axm5\xa3\xe5\x1d\xc1h\xae\x17^\xbdJK\aj\x85\xf7\x14Vc\x9d\xa8\x92`\xab\x11\x01R\xd3yw4:\xeb\xf6\xde_/z\x8b\x0f%\xce\xf3\xea.\xaa\xf1G\xd4\x19\xb7nd\xb0W\x95\xd61x\x94\xe7\xa0:\nS\xc5p\x9b\x81\x95\xc7X!\x9a\x8dJ\xbc\xa9\x1e\xb8MsV\x8cEP\xf4`\xea(=\xbc\xbd\x88>\x93X\x1f}\x1b\xe55\xbat\x94b'\xe3Tga<\xba\b\xe4b1j\xd4\xe9|<h+\x14\xba\x05\xcc)\x1d\x03ť\x94V\xdd@\xf1\x18\xc55P\x1a\x8cRTȏܾ\t,\xfb\x1dL2O \xe4\xd4]5\x10\xce\x06cv\t\b|\x83@\t\xb3\x19\n٠]8\xa5\x1a\xad\x11\f¼\xfb\xf0\xd9^\xe1\xf0\xa7\r\xe1\x88j<\xf4[\xfa\xdbI\x89,Xu\x03\x03\x87ф\x8bY\xcdpV\xa4\xf0?\xabɜ\nc.\xcf\x1a\xf25\x03\x12\xb9\xfb\x94\xc9\x11K\xb2Bo\xae*3\r\xf6\xfa\xb2\xa6Ԃ/\x93\x8fl(\xdc7\x9d\xa5Cu\xe09\xdaMXe\xa4\x06ч\x1c;\t\xe5\xf0\xcbv\xef\x99.H\xb0Q\xaf\v\xb3\x04\xf0\xf2\xcf['\xfa\xacm(\xeb\"o\xa38\xa2\xf7e\xd3r\xaa݄\x19\x81..e1\x96\xf7\xcf\x1c\x91Lb\xda\v̤[\xcd8\xccr\x92J\xbf\x1as\x14L\xa7\xd2\xf6^\xe4ǾQ\x88]8\x8e/*\xc59HԉqvW#\xab\xfb!\xb3us\xff/[\xad\xb2H\xa8=\x99\x83\xdac\x93}~\xc6܌\xb2'\xf0\xefY\xa5\x96|\xbb\xec\x93\xf8iu\x19\xd1\x04T5\x05\vO\x92FwQ\x1cn,\xaf\xab\xab\xf0\x95<]\x84\t\xa2\xf0Tw\x1b{wh\xf0-\xb3\x83U\x97\xb3õ\x82\xb1a\x0f\xad\xa3\xab\x92\xea\xd8u\xb8\"\xa8\x1cǔ\x83\xcac+\a\x96\x13\x9b\x97\xd5\xd1y\xb2\xbbi\x1dΝ\x9d\xb7*\x9f\xff\x92\xfb?\xc0\r\x1c/t\xff\x8b\x174\xf5\xfb_\xdd\xe0\xf5\xfe\xb7\x97\xbd\xff\x85ֈ\xf2\x02\x18\xfeu\xe8\xbe\x17\xb2Zg\xa1\x1e\x9c\x9a\x02\xa9Ŀ\xee>E\x0f\x80D\xe2U\xb2\x8e\u2eff\x86Y\xec\x9a\xc2wd\v\x82m\x0f\x8d\x99\uf289I\xfe\xd7}\xba\xd1o\x8f\x11\xce.\xddI\xc2\xe6\xf4I\x1a\xfdA\xd6\xe7I:x\xa0\xc3\xf6\x15\x9b\x06\x0e\xe3\xcf\xf4\xe9\x969\t\xb3$\x86\xb6\\\x9dmy\x1fe\xcc;J\xe5\xf1\xc0U#\xf4\x8c\xf6\xf1g\xad\x04É\xb3!\xb1rihI\xa3\xb3p\xe9\xe5\xa88\xb02\x81\xbf\xfdn\xb8\x85Aw\x95+䕧`E\x90\x01s\x1b\xc5\xebY\x92\x93\x98\xee\xaf\xcc\u0094\xee@\xb2\xe5\x80r\xe3\xc3o\xbf\xef0ʔ\xfe$Ωc\x98\x89\xff\xc6\x1c}w\xcd\x1c\xc5\f\x1c\x06p\xc8\xc1\xe7\xe2\xfc?\xbf\xd8\xd4\xc9\xd8?\xf4\xf9\xb3/\x8b\xfd\r\xdd\xe3(\xdc\x1c\xc41Iyǂ\xd1)\x98\x99B\xb3<\r\xa38\xafP\xb3)=\xfc\xe2\xd6\xc2av6\x18\xd7vd+\xaf%\x10SXy\x99\x85\x81_$\x97\x8ft\xea\xe1\x81\x02\xc5\xdf\xccI\xf2{\xea\xeeT\x06W+\x94οzE\xbet\xf5\x1e\x9fk\xdb\x05K\xf3\xf0K\xb1\x056\x0f\xbf,\xcf\x16\xd8\xf7\xae\xa0\x88\\\x16/Ұ{[t:\f\x1afٞ\xa40D\xbf\x91\xc9\xd1n0\xd2CJ\xb0\x1etZ\xbepT\xc2\xf4 \x00\x03뾯\xe5\xa5H\xecE\xb3\x05[\x14L\xf6\xdb\x1b\x92\x82g\x92D>h\x1f\xf9\x8ev(\xa7\xb2\xc8a\xd0$\xc9\xcf\xc8m\x92\x12x\a\b\xed\x92ns\x92°\xa2+\x93?\x94\xfbc\x98\xcc\"Dl\x9eU\"\xaeb\xee־\xee\xa5Q\x1e\xad\xc2\rB\xd3.\xfcݔ\xa5w\xc8N\xba\xdeF\xec\xda\x02Щ\xfe\xf6;\xf8\xa2\xf2>\xc5ɗ\x18#,b\xce\xc2,Z\x95U4c\x0fk\x89̈́a\xd6늟cz\xea/\xbf\x17o\xcc\xc1\xef\x7f\x904\x11 \xa1UZ\xb9\xd6E-\x10]z\xfe\x88\x83٥]l\xde\t\xae\xec\xa0\x05\x15\xc5w\xa0\x06_\xcdG\x80ޟ,ha\xc1[>\x06\xdb0b\agI\x96!\xc2p\x06C\xe9\x8e\xcbpv\xea\\͇\xf4\xf3\xcd>ݼ\xbb\x9a\x8f\xa8\xc7m\xba\x8d\xf2\x9c\xac\xfb\x93E?\xd9\xd2~S\x96\x82ȕ\x01\x01\xe3\x7fXm\xf6k\v\xb1`\x1d\xce\xe6a|\xc7\xfdyyR&$/\x99-\xe4\x82ݚI)\xc0\n(D\\͇\xf6\xc4\x1b\x89\xbd\xf9\xa8\x1fя\x9b}\x1e%qq\x1bS!:\xd9D\xabǲ.U\xd4U\x06\x8dX¦\xd4kz\x18\xdfG7Qލ\x1f\xb9\x10^\xa7\xd4PP\xb3\x04\x89\x87\x8f\xc3\xdd.\x8a\xef\x10\x13\xa2\x00F\xb1\xe97x\xa0\xae\x16\x12\xc69\x8d$\xc0\x8aDҤ\xa3\x00\xcb-q\xd9c\x96\x93-\xb7wr\x8f\x987\xfc\xa3|\x8fl\x05\xbc\xb6Ձ\xd04\xc8\x15\xb2#\xde8\xd9(\"\xaf\xedz\xeb\xac\xf6iJ\x97\xa3T\xaa\xa3\x8a4\xa6@q\xaf\x87Q\xc0\x84\xab\xe96&ؚ\xd8\x1bz\x8f\x01KTV\xabLb\x16\xdd\xf5\xee\xc9\xeaS\xe6\xbca\xf9\xf9\xceJS\xcf\xfd\xd9\x0e\xfdA\x1em\xa8\xb7O\x9f\n\xca}\x98-\xba\x93\xa2\xe3\xaeUaY~\x8b\xa1\x90\xceY\xa8\xfb\x11\xbf\xc1\xa4\xe2h\x8f]D-\xdc\xdc%\x86\xc1\x95i7\xc6\xcf֡A\xba2\x8e\xfb\x90M\xbf\xc1\xe0P\x99\xab;\x92+\x1a0̥p\x06z\xf3Q\x99\x87U\xbaqް\xa1\x11\x00\xa9\xc7g\xb5\"R\x12\xe6\xa47\x1fiOP\xb2\xd7\x03ё\xe7\x94|N>\xf1#\x11\xc50</Ê:\x15'_\xca\x01\x9f\x9e\x95\xdaE\xe9c\x19B\xabcʍ#\xc6c{\xeaTM,_\x90CHO\x9d\t\x9e:by\x83\x16;\xa7N\x9f\xe4a$O;\x14\x9d\x8eE\xfa\xe1{9\xe5Y\x8dcf\x8f\x12\xfbm\x93Ȋ)#\x9e\xbb\xbd\xcc\xfc\xd10\x13\xec\xe6|\xd0#E%)Bh\xb7ۍ\xf9K\x9d\x8b\xc1\xf2\x9bg~\xdf}BSu\x13\xa8\xd6k(\x17\b\x16\xed\xfcC\x94l\xf4K;\xcb\xcag\x01Z\xae\xde\x14-\xb4L\x8e\xd6Rs\xb2\xddm\x98\xa3\x1ej\x86\x86>\x91\xbf=\x8d\x1a\xb5\xda\xccm\xc6C-\x19B+ǥF\x80\x8dѭ\xb2\xd4~|\x97GK{\x19\xee\xe99\x8a*\xa3\xc4@zI\x11[\xba(:\xa0i@\x0f\xf6\x1a2ͭ*\xfdE\xd7\xd4@\x80\x9f\x93\xf1\xa4\bD\xb6t$\xf2\x1b\x87ض\xf2\x10\xbbط\xac\xdd8ovd\xfb\x8e}ЂͲ/IZ\xf10,8\xc6bN~\xe3\xc0Q\x17\x885\x1e\x8b\xb1f\xc1\xad\x9b\xe0\xc8\xfb\x1d\xc1]\x13\x1c9\xc0#\xb8\xa7\x9e\x8b1f\xcfW\xdeb\x97zT+\xce\r\resAikA\xf7t(\x8a~넛;g6\x18\xf3\xb3/\xd4\x12\x03JEy\xb0\xfd*..\x11+S&\xfa\v\x91\x03:.\x92\xb5f\x9e\x93\x1e\x7fp\xfd\t,m \xf8ę\x0e\xfb5\xf6_#\xd5\xe2Q\x060\xdd\xf8\x11\xc5\x03\xfc\x14A0\xb8\xf7\x11\x83=\x138Y\x13Zvt\x89\x81\xd1\r\x03\x9au\xd7\U001009f8\xbf\x12s\xf8\x06\x8e\xe1l1\xe8\r\xe2\xf5\x82-\x15\x14\x86\xa6\x8da\xb9\x8fc\xb2QЁ\r}\x95\x91T\xc1\xb6\f\xd8q\xb4J\x93,\xb9\xcd{\xc9vK\xd2\x15;,g˽\xebUIxOҘTq7\xaa\xb8\xb9%\xe0\"\xa4'\xc0X\xbf\xa6r\x9bJuB\xf2l\x15\xee\xc8Af\xd7\xc0\xcc\xec\x0fƔv\fh\x1e\x85\xa1\x02\x99D\xb3\a\x0e\xe89[]\xb6\xf4\x8e\x90\xeb\x11mN(\rwxjxY\xdeVR\x8c\xc2H\x84\xcd-x\x18\xd3\xc7\xc5\xc3<\xba\xd9\x10\xb3\xfd\xdd\xe7\r\x9e\x02ӔN\xedD\x97\xc1S\x06\xe7\a\xf2(]\xd1\x1bp\bh\xd9f\x84eR\x8a\x13RJ\x19f\xc5a@\xe3\xf0\x81\xdc)L\xfd\xcb\xc1\xee\xa3h\xe8|\xf5\xe1\xc0\x06\xe5k \x92\xe6*\xaa|\xf5\xa6@%q\xce\xf6\x84\xb7\xdb(ߒ8wL\x9d\x8c\f\xa3\xfe\xba\x83\x98{8\xa8\xe0\xb6\x06&\x1c8\x8d7\x8fHj\xe9\xedU@\xa3\xbb(\x0f7\xe5L\xdaTQ\x8b\x8e+6\xcbu=-\ttp\xbaK\tQ\xd3\xea\x06\x06\xa4-c\x85f\xc7}\x8f\x8e\x92\xf3E\xd72D\x96\xc0f%\xd0C\xc7\x19\xcd\x03i\xd3z|\x12Eٲ\x9e\x9fD\xb0\xb6\xf5\x00%\x82I\x97o\xf1\x00ݠWz\x160W\x9a7l{\x0f\xbcy`\x9b\xb3\n\x01\xecx\x9f*#U$\xa0\xc56b\x94z\x01|2\xc8\xce\xd6V\xe2;b\x86]p\x0f?\x96q*\xf3u\xeb\\O\xb14\bǕEw\x92i]V\x00y\xc6Q\xb6\r\xf3ս\x86j\x82G-Ŷ\fރ\x132\x12fT\x13W)hS\x99z\x89\x03\x9b\x93˄u\t*Z\x9ek\x9a\x0e\xfbU\xcf)N\x87}\xb9i\xb5\xa4\xe7\xd2o\x0e\xbd5\xc8\x18\x84v\x97\xfc\x1ay\v\xf2\r\x83\x16o\x1220\xb8\x1dA\xb5\xa40t\xe191\x1d\xf6\x19C\x12\xad\xcdV2\x90p\xf1\xdaᓒ~\xf0!F\x9cx-\n=-\xd0P7Uo\x81-\x11\xdd\xc5ĥ\x13M\x0e4ڨ\r\xac\x16_HF\xcb\x13)\xf3ĩ\x19\x05\xe2+c\xa7\xc3>5\xf3I&\xcaA+\x89%)4\x0eE9B\xc0\x90\x1a\xe1h\xf9\xf0+j\x03_\x05\xb3\xfaW\xcc\xf0\xe1\xf9\x9a\"\x90\xba5\x03\b\xe8G\x8b\xc0\xee`\xe1zm\x04j\x18A\x1d\x0f\x81|\x13\x88\xae\xb4 \xa8\xa9\x81\xd4\x04\xb9\xf0\x9405\xed)\xe66\xb6p1\x18\n\x15\x9dq\xf6\x12R[\x93\x14H\xb0\x9b\xb2UFi)\xa0\xc5\xd5\xc7\xe7\x94M&\x82JY\x19NE\xa5I\x9d\xf1\xf6\as\xaa\x825I\x9f\xab\x024\xfe`\x1d\xa8\x03\x91\x89\x9d\xb5\x1c\xd0%\x18j'é\xa3\x14\x8e)=\x18\x8f2X\x19\xd8%\xcd\xc6ݶŮ\f`\x063\x8d\x90\x01G.]\xe3\x9a\xed\xc9,F1\xf6\xe0\x8c\xa8\x06\x1e\xdcp\xd1\xfe\x96|r\x86\x99\x80\xf8\xfe\x1c\a8S\xfeN\n\xeb8T\x82\xf484L\x87\xc0$Y\xa7Z\xa7˳}J\xb4K<\xd0|\xa7\x18\x90\xe7\xb6iXy\b\x10\xe6\xden\x85Vp\xcf\xf5I\xd07\xfe-\xbb\xd1\xcf6>\xeb\xdb\a\x838O\xf9V'\xce\x04\r\x7f41TlE\x9c:\x9a/\x03u\x8a\x12W\x94B?\x05\xf2\x90\xeb\xa1\xdfd\x9c.-\xc98\x1fO\u07be2\x14<Ӆ\xa9\xf4-\x0e\x1c%7\xcd\x18\xcc\"\x9f{Q\xb3\x03\xdfE\xf8\xd6,\x8b3\x9d\x97]\xb7r\xe9\xd1\x00H\xaf\x19Tb}#v\xb6XXZ\x13\x94\xddh\xfb\x95\xb2\x9bF\xac]6LKӭ^\x87\x05F\xac]v\xb38蚟\x87\x9b\xcdM\xb8\xfaD\xdfk\xc9j)\xfd/\xf0\x99RO\x97\x1a;(\x9dJ7\xc0y\x02\x94mH\x13\x94z\xfd\x9fsw3a(\aO\xe0\x1a9\xcc\xd3=n\xa4\x03\v\x88\xd2\xc9K=,ː,\xc7Ȫ\xa3\xbf_\xa6\"mO\x96\xe98\xf5\x952q\x10+I\xc6a\xfc\bVO\xdaʤ\xad`\x87qNW\xe8\xeb\x88\xf5?*\xba\xa8\x81Wq\xe1\x9eF\xd6FW̖Ƚ݅I\xdb>\xb2C-\x96\"\xe1\xceTt݇6R\r\xe7z\x8c\"lF3\x01>j_\x11\x1a\x9c\x05\xdfQ#\aX-\"W\a\x99t\xb11X\\\x1a\x80\v\xecM\xf9:\xd1\x1c7.vEa\xca\x1fJ%&\xaf\xb5Lu\x16\x1b\x87\x0fe\xcd\xe1o\xcaF\xbc{d\x1d*Ь\xeaţ\x1f\x8eP|S\x8f=\x1b\xc1\x1dH\x8b\a\x9d\x7f\xfa\xb7\xfcS\xbd\xb1\xff\xf5\xfe\xff\xf5\x86\xef6\x15\xff\x7f\xcfm\xbc\xfa\xff\xbf\xbc\xff?\xab\x11\xe5!\x00\xfeu\xc8Y\xdf\xe0\x88\x8f\x9fi\x15}Ii:(^\x19\x95$\xc7\xe6\x90Wޕ\xc2\x10\xf3\xf0\v\xf3ip~\xa6\x9f\xff\xcf\x7f\xfd\x9c\xb0\xee(\xdc\xfc\xd7" +
+	"\xcf?\xcbfjt\x82\x901\xd20[d\x1c\b\x16N\x95\x12\x17\x83\xe5\xf1B\xa9?\x989]2#\x19\xc9A\x1e\x94\xd5r\x11\x11\xf7{aA\xd8\a\x86\x06\x19\x17\x03\x06\xed\x03\x1cO\x03K\xfaY\x94/\xf0\xce\xcd\x1bͿ\xe92\xcc\xc4fj\r\xf9\x1e\xa1\xbd\x0emD\x1d\xaem\xcaA\x1e\xac\xa62-\xf5'.\xf4a1\xc8\x11\x87_\xb9\xb8g\x93\xf4\xd2\xffd\x9aޅq\xf4\a\xbf\xaf\xca\x1c\x1cn\xae\xe2(\aDv\x19,\x7f\x9aG\x06\xcd\xd2\xe4s\x14\xaf\b\bZ\xe4)!\xb9\xf0n\x81\xd0$\xcb\xf9\x8e$\x04\xc3%\x82\f\xa4\x1b/\xfc\xb8x\x11$\xfdj\x8c\xd5C\xac\x05*1eyQ؉s\x1em6t\xb13\xefO\x16\xd4\xd4\x13\xafH-]Ǚ\xf3\x06\x84\x14\xc6L\xce\x132c\xe9\xbc?\xc9jQ\xec\x00\xdc[\xe73\x8d\xa3\xcc\xed[\x87\xdaX,\x06A\xc0\x88\xe5/\x13\x98\x1a\xf1\x12\x83=9\xe6\xc7\\\x01\x9e\xadZ\xa9K\xcfgB}\x7f\xa3\xf8n\xcf\xce\xf1R\xf6\xc5`)\xa5\xc1\x18lB+\xc4\xd84\x0e֊x\x1d,+哗\x87h\xb1W|T\xf5rz\xf3\x87\xebT\xd9Y\x8a\xfd>\xe4¦I}\xbb&\xb7\xe1~\x93\xff?u\xd6\xe2J\x1b\x83\xb1\xef\x10\xb6\n\xa0ڧ,\xf6͍\xdcll\xd0C\xcd\xdc\a\x94\x97\x87w\xffO\xfdm\x91Y\"\xfc\xa8\x99.\x7f\xfa\xdf\xf7G\x1do\xe8I\xf7\xbff\xff\xdc\xfc\xa88\x0e\x9c\xff\xf4\x83z\xa0\x9e\xffl\xd6_\xe7\x7f/4\xff\x835\xa2\x9c\xfb\xb1\x8f\xe2\b'?D\x0efx\x90\xe9\xaf\xeb4\xfaLR@M\xc9톬 >{\x8cW\x96\tb/\xd9췱\xf4\x0e\xb3\xbe\xbb\\\xa0Nؘizy\xa3D\x8c\xd8\xed\xeat\xa4)\xefY\x0f\xfc\xf2\xadh#\x13ua\xd8R\xbf\x84?\xd8\x18\xb5K\xc9*\x92\xbd&e\xceV\xe1\x86\x1c%i\xb1\nٯډ#T\xf1\x8e~\x9a3\xb3\xdfl譮4\xcaX\xfc\x16\xaf_T\xa7V\x94\x00\xfdR\x14\xf2\xdc\xd7\xfd\xee\xd2\xf0\x86\xfe\xaa\x19\xde\t\xe1e\xcco\x83KɆ\x84\x191\xde|\xce%\xcd\xe8\U000689fe\xe24x \xab\xcaK\xd0\xff\xb9'i\xf9\xacw\x98\xdeeλw\xef\xf0\xb6=\xbd\x88rc{\xb4\xe4?\xa9\x80o\x8e\xe2\xcd<\xf9\x92U\xc50O\xbe|k$4\x0eU\xa7)\xa1\xf6\xeb\xa3%Ӕ.\xf2\xadM\x17\xf3\xf0K\xed\x96\x1f\xe6,\xcb\x16\xfb@p\xbe\x8a'\x87\xce\xc8]\x14/\x1f\xcci\xe1'd\x96\x0f\xe5\xe9\x987\xcb\a\xeb\xe3:IF\xb6\xfb\xf9UL}\xa7zI\xbc\x9e\x97u\xcc\x1e\x7f\xfe\xd0\xcb\x1f\xaa^\xab/\x85\x03!\xf6\xf7͔;\x89{I\xfc\x99\xa4y7\xa3\xe7Qj\xd9*\x8c{\xf9\x83õ\xf5\x8e\xb6\xf1\"\xafk\x92\xe5x\xcf-KW\x12\xc9&\x8dx\x9f\xa1\x7fvt\xd3쟱\xa3\xcc}\xb2\xab=8\xb7Q\x1cnXRS\x1a\xe9\x0e\x95\x97\x81E\xbcL\xf6\x14Ɣl\x93\xcf\xe4\x88\xe8\x145\"\xde\xe3\xe2\x95'\xaduAtc\xb1\xdf3\u05eb\xf5\xca1\xf5F\\\x18/a\x93\xc8'vI\x92Ř<\xf3\x05֔\xb2\r\x1f\x86\xeb\r\xab\xb6Y\xf1,\x1c<\xbfOA\xd9}\x92\xe6\xf4\xf1\xa9\xf5\x86ya\x16@6O\xee\xefS\xb6H@,\v\x92\x8f\x81hq\xee\xdf\x00\x99\xeeH\\\x05\xa1\xb4q\xf80\x8an\t\x8d\xad\xb6Ƒ\xda\xf02\xa9\xd5x:\xb5\xca{\x1b\x12\xc6$\xd5^Ʀ\x80\xf2\xe1<\x81\xaa\x96\xa7\xc1\xe7\xfbX\xc8U\xf9\x9c\x1a\xfa\xa6\x17'\x81Z\xa2d+\x0f\xd9!\xb1\xfe\x19\xfb\xa5\x14\xe0\xe3\r\xa1J\xa4\x0eYE\xf4\x995\x1f\x14JRc\x8dB\x1c\x89*\xf20\xcb*\x89\x8d\xa8\xb7\xb4\xcb\bsr\xf7\xc8 s\xb2\xcf\xc8B\x84\xa8C\xb5\xfa.\xc9ى\x13'9\xb9\x8a\xf7\x19Y\xf7\x19\x90\xea\x80\xf9L֔\x86\xb5\xce\xe47\x1dF\xb0\x90ݞ\xbf\x98\xab6Ƣ\x97\xa5\xed2+\xde0W\xe61@B\xffL\x16\xa8M\x92r\x98\x8f\xf73y\xfaX\xbb\x8d\x8b\xab\xb2\xac\xda0\xf6\xf8\xfd\xb3\xef8\x9a\x02a\xb5\xa3\x19v\x82\xe1\x88\xe9AuY\x1f\x8a\xe1\x1b:ѷ\xce\xea\xce\xc9\xf2-+\xa6\x8b4\xbc\xb9!)N\\e\x02~\xe4lNʯ}\xa3\fBe\x1c\x9b\xb8\xdf~\xc7\x03|U\xb9\x1c\x88\xf3\x9bJ\xe5PҘy-s@\x12L\xc9\xf8\x91S\xe1B~\xed\xdbd0\xee\x1fR>\aⴕO\xfe\xf0\x94b\xa3\x1fO.\xba\x03\x1a\xfd\xdeK\v(\xb8\xf6\x1c\xe6\xef\xb0\x04(\xc4\xd4*\x007\fpL,\a\x8a>\x7fpd\x1c\xd6x\xbe\xa9}\xea\x99>\x10%\x1f\x86k'r\xbd\xc0\xbf\x11\xa4g\x99\n\xd8\xde?\xe3\x13\x9b\xe2Z\xffrZ(\xa63|\xd3\xdc\x188\x8c\xaf2\xe1\xa5D'}\xfc\x17}\xfa\x90m\xb5p\xf3\a\x0f\x90s-<\x13c[\xf5\x94\x93M\x92ג\x01\xcc!5\x82\x9c\x8cB\x82\\\x00qe\xf0K\x8f\xa4}C\x1c\xfdcӇ$&rt\x17\xc1\x93\x846!%p\xf9\x00\x91\xfcdO&N\x05k\x0f\xa6`\x8a\xd5\xe3\x90Q\xfb\xdcx\xaeJ\x03\xfe\x16,`\x14\xc5$L\xa3?\x98uGö\x10\x96\x9e\x9a\xe4Gtr\xb2\xd6\xc1\x9e\x06\xa6\xfe/V\xb8\xab\xc0w\x84\x1a\x8e\xe8C\xe5\xa1\x01\xed#4\xdfȰ\xa5:\xc0\xd88\xdce\xf7\x89A\x13M\x84\xe3/\x98\xda\xd3+\xefV\xa66\xadu-.\xf7\xca\xc4^\x14^A2T7\xbd\x03[\x83\xf4\xb3x\xfa\x0fn\xb5i\x1b\xbc&\xdf\x10j\x8e;\x03\x97\xb5\xb1\xdf\xfc\xa6\x95\xf2\xce!\xb0\xe7&\xe0\xdc\xe6W3\xa4\x10M=K8K\f\xed\xf0\xa0\x91@i\xc6\f\xfdX\xee+\xb1\xdf\xe2N\x02[Z\x98\xff\xee\xf1ia\xf0c\xd3r\xbeI\xc2<\xf0er\xe4\xe7-\xffמ(\x01|B\xba\n\x8ec\x936dwy\xcb\x1dg\xf6!\x9eر%\x8a\x81\x9e\x90$\x81\x7fB\x82\x1a\x1eHPã\xf2\x1b^e\x82\x1a\xde\xd3\x12D\xf1OHPYt\xfcC\xf4\xc0\x15\tzR\xa1\t\xfc\xb1\t\xe2\xbdj\xb1gZ|ɶjL\xd3B,<\x8eN\x94d86Ul\x97R\xfa[(۴\xb6Dq\x1f\x88\xa3\x93\xc4\xe1\a\x12ď\xd3\xecH,\xe8\x13\xd8\x11\xd2ݗE\xb2OW\x04\x04\x9f\xb0\xb9\x82AB\xff\xacV\xd8\"\xc5pO\xd7\xc4o\xfag\xf2 ؾ\xd8=\xee+\x86L:\x1f\xd0\xdel\x91}\xe5<\xfc\x02\x8f\x1e\x88(\xe7\xe4.\xcar\x92⾛Ǐ\xa78R\xef|\xa9\x02\xa2\x1d\x85\xf4e\xf6\x8c\xa4\xf9p]\xe37\xa5\a\xbe\xcc\x19u&\xfc\x92uoo\xd9\xfbT\x1a\xb9H\\\xf2\xa5j?i\x9e|\x11E\xc6l\xb7\xea<\x17\x1b\f\x18x\x90\xa6\xcaU$4\x1dG\xdbq)\xf8\xc4ٰ\xfb\xbf\xd3i:H\xa5\x85\xccj\x98\xe6\x1cQ\x1c\xc9K\x9c\xb9\xd9\xf1\xf8\x95ɉ\"*\xfcBgmIL\x9e\xb4\xbaa\xe6\xf3#\xa4O\xf8\x91?d\xacᔘ<䅍\xb3\xb6NX>l{\\\xa50^-\x16\xc4\"\x15\x95\a\xa2\xa4ᗛ\xfd\xad~\xb3\x13\xa7f$\x17\x80\xf2̞\xac\xc9\n\x94﷉\v\xc9eMVf\xf0\x10I\xf7\xe2\x04\x1a\xec\xd5YX\x9eP\xf9h\xaaWaa\xfa<\x96\a\xef\xaa\fo/\xc9f}\xf6\xc8\xe2\xd5\xd2o\xb4h\x031\x86j\xca\xfd\xc9Wa\x1c\xf3#|\"1L~\x96\xae\f\xbd\xa0l\x9b\xd4httá\xe0#\xccIO2\xfe\x942k\xcf\xe0\xe3\xdb\x1c|\xb5BC4S7\x87\xd1\xd5'\xfd\xf5\f\x1b\xee\xd1\x16\x05l\xa35.1yb\x84=p\xca\x16|\"\xc1G\xacqK{\xb2\xd1\xd0\xc7e\x1f\xb6%=\xc5\xf2\x03d֞\xc9w\xc8Jrش\x81\x85Վe07#N+\xb7\u0094ad\xf9pt[X>\xc0^\xfc\x04\x85G\x19\x0f\xc4}\xe5\xf2Ao\xc0\x88Xn\xfa\x9b\xcd\v\xc7n\xfc/\x1f\x0e\xef\xc7.\x1f\xd4NIl\xf3\xd6\f\xc2\x18PX\xd6\xd7J^\xf9\xfaQS4%\xa5\t?\xd4S[G\xd9*L\u05ecͰ!\xc6\x00\x9eK\xb0\x89\xf8ݶ\b\x80\xb0\xda\xd1\f\vny\xb7GMM\xf3\x8e\xa8[\xec\x1f\x8d\xbdV\r\xf9\x91\x16z)\xbf\xf6\x8d2~\xa4\x99\xba\x90_\xfb\x0e2\xbe\xb7a\x16\n>\xde0+\xfa\x13\xe5\x94NacQ\xac-\xec\\\xe2\x9aݬ\x02\xdf/*[\xbc\x14@\xbd\xb7ލ\xf79y8\xe2\xe9F8x\xa4\xd5^\x1c\x18J\aU\xba^aU\u05f6\xf9\bY\x88pF\xa7K\xb4d\x9f\xab;¨\x1f\xc4Q\x95;\x93\xc7\xf8\x02@^v\xae,\xcc\t\xd8ȍ\tYgs*S\xf43ֈ\xc5 \\5\xfc\x1e\xb3\xe7V1 \xc3\xd8X\aZ\xec\xafZ\xbc- \x83y\xf4\x82\b\xeb\x18f\x99\xa0\bj\xc9\x06'\x8a\xba0Y\x03\xb5)\x11z\xb3\xab\xcc>\xaa\x9f,\x9f\xa9\xf1\\\x1d\xacI\x8e)\xf9\x8a\xcaA\"\xbf\xc1'\xee\xd46\x1e\xbe\xa4\xe3\xb1\xc1q\xf3_\xec\xff[o\x05\r\xf5\xfd\x17\xaf\xd5\n^\xfd\x7f_\xde\xffWԈ\xd2\rX~\xdb=\x81K__\xba\xd5R\x98˹\xbf+p\xf0\x15\xcet\xa8q)\x94Z\xb4~p\xd8{&\xcc\x18V\x84\x7f\x85.\xad%w\xb1gXn\x19\x9e\xe2\xd6}j\x9bv\xc1\xbe\x13J\x17\xbb\x99j$\x95\xfb\x9bp\xa7o\xf9`\x92\x8a'\x92@\xf83f\x98\xb6\x943\xa3\x1eR-\v3t[\xb8\x8b*6\x1f\xf9\x8f\xaf\xbc\x18ŮVq\xfe\xae,<qX\xa4\b\x90;\x8e\x8c\x1b\xc4\xcfL\x971\xb2N\u0088\xbf\">]3`k2I\rrX8\x10&\xb6\xf9\xceB>\xdfǛ\x7fs6\xb5\xe8\xa6w{v\xdb\x1b&.>E;4\x16\xd0i+ʊm\x1e\xfb\xdb\xef³S\x99\xc0~E\x82\xf4\xbc=g\xde\xfd\xdb\xeflk\xcb\x1a!\xbf\x1c\xb1\xd8e\x00\xde\xe6Ì\x1ad\x18cM\xb9\x12\x18\xccS\x86Y\x05º]Z\uee61S\x96h\xd7m\x9a\xae\xe98\xcf\xf7\x919L\x9e[S\xd8م\x1d\xb8\x7f\xa0!%\xa0\xf6\xa6\xfc\xad\xcc\x18&INM\xeb\xe5\xb1@Y_\xb5\x9eD\xd8\xe19\xfeD\xfa\xf8\x1as_\xb3m\x12\x1c\x1f\xcd\xf3⠎\xa7H\x114\xa0b\xa6*\xb5\xc0\x16\f\x88Ѻ\xbe\x01\x95\x17\xack\x14Az\xdd}\xd6zL\xad\xbc\x86\b\xbf\xf7\x16\x00m\x0f\\\xa6p\a\x88\xd9?\xd0p\x0f\xaa\x19\x1e3J\xaboy\x9c\x93\x19\xb7\xd7\xfc\x9ayS\xfd\x83\xe9\xe1\xfbj\xc5!D@9q*\xb3ef\xa9\xcc*\x88\x9e'[\xb7\xc7R\x1a\xcf\x00]J\x16\xb9\xe4\xfe딥\xf0lG.\xedQ\xbc&\x0f\xfc\xed\x0eջݐ\xf7\xd2ڭ\x9eK\xd1Rb\x87֊8O\xcakd\rQ\x8c\x8a3=\x16\xc1\x1c\x00\xc5Y\xce\x01\x99\xe5\xcb#9\x151H\b\x8a\xc3r|\xc7\x1c\xc9L\x1e/Z\xac\xc2ʨ0\x10Ex\xdc\x11%s\xfc\xf2\x8cRE\xcc\x12\x02\xe3\x84癠`\xb4a\xa3ɼ\f3ӎΩ\xb6σ\xb7\t\xca\xfah\x7f\xe9T\xac\xe3\xd9\xfa\x1b\xcf.\xe7\xc7-\xf3Ѧ\x84\xb5S(\xf7\nl\xc3>\xbb\x05k\x18\xef\xf69w\xf9?\x856\xec\xea\xee\x96F\rf\x05O\x99+\x1c5;(\"\x81\xdd\xf7\x93z\xf5#\xfaq\xda\xe5\xa2]|\xb0\x04@Sjl\xbc=Ռ\xb0_\xbf\x93\x1d\xeb\x037ՠi\xf1P<Nt\xa2\"\xf1\xae=\f7/\\\x8e\x1dё((\xa2\xd8\xff7\xc2\xe5\x12J\xbb\x96H\x12\xac\x17\x7f\x96\x00\x94HmC\xce8\xf1Pg\xf7Z\xf4*\xe0\x19\x93\x9bb\x82\xaa\t/(ϐ\xca\xc7wM$\x0f>rܕ\xe0cFܲ\x8akq\x96\xa4'\xe5\xe3_d\xff!7\xfb\xbb\xbf\xb2g\xaf\xa2\xf86y\x81\xf3\xdf\xf5z\xa3\xd9\xd2\xee\xffy\xb5\xff\xbc\x98\xfd\a\u05c8\xd2\xf6\x03\x82\x8c/\xf0\xa6\xfb\x98Z\xdc\xff\xca\x04\xc8\xd3\xdcg\x94\x89\xbd\xb7\xf47.\xf9]\x11R\xf8\a\x85\xebZZ>\x0f\xd2\xe5\xb6T\x8cU\x9a&E\x9eG\x1b\xa2X\x02h\xe2\x1c#뿶Q\xfd\x1b\xfd\xf1\xd2^\x7f\t\xd3\xdb\x1f\x16Gu\xfbo\xb8\xae\xfe\xfew\xc3m\xbd\xb6\xff\x97l\xff\xacF\x00\xbb/\xff\xd4\xee\xfe\xbaa\xb7\x9d\x17w{\xad\xd7)\x1c\x02\xd9+\xadpe\xd0M\xd3\xf0\x11\"\xf8\rH4\xe4\xd4a\xe1\xfc'\x9dˬ\t\xbd\x83*\xfa\x83H\xbfTp\xe0\xa0\xdc\xc9)$Z'@\b\xc2\xefr\x10\v\xf2\xe26/\xb6\x9d\xd4\xf0$\a\r\xb2\x8a\xe3ċ\xc4r\b\x80\x92\xbb7Y\x9e\x86\xab|\x9aFwQ\xccc\xf8\x9b\xe3w\x10f\xb5\xa2+\x91\x9b\x88\xdd3% \xcd:\x82\xac\xd7\xe9Y\x98\x11IuݦJ\xeem\xc2,+\xb8]D\xdeDw1\xb3oJ\xf6F\x80\xe9\x9bd\x15\xe6d-\xe9\xad6\"\xf3[v\xa2pS\x88\xf7\x10=˒U\x84\xf8Q\xfe\xce\xc4:\xbeL]\x03\x91Y\xbd\xe1K]\x01\xe8\xb8\x18\x90Ooo3R&\xdfSȬj\x14yC\xc4ǜ *\x92\xdc\v7\x9b\xeefC\xff\xc9J\xe1\x9e\x01\xc2]j\x15`\xc3\x00\\\x86\x91*\xcfWa|\x19-\x01\xed\x96J\xa7Ə\x91\xb8\x0f\xaa\xccV\xc3\x04\xe3˓\x02\xa3EEG\xc6\"\xa2\xb6J\xa5GO\njG\xa5\xe2:\xebzZ:g\xbd\x92\xa8q\x17\x1b\x04%F\x8b\x7fN\xf2}\x1aC1M\x15\"\xf5Y汮C\xd2;P9\x1a\xae\x19\xd0\xdb$t\x03\xb7\xac\xa5nC+i\xacN/P\xe9t\x05++1\xd65\xeb\xbc\xe6\xe4V\x92\x15\xded\xbb\xebG\x85*\x14U\xd2_\x83\x87]\xa9\xa9z[\xa3\xa3\x94y*=\x0f\xa3X\xae9$\x06\x97\b\xeb0eґ\x96i5қX\xbd\xa5b\xd4\x1aٮ\xab\x881\xa1ך\x8d\x92U\x11\x11R\x82\xbcD\a\xb5tτ\x88\xee\x8aX:\xbe\x02PZO\xb3\xa5\xd2a\x95o\xb6U*\xac\xf2͎J\rS\x94\xc5\x00g\x91/pQI4L\bXŔ(6\x04Q\x95\xe4e\xab4\xda\xc1\x04t\xb0\xf8\xe8N\x9e\xb5\x93t\\\x92Q\xb6*똫\x91F\x91\xf8\xa2Y\xd3ɸ\x8a\xe1\x82\xf9\x920\x83mы\xa2\x84\x0f6쒀r|p\xeb\x88{ \xa6\bE\xdc\nu\x1d\x851\x18\xfaܺ\x8b\xe9y\xfa\xb8+\xeaT[a\xdeo\xd1\xc8\xe7֑\xc6\xe5\xdb\u0605\xc2Tj\x92\xe6\x8b\xc7\xedM\x02:\xecF\vc\xe4\xb5q2\x01\xbeJN\xe32\xeb\x01\xea\xa6\xcfi\x1f\b\a\xee\xc0\xc7\xe4\x88\xc4Eu\bPa^Fw\xf7e\xae\xb1\xc2\xcb[\xf7zP6jmC>?\x93ŉ\xe2\x1d\xc6\x1b\xd0\fp78̦\xe2B\xbc\x82\x8e\xb24\n\xe3\xbb=\x9d\aʔ!\x8d\x8e\xa2\x98N\x12qeA\x95X\xedF<\x85\xb8\x89\xb2<Cs\x1d_\xe1\xffBҳd_\xea\r\x8fz\xa3\xe4\vP\x1b*\xcaq\xb8J\x13\xb6\x00\x95\x1a\xd3\xc9`\xdcv11\x8a\x17\xfb\x9b]\x9aܥᶬmH\xe7\xe3=;?YT6$\x1eꤡ\x12h\xae\x879)\x04\a\xa8\xbc'I\xcaFʲ\x8a\xa2\xaa¯\xdbd\xcfۃᶎ\xd46M\xd7$\x05m\x10\x95\xda,Z\xd1k\x1d\x85\x89V\"P\xd6fi\xc4\xee\x1d/\x12\x88\x05\xa4\xc9z\xbf*#\xc7M\x88>.\x99\xd0\x19vYd\x98\x9b]))\x93\x8dt3\x0f\xe3\xbbr\xe2\xd8n*\xb4Oe%\xc3l\xf4\x15\xbf,\xfa\f\xc4\xfa\x98~KRv]h\xc1\xdf\xc1t\xaan:\xad.\xa6쨞\xce\xe3;\xbd\x9e\xbaHasv\xdeK\x8f\xc8CŲ wpb\xde\xc2\xc4\xe8\x06\xce8\\L+\xee\xe2\x949D\xcaYl\xc1\xa4\xa9\x83\x94\xb3ؑ\x15\xbf6\x134\xc3\x16\x96\x9e\x87i\xbe" +
+	"X%\xe5t\xc2\xf7\x15z\x1e\xadhc/\xf8=L\xdf\xe6p\xb4\xc1\xaaY\xe4)\x9fm(\xfaS\xa2\xa0뾢\xe4]\x9d\x06\xe7\xf5\xbe\x1aA\x14߉\xbd@\xd9\xcf4m\bu\x01\xe1\xbaV\xa4\xb6\x9a@\xb9^ާ$\\gl\x82ST\xf5\x0ej\xc9\xcb4\xdc\xee\x12\xd8\xfb\xb6Q\xca\xe1\f\xae\x85J\xedj\xb7S\xfa=\x1f5\xb2\xab\x8c\xa8]k\xcbW\x00W\xcb\xf3v\x11/\x92\xfe!Lل\xbd`E\xda\xfa\x10\xa5\xf9>\x84\xcb\xd3V\x80\xe9\xea\xf2\x15\xaf\x8d>\xb0n\x91N\x13\xc0\xe4\xb0%_^(L\x03&K\x80\xb2\xf0/'\xaa\"\x00\xcf^\x15\x8b@!\xf9\x84GB\x9f\xcf+\xc2L(\xf3M\xc0gʎ\x8bf\xcb\xe8݇\xd5\xc6\x0e>E\x01\xf7\b\xb0\x00\xabaAP\xad\x96\x05F\xa7\xbd\xd3,O\xf9\x87x\xbeD!\x93,+Ɉ\xca\x1e\"-h\x1e\xa2\xb1\xf5F\x18\xe7\x05\xb9\x81\xc8t\xa5B\x8bQR}D=߄w\x05\t\xa7\x88N\xbda\x82\x03LMV\xa3H~\xa0.\x03\x92!\x7f\v\x01\xc6\xe1\n\x12ۈȆ\x11\x95\xbf\x83!EO]\xa4\xa0n\x06t7 \x95\r3f\x11\x95Zp\xb1\xea\xe7\xf1\x1d\xceh\xcbD\xceP\xd1\xe2ܔ\xfd'\x02uT\x10\x1d;\n\xaag\xa0\xa2\x9c\xe0r\x14O\x81\x14\xe4z\xe1P(\xdb&\xbcz\x006Q\xe0\xe2\x05o\x11\x95l'B\x04{ʲ\b5\x02\r&:\xba\x90ސ\x87\xca\xc6F\xbb\x84\xe3\xefP\f\xf3\xf0\xc4مiF\xba77)\xf9\\Kno\x9d\xbd\xd8ף\xf7\x8b\b\x87\x8a\x90Q\x97\xb4#\xd3o\xa1aB\xf8\x9e\x05\xcd\x17\xff\xa5\x92\xd9T\xa6F\x9c7lEÎ\x82\xfe\xe6\xfd\xbe7\xee7\n\x1e\xea\x02#\x1a\xf2y\xc2WBP\x00\xff\xf1֩\x94\xc1쵞\x88}\xef\xbc\xd9\xc7Q\xfe\x96I.\xf8RF\x94\xce!)\xc9\x1d\x90\xb4\xa3\x12\xca\"i\xaa\x91\xac\xf6\x8eL\xe2\xf7\x8e\x8fZ\xa6\xa9fj\xb7I\xba\rs\xe6\xaa|\xce~\xbe\xa5\x17\\rk\xa9\x14A\x9d\x87\v\xe1\x95ri\x1f%\xcaq\xb5\a\x8a.\xc3-\x8c\xdd\xf5\x9a\x1f\xf1EG\xdbi}\xcc,O\x97\x16|\vyX\x04r\xae\xf8\xfd\xec\xd5\xcc4FV_yw@\xbd\x8cM\x87\x8a9\x98\xceM\x18\x03\x8a'ei\x94Y+e\xf1\xb4\xf7\xc2\xd5=q\xb6\xe1\xee7\x1e\xfa;\x97\x7f\x1b=\xecw\x99\xf3\x86BΣ\a\xf6\xe2gE̬u=K;Yt\xb7LX\xa2\xb3\xe8\xae,\xc2Cq\xd17\x1c\xc4ak^\x11\x8dЄei\x99P4\xd2\"\xba\x94\x93AWɆ\xba6\xf5Y-\xa7U\x8bC\x8b\xaa\xae\\\xe1JV\xc9\x1a\xbf\x8c\x8e\xfd^\x19\xb3\xf8\xe7\xd4\x19\xa4\xa9\xda_\x02\t\x96'\xa5\xfaI\xce\xffg\x9e8\x81\x0e\x0e\x02m3\x1c\xf4\x9c\x9d\x92\xbcexG\xff\x7f\xea\xf4\xee\xa3\xcd:%\xf2҇\xf3\x88l\xe8\xfb\x83\xec\xdf2>\xd1d>\x84\x9bZ\xe8\xf0\x1d\x12\xe4=\x83a\x14\xc0\x04\x14\xe07\xec\xb3H\xd7~k\x9b\x19R\x9az\x89\x0f\xbdړ\x86+\xcf_H1\xf6\xec\v\x16\xdb\xe5@\xc5\xc4Rx\x86\xcb\a\xb6z\xa8ܹF\x84\b\x9a\x1b\x96\xa5B@yq\x06\x1fT\xd9\x7f\xe5\xa0Ů\xb5\xa9\x1c\xd6\xe8cp6]\xf0\xf5*\xd85c\x93y\xf6\xfd\xdb\xef\xb8>H16]\f\xe3\xeadО\x10U\x179\xd1܋\xf1\x7f\xba\x1bJ\x9f@ZI6\xc4a\xbd'\xfduʸ9E\x98q\xd9\xefa\xc6<\xecx\xbdb1\xf2\xf9)\x0f\x98\xa5\xc9&\xb9ۓA\xbc\x16!\x83]\xc4B\xd8\t\f\x116\\t\xb90fˌ\xb6Q,\xfd\xbbhUY\x17\xef\x83@\xe7.\x99.c\xb1\x8f\xa9\aC\x91\xabұ\x142\xf3~\xf3\xe8\xc9F\xc9»\xe3K1҈\xdd\x01\xe3\x93\xd5\x1a\xd3h\xd2[\U000913b9!o\xe2UN?+\xf1\xb5\f \xdf\xf2\xd1:\xf0ř\xc4\x1a\xdb땝\xf2\x16d\xfb\xad\x93E\x7f\x94\x1f\x86c\x9ajdT\x9b|\x9ar\xc2\xfd\xcd\xdeVq\xec\xd9\x1b \x94\xa7f\x060\xdfjB\xe5\xf1J$z\r\xbb\x82\xb2\x9c\xec\f\f\xe8\xd8%ć\xeb\xcfa\xbc\"\xb3^-\xd9u\xf9o|72\x04/Ɇ>\xa0X\x06͒\xcc\b\\\x10\xf2\xa9\xb6K2\f5\ve\xae\xa85\x9bF\xa9\x9bjN_~6\x03\xa8\xea\xb87z\xd1Ƭ\t\x9a\xf5j\xbbUY\x966\xa5*u{\x96d\xf6\xbbr&䋘\x17;\xf2A\xf4P\xcc\xed\xe4\xf7-\xb5\x80\x17_\xcc+G~0+\x88\xfc\xd8\xedob\xf1\x96\x11\x0fP\xe4d9|\x83\x98\x8eʊם\x18\xb5\xe4\xf6=\v\x9b\xe5\xe9A\x0f\x83\xb2u\n\xb4\xadc\xfc\xcf}\xb8\xb1I\xa3\xb4\xa2\xe30\x88\x96\xbcv\xcf\x04\x80\xd0W=O\xecfd\xa9\x8b\x9e\xb9\x14\xa8!\xe8ڍY\x86\xa9\xd7+ۇ\x7fW\x04i`V\xab\xc1<I\x03\xb0\v\xb2\xd9\xdb>tF\xa5\xd3c;\xe9\xe0\x04K\xe3\x10w\n\x15k \xf5\"\x1d\x99\xe8O\xd1NN\\L\xf1n\xf8\xc5\x18\xe5$X\x83\xf0ib\xedDdۨ\x16ض\fi\x12Nִ\xd0\xd0\x1c\x01\x8d7`\xf4fe\x80\xcc\\\xea\"\xfb\x18;\x98b<\x83\xd7\xe6\x14)\xa1\xc5.\x98\x94:\xc7A\xb6\nϩ(\xfd\xef\xa3x]|\xc8\xc9!\x8c\x8b^\x18\xb6b\xeb\xf7\x9c(W\xa5\x95\x91\xd9[\b\xc4\xf4\xc9m\xac\xb6O:O\xc5\xce;\xcb\xf0\xcehD\x03T\x8b\x81m\x19\xdeq\xaf\x1c\xb8)L#\xf8\x9b\xd3hBP\xe1\xc4ĉ.\xa4\xe5\xc96Z\x01b\vR\xa5S\x8c\x94\x1a\x00\x1a\xf54XDy\xc1\xe7\x19h\xa5{\x85\x005\x10(_ݳ\xb6\xc4\xe7Q\"\x8e\x16\x84\xd0\xc9'H\x00\x8a#\t\x95|\x05mD\xa6\xf5@\x13\xef\x05\x1af\x18\xaf6\xfb\xacԝ\xd7\u0090]\xb4ak5\xa9=L\x8e\xd7\x11\xd0{\xd0\xc0\xc4,\x87\xeak\xab\xc40\x96R˽\xa6ex\xc7R=K\x93\x15Yӽ\x13\x8eh\xfa\x10\xf1\x18\x87J\xb9\xd5\x01\x99\xb5l\xb6\xd5&\xc5#\xe2~Kxm\x01\xfc\xbe\x01\x91\xc8r\xf3\xa1p:~CFXaؔo\xa3\x16;\xac\x8cb7Y\xf0\xc2\x02\xbd 1I\xa3\xd5b\x7f\xc3FU\xa9Q\xa8\x98\xe1v\xcb7/aƛ\b@\xf7\x93\xc9Zo\x11m\x03j\x9c\xac\xf7\x9bB\xbf&\x04(\xf7\x00\xe6s\x18ߓ4\xca\xd9LL\xd4\x1a\xc4ζ\xae\xd74+\xc9>\x8f\xe2\x02\x842#\x17[ 3MX=G\xe1\r\xd9\xc8Z\aK`D\x1e\xb4\x8a\xed\xc2\xe4q\x1f\x17I\x81\x85\x8f\xb2܀Bǚf\x03X:r\xffW\x96\\\xc3@c{Â\xee+\xf4l\x17\x16\xbaj\xc2&4\v\xe9\xf5\x1e\xb0B5\x115\xa5\x0f\x1d\xc3r\x80\x89\x16\xdbɰsC\xdcy\xbaL\xb82`;\x84\xaa*\x8c\xe1PF\x80\x00\xb4\xd7]\xc1\x86܄q([\xa8P\x7fP̂\xa0\xae\x00\xaavqOo\x88\x82\x8cPy\x8bO\xd4\x1f'\x89\x81\nZ\x88^\x1e\xe4\x10\xa9oc\xea\x1e\xa5݅U\x10\xf8\n\b\xdd\a\x98\xcaZ\"LwC\xa1\xf3\xea\r\xbbh\xa8\xdc%\xd9\xee6aN\xba\x9b(\xccd\xe6Z\x06\x00\x15\xa0\xf6\x1a\xbe\t\xc8\f \x1a\x12\xe6xy\x9f&_P\xcf\x06s\xbcL\x1f\xb5\xa6ӄ5\x8a2\xc2\xda\xd6Thkr+s\n\xcb\xf0*\xc6ݩ\xd7@Č\xeff\x93\xf5\xac|\x11X\x00}3\x10V7\x98\x81\x0fa\x1a\xd1v*i\x9eJ+\xfa|\xaf\xa9\x93hs\x92\x9c\xb0\xa0>\xb0\xe3h\xa8\xf57a\x0e~\x89\xf2{f\xfd\x10\xd5@ο\x94\x03\x98\xa6M\x93S\xb4V8U'3_\x81\xa45\xb9}\xc2J\bp\xd8\xe7c\x18\xa4/Z\xaeV\x87vE\xaf\xa2\x03\xb6&\xb5\xd0*p\xfb\x1d\x1fV\xac\xb9\xe4V\xcc2\x8f\n\x8fm\xd5\xf7!\x89ևL\xac\x12c\x93\x01\xb6\x8e\x98-\x9d\xcfR\x7f\xe7\xc1\x02C\x1792\x0e\xb6\xe2i\x1eq\x9f\x167=\x7f\xe6/\xb8*K<N\x13\x96\x9e\xd2\x1a\x83oT\xe5 \xba_\x92\xe9\x8bĒH'\xab\xb5\x13\xb1\xbeQ\xe8\x19r氡R\xb1\xabY\x85\xd9$+\x13\x86\x9f>,\xb6w@\x9b\xc0\xe9>\x85\x99\x85\x16\xae\xb7N\x94\xbdg\x9b\x99,\xf3\xa7Xa\xb2\x0e\x95+@\x10\x83\xb6\x82>\xb5\xac;O\xf9\xeaX\xac\x8cO\r\xeb\xcaSu!\t#f\x9b(\xe5\xfa\xdeP\xd4`\xab%%\xab$]\x17K\x8fZ\uec36xb\u0086\xbb\xdd\xe6\xb1&\x97\xa0\xd2\x02h?2\xcd\xeb\xe4aKCQg\x9b&Y\xafg\xa9\xfe}\xcf\x7f\x91\xcd\xed\x0f\x8c\xa3\xf2\xfc\x97\xdbj\xb4\xea\xaer\xfe\xab\xdej5_\xcf\x7f\xbd\xe4\xf9/\xb2\x01\xa7\xbf\xc8\x06\x9c\xfd\x02\xe7\xc3~\xae:\x11\x86Έ\n\xb7\xb0\xf9\xf8zܽ\x18\xf6\xae\x97\xf3\xeexv=\xb9\x1a\x9f\r\xe6l\x95\xe17\x9a\xf5F\xbb\\|\xf6\xa6\xe3\xd9|\xb0X\\_\x0e\xa7\v\xe6\x01\x92\xf2\x9b\x1bظLg\xe5\xadN\xddo\xbb\x1d\xd7\xc01\x9bO{\x06\x1e\xcf\xf5[~\xbb\x11\xf8-\x8dg45\xc7\x12\xb8\xf5\xc0\xf5Z\x8d\xc0\xc0a\x89\xa5H\x99\xa7\xf1\xfcc4<3q\xe8\xc0Ųo\xca@\xe1z\xb6N\xcb\xd7$\x18q/^\x93XD\x7f\x94\x1ft$\v\xe990\x11\xf2\x15\xf0\a~5\x7f\xe0\xab\xfc4D\x1f<\xf8\xb6&\xbcW\xfd\xb9nn\x85\xaf\x0f\xcc4t\x9f\xc3${\f*\xce\xeaR\xd7?\xbfv\xaf{\xd3\xc9yw\xb4t\xfa\x8f1\xf5cs\x99\xe3i\xc7C\x98\xfep>\xe8!\x88\xd7\f\x14\xc4b6\x1f\x8c\xfa\x93\x01D5\xbcV\xd06\xe1f\x93>\xc4\x05\xcdf\x03\xcb\x1b\xf4\x87\xcb\x01\xc2x\xf5N\xcbm\xe2t\r&\xfd\xf3\xe1h9@\x91\xbaA\xa3\xed#\xd8\xc5hz\xd6\x1d!a:\xfd\xaa?\\b1\xadV\xcbsq\xb2.\xe6ӫ\x19Dሆ\x17\x93\xf1ը?8Gq\x05\x9e\xeb+\xb8\xc9py>\x9c/\x96XY\nf9\x98Ϧ\v\x9c\xb7\xba\x87%\xbd\x1fO\xb1\x96\x82\xb6\xdfh\xfaJ錦\xdd\xfe\xf9h9\xc7\xf9C\x90ɴ7\x1d\x8f\xa7\x13\x1c[\xab\xd1\xf2ݶ\x12\xe7d\xda\x1f\x9cӦ\x8cJ\xc7ok\xa0\xd1`\x89R\xaf!\xb4j\xe56\xdcz\xcbSaWc\xac\xf3zGM\xfbe\x1f\xe7\xad\ueddb-\x15\xf4~\xf1\xebx\x01aM\xcf\xf7\xdaj\xa2\xa6\xb3\x01RB\xa0f~>\x18M{(=n\xc7o\xd4U\xd8/(A\x888\x9d\x0f/\x86X\xd3\x1eN\xc6l\x88\x8b\xbd\xe1{n\xab\xa5\x80\x16\xc3\xc9\xc5h\xb0\xc4e\xd6h4\x9b\xbe\xafԥ\xc5\xf2\n\x95V\xd0r\xeb\xed\xb6\x92\xb3ů\xe3\xe1d\xa9ָv\xa3\xdd\x0e\xea8\xe2\xe5\xbc;\xc1\x8atq|\xbf\f\xba\xefY\xc3D\xa5\xd2l\x04\xedV\xbd\x83\xb0g\xc3I\x1f*K\xa9%XS\x8a\"\x17\xcb\ue48e\xa5\xa3\x05\x04\xc0R_\xfc:>\x9b\x8e\x86=@\x87\x91/\a\x1f\x97\xf3\xc1\bPK\x8d,\xaf\xbb\xfd\xfe|>\xb9\xb8\x1cR\xba\xb4\x85ѱ\xad\xd5i4u\xdch\xaa\xe1\x82v\x1d\xe2d\x0f\xa3\xc8\xf2\x10\xe6\xe3p4\xec\xce\x7f-qŨ\r\xe3\x84jS\r?\xfd\xe5u\xefr\xd0{\xbf\xb8\x1a\xeb)j\xc1\xd8h\xd7?\xbc0$\t&\xbb?8\xbb\xba\x80\xc9A\xb4\x99-W\x106\x98\xf4\xa6\xfd\xe1\x04HA\x99>\x1ft\x97W\xf3\x81!\xb1\x01D\x15\xf5I\xd1K\v\x81&\xb0\xb8\x1a\n\xe9\xba;\x9fw\xa1n\x03#`\xf1\x0f\x00iCȨ{\xb1\x00\xb9\xa8\xab\xb4kW\xcdE\xdbm\xc3\x04^L\xae\x98\xdaGÞAk^\xdb\x02]\xfcC\x03\a\xad\xba\x02\xbe\xec..\r2\x9b\nl4<\x1b\r\x17\xa6\xd8;f\xa41rW\xc1\xd2Q}8y?\xe8\xeb\xd8\x00\xcaŉ\x84\x95\x96\xcdt\x15f\xd4\xd5/\xe5Զ\xba\x0e\xd0\xc1\x15\xc8\xf1\x14\x92V\a\x9aF\x00\xaa\x03P\xfc\xdf\xc73\xd1g\xa8\xa6\xd9\xfe\x92ϣ˸\xc5\xf4\xd9\xef \b\u0383>_\xee/\xaf\xc7\xc3قv\x06\xd7\xfd_'\xdd\xf1P\x87{\xbe\xab\xc2Ϻ\x8b\x01\xeb\x8c\x06\x8b\x85A|[\xc5\xd3\xd9v\xb7\xb7\xbc^\f\xff1\xd0\xe57::\xde\\m\xdb^\xbdn\xc3N\xa6\x06tCC\x7f\xfc\xa86-\t\xf6\x02\x15\xdc\x1f\x8c\x96\xdd\xebިkȥ\xe76+\xe0\x8b_ǆ\b\xfcj\x8ekS\x16\xbc\xaah\x8c\x1c\xae%\x1f\xc3\xc9bٝ\xf4\f\x05ඪ9\xccѴ\xcdLŜE\x81w*\xe0\xe6\x9c\xd7\xcd\x1cfݺV\xb0Y\xb8\xd6\x04\xfa\xbfN\x16\xcb\xf9uw4\xbc\x98\x18\xea\xa8V\f\xe6Z\xe4v4]^L\x97\xc6$\xbbZ\x92/f\xd7\x1f\xba\xa3+C\t\xf9\x9a..\x87\xfd\xfe`B\x85\x0f\xfb\x1f\r\xe9uu\x86Y\xf7b`,IM\x17Cۨ\xdev;\x9a\"\xd8\xea\xe1\xbck\xaaX\r\xdf\n\xb6\xf5\x05Z\xe5\x1d~\x18\xcc\x17\xc3\xe9Đ\x14\rk\x19o\xda^ݵ@\x8d\xbd\x86V\xb3G\xd3^wD\xd5gշ\xd71\xf2\xd8˧n\xc5\x1b\x93\xa4\x15\xd1\xd8T\xa7\u070e\x96\x8c\xe9l9\x9cN\f\xfdWC\xeb\x1bg\x83\xf9\xf9\xf5\xe2\xea\xfc|hJ\xb0\xa6\x94\xd9\xf0\xe3p\xa0\x0e\x81R\x1dZ+\x98\x8d\x96\x17S\x03\xd2\xd7r6\x9bO\x97\x83\xderз+O㙏\xfa\xd7\xe3\xee\xccP\xb7}\v\xf4\x1a\x8d\xacEj\x9a&8\x9d\xbc_\xcf\a\x8b\xe9\xe8\x03\x1f\xf6\fIj\x99\x18\xedUWS\xfe\xfc\x97\xd9Ȥ\x1e-\xfd|\xa1q-\x16Ŋ\xda\xdb\x06\xf4\xb2{f\xacRZUY\x0e\xc7\x03\xba\xd2\x19\xcf\f\t\xd6\xd2q5\x99\x0f\xce\a\x1f\x8d\xd5\xd5E\xb5{\xfaa0\x98,\r\x13;_A\x19g\x7fM\x05\xb4\xec\x9e\x19\xd6\x00P\xd4d0\xe8\xa3\xc9!\xa4]\x8d@\xb1\xc3d\xaa\x15\xb4\x81i\xb3n\xdf\x10\xad\x0e2\xe6A\x81\xe1I]]#\xa2\t!\xa4N\x17t\xd4\xd1g\xfe\xad\xa0\x05\xeb\xdfl\xd6\v\xfc\xeb\v:I>0\xf9\xe3\xc8\xe9\xac\x7f\xa0\x8a\x16\xb8\xc5?\x0e\xd4\r\x89\\\x1e\x18:f\xb3\u07b5\xa9C\xd0\xd2g\x91\x85R7\x1f\x98\xa6\xdb\rφ\x81\xb9@e\x88;\x85\x16\xa6tK\x92J\xe9M\xaf&\x86T\xb6\x9b\n\x0e\xb5\x83\x8eB\x84\xa9jc\x9aM~\x80a\xb8\x99)\xf2q\xd1Aڬ\xbb\x84\xab9\x94\xe8\xab\t\xa6\xa2\x81n1\x9dt\xc7p\x14\x875a1\xeb\xce{\xd7\xf3\xc1\xc5p\x81V\xd3\xc6\"\\,\xe7(}uLC\x8d\x1e\xa6\x0f\x9a]T\x17(N\xc6:q1q81\xf7L\x1d\x15v>=`\x9aa(c\xe3oc\x14\xcaJ\xa0Ѯ\x17\x97\x138桞\rX\x91TO\x1eJ\x1d-\xfa\x83\x85\xb1Y\xb5:^ˀ4\f9\xad\x0eZ\a]-\x06}\xc3\"\x18B>tGf\xdb\x15V#\x87\x19LW>Z\x17}\x18̅Q[\xa9\xeam\r41\xcdO\xdb\x1d\x8c\xa3\x83\x81\xa1\xee\xd5u\x94Q\\\xc7\xc5@㬫\x15\x88-\x15v4\x1an\xd0\xf0㍖\xdd\x13N\xb4m\xcf\xf4\x1fc\xb0\xf1\x14ށG̕=\xa6\xfec\x1c\xf8\n2\xf0Kd\xe0\x03$\xb3>\x82\xad\x1e\x11dO\xa4\xa4W\xa4\x93[]\xb1s\xb8\f>$ح\x94\xbc\xd4\x12\xbb\xacN\xeb\xf2@R\xa9\xef\xf1\a\xee\x9fb<%\xc0\xcf\xd6\xed\xc5\v\xf64\x81\x99\xc2F\xc3N\x9d>\xd9e\xc5#\x15_\x8d\xf2\xfbdW\xbe\xd7o\x93\x83\xe3\x13,V\x91\x94G\x80\x8d\xf4\t!\xe6\xc3\x0f\x94@ӫ\xa5\xf0\xab\xacރ\xe1u\xf7\xacXk\x81\x01h0\x14\x16\x10`B\x1b\f\xaf\xfb\xdde\x17\xf6Ń\xe1\xf5dا\xbd)\xec\x82\a\xc3\xeb\xe9\xa2{6\x84C\xe6`xM'S`\xfc\x1b\f\xafˈK\xde\xd1" +
+	"9\x8b\xb8\xe1\xe9\x97lHZ\xe0\xeb\x97lH\xdad:\x19\xc0\xfb\x15\n*M\xba7Z\x9c\xf1报2ڸ\xa4y\n\x8d\t\x154(sܽp\xfe\xe6\xfc\xfc_\x0f\xad\xdb\xc1\xe8\xfcg@a\xf9\xbfn\al\xb3G*\xa3\xa9\x01\xbaÏ\x8e\xae\xaa\x82:\x1f\x17Ԏ\x89<]\x14tW\x17\xde\x1bM\xaf\xfa\x94Z`t\x19\xe7\x83\xc9\xf0#\x14\x13\xe8\x90\xf9`p\xb6\xe8\x97)\xd1\x10\x97\xb3\xab2\x1b\xaeN\xbe\x9a\x97ܾF\x1e\u0381\x12\xda\x1ay4\x9c\x00\xe9\r\x8d>\x9e\xf6\a\x8b崌_O\xc0d\xb0\x84\x19\xf0t\x00-_I\xae\xeb\xe4\xc5\xfbR\xbc\x9e\x01Z\xc6P\xbe\xeb\x19!\x1f\xc6@\xcdz>\x16\xd3Qw>,!zAP\xb3\\\xbf;\x82i\xf5\x9az\xb1/\xe7W\x81_\xc6Tfg|\xddh\a\xce8\\\xddG1A\xba\x1c_\xfb\x88\x12\x00J3h\xd7냏\x80\ṉ\x83\xf1uо\xec՛\x80\xdc\xf2Tj\x1bR]\x85꺐\xaaJv=@m6T*Jt\aQ\xdf\x03\x92\x0f(\xad\xf6\xfb\xe9\x1cЀ\xf5d0\xbenכ0=nЄĠ\x0e\xd3\n)(:\xc8\xd3A<.\x8c\xab\u06dd\xf7.\x03\x1f\x92\xdb0\x87\xdd\xd1\xec\xb2\v\xcb+\xe8\xd4=\x95~M=Y@N]\x04X\x0e\xe6\xdd\xeb\xc9p\xba\x80j\x04\u05faQи\x7f1\xbb\x82\xc5\xebAuu\xe7=(\xbe\x89I0y\xe0\x92HN\x94\x1b\x00\x00\xd3i\x98!(y\x1d\x1c\xc9\x18\xc6\x0f\xebG\xf7\x03,G\xac\xba\x0f\xf3\x06\x92ن2Ϻ.\xaa\xce.\xa2y\x88\x06\x15~6\xea\xf6ޟ\x0f'(Ӱ\xad\x9c\xcd\xce!\xb3\x0f\xabH\xcf\r\x02T\b\x90\xb1ןAF\x17\xa6\xb67@lPɬ\x87_\\\x0e\a\xa3>R Ltoz1@\xb2Q\xbc\xd3Q\xff|8\x87\x80&f\x9e\x8e\x103\xca\xd1t>\xe8^\xbb\x8b%\x8a\xbb\xa1!\xbc\tN\x1d\xac^=\xd4\x14\xeb\x88y\x8e\x9a\xb7\xdbBqϻ\xbfN>\xa0\"F\xfdN\x8fv\xa5\xa0\xad\xa2L\xcf?\"\x8d\xa2\xf4,\xe6\xd7ﻣ\xe1\xf8\xac\x8b\xf2\x8d\xb4~\xd5G\xf5\xbe\x03+e\xefW\xeea6n\xf7P?\x02\xebX߭\x7f\x805\x17\x96v\xbf\x81i0\xe1\xfd\xc5\xccC\xddEC\xa1\x0e{\x8d\xfa9\xca[\x1b\x02>\xce\x10\r\xeakЛ^\xe0\x8e\xaf\xadRqa\x04*\xf9c%7*\xa9\x06\xd4\xf8`\x89z\x1f\xb7\x85X?\xf6\xe8f#L5T\xe4\xb97\xc6c\x00\xb8\x91\x92\x92\x87\xf3\x01\xb3c\x80\x8a\x00\xa5\x9f\xcf=\xd897Z\x88ր\xb46\x92\xbbD\x9d\v\xd8V\xa2ď\xa8\x9d\aPQ\x97m\x98\x17\xbf\x8dH\u05cd:\x8c\xd1\x0f4*\xcc\b\xea[.\xdb\xd7M\xcc\f\xeb\xeb\xe5Uw\xf2+\xcc\vT\xe1\xb0{\x8d\x86\xa0&\xac\xcct\xdff\xe4\xa1\xd1ݫ7u@\x80\x00\x81\x0eh!@K\a\xb4\x11\xa0\xad\x03:\b\x00\xb37\x9cy\xefQ\x15\x80\xd9\xfb{\xf7\x03u\x1b\x805\x00\xc6\xfeޭ\x8f\xd10\x01yߏq1\xa3\xa9\xd4\xfb\xf1GT\xf3<Ԝޏ?*\xbcX\xf0G\x94_4\x1e\xbf\xff\x80\x87\\\x0f\xf5O#5\xc10M\xa3\xee\xa4;D\x03\x10b\xed.\x97\xc3\xde`<\xecM\xf1\xd8\u0600\xea\x1eM\xa7\x93\v:1\x81r\x9a\x101v\x03Ե\xa11\x01\xab\xcc\xc5\x14\xc4\xe6\xd51\x11\x8d\xe5(\xbe\xeed\x88\n\x18M!\xc7]\xd4\xf1\xa0\xf1z\xdc\xfd\xf8\x9f\xa8\x15\xbbh\x8e8\xee]ήgC\xa4l\xd4\x7f\x8c{\x8b\xe5\xf5`t6\xbfB]P\v\xb6\x80\xf1\x00U\x83&\x8a`\xb0\xec^ N$}؛O\xcfF\xdd\x7f\xa0\xf1\xbd\x8d\x04\fg0\xe6\xb6B\xba\x9e/\x1a\xd7#\xc4^\xd7!\xfe\xf5Y5\x04*\xb0\x89t;\x86\xe3\\\x13%~\xdc_̮g#\xac\x99\x00\xc9\x1e\x0f\xa1dTS\xc7\x13\xb7\xee\xa1\x1e\xab\xa3\x92qo\x88\xd52\xfd8Ds\x1a\x0f6\xa0\xf1b\xe6\xe3RG]֤\x87ƛ&\xec\xad&\xfd9\x1c\x04\x9b-D[\xe0\xba\x1d *]\x95\x95\xc4:\")\xed\x19\x16\xe3d\xd1@\xbdW\a\n\xa5\xab\xc66\x9ai\x04\nu>\\@\xd9h\xe27\xeb*T4\xab\x9c\xf5\xe0d\xa0\x89H\xfd\x99\v\xf5\x17\xf8\n\x11\xea(\xc0\x9c\v(5\x80\xc52\xfb;L(\xacf\xb3\x19n\x82\x98\x84F'\x0f\xf1͇\v\xd8\x19\xb6a\xee\xff\xb3\xbf\x98\xa1\x99\x01\xca\xc5\\\xe9\x8c\x02\xc8:G3\xee\x06\xacy\xf3KT\aP\xcfI\xb5\xfd\x01\xf5\xbe0\xfb\xf3Q\v\x17%,\xe8\xf9\x02\r\x80\xe0\r\rJD\x1d\\\v\n]4Z\xa8\r!R\xa7\x8e\x9a\b\xa4\xd1yy\vE\bKq1@\xf3D4\x1a/\x06\xd7=\x17\xb3v\x14r\xa3\x81\xb8a>\x17h\x06\x83\x92t\x89G=\xf02\v\xa5\x8e\x06\x1d\xac\x05\x14\xe9d\xe6\xa26\x84\x883e8Ui\rO\xed\xc5\xda*\xe4\x03\x9c|\xa0R],]\xd4M\xa1\x0ep\xb1t!#\xea\xfc\x17K\xdc\xfd\xb9u\xcc\tU\x8c&ӋeGI/\xea\x87\x16\xcb\ue716.lۘ}\x8cj\x1aZ_,\x96\x1fg-<\x8fG\xe4\x0f\x90\x86*\xe2r8\x1a(\x96\x8c\x96B\xbe@\x82;\xaeB\x9eͧ\x88\xbd\x8d\xe8\x93_a\xef\x11`\xe6\xeb\xee|\x86\xbbf\x1f\xa7\xed\xba\xe7ձ\xb6}EB\xaf\xd9T\x00\x9e\x02\bT\tu\f\x98\xcd\xd1*\x06ͼ\x96\xe3\xf1\xf5\xc5\f6*ԑ/Q\xff\xd7A9\x9f\x0f\x95\xd2Ă\xe7\xc3\xf1\xa0?D\xabQ\xd4\xe9.\x17\xef\x1bJ\xc2\x1b0\xebW\x13U\xbe\x8b\xe6\a\x1fڈ\x19\xad7?\xb4\x9b\x90\x86\n\xfc\x03\x9a\x95\xa1\tӇa\x7f0U\"\xed\x18\x01\xa8\x1fi\xb4L\x90&\xaaSm\x04Y\f\xafƨ\a\x84\x19\xff0\x9b)%\x0e\xe5\x7fl\axU\x84Ƈ\x8fJ\xea=d\xb6\xf8x\xd1]b\x85\xc2\xcc}\x1c\x8e\xa7\xcaJ\x1a\xea\xfb\xe3r0Y\xc0\xe2Dƒ\x7f\xb4q\x97\x0e9\xff\x81F^\xd0?.\x99\x15\xc6\x11'\xdc|\x10\xde\xffu\"\x83\x1b x\xf0q\xd0s\xca\x03qE8s\x9f\x16\xe1A\xd3CQ\b\xbf\xe9\x82\xda\x04\x03\x8apZ.h\xe0\x84Ѡ\xf0V.\xe5\x82y\xe2\x92O\xab\x04\r\x86\xd3\xedp\xf5p\xdf\xe0\xc3u\xefj>\xa7\x9bQr\xaf\x0fS\x99\xb0\x92T/.\x1e\x9d$\xf2\xb5\x1a|\xf1hy\x87%\xfd})N\x9c\x8b\x9b\x853v\x11\x17\xffͮ\xd3d\x0f6\xbf\xa1?\x8e\xb8d\x80\n\x94\xfb\x94\xec\x0e\x83چ\xbe\xfb\xc0wS\xd9\x1d\xbb\xfc\x86>\xe5\x026Ά\x9e\xcaVh\"Eg\xe2\x14\xfb\xe3N\x86\xf0\xa3z2\xc5\n\xd7\x1d\x91\x0f\xf6\xe8,\xb5\xdf~紷Ne\xaaJ\x19\r\xef{H\t\xfc\xe7K1\xdc\xfel\xcd;;\xcd?'\x1b\xf1\xceCV[gy!=%\x1b\xdbe\xcaf\xee\xee\xb8\x1f\xf8\xdf&\xa2\xd1\x0e\xbe1\r\xf3\xf17\v\xf8\xd6L\xccf\xbdo\x16\xf0\xadi\xa0\xab\xdbo\x97\xf0\xad\xa9`\x16\x95o\x15\xc2\x16\x17\xdf*$kt\xea\x0f\xdf&\x82͇\x9f\x9a\x8e\xfe/\xdd\xf99\xbbރ\x9dj\x7f\x87/\x1a\xc5\rW\xf4B\xa8\xa9\x1b\x91©\xe1x\x06yG\x1a\xe2\xc0\x81\x16\xce5\xf2\x9f\xc8j\xf0\xdaTc~\x15<\x8b\t\a\x1e\x15\x13\xf3\xdfxbl\x9c\xc7\x10#%\xd8\xfa\xdcx/@\xc38\xcaQ\x84\xe6\xeb\x86U\xb6Z$\xdes\xb8\x0f3\x11$.\x89\xf9,\xe5R\x87\x97\x0f\xe0\xa3 \x15w$o\xa2\x9b4L\x1fewm)\xbd\x11CED\xe4Q2۪\x88p\t\xca\xc3;G\xfa\t\x1d\xc1ş\x8fW\x98\xf6\xa6g\xe9ˉAq\x97Qy\r9\xbf@\x94\xfd\xe7Tf^\xfe{*v\xe8\xd9\x7fO\x1d\xea\x85#\x004\x9e\xf6iy\x8b\xacv\x87,\xba\xd5T\xce\xf9Ŀ\xa7\xe2\xd6y\xec\x01\xc6/\xf9Aw\xe7\xeb\xf7\x0e\xd7\xde\x00\x98\xe5\x82|\x9e\xd5\xd2C\x8d\xbd\x99\xe7\xfc\xe6\x06\xac\xba\x88\x84I\xbf&\x994\xf9\r3\xd8\xf0`J\xe9\xd7\xec^\xbe\x11B\xbf\x16\xe8\x8b{R\x15|\xf7\xc5\x05\xdaT\xec\xec\x9eĹ\x12\x12\xef\xb7\xc5\xd7B\xa3/\x14z\x96\xa7\xf1Z\xfac}EY\r\xfc\xef\x9c\xd5\xc0\x87Y\r|\x98\xd5\xc0\x7f\x99\xac\xe2N\xd0|]?j\xac\xa7\x0eo\x86\x8f\x8a\xdf\x1aV\x83\xacW\"\xd4\xea\xc0WЭ\xf7HL\x96\xd7\xe7\xb3\xf9\xe0b1X:\x13ui2\xa1n\u07b3\x05\xf3֝\xa8\xeb\x19F\xa4縯\x16\x05\xd1\x15\xa9\x9d\xa8\x17`L*\xaf\xbd\x98X/\xbb\x90\xd7v\xb3;\u05f5\x97\xf6i\xa8z\xad6k\xfa\xd0e\x94\x85X\xa3\x16T[\xd4\xd3\x1dQ\xa6\xbaj\xac\\\x19\xb3\xf3\xebqw\xf1~\xba`\xcb\x15y^=h5\xdaA\xbb]WPlY\x06p~\xdd\v\x9a\r\xb7\xedC\xe0\x1c!\x00\xe1\x17\x14\x05 |\x84\x84bu6[Jo\x18\xea\x15r9\xf8\xb8d0\xeb\x8d/\x00\x7f5\xf9e8\xe9\x1b\xe1\r\b\x9f\x8f\x8f\x17=\xa7;\xcf\xf4\x10\xd2!\xa9\xf2\xf4,\xc0AA\xf4\xdc\xf2\xe0\xf2\xfa|N\xfd塬\xa0\xddl\xb4=\xbf]W\xc0cv\xee\xfer\xa8\x83\x83\xa6\x05<\x9a\x1a%7\x15\xf0l>\x9d\r\xe6\xcb_\x8d\xe0\x86\x02\x9e\x0fFs\xb3X5w\x8be\xb7\xf7ވ\x84e\xcbL\a&]bPQ\xe5\xac\xf7\t͖\xe2\xda\x12\b\x83\xa9\xa7\x17\x91\xa0\x98\x10MI\x85v\xf9Ь\xb0G\x1c\xaa\"\xfc\xfc\xf4ِ\x9f\a5\xa1\x9b*Z\x9e\xc93\x81}\x15L\x0fP\xd0\x0e\xed\xa8t̗\xd64C\xddL\xa6KT\va\xa4\xec\x84\x14\xa0\xc1\xba&\xfc\x1b\xafϦ\xd3%s\x00\x86\x11\xb5\xeaM\x8fVO\xcf\xc00\x99\x9e-{\xe7C\r\xdfh\xe1\x9a/\xf1\xf3\xee\xa4?\x1d\xd3\x13\xa2\x06\x8eV\xdb\xc0\xf1\xcbGq\xec\xcb\xc4\xd0\x01\f\xb3\xae<\x01\x8e*@\x87\xde\x1e\xe0\aP\x133z\xd5\v\x00\xc1\xca\xc1Va\xd7\xdd\xe5r><\xbbZ\x0e\x0e\x17<݅\xb9\x9e],\x96\x03#\x14*mqI\x0f\xf7\x01\x14\x92s5\xf9Eoj\xda\xfd\x0f\x02y|\x9fC\xaf6\x01\x18\xf9x$\r\x92\xd3\x00\xfa[\x9a\xbe\xc0Ȧ\\U%_{H\x93\xbb\x13>\x1e\x9dH4\xbdÑ\xa4@\xb2\xe5f\xad)\x98\xf0}\xa0\xb7P\x16_3\xf4Ş\xe7\xf8\xa3\xf8\x1c\x93-\xf8\xc2ӧ\xae\xe1n.\x9a\x02\xcb\xdd\\\x98y\nfeez\x02\x1f\xa6'\xf0Qz\x02\x1f\xa6'\xf0a\n\x02\x1f\xa6\xa0\xf0\xe9/\xcfN\xc8`\xeb,\xa0\x04؎:\x94e\x85\xf2'KX\xe6P\n\xfa\xc1yT\xa7W2\xac2\x83\xd6I\x16\x13;g.\xcc@&\v\xb0\n\x14T뼒ѯ݀\xff@\x9bϜ\xd4\xf0\n\x92\xabR\xe81\xaa\x82\xa8PۥD\x0fSz\xd3ٯ\x05\xb1\x89i\xf4\x9a0zĢ\xa0\a\n}\xba\x04\tj\x18\x88\x1f\v\xaa\xaf\x93\xa7\xe7\xe7\x05\xb9\xa3Qg\xbd27\x8a\x16\x86\xf4\x9c\xe2r\xf8aPJWr\xf5\xf7\xf1\xecz1\x9a\x96)oa:3\xbdK\x9a\"}փ\x05\xe0\xaaD\x90cO\xa5\x015+ٝ\x8d\xa0\xaa|LԲ\xd3\xc6tzI\x01T\xb4B^\x8e\xe8=\x13\x8bR_\xbek\x06\\\xf7\xba\xa3Q\x89\xaa\x1bP\xcb\xd9xڇq5\x8d\xa0\xe9\xf99\x04\x05:\xe8\xa2_\x16_\xdbD\x86u\xd9\xf3\x8d\b\x94\\\xcf\x18\xc9\xf5l:+!-3\xe4jqYb\f\x19\xba\x98.\x91\xfe\x1a\x1d#fX\x16\x90kH\f$7Md\x98\xe3FCG\x8c\xfa\xe3RB\xc7HGJk\x9b!Hk\x8d\xba\x19\x04\xd5\xd6p-\x18\xa47c\x82\xa6(O\x9e\x01\x02\x94\xd22\x91\x91\x00C5`u\xad\x94aC@)\xad\xb2s\xeeԕιS\xaf\xea\x9c;\xf5\xeaιS\xbfv=\xfeCi\xfe\x94\x12\x14\x94\x06\xa6x\xf5\x82\xd2laR\xa3\x14\xe7cJ\xe0\x97\x11)1\xb5\v\x8a\x8b\t\xb23g\xb4\x0e\xa6\xc1Μ\xf3\xd6\x15\xc0t\t2\x17\xe8\xc42\x7fnS\xa3\xc2<\xb65*\xc8fK#\u009c\xfa\x1a\x95\xee\x9f\x16d=U\xa2v\xf0d5Ld\x90n\xafe\x02\xc0\xf8\xf5\xa4\xcfz\x05\xd5\xf5\r\xd4\xfe٨dwu\xc0\bj\xd5\xeb\x18\x01\xa0\xe6\xd4M\x00\xa8\\\xa3\x04\xa0߆1\r \x8f\r\xcf\x04\x80jn4L\b\xacʆo\xc1\xc0\xa44-\x18\x98\x1a\xa5H\xe1\xc8͕\xae\xe4G\x0e\u074c\xa8hK\x0eݜ1Љ\xb0\xb0ܖJ\aIo\xea4\xc4\xdbQ\xe9\xb0\x12)\xeac%\x8c\xb8\xdb\x1a\x00Dm\"\xa2JV\xd7\x000r%\xe5pn\xc1#W\x8a\xbf\x1c\xf6\xcb\xcc\xfbF\bljͦ\x0e\xb9\xe8\xc3ެn\x02\x80\x84\xfa\xae\t \a/^7\xda\x06\b\x1d\x87A\x8b\xf2=\v\x06\xb4\x99\xa0n\xc1\xc0\xf46,\x18\x98d\x83^\xb0\x90\x96\t\x00%\xb4M\x00\xd8\xf6\xfc\x8e\x8e\x18)j1B\xc60\x1dM#\x02&$0!\xa6\xb0\x05xF\x04\x90\xd14\xa8l\x04\xd5Ѭ\x9b\x00P\x82\xa1\x0e0\x8bY\x91U\x83B\x95\x8a\x18\x14\xa3\xbd<\xbf\x88F|\x11X1\xea\x17\x88\x8a\x91\xbf0\x06\x9f-ܠ\xfcf\xed\xadc\x845\xbc\xf2\x1b\x1fY@\xb0\xc0/\xbf\x19\xace\x80\xf5\xfb\x14z=\x9a\xba\xde\xf5\xa4\x87\x19ZF\x869\xad\xbb\xd7\xf4\xae0\x84n\xc0\xce\x14\xa2g\xf3\xc1\xe8z4\xf5\\E\xb8_\x01\x9f]\\_\x0e5\x8e\xe6a\x0e=\x0f\x81\xceDk\xbc\xa7\xa8\xba\xdd0ও\xfe\xd9\xdc\xed(Ⱥ\t9\xfb\xb5\xfcT\xee\x96.a`\x9e\x84\xa0\x86\x8cQ\x1d\x8f\xfa,oJ\x02\x1auC\xad\x80\xcbV(\x1a\x0e\xc8\x12\xfb\xf7\xab\xf1L˾\x05'\xc7K\x94\\\x83JG}\x9e\xe6\xe9\xf99\xadKM\xa5j\xd4\xed\x1c\xb4\"\x99X\x1av\x16cmm\xb8\x9e\x89c\xb1t\xbd\xb6\xbd\x8aw:\x16\xa6\xc0\xce\xd3\xf6\xcd<\r\xaf\x82\xa7i\xe6\t\xfc\n\x9e\xc0\xccS\x91\x9dV\xdb\xc4\"\x9b\xa0Z\x93[\x06\x1d\x8f\xa7\x1f~\xb9^\xd0\b.\xea\n\xba^\x89V\x1b\xab[\x89Vz\xb0\x96gA_\x99R\x124\xaaњZ\x02\xbf\x92AIzЬF\xeb\xe2\x83J\x06%\xafA\xab\x1a\xad\x8boW24\x14\xb4\xa1F\x8bym\x892\x14%\xdb\x15\xc1\xe3\x85![\xb3\x86g\x1a\xa8\xac@e\xa8r-\xc0\x8aQȵ\t\xb7\rD^`g0\x8fEn\xfd\x00\x87i8r-y1\r.6\xa8i|q;6\xb0:Ĵ-\xc96\x8f2mK\"\xec\x03\x8d\xd74sXƚv\xdb\f7\r7u;\xd40\xe2\xb4-U`ԗ\xe97\xf6\x856\xa6\xea!\xc1\xadⳏ\n\xaeog\xab\x18\x18ܦ\x9d\xadblp\x03;[E\xd6\x1a6.\xcb\ba\xa9\x8a\xd61\xa2]\x817\xf5\xe4\xcd\xc3x5\x0f\xc1A\x16\xdcL-\xa5ɪ;\xae\x94\xbe\x1d\xa9\xf4b\x165Z\x9a\x85\x05-n\xa8S\xc0\xadJ0\xeb(͝dp\x88q\xcezJ\xb5\x13\xf3\xfc#\xf8惑\xc6W\x9d+i(\x86\x1c\a\xb2\xc6\x1a\xb39o\xcdC\x9c\xa6\xde\xcb\xf5<+\xdbEߪ\xc9\xf6\x01.\xa3\x1am}\xebr\xb4\x18\x0e\xe4H\xb5\xe4C\x89\xa1\x10\xea\x8d*v\xd9\xcb-e\x1b\xd55T\xf7\xab\x05\x80\xe8\r\x8a\xaa\xdb\xf5;\x1a0Eq\xdeˡ\xab\x8c\xe6\xf5\xceq\x9c4\xd1\xca\xe0Y?\x9eSˮ\xebV1\xb3\xae\x80s+\xdd\r8\xa0y\x90Q\xd7q\xfbX^\xb5t\xedM\xb34\x8e\xc1>\xc0\xaf\xc4\xcf\aJ\xc3jۋ\xef\xda\x04\xb7%g\xb1<\x9b\xbb\xbe\x026`\xf5\x9e\xd3\v<3N5T\x04\xae\x19\xa7Z*\x02C\xed\xb0,pM݊\xb1k\xad7\\+\x92V8<0\x05\xedJ\xb0\xb1^" +
+	"6\x03\xbf\x8a\xc9\xd8s4\x03\xef\x00\x8f\xde\xf96\x83\x8a\x9ch\x1do3\xe8\xd8\xd1lEm\xceK\xa3\x8ak\xae\x80[U`S\xa7\xd3\f\xeav\x16jD\xd0[`3\b\x0e\xb0\xa8jj\x1a\xf1\x15\x9d\x7f\xd3\xf5\xed,\xe6\xf2s\x1b\x95\x1c\x86\xd2s=\x1b\a\xebD\f\x19w\x83j\x0e5\x02s\xbe\x8f\x18\x89\x9a\xbekc\x95\xb6\x17{\xb7\xdc\xf4=;s\xe5\b\xd4\xf4\x1b6N\x9eA\xc9kЍ_?\x92U\xc9i\xc3\xdc*F\x15%ݮ\xe40\x95t\xcb\xc6!\x17!}\xcb\xf8\xd8lyO`Ք\xd22\xeb\xb3z4o\xfa\x9d\xc3\\zJ\x9b\xf5㸴D6]\x1b\xa3̢U9\xf5\xe39u\xddX\xa3\xb5O\x1c\x9a~\xeb(&\xbdv\xb6\x8f\xe1S\x1ba\xf3(&=\xb2\xe0\x18>\xb5\xd0\xcd=\x9e\x98\x99(\x03\xb3[\xf7\xdaV\xb8>\x8e\xbbu\xcf\\\xa5\xae\xcd膩\\\r\xd3\x12v\x1e[n̰\x8b#\xf1\xb6\f\r\xaaڔ\xe1\xf4\xaa-\x19\x8a\xb8>\x9b\xb3\x970ħ\xb2\x96d\bi\x93\x11t\xb4\x98b\x00h\x8a\x91\xa0\xa6\x06\x9aч<\x01$\xd0\x11\xc5dJ@\x1aF\xc8\xe5\xf0\xe2\x12\x80ܖ\x115\x9a\xfe\x02Am\x1d\xc4\xdf\xe8\x01\x18-E\x97\xc3\t̔&c8\x1e\x0f\xfa\xd7g\xf3\xeb\xcb!J7Z\x94\x01\xe4Ō\xfb\xb8\x141v\xac8Ub\u074c\x1cM1\xaca\x86-z\x13M\xa2\xabB\x81\xe3@\x01\xd2T2\x1a\xd2\x1bHG\x00\xe3\x1b W\v\xa8X\xad.HK\xad\xa0ky\x9bήg\xf3\xc5\xe5\xf0\x1c\xa6\xc5m\x9a`\x8b\xab3\x88\xf1M\x18\xee\x85U`<\x03f\xb1\x9c\xceQ]\xd0\x149\x1f\x9c\x8f\xa6\x93\v\x881@\xfe\xf3\xaaۗߊ\xc9X@\xca\xf9\xbd\xc4h\xf5wQ,>\x04\xa4cB\xa0\xd2t\xeb&H\xe0\xcbOaʕ\xdd\xc9|\xact&\xf3qUW2\x1fWw$\xf313\x97{\xfc\xb7Ҷ\x051(\x88M\x8d\xd8(9=\x03\xf1z2\x1d\x96\xdc:{\xbb \xb6\x15\xda\xe8\xeaz֣\x03\x82ۼ.Q\x8d\x86\r\xe65\xae\xddf\x89\xf3m\xb8\xd6u\xbdDy&\xd4\xf5E\x89h\xb6-\b1\xb8qP\xcb\frK\xa5\xd6-\b$\xa6c\x06\x81\xb2qu\xc4\xe2\x8c)\xa9s]L%x\xd6\x02\x1b\xd4k]{\xf5\xeb\xde\xfb\x12\xda2A\xa1\x16Z\xae\x05\x01cl\xd5͠R\v\xad\x86\x05\x81\xc4xfP\xa9\x85\x96Z\xb6\xe3\xd9\xf5\a\xba\xac\xec\x14\x10W\x11\xc2_K<[\x94yVr$W\xa5\xbc\"+\x85.GQNT\xb2\tGP\x0ePDӓY\x1f\xa8\x8bټ\x14\x02n\x98\x82\xa0\xe1\xe4r0\x1f.\x01L\x95%\xbc\tEL\x81F\xe5\xde!\x82\xec\x9bȠ\xa9w\xda\x1a`V\x16\x84\xd7Ԩ\xb4\v\xfcX\xb2w4\x00\xd2qG\x17pMk LAK\x87H\x8b\x10\a(YD[;\\Gj\xdbb\xdb9~\x99\x8d\x8eN.\xc6J\x8eP*˨\xdfSz\x81\xa0eF\x80\x06\xde6#@\xdb\xed\xe8\b\xdc\xcaڮ\x19Q\xc6\xd2\xf6̈2\x96vCE\xcc\x17jf|\v\x04\xe4\xa6i\x81\x80\xec\x04\x06\x88\xd2k\xb4-\x10\xd0#t,\x10\x90\xa3\xba\x06QS\xeb\x99\x11 \xb1\xbaVd\xbf\xe9^\xbb\xa8\x17k4MP\x94-\v\x02\xe4*0#@\x17\xa6T\xa8\xf1\xe0z9\x9d\x82\xeeKQ\xddx\xfa\x01\xb7-\xdf7\x00\xce`\xcbi7\r\bԶ\xfc@C\xfcB#\x81\xea\xf0\x1b\x06\f\x8e'\xb0 \xa0\x98\xb6o\x00\xcd\x14\x90\xaf\xa4XN5\x19Q\xa9\x05\xb3\x9e\xdb(\x19U\x1ah\xfeJ\x9b\x92ަ\xa2\xe5\xb7\fT܇\xa9\xb2\xe9\xec\xad,i\xdfS\xc9\xc3\x0f\xdd\xe5\x00L1\\\xd7\x02q\x01\xa4a\x81\x001\x9eM\f\x90\xe3\xd9\xe4x\x00\xe3[0\r\x80iZ0@\xad^`\xc14\x01\xa6eƀ世\xe4\x80Ը\x96Ԁĸ\x96Ā\xb4\xb8\x96\xb4\x04\x00\xd26CZ\x00\xd21C\xda \xd3u3\x04NN\x94J9W\xe6\xd1M\xa5\x1c\xe7t\xfa\x02ȊB\x8a\x05\x05#7\fD<\v\x0f4\x04\x1eQ\xd5j4G\r\xcak*E6\xc7\xf1{M\xa5\xa6\xce\x17g*BQ\xd1\xfc\xe3\xac\xe7\x95e\xe5\xf9\x8a\x96\xb9\x80\xb2\xa27\x8ct0\xabPȿ\fa\x87\xa0\xe4nٝ_\f\x96@x\xdbD/\x85\xfbJ\xe1-/\xd9B\xa6L~\xcb@\x1f]]#o\x01\x91\x12\xaf\x02\xeabh\xa3\x02\xeaa\xa8_\x01\x05\xed\xaa\xd14\xe3fb\\\x04\x8b C\xe4\x86ɜ\xdb0\xa8\x86N\xb7`\x1fU\xf7,\x98\xb2}4-b@!6\xeaf\bX\xa9Z\xe2\x01-\xb5nȖ>ж- <\b\xdaPh\xc0m֍(}\xd0mYp8\xceN\x05\n\r\xbe6q\xda\x00l\x908\xeb\x81\x12n\xfa&\x80\a\xaa@\xdd\x04\x00:7\x14\xed\x1cI\xf0L\xa5\xbf\xf8e\bd\x18\x12AM\xb6h\t\xd7i\x98At/n1\xf8O`\xd2p\xbdN%\xb4\x01+\xb8!{\x1f\xb1\x02\x94\xceUO\x98\xab\x03DL%\xc63`\xcaé\"\xa6\x96\x11$\x0f!\tP[\a] )u߀(\x8e\x84\x8a\xf4\xd4u\f=\x87r1\x03\x05\xeb\x9a0(&C\x82\xe5\xc9\r\ti\x9a S\xd8\xc7\xd4;F\b\x92b(\x83\xd1\x00\nq\xeb&\x04\x92aP\x9c\xa6\\%)\x1f\xfc\xb3rq\xec+Q\xe0!\xcem\n\x13\xdd\xfc\x9a\xde,R\xcb\x1e\xb7\xe2\x02\x82\xb7\x0e\xbdgS^=\xbag\xe7\xf8\x11\xb6\xe1U\xa2\x1b^a*\x1c\xb1\x97#\x90\xb1\x90\x05U\x98\v\x05\xbd\xc2`\xc8\x10\xfc\xe4&\xfb\x89\xa7ؒ\xcamm\x00\x03m\x05\x1c\x14\xf8\xf2'6\x1dJ\xaa&\x02\x15=G\xb13$r\xa3P\xe2\xa0\x1d\n\xc2FS\xaf.\x83\x94\xf5x\x01\xa3^\xb4\b\xd42\x81Ć_\x01jk\xa0~\xdf\r\xe4\x972\x88\x14\b\x0f*\xc0\xef\x18\x10H\xcbͺ\x01\x11 \xfd4M\b\x18K\xd35 \xda0\x19-\x03\xe0\xfaj48s\xbd6\x8aK\a\x8e\x86\x17\x13\x04\xd1\n\xf5\f)\x05\xda!\x04\xdds\xe5o\xc5\b!\xe9\x88?P鴣m \x95\xb8\x9a\xd2z\xe7]\x94H-\x11\xc2\xd8WhD\xa5\xf7\a\xa3\xc1r\x80dhZ\xc5&\xbfB\xfbm3\xae\xb4\xfa\x15HM\xbb줰Z\xd3۞\x19\xa6\xd4\xf4\xb6k\x86\xcdzZ\xd3i[\x91\x8a̖)\x85j\xebiuL \xa5\xf5\xb4\xeb&Ь\xa7\tkZp\x8a\xbc\x96V-\xa0Ͱ(4Mu\xd0.X\x94\x83\n\x1aw\xe7\xef\xafG\xb0\x06yu#F\xed\xbc<\xad\f\x84uC\x0241\xb3^w\xd45\xf4n\xbe\r\xa8\x96P\xc3\b\xd4\xd4\xea\x9aa\xaaV=\x1dF\x1dJ\xea\xd7\v4\x14ԵX\r\xca7b>\"1\x9a:\x16әp\x82+\xa44\x8d\x98\t\xc2\x04F\xccb1\x98\xc3rn\x18\xa3\x1b\x9e_\x0fF\v\x94\xf0\x96\t7Au\xa6\xe1\x9a0\xb3\xe9\x8c\x0e\x8b\x8b\xeb\xfa\xb5\xcb\xff\x17`\xcd\xf9\xcd\x03lM#\x97_\xc9\xe5\x8a+\x1b\nx\xfd \x1c\r[\xeea\xb8\x92\x1e\xef\x10G\x13\xaa\xaa]\x89n^\xa3\x9a\xea7*\xd0W\x10\x18T\x015\xa54:F\xf8Ղ\r\xf6\xd3\xd1\x15\xea\xed\xbd\x86\x15ݿ\x9aA\xa0o\x05^̔Ρi\x85j\xfd\x88g\x87\x8e\x96:ܞ\xb7➚\x02ۮƢZ\xee\xb5*\xc1K5\x87\xc6\"Y@Hè\xd8\xc5\x1cB\x8c*\xe5n\x04\x05FW\xd0\x15\x9ew4\x1b\x06\x04\x9a\x8c5}\x03\x02Oƚ\x06\x04\x9e\x8c\x05&\x04\x8ań\x80\x93\xac\xa6)+\xc6ɘVrrU\x89\xe7\xe9\r\x1b\f\xa5\xcb\xf5\xed0u4B6S\r\xac\x8cH\xc8\x06\xab\x81\xf5و[\x99\x12}N\xe2\xda3(\xd7\xe0\xe5\xf0_\xb7B\x95\x01\x12ٟ\x15訏\x80\x1d;p\xaa\xe4,\xb0B\xcd\xe3\xaaWŠ'٭Bk\x89\xf1\x8c\xe8\xd2\xe0 \x91\x81\x1d\x87jP˂\x93V\xdab\xeag\xc7!yF\xbd^\xf4\xd5lw\xda\x16\x9cE\xa5M+\\\x93l\xcc\x11\xbd\xdcB\xad\xb3\x1dߊTjk\xa7aE\xeaM\xa1S\xaf\x02\xab\xf3\xfd\x8e\x19\xac\xe5ʵ\xe0\x94\n\xd2\xf1,8]Q\xed\x96\x1d\xaa\xce\xfb\x8d\xa55\xd2K5\xb0\xe0,\xa5\xea[\xe1\x9adc\x05\x18\x19J\xb5\x1dX\x91\xaa\xee-2\xd9:z\x8e\x12\xeaY\x90\xaaJ\x1b\x158E\xa4k\x81\xaa\xaa\xf7+p\x8aHc\xeczSv\xebV\x1c\x1e\\JO6\xf6\x9e&\xb2Nѐ\n\xe3\x14'Wئ(\x80\xcf`\xe9/l\x99b4\x0f\xd0|\x85\xd6\xf0\xc4/l\x8eb\xb4\xc0\x17\xbf\x14\x93*#Ғe\xae\x9a\xc3\xeer \x02\x95\xc9\n\xc3\xd1!\b%\xce5\x00h\xcd\x03\x18\xb80)1\xa3)¨r\n\v\x85\xccMK\x01\xc8\xfb\xcc\x04\xbd\xa3\x93\xaf\x85ïL\xaa\t\x82S\xeay\x06\bN(\xacK\x05dz\xbeX\x02\x88k\x80\x88\x03\xf4\x12\xa2\xaa\xe4\xa28\xf0%\x10-\x13\x00\x16\xaf\xab\xa6\x15g\xa5\xa9Q/.\as\x90\x82\xb6\t\x80\xb2\xa1\xeak8\xa1+\xce\xeb.\x804͐3\x00\t\x14\xc8\u07fb#\x90\x8c\x86\x9a\xcfҿW \xd4t\xe2\xe2P\xa5\v\x83\x84\xa0\xaaZ\x16\xb7\x8bI\r\xead\xd4~|5\xea\x195\xaf\x80\xb4\xab\xb9W\x8a\xb0\xd1\xd0\xe90\x02\x03\x99\xafO$]\xcd\x1c\xf5\xa2\xc6u\xba\xa1V\x02\xe6\xb4\xdc\x04y\fL\x00\xa8\x04\xb5\x00\xf8J\xa4ЁBU&U2\x19m+\f\xf6:~\xdd\fS\xf5ұ\u00904\xd7\nSڂ\xefۑ\xb8:\xf9M\x03\xf2\xa2/\xbe\x14\xdb@\t\x10ǟ\x00,0\xc0\xf8\xed\xa0\x12\xd10 4U\xf8-\x1b\ni\xa2mC\xa9\x8a\xe8X\x81X\x0f\xcdz1\xc2\xcdf=<\xc0\xcdf\xbd\x8a\xf1\x8dQ\xed\x97-\xf3\xe7wUy\x95\xb7{\tzŐ\xc9\x10t\b\xe3GX\xd8'\xeeB!\xe2\xfal>Yv\xdf\x0f&\x00ڱBUdۈ\f\x00\xa2aF\\\xf7\x17\x00\x04\xd7\xcd\bu\xd9\x05(+h\bEYA\x17\x972L\xddFЀ]\x84t+\x90\x83\xb9\fU\x9a\xaa\x01ـ\xc5\xe16\x82J0L\x82_\xaf\x86*\x82[U\xe8\xc5R\x06+\x9d\x86\t\xaa\bnW\xa3Q\x92\xbd\x03XE\xb4Ms\xa3)\x14j\x05)\xd5ɨ\x01\x0f\xc6hL^Ó\x9fx\xa2Y\"\x02(ì\x0f\xb6\x80\xe8uG2P\xb5\x92p\xa4ؒ*\x10Z\xfe\xfb^[\xfe\xc6\xef\xdd\x16\x00\xacA\xafm\x00\\_v\x1bu\xa4g\u05c8\x1a*\xa8\xba\t\x85\x8a\x02\xb9X\bL9\xc2\x15-\xb6m\x00\xc9yA\xd11\xf96\x10.U\xb4)\xa7\x00Q\x8bm\xb5\xec8\xd8U\xb4\x82\n\x9c\xd2[\xf8\x95P\xdc_4+\xb1\xa8\xc7@\x9b+F0\x12]\xafN\x06n\xdch\xf3،\xc6\xc2\xed\xea@E\xdfjV\xe0\xd4\"\xf3\xccX\\w}K\x81\xa1\xba\xd4\xd2\xea\x92܌-5\xafA\xc0I\x8e\xa2\xe5k\x95\x1bo\xed\x16\xb8\xa6o\x06\x96{\xbb%T+E\xb9\x1e+\xf3hD(\xfdV\xdb\f\xc2\xf5\xabe\x01\xc1\xaa\xed\x06f\x10n\xc3M\x1bHIVǀ\xb34Ҏ[\x89E9\xe9\xf8\xd5X\x98\xa1N\xa3\x12\xab&\xb9\xe3\xd9\xe1\xdcʄk`Әl\x03\xb0aL\a\xbb\x18\x01wj\x9d\n\x1cVC۫\x84B-\xb4\xdd*(*\xdcv\xdd\n5\xe5\xcbo[\xe0#\x9c\xafv\xa3\x02\xa7\xe4+\xa8\x84\xa2|5\xab\xa08_\xbe\x15j̗\xb9\x1cLU\xb7ݪ\x82\xe2\x9a[\xaf\x84\xa2\xacU&@\xad\xb7\xed\xb6\x15m\xac\xb6Z:\xa0cD\xd1=\xe9\x85K\x0f\x17\x0f\xf9\x1a\xadD\xb5L(\xe1>Q\xa0\xb4\x1a(l\f\x12\xa0\xa5\x88\xdbt\xf1d\xa6i\x04)-\xcd3\x82\xae\xa734\xc8yZ\x85dד\xe3\xf2j\xb8\x16\x10,\xa9F\xdd\fB\xd5\xcf\xeb\xd8@\xb8 \x03\x9304\xbf\xf4Z\x06\x04\x1a\xf1\fj\x1a-ŮW\x99\xff\xba\x05D}\x18\xa6=\x04\xf5\fPu\xa0jZAJ\x06\x9bV\x1cR}\xb3\x02\x87\xd6m\xbe\x15\x87\n\xa0\xd90\xe3\xf4\x12\b\f@iP(\n\xa1m\x06\xe1r0I\xe2ν\xe5ԣc\xc6\x18J\xc1T\x17\x8d\r\xc0\xaf\x00\xear\x1b\x9a\xa2i\x17\x83$\xbaF\x84\xc9\x02\xe06lP\r限\x01\xeaX:FH\xff#\xae)\x9e\x1f\x18q\n\xaa\xe9YPC\x84rm(4\xb1\xf7\xfc\xba\x1d\x87\x13W!\x10M\xe9=߫B*\xc3S\xbd\n\x8b\x13Ш\x84*r\xabR\x8bV\t\x9e\xefWB\x15\xb9^%\x18'\xb8Y\x8dU$[\xb2\x87{ߦI_ha\xef\x9a\x11z{q\x033p\xd6ћ\xacIEh\xc9\xdch\x99\x10\xa8\xa71E\x87\xbb\x19S,\xdaX\xae\x15\xc0b\xd0\x13Gċ\xc44,\x18\xa5\x83tm0<v\x06V\x18\x1a=\x9b6\x18*\xc1\x86o\x87)\xc9\xd3r\xba\x1c!z\xcb@\xbf\xe8\xcb/գ\xb9\x80\x8c0D\x1b\x00p\xd9\xeb\xfd\xc8r\xdaCݛ\xdf2\"\x94\xcc4\xcc <T\xd6- \xa8g\xbfc\x06aCY\xdb\x06R\x92\xe5\x1bp\x8b.\xaaq\xc8G_\x804\x1bN`\xc3(˲\xa6\r\x86\r8\x9e\x15\x86\xec7\xae\x1d\xa6\x98o\xbc*$\xb6\xde4\xaa\xa0\xa8\xa7ﴪ\xa1h\xc5ЮĢ.\xb9\xd39\x80\xc5f\x9b\xba\r\x8d\xad6\x150\xa5\x90\x02#R\xe9\xae\xcd l\xb1\xd1Ty\xa5m\x0fxM#\x06w\x9d\xbe\x11\x83;O\x1c\x17\xda\xfe\xd06?\xcc[\x1f\xdaƇq\xdbC\xdb\xf4@[\x1eچ\x87\xba\x91\xa1mc\xa8\x9b\x18\xda\x16\x86j\t\xd7\xec\xe0ȸ\xad\x99\xb6\x91a[3k\x03;\xb4n\x85\x86[\x9b\"\xe9m\r\x00z\x02ݖ\xab\x1b~t\xf3\xacn\xf0\xd1M\xb3\xba%R\xb7C\u00adS\x01PR;\x18\x9f]\x9f\r\x97\xd7\xe7\xa3~\x99e\xb7\xa9\x83\xc6\xf3\xf7\xf3\xc19\xc0\xd4u\xccD)v\xd5\xd4\tA0\xf7\xaaU\x16\xe3\x86\x00\xe7\xdbq@\v\xaa\x05\xb9\xc4\xc1B\xaf\xbb:h>\x18-\xfa an`\xc6\fz0\x9b\xaeE\xd2\x12e\xd2\xf5\xad(\x90E\xb7aC\xc1\f\xba\x06\xc5.\xfa]\xcf-!\xf5\x8e\x192D\x05\xd42\x83\n3\x88a> AX\x90\xa2(ld\xd6M\xcc\xd0\x18\xac\x9b\x82\x15\x1b\xafn\xe1U컺uW\xb1\xed\xea\x96]\xddF\xa9[(\x8d\xf6I\xdd:i\xb4M\xea\x96I\xa3]R\xb7J\xeaFF\xdd\xc4h40\xea\xe6E\xa3qQ7-\x1a\r\x8b\xbaY\x11\x1a\xe9JLˊ\x81\t\xeb\xd4+` a\x1d;\f&L\xa9\x90\xd8&\xa7[\xe4\xf8\xe6\xa3\xe7\xcfz%BQhi\xb1\xd3\xecu\x8a\xf1L7\x9d)\x863\xddl\xa6\x18\xcdt\x93\x194\x84\xe9f0a\x7f\x01Ù\xdb6\x01\xa0\x04\x05\x00\xcc\x1e\xba\xd1\xc3h\xf2\xd0\r\x1e&s\x87n\xec\xb8VRZר0\x99\x81F\x05\x8b;}iG\xbb\x1d\\\xff\x1a*\x00\xae\xfc\xf4u\x9f\xb6\x9c\xd3\x17s\xdaRN_\xc8i\xcb8}\x11W.\xcc\xf4e\x99ֈ\x82\x8e\x91\x8e&\b\x9e\x05\x02\xe6\a\xae\x19\x02\xa7\au\x03\x04\xce\x0e\x14m])\x03\xba\xd74\xd0ay\xfa\x85\x1b\xcf|\xb8\xe8}\xc0n<,\xa8\u008dG\xd0+\xdcx\x18\x82\xbb\xb0\xb2\x9fx\xee&\xa9\xc5\xf1 \x89\x81\xbe\x0f\x1c\x14\xf8\xf2'\x9e\x1cr\xaa<\xd7,\x01\xb0l\v\x04J\x05\xac \x05\x02E\x02kY\x81hC@C\x03\x88\xa3\xc6\x12\xe1k\x88\xb3ywһ\x84\xda\xd0b\x11\xb6\xf1\x02\xd06\x01\xae\xf9\xbb\xf2\x05\xa8\xa3\x81\xf8\x94\xb8H\x89J\xc7\xdb\xc5\x05\xcc3\xe3\xca\xdd\xe2\x02\xa9\x15#8g[\x94S]\x03\xd1\xfa{=\x84\x82Zf\xcc\x02b4\x1d" +
+	"\xa8\x11iZ\xfc{\x17)Q\x8b\x04\x9e\xaa-j\x9d\nbn\xe70\xb1^\xcb\b\x81i\xf5\xb4\xb4\x8a\x91J\x024\x9d\xb0ʯ\xe5\xa8a\x86\xe9I\xf2+\x80(aZ\xf6@\xe7]\xd4i\x13\xe6#ԑV\xee\xf3\x0f=\xbdZ\xfb\xbe\t&|m\vP\xd3\x04\x1a]\xa1ꡕ\xecb\xb0Dm\xbd\xd94 P[o\x9ad \x11\r\x03\x006\xf5\xa6\x96\x1dyx\xafP\\ۀ\xc0=Nǀ@\xa9\xf0L\x00\xd8%\xf9u\x03\x02uIZ\xfdT\x9c{%0\xb0\xe3P\x8c6yr\x14\x92\xb8\xb6\x1d\x87\xe4uL\xb8\xf2\xc4TQW=#\xce\xd0ø&\xa0\x9e>\xb7nš\xf4\xb9\xba<\x8a\x11'\xbc\v5{f\x94\x9a\xb8\x8e\x19\x86\xea\xb7\x05\xa3\xb5\xf3F\xbd\x02\b\xdbyÒ\x05\x88\x01\xbe\xc0\x8bYw\xaex\x03\xb3\xa0\x8aa_\xd0+\x86}\x86\xe0\xd7\x04\xb2\x9fJ\xfa\x05مdW#7\x00\xd9\xd5\xc9\x01 {*\xd5\xf3\xe4O\xa5\xf09\xb9\x01\xc9\r\x95\xda\x04Dؑq*\x8c\x17\xf6`\x82\xeaC\xc9Z\xbaZ\x90Y\x8b\xb8\r\x13\xad\x12ň^\xd0;*\x80\x1e#@Zi\x9a\x10(\xeb\x81\t\x81\xb2\xe0\x1b!0\xa1\x9a\x82\x80%\xa1(\x9f\xba\x11\xf4\xf7\xf1L\x06(\x93\x0f\x01\x9a.Q\x1dr\x1b&\x04\xaa'\xbe\x01\x81+\x83\xa6\x95K\x1ff\xb8\xa9%\xf5\xf2\x12\thhQ\\\x0e\x11\xa0\xa3\xd3g#\x9c\bOO\xc4\xf0#B\xc0)\x8f@\x8cq{\xd2D\x80Eu\x11\x8fV\x8bF8\xb3\x9a\xcaGc\x9cY\xad\xf8GS\\$\xba\x84)]<C\x8cg\x10\xf2\x11!|Mgc\x9cN-\x1fbR%\x01Z\xa1M\x95t6\xb4\xaa3\xeb\xe1\x8c\x04:\x00W\x9c\x96\x0e\xd0r\xda1bpٷ\x8d\x18\xd4.=C\\Z=4b\x94Jb\x88K+b=\xcdjj|\x03\x02\xf7\x12ZZ惋\xe1b9\x98\xcb\x00e\x96%A\xc5\x04\xb4\x88K\xabOW]ܩ5u\x00NmC\a\xa0\xc46\xb5\xec\xfc\xa2u\x9d~\u074c\xe9@\x8ck\xc4 嶍\x90\x06,#Mu\xbfP\xfd\xa3\xaa\xd9..Z[\xfc:nx\xb5(\xbeM\xb4\xcb\xd5JD\xe0\x97\bz\xccFA,\x7f\x9d\r\x0e\b\xa1\x10\x9b\x141o\xf8\xd8\x0e\xae\xd5c?<\xacb\xe6 \x01\x15S\a\x0e\xe1û\x88\x03\xf72\x02\xd0\xf0\x8a\xdf\xca\x10_\x00\x16\b\xe1j\x88\xc0/~\xe3\x01W\xd0ۈ\xeckt1\"\x17\x90\xa6\x86\x003\xeaRP`\x82\xc9\x1b\xfc\n\x98\xe7Z`\x8a\xb4\x96\x06\x03\xe3o\x01\xd3\xe3\x947\xf6\x17\x90\x86\t\x82#\xf3Z&\x8c\x96&\xafi\x82\x89\x93\x98%*\xb0\xa1\xc03\x84e\xea|3Z\x89\xb9cFq\xebR\x01\xb3\xa3\x14qm+\xf0#\xc4\xf9\xae\x117R\xd4ר\x9b`\xacPQ\xacze\x87\x0e\xb1\xa58\xbd4\xc0\x14\xa0\x80\xe9(1v\x16\b=Y\xe2PmY\xcb\x1a\x06\x88R\x9cF\xc4\xf5٤_\x84(\x83M\x01S\x94\xee\x1b \xb8)\xea\xf5K\x0eYe\x91\x98!jz\xfc\xba\t\xa7\xd5\xe8\x86^\xc0\xa6\x12i[Q\x8a8\x13\xf0\xa3\xa5n\xe9\x8a]\f\xff1Pڮ\x19\xa4Ī\x17\xa2\xa9\xa1\x056\x94\xb4L\x96Ц\tz\x81\x14\xecvL\x98\x11\xc2xz!\x98:Ć\x19\xa5\xf4\x87m9B\x91Mã\xa3\xcb~\x95\xff\xdf\xe9\xed\xad\x18\xbfN\x9da9\xee}-\xb1\x81\xafb\x03\x1f`\x03\x1f`Ã\x82O\x9d\xeezMⵣF\x13\x1e\x8c\a\xb2\xb2Xy\x9e\x17\x97\xe7\xd7\xdd\xd1h\xdas\x16d\x95GI|\xbe\t\xef`\xb3\xa3\x80\xdet<\x9b\x0f\x16\x8bA_E\xd5\xfd6\x04\x0e>\x0ez\xc3\xc9b9Wp>\x04]̧W3\x05\x00\xde\xe0\xa4\x10z\xef\xeb\xf5h8y\xaf\xc0\x02$\x88\x02\xae\xa7\xf3\xfe@\x8d\x0e\x9c\xf7\xa3\xb8qw\xf1~\xbaP\x93\x1e\xb4\x1a\xed\xa0ݮ\xab\xc8\xd9\\S\x86_\xf7\x82f\xc3m\xfb\x18<\x98_\fԘ\x03\x88\x98\xb2K\x06z\xd3\xc9\xf9t>\x1eN.\xd4$4\x11z\xb1\x9c\x0f'\x17j:\x1bH1ˑJw\xeb\x1eR\xca/\xf3\xe1RKU\t\x98\xb0˾\x05y\x18\xaf\xc9\x03sWoz\bӛ\x8e\xc7Ӊ\t\xe6A\xd8\xe5pj\x90\xd5\xf0\x1b\x18\x045\n`\xae\x8ba\xf3\xc1b0\xff00\xc4\xdahB\xe4hj\x8c\xd5\xf50\xc8\x1c\xab\u05eec\x985V\x05y5\xe9\x0f\xceU\x14B|\x1cN\xfa\x83\x8f\a\x92\xbf\xbc\xee\xff:鎇EҖ\xb4\x05\x83\x89\x94\x80,~\x1d+\b\xa8\xae\xe5\xf5\xf9p2\xbc\xee\xce\xe7\xdd_U\x18\x8a\x8cn\xb3t\x97\xcb\xf9\xf0\xecj9X\xa8\xd0v\xabS\xf7\xdbn\xdbUY.\xbb\x8bK\x1b\xd8S\xc1\xa3\xe1\xd9h\xb8X\xda\xf0\r\x15\xffa0\a\xaaT\xe1\x1d\x03|2\x18\xf4-\xf8N݀7(O\xc2qVaO$\x91-\x880(\x02\xe9\x17\xb6\x80ʨp+\x10P\xcf\xf5[~\xbb\x11\xf88\xce\xe1\xd5b0W\xa0\xbe\xd7\xf1;A\xcb\xeb\xa0臓\xe1\xd2\\\v|\b\x83-F\x02\x02\xb7\x1e\xb8^\xab\x11`\xa0!\x95E\x86<\f5\xa4\xb2\xc8P\x1bB\xf9\xadAg\xc3\xf3Q\xf7¢-\xaf\x81\x12<\x99\x9e\r\x97*\xb4\x8d\x11ˁBGJ\x9c\\\x8dF\n\x1dՔ\xd9|`W^\x80\x91\xd3\vCjP\xe9\xd2u\x00&w\x14rW-OD综\x87\xda).\xfb\xc5\xe5hx\xa6\"Q\x1e\x17\xcb\xf9\xb2\xabBPs\\\xfc:\xd6\x11\x9e\x8e\xb8^\\N\xfa\x1f\xb5d\x15\xc0\xe5\x19[\x1dvG\xce\xe2q{\x16\xc5k\xa4\xa0\xe5\x99h'%\xcd\xc3D^\xebJr\x13\x92\xf9\x85\x01%\xb5\x8e\x89X\xb0BT\x057 \xf9\x97A\xf7= \x824-\x8b\x11\xf0q\xab5\xfb%\xed}G\x03@\xf3\x11\xedj\xd2\x034$\x95\xf6OC\x05\x00\x93\xbc,\x14%\x89\x1e&\xca\xfc\xe8\x9d\xfdrY\xe8\xc2(\xb8ԅ$7 y2\xa5\xb6\x19@F\xccӳ\xbf\x0fzK\xc8\f\xa9\xf3\xc1\b\nnC\x1au\x90\x19\"E\xa2h\x17\no\a\x12\xd9d\xa7 \x95\x8dr\xf9\xe1\xba?8\xef^\x8dX\x8a>D\x19N.\xdd\xc5\xef\xf7\a\x93\x92\xe8A\xe2p\xb2\x1c\xcc'\xddQIv!y6\x9f.\a\xbd\xe5\xa0_\xd2\x1b\xc2дX^\x9f\r'\xfd\xd2|\xd5>\x91էD\xb0\xe7\rnh\x8d\x12$\xfed\x81\xc8\a7w\xb5K8պ*\x90\x02Kć\xe1bx6\x1c\r\x97\xbf֒\xfc\x9e\xa4\x00\xf8!\xca\xc4\x12@\xb4L\xb9\x06\x10\x9f\x97$\\\x93\xf4ԉ\x92ws\xf6\xb3\x9b\x9f:\xd7\x12\xf4\xf5\xab4\xa0\xbd\x11\xf8\x13\xa7\x1f\xe6a\xedĩ\xfd\xf6\xfb\xcdcN\xde:$M\x93\xf4D\xc7Mw$\xae\x9dH\xc1\vB>\x91\x14'\xa5\\\xcbL\xc2-)\xd6/\xac0\xe5\a\x9d\x9efpi\x93\x16\x1fp\x01\xb4\x88\xfe(yFQ\xfcɺ4J\xc3Mt\x17\x17!\x838\xcfJޯ8\x81\x81\xff\x94\x04\x06>H`\xe0\x97\t\f|\x90\xc0\xc0?>\x81\x81\x8f\x13\x18\xf8J\x02i\xe4\u0600Z\x03\x14\xab5\x14al\xb7+\xa1\n\x82\xf4\xc0aB\x0f\xa0×\xca\x00\xe2u\x8dd$\xff\xdeJ9uΣ\rYX\xb5\xc4\xe7\xd9\xc0\\\f\xc3\x0f)I\x80\x0eh\tT\t\x85\x9f7\xe9\xea88\xc6\x1a\xc5\xe3\xd6\xd2R>\x84\x9b=1\xb7\x81B\x81\xedSgZ\xf6\t\xa7\xce\xe2>^?\xb0\x0f7(\x97\xf84\n\xc6ϧ62^K\x038J8L\x9eR\xd80\xde\xc0\x17\xf1\x16\xfe\x80r\xb8\x85\x05ƃ\xecz\x94\xf4\n\x1d\xb2\"\xc22\xab\xcbF\xd2+d\xd2\xce\x1f\x8b\xfc\x10eU\x12\x19\xb9B\xe0M\xb2165\xa6q\xda\xdfJ\x85\xf3ߗa\xf6\x81\xa4\x19\xed\xd6o\x92ds\xea\x88/^\xe3\xe1\xc7i\xd1\xff\xc3z]QF\x85\xac\"\r\xa3\xe8&\r\xd3G\xf1-\xdbXQ\xf3\xdd@\xe6\xb3R\xadV\x9d\xf2\x1a\xf1a0\xbf>\x1f]\xb0\u05c9\x9d\xfec\x1cn\xa3\x95H\x88j\xa9\x90P:\x9c\x9a\xa1\xbe\ne\xd39#T\xee.\x15\xea|\xcc\xe5\x00[\x13a\xd6,\x15t[\xc1\xa2R\xc1\xba\x82\xa4\x13g\x98]F\xd4\x04W;a\xe5i\x01\xd1\x7fj'R\xd0O\xdf\xf6\xb7&7\xfb\xbb\xbf\xde%\xd9\xe3\xf6\xa7\x1f\xf5W\xaf\xd7\xeb\x81\xef\xb3\x7f\xeb\xf5\xba\xfaoШ\xbb\xf27\x0fw\xddF\xd3\xffɩ\xff\xf4/\xf8\xdbgy\x98\xfeT\xaf\x7f\x8fL\xc2\xcc\xfd\x9b\xfc\xdd&\x0ey\xd8%i\xee|v\xff\xb2\v\xf3{\aԈ\xbf\xfce\x17\xae>\x85w\xc4\x11\x9f\xac:\xf7\xc9*YG\xf1݀\xce\xfcdwe\x9a3\"\xe0\x89\xc3\xfeQ\xdb\xc69\x85\n\xd6A\x9c\xa7\x8fE\xff\xf3f\xf1\xb8\xa5C\xfd\xba\b\x99\x85i\xb8͜\xdf~\xe7\xa4Q\xb2\n7\xe5\xe7y\x1an\xf9, \x8as6\x9f \xcb\xf0fC\x9c7\xc5\xcfSgz\xf3\xdfΛ\xe9\xcd\x7f\xcb\xee\xabD\x894\xd0\xe9\xad\xc3綧ά\a\xa7'Bp\x99S\xfa\xfb\xb7\xfa\xef4\xb7\xb5\x932ۅ\xcc\x13g\x17\xa6\x19\xa9\xe5azG\xf2B\xd8[\x87\aH\x91'N\xedƑ\xd3\xe9ݪ@m$\xdd$9\xdbD+R+\xd0' \x97&\xf8\xac\xb7L\xe8'\xe4\x00#\x17\x84\xb2\x9fɬW\x93\xf1\xbfu\xb6\xe1\x03\xe0C/\xb0!\xd6(\xbbH\\O\xe9\xbd \x80\xb2\xee\xf2\xb4\xc8o\x950\xa6\xbb\xd9j\x13/Û\x9aQ\aw\x89\xeb\xd1\xfa\x93\xd5N\x9c\xdf~\xa7\xbfL\xa8\xdb(^S\x1a\xcc:Eђ6\xe1S\x12\xae?\x87i\x14\xe7\xb5\xdd\xcey\x03\x13\xda\xf0L\f4\x84\x8eֵ\xa4X\x91\xa8C\x04*9F9O\x93m-LӢ\xe0\x9f\xc2|dL4$\x0fo\xce#\xb2YӆQ\xb3\x969\raj\x96\xbfl \xb6\xfa\x8b\x8a\xb8\xab\xf4\x98\xe5dW+\x14\xc8j\xf6\x1bY\xb5?\x87\x1b\xe7\x8dx\xae\xef6J\xb3\x9c\xd5\x18{\xbd٭>Ӊ\n\xc8\xf7[\x87\x80\xdeB6)\\\xbb\x1b\x9e\xb5>D\x1b\u009a\x03\x16r\x1bmH\x1e\xde\x14Q\xd0\x16\x00\xbf)=\xdeo\x1d@\x96\xbfW{\n<\\\xabi\x9d55\xc6Zek\x97\\4݈\x8b\xa6H\xd4\x00+cѠ\x01\x18\xf4.\x0e\x10hl\xd4q\x94\xd3x\xc7\xe1\xce\xde\f\xc7\xe1\x8eb\xb2\xda\xd6ن\xbb\xdfx$\xbfӞ\xf6\xad\x93\x88>W2Oȗ\x82\xbb\xb6.\xfb۷NN\x1er{\x876!_8O\xf6\xb8-\x95M+\xd6&v`\x82jo؏\xd26\xc1\xbaz\xda\xf5\x8b\x9e\x9bu\x1a\xa2ˠ\xc3J~O\xbf\x16\x8f۲\x13\xa7\xe9e:\xa2\r\xb5K\x7f\xd4B\xa02\xa9ET`\x8c'\x94L\xbc\x84\xe9\x88jP9\xebVQ\xf2\x16\x8f[\x99<<)g3l>\x1e\xa15\xc1ER̽\xd9\x1a\x98\xa6\xe1\rϑр\xf3\xb8\xa53\xd80\x8fVj\a\xcdHq\xb8%\xbfD\xf9}\xb2χq\x96k\xb3\\\x8e\x9a\xf1\xf9\x00\xeb\xeë9Y\x91\xe83I+ ga\xa6J\xe0k\t8\x10/\x1e\xb7\xa2Px\xde\xca\xf2b\x15M\xadflh\xa7\x18\xf6ۤ\x000\x12\xaa\xc3\xc1\x1b4vT\f\x99\xe6&\xf4ֹ\x8d\xb9\x8c\x13U\xc8\xf1m\xaf\x14\xc2*\x86jW\x93\xf2\x92\xe4\xd3~\xb7x\xdc\xd6\xe2\xb2.\x9c\xb0\xb9\x92\x19ʲ\x8a\xb1\xa6\xcc\xd2\x15\xf4#5\xb4\xd4\xc2\xd2`#\xe5\xb2¹\x8a?\xc5ɗ\x98*\xbf\x98\xf8\x81\x92Uɖ鞀Q\xb5\xa0\xe9\xe3y\xa9\x9er\xaeU\x96\x9d\xcaf\x91.\x87#}Rj\x9e\xaaI<]y\xfd\x83\xa4\x89\xda0J:\x1b'f\xbd\x9aڵ\x97\b\xaa\xe3\xe9\xedmM\x9d)\x94\x885\xb9%iJ\xf2}\x1aW\xa0v+ZU*\x01\x9b*\xfe՞[\xd9* \xb7tBP\x8b͏\xe4\x8a\t\x80\xd4\x1c\x9c<\x1bZ\x95@\x9f8\xbdd\x1f\xe7x\x82Q\xd0v\xabZī\xbb\xae<\x06\xa0?\xa8\xee\xaaQ4X\x9f9\xfe\xf4\xfa\xf7\xed\xeb\xffm\xb8\xbaO^h\xfd\xef\xb9uO_\xff\xd7\xdd\xd7\xf5\xffK\xae\xffY\x8d(\xd7\xff\xe23\xda2\xe8\xcf\x1c\xb3\xfe\x12\xa6\xb7?\x97\xa1$\xe6\xeb\xfd\xbf\xdeDq\x98>\x02J\x94\xfc,mq\xdd\xf9\x98{'N\xd9\xdd:\x03\xea\x15I6ɊNf\x18\r\xedKB4?\xea\xe9\x05:\xde3\xc1\xf9\xa5\x96\xdd>{,\xc7su\xae\xe6A\xae\xe9\xf9\xb9\xeb錁\x89\xd1\x16K\xc3\x06\xb6\b\xf7\x8d\xf8)ۓ\xbc^N\xf9\xa3\x93*S\xcbĴ\xb8:[λ\xbd\xe5tnа\x89a9\xfa0\xabVY\xfb0\x9b%[\x1d\x13\xe7\xd5d1\xbc\x98\f\f5\x00V\x80\xa2\xf8=\x1f\x01\x95\"\x14\xb0\xcb\xee\xe8\\\x85\xb5\xcd0\xb6\xf5\xdc\x1f\x9ek\xf8\x8e\x8eg.\x06V\x86\x86\xce0\xeb\x0e\xe7*\xcc5\xc0ήG\xdd\xeb\xd9R\xc3\xfa:\xd6\x16\xbb\xa7C?t'\xc3Ѩ\xab\"\x91Z\x97\x97W\xe3\xb3\xeb\x86G\xeft\x92Ǩ1\xbc\xa5Ë\xb2\xf0T\xb0\xdc7\xea\xed\xf6\xcạ\xb7\xdb[\x8d\xe7\x8cf\xdd\x0e\xe8\xed\xf6\x8dv@\xff\x81\xa9\xe9\xed\xf6\xdd\xed:\xf0E\xb8\x1b\xb4Z-\xcfk@r\xba\x95D\x0f\a\xab\\m@\x9e\xedV\x92\xa8\x04+\\\r\xb9W\xd5\x7f\xdcD\xc5Ti\x94\x84\xeb\xb3ǜd\xcaBm\x19\x81\xbd\xb3\xde>MI\x9c\xcb}\x86\"8\xd9\xeeB%\xf4+\x8c\xa4\xb7]\xcbx\xe8O\x1aWo\xbb>uF\xa4\x14\x82w\xa9\xbf9V\xb1\xcc\xd6s'I,\x01\xc3x\x1d\xa5d\x95\x8b\xf5\x9aY\xc81\xa9\x1fn\x98=\xf7q[\xe6G\v\x19\x92\x87|Mn\x11H\x0f\x1a\xeec\x15\xa4\x85,\x93UR\xeeF3L\x9e\xac\x8a\x8fq\xb2\xceÛ\x04\xb8\x18L\xb6,\xa8\xf8\x1e<\xe4)\x13\x89@D\x86\x96N\vRA*4*\xc33,u\xa3\x8b\xdc\x14ߣd\xa5\"6,\xa8,\xbf\xcfaJ\xd7G\x93$?\x0fs\xe7\xcdy\x92nÜ-\x98\xa4\xd1=̻\xe9\xea\xbeX|\xf1O\xe9\v\xf2\x86.ƾb(v\x03\xa0m\xa1\xb7۟:\x8b\xfdM\xd9\xdc\xd1\xfe\xbe\xb6#\xdd\x05~\x17@\xb8\\\xf8Q\xb1\xe3\xf0.*\x8b\x80FKت\x9f'\xc1\xbc\xaa\x17\"N\x9c\xde&\xc9貉\xc0|\x02\xe1\xf4\xb7\xcc!\xad\xc7Ӕf\x88\xcfT\xde\x15\x01\xa7\xacn\xd2X\xe9\xbfŮ)\xdfe\xe0\xbfO\xe9b\x99ք7\xfc߲=8o\xe4/KZM\tE4f\xf3\xe6b\xa9\x95k\x1d慕+\xcbSh\xf4Zmאz\xbfNezz\xdb5\xb3&Ӓ\x90f\vAҼuD\xac\xfb\xec^d\xae\x96\xdd;2\xa3o\x9d\x14\xfa\x05\x99S\xbc w[\x12\xe7\xaa9\x82\x87jX\x1e\x87\x8ae\xa1\n\xb6\xffKw~N\xfd\x8cްY\xe6;\xbar\xb5$\x7f\xc8&\x99d\xcd\xf7\xcf3\xee\x9c$M-\x95\x1c|K;\"\x16\x9e\xa2\x0e\xe1ڏ\xab\xa9\xad-,\xe5\xee\xf8\xa93Ym\xd7%~\xbb\xce\xfe0\xfa7\x15n\x114\xb0\xbbٌ\x93uv\x96\xecc\xc9ˎ\x19t\x02\x05\x95|Y\xe4\xe1\xea\xd3\xe0\x81\xac\xf6yٍ\xf3\u05ca\xea-\x0f\xc1w\xbb\xc1CNb\xda\xdd/\xc2[Rb\x1b\x8df\xd3\xf7\x1b\bM]*\xba9m\a%\xae\xad\x02\xb2e\xf2\v\t?\x95\b\xea͎\xd2\xd8\v\xe3$\x8eV\xe1\x06\xa4,h\xb4}\x88\xe9S\x17\xb1<\x8dv;ji\xe0\xa3j\x99i\xb7\xe37\xea\x18\xff\xb8Y\x03\x87!8]\xa2\xe4\xf3$]\x91\xf3M\x98\x97tp\x92\x82\".\xc3l9\xfa\xd0'\xd9*\x8dvy\x92f \x8b\x8dv;\xa8\xa3\x8c\x0e\xe3U\x8a\xa3C\x8a\x1a\x85\x7f<\x0e\xe3\b\xc4\x16\xa0\xe4L\x92\xf3\xe8a\x96\x12\xea\xf3\x17\xc5" +
+	"w@\x0fuO\x01^\x92pg*J6\xedp\x03\f\x1e\xef7y\xd4'\xb7 \xf1\xe0\x94\f\xc7\xcc\t_ޑ5\xd3j\x06#\xf7\xdb͖\"\U0008a353\x00\x04ɳ\xe1\x00h\xa0\xdei\xb9M\x14\x9b\xc8\"-B\x88\xf3\xdb*\bWj\x9c\xady\x92\xe4\xb8nz\x81\a\xde\uf898\x05\xc9\xf7\xd1\x1a\xa3\x9a\x9e\xef\xb5QL\x8b\xdd&\xca\x17\xe4\x0ed\aW\xf0\xc5\xfe&\x13\xfd\xfb\x87(\x14\xdd\a\xa8\bn\a\xc1\x97_\x92\x11\xf9L`=\xf6P\x84\xb4!\xf4\xc9m\x14\x13\x14e+\x90'\xc3\xc0H\\\xb5o\x0e`\xba\xa1\x93Gw1\x98\f\xe6\xc3ށ\xc5\xc9\x05\x89I\x1a\xad`\xf3\xc0\x8cx\x91R\xc2]\v\\_\xac\x94<\r3OU\xaa<3\xcbr\xf4\xc1\x84n\x9a\xd1\xdaR\xa7\xe4\x90wͰ>,\x8as\x92ކ+\xf2\x7f\xe7\xe1\x97ډ\x18:\xbf\x02\b\x9bފpY\x18E\xf8\x89\x03\xd9\x00W\xaf\xe8\xdc!OokwW+\xe8\xd6u\x8f@\xf0\x8ePF\x02\x173\x00\x10\x7f\")\xc445\x8c\x98\x9d\x00\x8c\xab`\xe6\xcc\xf2S\x02\xe4)\x8d\xb2{\x1041\xb0CYfD\xe0Cqj\x9a\x16j\x8aԌ-\xef\xe96<\x00\xf8\n\xe0*\x8e\x1e4P\x11\r\x1b\xa2\x1b\x1e\x18\x1e\xbcV\xab^\xef\x80[\x86\x19$\xf0\r\x90\x06\x82\x9c\xc3Q\xa4\xe1כA\xc7m\xb6\xbdrOR\xcc@k\xcad\xa9\x98\x9a*s\x10\xcaa\x86Gږ\xe5d\x13e\xf9!7\xe96\x9f\xa5\xca\xdftL3\xb8`\x96\x93n&4\xf0\xbf\x87P\xe6\xca\xc9rż\xbdѼN\xcdP\x01;\x0fs\r\xa9jJ\x1cZ\xbd\xcb\xe8\xea_$\xb4\xfb\xb1H\xe6Y\xf9\xb3W\xfe샟\xc3\xe2\xe7\xa2\xfcy6+C\xc1\xcfE\xf1\x93\x1f?\x92_\xc3\x12\xd3+C\xfb\xe5\xcf\x01\xe0,\x7f^,\x14\x85\xd3|t\xc7\xfd\xc0Wr\x12\xf8EN\x02\xbf\xc8I\xe0\x179a?\x87\xc5\xcfE\xf9\xf3lV\xfc\\\x94?\xe7\xed\xf2g\xa7\xfc\xe9\xd6\xc1o\x17\xfc\xf6\xc0\xef\x06\xf8\xed\x83\xdf\xcd\xe2\xf7\xb0\x8c\xa8\xd4\x13Kx\xf9\xf3\xbc\xfcY\x00J5l\x92\xc2\xd5\vy\xf2c?fX\a\xa5}\xa0}\xea\xcc\xd8\x1a\x97\xbb\xb9\xd2Il*}^\x17\xab0\xcfIJ\xd6\xec\x1b\xc5&\x8dS\xf0\xaa\x10\x10n\xec\x80M0\xcb\xfb\xc3\xd8hi\x89\xc4r\xe9\x88\x19x \"9\xb2\x99\xa2\x12\xb4c\"+\xa0\a\xa23\\\xb4\x82I\xc7Df\xbd\x99\x85\xc7%\x1d$\x14\x13Ӭ\xf4\x9b\xf8\n\xa1\xc7\x18\x92\x18\xaf\xf1@\x87\xe5\xf0\vK+\x1d\xf8ُ?\xc5a\x98\xdf\xdc@\xf8 .\xc8\x1d\xf8B\xed\x06\xd9W\x14\xdbK\x17\x1ds\x99+\x16\xa3\x94\xb5E\xf3!\x9b9\xc9H\xfa\x99\xb8j\x80w\xd4!\x99\x83\t\xd7\xfc\xcc\xffe\t/\x02\x1a\xe6\x9cT\x1cu\xa1y\x91\xbf\x7fxN\xcad\xf1\xb9\x96\xde:\x04\xe5\t\x87\xb7\x18\xfe\x88\xfa*p\x95\xf5\x95a\x1a\xde\xc1v\xa8\xd4\aTu\xc7d\v\xac \x8a☃OI\x1d\x87\x0f\xbb4)\xc93\xf81\xc9Ȫ\xfc\x02\a\xa2\x14-\x06\xfe\xb3\xd3\x1b\xf80\xbd\x86sL \xbd\x81\xff\x9dҫ\x18\\+\xd3l\xac\x99\xff\xc2\x14\xdbϭ<g>)e\x9a\xf7\x1e\x16\xe5\xceC\xe9!vS\x8e\xfb\x8b\xa3\xf7\x1b\x16\x8a=\x1e\xd9\xe1\x17y\n\x89\x8b<5\x1c\x13\x14\xeb\x8fC\x11\xa1\xf3\x82¿\\\x11\xa4\x1f\x1f\xfb\x96C4\x94|\xb6\x8f\xd7\x1b!\x19\x98\xed\xe87_U\xaagf\xe97\xb5<9\xea\xa9d\xfaM}'\xd5s\xbd\xe5=CӞ\xb6\x7f\xa8_΄\xe0h\x13[\xbf9IŲ\xdde\x03C\xc3Ƞ\xee)\xeb\xf7\xac\x19\xe0\u05ee\x81!\xa8b\xf0\f\f\xad*\x06\xdf\xc0\xd063\x18v\xee\xf5\xbb\xde\x10\v\xb2\x99\xe8\u05cd!\xac\xbe\xed\x0e.\xe4zu\x89\xfa_\xe8\xff\xb5#?2\x8ej\xff/\xb7\xd5\fZ\x8a\xffW=\xf0\xbdW\xff\xaf\x97\xf4\xffڑ\xd2\xf9kGJO.\xb3\xe7\x17\xf3\xef\xe2\xde\x1f\xd3\xf3sÄ\xe0\xb7\xf6\xefb\xe8W\x0e-\xf3\xa9\xf7d\xbf\xbd!\xa9#&\x04\xe0L)\x1dz\x934\xbc#\xbdM\x98er\xfa\xc0\xe1\xd3\xdb\xee\xfe\x01Z\xed\xdb匷LƉs\xbe\xdfl\x98\xbby\x96\x8b\x01U\x9e\x130n\xf8\x95\xac\xdd\xfd\x037\xc57\x8b\x85#\\qM\xf6[\xb1r\x94i\x9d\xec\x99\xd7?O^\x19ܻ'\xabO\xd9~\vs=\xd9o\v\xfa\x82l\xc4\xfaT\xe4\x0f\xcd\xe1\x85\x1fJ\x91*yN[n*\xd0\x19E\x9fy\x12$\xe9\xa3d\xfc\x10\xa5\xf9>\xdc\xd0\x19!\xc92ӊ\xf1\xeb\x81=\xf2鎦(\xdc\xf0o`=\xffj\xdf\x15g%\xc1\xcf\xe3\xddP\xd3L\x99h\x1a\\~\x9d\xc2r\x80\xbf\x9f\xbbo\xfeﶳ\x8c3V\xe8\x85/\xb6X\xa2\xfb\x84\xd6\xeeZ\xb4~\x10\a\x05ޘ\xea\xe5\x11{ի\xfb(&\xb0\x86\xb2\x96S\x94\xa0$Pw\xa1~\x98\x93E\x1enw\xe5B a\xe5\xbeLx\xc4K\xb0\xb5\ad\xa1\x8d3^ɦ\xb7J\xfd)\xdbB\x98\x86\xab\x9c\xa4Q\x96GE\xd3\xf9j\xdf {Ҷ\xd8pܽ\x18Лy\xfa]z\xb1\xcch\xd0[^w'\xbf\xc2y\xa8\x11\xb2XL{C~\xa9&\x98`\x99\xa0\x83\x8f\xdd\xde\xf2z\xdc]\xf6.\xe1\x8c\xd5\x04\x1du\xe7\x17\xf4eR0\x8f4\xc1&\xd3\xfe\xd5l4\xecu\xe9\xb5N`\x92j\xc2.\xba\xe3\x01\xbb_\x13N~9\xb0?\x9cӗ\xa6\xe6\xbf^\xb3\xa7u\xae\xbb\xf3\xde\xe5\x90\xdeSs5\x1f\xc0\x99\xa9\x19M\xcf۳ɡ\x9e\x7f\r:\xbd\x9a\xf4\xaf\x87\xe3\xd9t\xbeD{Ifxo:\xbe\xa6wx·3:\x9f\x85\x17\x8f\x99\x19\xfa\x83\xb3\xab\v]i:l\xd4\xfd\x15$\xe3\x80:\x06\x1f{\x83\x19\xbbg\xe8\xa0\xe6\x06\x1f\x85\xccz5\x8e\xdfgEwC\xc1<\xde\f\x1dv\x97h\xfb\u0382*2S\x8dc\xbe\xb6\xf4\xce\xc8\xe1\x05r\x966\xa3\xe7\x83\xc5\xf4j\xde\x1b\xe8\x8d@E.\x06\xbd\xab\xf9p\xf9\xab^\xb1U$\xbdw\t\xac.\x04h4\xea]v\xe9\xbae0\x1f.\x96\xc3\xde\xe2\xba;\x9b\xf5\xa6\x93ew8\x19\xcc\x15\xf7\x15+\x8f\xb8\xfd\x90UI\xe4Ja\xe58\x9f\xce{\x03vkӅH>ؘ\xb7r]\\u\xe7\xfd\xeb\u07b9\xea\x8fbe\xa0\xaf\a\xb3\xfcOg\xbf^\x7f\xe8\"w\x02+\xd3d\xcan\x84R\x1c!\xaa\xe0\xc3\xc5t\xd4\x155\xb5\xe9\x1e\x15\xc3bp\xa9x\x93\xd8\xd1\x1f\xd9]\xad\xdd%\xf6\x89\xb1\xe2\x97\x03z/)\xf3.\x98\xd3k4\xba\xbft\xe7\x83ҭ\xe1\x00\xf7/\xfd\xf1u\x7f>\xfc0\x98+\xfe\x14\x9c\x83^\x92&\x1c\x90\xc7\xdd\xde\xe5p20\xa5\x8a\xa1\xba\x17\x17\xf3\xc1b\xf8ap\xfd\xcb\xe2z9\x1f\x8e\x91\xe3\b\x00\x9e\xfd\xba\x1c,\xae\xe7\x03z\xc9\xe3\x80>\xefcN\xac\t;\x9a\x9a\xaa\x0eC\xb2N\x89ݿ:\x9b\r\xfa\xa6\x92\xe1\xb0\xd1ȞQz\xf5\xedղ{6\x1a\\\xb3P\xbdA2\x18\x1b6سÃ\xc5\x02\xe8\xdb\x04\x1dN\x06ד\xab\xf1\x02\xa6\xcc7\x01\xb9\x83ȯ\x18iʨ(\x87\xeb\xee\xb8Ѡ\u0082V%\xa8/\xaeH\xf7\xeb~\x15\x8e{\xb4\xfb\xedj\x8cx\x89:\xf0\xeaո\t\xad\xbc~ӫ@\r\xce\xf8C\x15\xad*А\xee.S߷JPW<d_\x05\x1aM\xa7\x93\v:\xe2\n_3\xb7\xd1>\x06-\xee\xacw\xdbU\xe8qã\xad\xa7\x11t\xbcN\x15l8[\xb84;\x81\xeb\x1f\x80\x9dϮ\xd8s\xac\xf5\xc38&\xd1u\xbd\xa0\x02:\x9b\xfe2\x98\xf3\x87\xb5;G\xe0\xceg\fYU\xaf\xe6~\xbd^g\xd7|W)\x86݉\xe9zmַ\xb6Z\xc1!\xa8pGk6ꇐ\xe2n\xf0f\xbb\n\xb9\xb8d\r\xc4mWc\xfa\x8b\x19\xf7a\xac\x84\xf1\xfbֽJL\x93a\xaa\n\x97\x1d\xd1`m\xa3*\xe1W\x93\xf7\x93\xe9/\x13}\x82\x83P\xbf\xf4\x06\xb4\x0e|`n/\x81k@N\x06\xcb\xeb\xf9\xd5\xe4\xfa|>\x1d_/~\xe9Ό\x83\x1c\x83\xb2\xa9%\xea{\\#j<\xfd\xc0\xfaGU\xac>\xb41\x86ů\x8b\xe5`l\x9cX0\xfa\xd5L@\xae\xa7\x93ѯ\xe6\x81~ћ\\\xf7&\xf4:Ͼ\xa9\x9f\x95dz\x15\xec\xb0;\x1a\xfecЧ/\\t\r3\x13\t\xbd\x9a\x18\xc0ڰBѣ\xc9{1\xc17\xe6\x81Bƃ1}\xf7\xaaם\xf7\xa9f\f\xae\xba\x18\xcb\a\x19\xb6\x8ci\x04\xedV\xbd\xe3Z\x80\xf3A\xb7ϟ\xf5n\xb4|\xb7\xed\xf9f\x18\xbf,\xdcto\xaf\xc0]\x9d\t\x15\x0f·t\xbe\xc7V2|\xf6\xe2֫\xb0g\xd3\xe9\x92\xcd*\x86\xbdA9Ip\xdd*\x9e\xf9tl\x98\xeb+\x98\xab\xc9r8\x86\"=\x1b|\"\x97|n5\xe2\xfa\x97\xe1\xa4?\xfde\xa1\x8f\x99%r\xba\xf0\xae{WC}\xfdTBf\xd3\xc5\xf0\xa3\x00\xb5l k\xeb,!\"5\\\x83\x8aʃCL\xbd\xc1\xf5\x05KA\xe7 \x92\xc1\x1a\x87`\\\x9aU\xc7\x1fϦ\x1fŪ\x8f-\xf1\xb9\x85D3XM\xd3\xe8.\x8a\xc3\xcdy\x94f\xf9\xf2~\x0f.\x834\x9b&Γ\xf4KH\x8fl\xf4\xee\xc3\xc8r\x06\xca L\xb7/\x1d\xe9\x90w\xa4\x9b\x1b\xcb#\xb6}\x94[\xe5\xe5\xc9\x01j\n\x19\x87\xff\x9d0\xb7r\x92\xc2#X\xedSg\x1c\xc5\x16\n\xb7\xad\xf4\x925Q\xcc-\xc38ʣp\x13\xfdA\xd6l\x8b\x11S\xaf\xe2\xc8B\x17\x16\xc2\xe9-\xbb|\x8b\xd9{\n\x1a\xbd\xa7C\x89\x8c\a!\t\xc3mxGh\xb8j\xd4e\xfe\x0f̃\x01n\xec\xeb\xa1L\x0f\xd3\x1dI\xc3<\x8a\xef\x16\x8fYN\xb60\xdbn 4r\bC\xe5\xb0\xd4\x18\xb9\xcd\x14\xcaC\xfd\xc0\xed\xb1Z\xa9\xbfDq\xc3\x13\x81\xfaU\x9c\xd3[\x16\xa1\x12\xc6kDi+c\xa6\xe1\x054\r\xcb؊h\xfa\x9b\x8d\xd9h&e\xb2\xf3\x1fµE\x89\x8e\x91z\xc9v\x1b\xe5zBvf\x1eJQX\xe8\xb66I\xb1\xb7\x8d\xb4\xfe\xcd?\x87ݘ]/\x95\xa1M\uec85S\x97\n\x14\xf2\xd5\xd8L\x02\xff\x7fN3\xc1m\"\xf0_\xdb\xc4K\xb7\x89\xc0G$P\xc1\x03\x1f\xd6|3\x8f\xd2&\x02\xff\x87\xb5\t\xe4%k\xdb\xc5)\x8d\xf1坢\xc8Ӥ\xb4\xa8\xff\xf9=\x1f+\xdc\xeeD\xfeѮ\xdb\xe1\x9d-͟\xac\xd8\xc5`\xb9\fˍ\x0fDTw\xeeP\x81*\x8cp+ż\xe3\xa7WN\x8b\xbfaóm\x94>-\xf3\xd3\xdby\xf8\x05\xf5Ye\xb6\xad\x84\x17\xd7\a\xae.R#\xf4F\xa0'\xed\xd9\x02\x88r~\a1\xf3\x8f\x1a\xdd\xf1ϋۏ,\x12\xedNm߾\x83\xfd\xf5/\x7f6\xff\x8fM\x18w\x92\x9b\xff~\t\xff\x8f\xba\xeb7\x03\xf5\xfe\x9f֫\xff\xc7\v\xfb\x7f\x88\x1a\x01\xbc@\x8a\x10\xe4\xf5Q\\6 \xb7\x81\x8f9\x80ot x\xe6\xd6?ܛ_<n-\x9b\xec\a<\x04\x8e:\xea}\x06\xba\\|W\xf1,O\xc1\xcd\xc3I\xb8\x86=4\x05\\\xaeS\xd3\x05\xf8L>\xb7B\xfb\x1d\x17\x85\x06\xbe\xbaq\xc1\x82\v\x93{\xd3ou0\x85_1\x13\xd4[?na\xfb\xe7{D\xa4\xcai\xdf:%\xf8zԝ\x9b\xe9>\xc6wn~\xfd\x1f\xe8\x1cI\xb67d\xfd\x83\xe38\xd4\xff\xbb-\xcd\xff\xcfs_\xfb\xff\x17\xea\xffY\x8d(\xbb}\xf1Y\xf6\xf9\x7f\xbd̀\xe7_\x1em\x89\xf4\xfd;_T\x9cj?_\x9c8\x1bv%\xa9\xd2\a\xd3\xeb.!\x88\xfa\xd4\xf7\xa3\xb4\xb6\x8e\xd2\x02\xf4\xdb\xef*\xca\xd0a\xddf\xef\f=\x1bCυL\xcc\xf0\xdb\xef\xb7ٻ~\x94\xb2\xbe\xdc\xc6ƺP\x95\x0f\xf7Z,\xf3\xb7`\xa83\xf5}\xb7l\x182ߊ\xcbi\xe5\x15݁\xaf\x90\xc6ɚZAk'\x0e\xd5\xf7;\xfa[A\f3\x9a?\xf5\xfeR1Bf\xb5\x13\xe8\xc0\xa7\xd0igGo\xfe\xe6\xea\x1b'kMv|\x9b\xd4J\x05\xd3O\xad\xcb.\xd2Y-J=\xb1\xf0\xd3\xeb\xdf\xcb\xfe\xc9;\x1b_\xb0\xff\xaf\xb7\\\xad\xffoy\x8d\xd7\xfe\xff\x85\xfa\x7fQ#\xc0\x10P\x84\xb0\x9e\xee\x8cݙ\xd5\xdd\xedH\x8c=\x83y\x10'\x83\x8bz\x95\xfe\xf2+\x123\x0e\xd3\xec>\xdc 9\"L\b:q\xf0%\xf1\xe5\xe5\xd8X\xd2U\xbc5\xc8*B\x854 J\xac'\x8aCX\xe4!\xb7f\x8a\x12\x0fg\x89\xa2*2Ą\x9c85vͽ=;\x14v 3L\x12\x10Sd\xe5\xd9\xed\xff\xafa\xb6\x8a\xa2v\xf3%\xda\x7f3P\xd7\xff\x9e\xdbz\x9d\xff\xbdp\xfb\x975\xa2\xec\a\x8a\x00ñ\x8f$M\xf7\xbb|\x18\xef\xf6\xc2}\x1bMc4\xb2\xee\xc0̀\xec\xc5 R[ge\xfb\xc8\xd2U\xf1\xfbv\xb3\xcf\xee\xc5S!\xb5\x98\xa2\xd8\xed\xfb1\xc5\xc8\x17\x15\xf0\xc4d\x10W\b\x84\xb7\x95\x8f\xc3\a\x8e]\x8f\xe8Ē;ݗ\xe4\t\xf9\xc2Ӗ\xc2\xe5\xf4I\xf9\xb3\xc4q1i\xed\v%\xfe\x92F\xb9\xc0\xb1\x9f̒\x91\xfe\xe5\xcf7\xfe\xff5\xccb\xf7e\xc6\x7f\xafUW\xc7\x7f\x97v\t\xaf\xed\xff\xa5\xdb\x7f\xec\xc2\xc6\x1f\xbb\xa2\xb1\x9fE9\x9f\xc7\xcbe\x0e\xbc8딒G$\xbe\xcb\xef\xd1\x1b\x0e\x05Ӊ\xd3\xcdk\x91\xda\xc0 }\x1e\xdd\xdd\xe7lO\x14ܷ%\x8eAQ#zw\xb7\xdbD|\xfb\x01\xfa\xee0Z/\x89鸸ؑUt\x8b/\x19c\xf4Y\x1a}\x0es\xf4\xca0\v\xbf\x8a\xe9\xa3%Y\xb8\x01׆\r\xe2\xfd\x96\xee\xae\x12\xf1\x18%_\xe4\xd2\x03\xf0`\x95%\xc6\xf6\x1a}\xd2\b\xac\xb1l\xc6-\x01\xa7/\xad\xf0\xe7\xccTƷΎ\x85ۗ\x9b\xd4\xe2:\xd9o6\xf8\xba2\x19:\x0f\xbfp[\x96\xfcQ>\x7fCV\xf9pM\xe2<\xba\x8d\b}x\nh_\xa5\x9e8\x83\x7f\xeeÍxOX\xa7\xc2e\xa6N\xb5\xdc\xf5\x12~a\xa5\x13\xe7\xf8\x12\xb5\"\xc5\xf2 =-\x0fnJ]\x86w\xfc\xc70\xa3w\x15\xb3{\x03\xf9\xad?\xb8ʝ+\xea\xf8Z\xee\v\xedW\xf9>\r7\xe8L\xd18\xbb+/\x97)\xf6\x89 \xd2\xf2\xc0\xc8\xe21\xceÇ\x83\xb2J\x94\xed\xa0\xd22\xbc;\x1b\xcfD+\xfa\x9bӨCBѼ@%\xa5\xe1I\xb2!!\xaa\xf2\xcb\xf0\x8eVR\xe4k\xb7\f\xef\xd8\x05?ᦐ\xe2\xb5t\"uq`wD\xf3\xa7W\v\xf2\xb0\xdb,\xf9<H\x88srGRt5@xG\xab\x1c\xf4ycA[\x92F\xabBJ\xe9!\xbb\f\xef\xa6\xc3>\xbau \xbc\x9b\xaerRf\x18&e\x96FqNw\xeeJQ\x1d@^\x90\x7f\xeeI\xbc\xc2\xef\xf1\xb3\xf0\x9c\x06\xc1</\x03\xb7\xcc\x14\xd4\xd4ղ'\x95\xd0@\xc1\xe7\xed2Ry)C1\xfd\x06\xef\xf5\xe9\xcd>%\x99iv\xaf\xca\x00=\x80U\x9a\xde\x17\u0604\x7f\xfb\xf8\x7f\x13f\xa4\xe1\xbd\xc4\xf8O\x0f\x80\xab\xf3\xffz\xfdu\xfc\x7f\xe9\xf1\x9f\u05c8r\x06 \xbf\x7f\xc4\xec_\x8c\xb7<\xe6\n3\xaa\x84\x9c8\xbc\xfd\xac\xe9Gm\xc7\xffe;6'ΛAa\xaf\xe0LoJ\xae\xea\xf5\x80\x81\x81/\xff\xab\xd9\x14\x97\x03-\xba\xa5\xbc\xa8\x05\xf2(&`\x95Ǽ\f\x81\xb85\xa9L\x13g~\xeb\x101d\x1a_\x15+\xa5\xf5\x8f\x94V%\x83\xeb\xea\x90$˞\x9f\x9a\x14\xa92\xfb<\xc8¨i\x8eΌ.Ƀ\xc4i\xf5\x03\xac\xedH\xbc*\xc9\xe8\xfe\xf3ʥ\x9e\xc2V\xb5\xf2ü4\x87\x84\v)7D\xca\xe4\xf1\xf1h\x92\xcc@\xfdv\xfe\xe6\xfc\x1f\x97gj\x91\xafM\x99\xe2\\\x8b|\xad\xb0\x05\xee_\x0e\xf4\xff\x81\xff\"\xeb?\xb7\xe1\xeb\xfd\xff\xab\xfd\xe7\xcf\xd0\xff\a>\xee\xff\x03\xff߰\xff/\x99h\xa7\xb2\xcak\x06\xcc\xff\xe41\xe2?\xf7a\x9c\xef\xb7V\xb3Z&V" +
+	"㵘\xffz\xeb\xfc\xfb\xf4\xf6\xdf6bYF\x8c\x7f\xefaa\x1e~\xb1\x8c\f\x82z5\x1f٨O\x1fR\x18\x9bY\xe2O\xaf\x7f\x7f\xfa?\xe5;\x97\x18\xff\xfdVK}\xff\xd1\xf3\xdc\xd7\xfd\xdf\x17\x1f\xffY\x8d\x00\xe3\xbf\xf8F\xe3?\xeb\xb7\xf8PP\xbb\xd9ߖ\xcfٳ畊w\x95\xde:l\xe3\xb5\xda@\xca&\x01b\x13Y2j;\xb1W̫\xba\xe0\xe5N\xd6'\x85%\xb0\x045<\x04\xa2\xae\xde:(\xf0\x11\x88^T-A\x8a\x11\xf3+\xca\xeb\xd5\xe70e\xcfт,?(\"\x10\xc3\a\x13^\x81Ӟ\xf4,\xba\x1b\xc4\xeb(\x8c\x9d\x1b\xf9KZ\xdd\r*\x99\xeds\xb3>N\x1dAҵP\x90\xf4\xbck\x99>u\x90\xf8\x93§\x1d\x89>)\xbc,\x91\xd8\x13\xe5\x8d\x001`?\xb5\x96\xa0\xa7\xd2\xe1\xa6\xde7\t\xa2\xba\x1eEy\xbe!B\xdd\x1b\xf0Q\xfa\xd5>\xf0r\x1b\x91\x98݄\xd00Q\xf8\xf33&J\xf1\x98)3·y\xf4Y\xc6\x16\x83\x0f\x91+Z*\x95\xb5\xaa\x9c\x9a\xcc\xf6yU}*\x81tf\x027,\x8fR\x14\xf4\xb2\xa6l2UL\x0e\xe5\x94S\x9f\x9a|\xb9\x1c\x95\x0e\xa5~\xb0q\x98\x18\x98\xdf\xdbg\x9c\x862\v\xbaN@\xc4\xe0\xf9\xfd\x0f\x06\x98\x8ebs.\xb85\xfb\x14\x8d\xb0fX4K킲\x82r\"[\r~\xac\xda\r\fȲ\x05\x03+\xb0l\xc5\x06<\xea\x04\r,\xca:CIS\xc3S\xd2\xd4\xf0\fȲ\xebP#hx\xd5i2\xb3T\xa7)\xf0\xf5G\xbd\xadi\n|-\x82\xc0\xafN\x93\x99Ş&˝\xcb\x00a\xb8x\x99U\r\u0605h\xb5\x03\x12\x0fV\x10\f>\xaa\x8e`\x96'V\x13=q\x155Ř\xb8\xea\xcabK\xdcQ\xf5EO\\E\x951&\xae\xba\xd6\xd8\x12wT\xc5\xc1̖\xba\x83A\xb6\xea\x03\xc7\x04Y} gQ\x95 \xd0\x1a'\x06\xe9q\xaa\xf3\xffU\xf6\xf9G\xcd1\x0f\xda\xff\xbc\x86\xe6\xffQo\xbd\xce\xff_x\xfe\xbf\xca>\x97\x93\x7f\xf6a:\xf8u\x16\xa6\xe4?\xf7IN\xe4()\xc2\xcf#\xb2Y\xf7\x92}\x9c+\x04\x13x\x99\x86\xd1&\x8a\xef\xe8\x19\xeb\x10M@&\xe4\v\x9f<`\xbf\xab7\xaaͅ\x8f\xe6\xd8\xe9\xea\r\xff!\x9a\xd7,L3\x82\xb6\xee\x17\xf4\x1c(=\xae*\xcem\x15\xbfz\xc9f\xbf\x8d\xf9\uf074\x1f\x95n\xf3\xa5$\x8b\x1b\x1b\x86\\\xc5_\xd2p\xa7<M;\xc7\xef;\xb0|\xf3\x03G\xf47\x89s\xf1\xc5Ԙ\xcdH:'\xab$]\x8b\x94\x86\x7f<25f\xc2\x1bb\x99F\xdb\x11\ti\xb1-vኈ\xe09\xd9gD0J T4\x0f+ͬ\xf4\xf7o\xf5\xdfi\x1ej'e~\xa5\xd2\xe9\xbf5\xb6\t\xcd$\xa2kmU\x1b\x9b\xe4a\xe9\x9f%Y\xed\x96\xfe\x10vƍ\xd0\xf4[gUhZge\xf6b~nK;\x16\x01\x93\xd4\xddl\xcaTQ\x0f\x90\xa3\x12F\x8f\x99\xd0\x12\xaf8\x86\x06\xa1\\\x8b\xc2\xda\b\x8c\x94\xc6\xc3üޙ\n\xf7*#\xbd\xf9\xe8\\\xd3|\xa9lYyٿ\xaa\xaa\xd5#\x90\x12|N\x1d#k'Z\xb8\xac\x9df.\xf6/՞\xae:\x1b\v+\xc5\t!\xeb\x8c\xd7?Q\xac\x92\x89f\xeb\xd5\xec\xf7\xef\xf4W\xf4\xf6w\xc9\xcdˌ\xff\xae\xdfl4\xd5\xf1\xdfo5_\xc7\xff\x17\x1e\xff\uf49br\xfcg\x1fh\xfc\x97\x1f)\xb9ݐU^\xee\x05n\xb7I\xcc\x0eК\x0e\xe5\x0e\xd7\x0e\x85\r\xd7\xe0\xae\xfe\x82\xe3ĉ\xe8\x00\xc3\x01FzF\xf2\xe1\xba\x16I!'f\x90yF\x8c1\xe1m\xb1\xe9DH\xecl\xc3\xddo\\\xe6\xefܿ\xbc\x827Ƈ\xf8\xf8-\xfc\xa4خ\xc1[\x97\x961U\xe0O\x9c\xb8\xd8fZ\xe4aέZo\xd6du\xb6\xbf\xbde\x93\x985\xa0\xea\xec\xb7)!\x88\x7f\x8d9Nt\x16N^D\xf1݆\xd4H|G\ac\xca3`?\x99\x13ڞ8\xa2X\xdf1\xd7L\xbb\x14\x96\xcb\xe7K\x89\xee\xe2$\xb5J\xb13X\x12oMg7M\xc3\xc7K\xb2ّ\x94^\xff\x91\x13\xac%cr\xdf:dC\xb6\xd3\x1d\x151ݽu6\x85S\xf3['\xf9|\xbb\xe1C\xe4[瞉\xa5(\x1eAu\"^.\xfaq\xb8\xabm\xf3\xc7]\x11\r\xad\xcdo\x9d\xe3\xd3\xf3\x89<\x96\xc9\xc1\x89+\x13d-\xb3\x83E`\xcbn\xb5\xc4\xef$\x8b*\xc7(ɚi{\x9b\xd8D+\xf2\xecb~bъ\xf6`\x8f\xf2\xa8$\x0f\xa5\xb5\xb3\x16=\xbf\x82XSWJ7\x88\xb2\xa6\xe9\"\xb9\x11!\xb5}\xee\xbc\xd9g$\xa5\xe9\u19cf\xbf9M@\xfaщ\x9a\xeeΓ\xb4\xf6%JI1\x8a\xbdu\xd2\\Q\x178+NMϳ4\xb9cW\x81\xd0\x11\x06\"\x7f\x7f\xc3\xcb\xc4\xe1\xff\x1ac\x1c\xb2\xb4\x1a\xe3U$\x8b\xb1\xeb\x90\xcc;\x99m.SU\xac\x9dq\x95lwa\x1e\xddl\b\xc5\xd6nS%\u05f7_l)Cy\x16\xc36>\xa6^DB\xa9bPN\xc96\xc9\xcb\xfcj\xe31JX\xb4\x91#\x82\xc2\xf6\xd6\xd1sh\x1a\xac\f\xebD5\x82!\x1cx\xb4ԕ¬\x02\xfad\xf5#\x92wG\xf2\xbeD\xce\xf2\xf4\tQ\xcc\xf2\xd4y\xf3\x84h\xb8\x06ʘP\x85|\x9eT\xde\xe6XKU\xeb\xf7Sf!<ek\x83\x1c\x03KJV\x9fY\x1d\x8e\x0e\x80\xc6$\xcb\xc2;R\xb3\xd5Vj\x16\x90\x98\xf8\x86\x9d>1\x8f01yȇ\xb1n\xc4@\x00jq\xae\xa9\xd6l5\xa34\xdd\xf2\xd8C-ʊ\x8eU\x9c\x89T&\xce\x053\xffQ#\xf6}>\r\xccu\xf9Y)\x00d\xc2\x1a\xc4O\x9b\xf2\x0e\xe2\"\xbb\xd2EJLy\x9d7$.'\xbc$6Lx\a1\x98\xf0\"n\x829Nt\x16N\x16M\x17F\xf6֑\x8d\x8d\xc4\xc7M\\U\x99|\xe2\xfa]e\xf2Y\r\x16i\x9c7$;\xca!\xa7%\xc3x\x1d\xa5|v\bg\x8ab\nAbm\n\xa1DK'@8\xd2\xedg\xf3\x04\x10DZ~}\"\x8f \x05(A\xd6(\xcby\x01\x8e8\xfa|\xa4\xaa.\x92\x1b\x11\xa2H\xd0'\fǊ<F\xf1Z\x8f\xaa\v\xfb\xc2\xcdѥ\x9f_\xaacv\xfb\xec\xde`\xb66\x00\x93\x9d\xc0\x19\x88\x19\xe1>\xb55\x9b\xa3c\x91\x1c\xd9W\xa1\xddo\xd4\xf6L\xd2\xe3uwE\xef\x9cd]&b\x15\xf3&\xd8\xf8L\x9a\x0f\x19;\x9a.\xd0K\x17I\x9c\xf3^\xcb\x1c\xe9\x91\xd1%it\x97?E\xf6 >\xdc\x15j\t\xa1\xcf\x15\xa7\xd1.O\xd2gh\xa0\"\x83\xc3u\xed\xb9\"\xf8\x0f\xd1K\x1bz\x17\xd4S\x97\x93]\x90\xed\"\xb0f\xbc\x0e\xa3l[\x98G(\xd0t\aF\xe5Iy9\xc0\x1c8(/sX8\x93\xdcE\x19\xad\xf9<\x8f\xb0\xd0\x14\x04\xbbY\tͽM,,k|\x98tЦ\xb6\x9c\xc5\xdc%7\xe2>$\xf1K\x05X\x8cK\x92l\xb2\r\x15֜\xa3\x87J`\xffa\x9b.;\xe4ӢmT\x00x?Mv5eCE\x91F]]h\xf9\x19\xf7<\x00v\xc4\xefދs\x13\x91\n\xc9j\x9a[;@,H\xceA\xf0\xca\x15s\x9a\xd8\x0eO\xa9\xac\xa2%Tݧ\x05qrz$\xe6P\xb5\au\x8b߈6M\xc90\ue388\x93\xec5\xa9xѫȔ\xf2\xf1\xfdp\xb1J\xfct簰\x88M\xef\x87q\x96\xa7\xb6\xb5\xac6^I\x19|\x1c\xe7r\x8ea\xb5Y\x8e䴖Ʌ\xbd\x8dQ\xeb\fU\x8c\x14GWd0\xafcc\x10\xab{+\a\xd7\x05\x00\xe2\xbb^\a\xaa\xbb\x8aW\x0f\x0f\x98\x80ze\x06DKe&\xb1\xa5\x8e\xc2\u07ba\xaa\x8eB\x9c\x9c`\xb0:\xaaWP\x13\x94V\xd0ȩ\x04\xeewk:\a\x8ehMb\xa3\b\xabS \x9dj\r\xd5j%\x9b\xbf\x15\xb5\x92\xc4J\xad$qE\xd5\x02\xf1\x18je5+\xac}\xa2\xab\x05}5\xd8\a8\xc5}\xea鱦\xfbSm\xb3\xe0T\xed\xbcA\xcbd\xb9>\xb2!K%\x1d\x84\xff;\xed\xffݓ\x87?\xcf\xfd\x9fnӭ\xbf\xee\xff\xbd\xf0\xfe\xdf=y(\xf7\xff؇\xcd\xf3\xffY\x87\xc0\x9e~j\xeeP4\xfaX\xf1\xa4\xc3e\xe00\u0603z\x18\xac\xbf\xdf\xee\xf0\xddq\xf8ު\xfdvw\xe0ʧ'\xdeGu\xec\xa9@\xcbI@\xe1W%\xee\xe1\x81k\x81a\xfc9\xdcDl\x12ȝ\xa1\xe0\x90\xa7\x12-\xfeM\xdf\xeb6\xac\xf4\xd5W\xe4O\xd3\xff\xffw\x96\xc4/\xd3\xff\a\r\xc3\xfd\xffu\xf7\xb5\xff\x7f\xe1\xfe\x9fֈr\x00\xe0_r\x04`\xe6n\xe0\x04\x82\x98\xfe\xca\xe6qq\xb8a_\xc9.\xb7#\xff;\xe3\xf7uY\x01\x9f\xbd\x9f\x0f\xfa\x9d\xb0\xbe\xa6\x17n6c\x92\xdf'\xeb\x8c\x1e\r\x1f\x91\xbbp\xf5\xb8 \xdb0\xa6/\x8e\xd4>\v\x13\xb9L\xd1;\xfe\xf8T&\xb9\xe9\xf6\xd6*g\x1d\xf3\x1b\x96\xb9w\xd2\x04\b\xbb^ؑZ=>\fN\x1eW\x99x\x12\xa5f\xd8\x1e\xe8GY\xb8\xd9$_\xae\xe2Oq\xf2%\xe6\x1e\x9fF \xfb\xa1\x1e\x95\xb1\x98\xf1y\xf2ɺ\xa6w\xcb\x00\xb5L>\xb1\x85Q\x8d\xfdxkݨ\x19'\xa9}#\xc4\xee\xa8\xd9'\xb7\xe1~\x93\v]\x7fpk\xa6\x02\x10\xda\xdcD[\xe6$)\xe5\xb3\x10\xdb]b\xcf\xde{\x18ćuX\x80\x17$\xa7\x0f,\xc5ym\x97\x92\xdb\xe8\x01l\xeb\xd2Pm\xbd\t\x19\a\xd9*ܑ\xcb\xe5xTKbd\x8d<O\xd2m\xc8l#\xcc\xd4\xdf\xcd\xd8?\x87jh\xc9\xf5\x9c\xfa\u0379\xfb\xfb\x94ݚ\xd7\xcd&a\x9c\x1c\xe2\xa1i\xe7\xb98\xd0,\x04^\xa8\xaa\x12\xfb֩Ԥi\xa6B\xef\xe2\xc2n\xdb\xea}koT\xa4\xe5~\t\t\x93Wp!\xa9\xfc\xc9\a`H\xd6\xc5#>\xcbԨ\xb8\x0e\xf0\t\x97\x01\n\xc5}V\xae\xfe\xaaT\x94\xf9\xf0ly\xf9\xf0\xdfX\xa9\xbe+\x02T@uށۻ\xd9K\x19˱\xba\xc1\xab0\xd5\x15^h!_\xdd\xf7\u008c,H\x9cE\xf4\xd4\nk\xfal\xc3\xe3@\x15\x1d\x93\xf4\x8e<\xa39|7#5S*\xefڕ\xbc\xf3@\xeb\xf1\x1cI>\xdf$!=iD\xaf\xd7\xe7?\xd5NX\"\x87\xb1\xc0Eq\x05Jh\xfc\xef\x8b\xe9d\x99\xf0\x1b(\xe4\x18\xfb\xae\xdc\xeaBݝd-\xea7e>O\x93mmM {\x9f\x18ا\xdb(\x1flw\xf9\xe3\xd3J\x01\xbc\xff\x989\x7f3\x00\xf89Wz\x8a\xa2\xec\xf2\x92$#\xf3\xf3\x9e\x1f\xf8\xedC\x05\xcc8\xe9\xcd~\x90\xb1\xd1ht\x0e\xa5i\x1e~\x11\xfbd\"Y,\xeb\xe2\"M\xb1\xe1@\xe7\x1f\xacB?\xa7'\xe6\xf5!\xba}\x8e\xc2\f\xd7O\xf2a\xd7\x11\xef\xd6\x18\x1fݩ\xba\x8d\x92\x89ec\xbf၈\xf2\xbeCt\x01{\xc5\xd8Yp\xb0!\x8d֡n\xfc(L\xe8\a\xf2V\xb0\xb2\xf9\x0f\xca\xe3{\xf2X\xd8\xf4\f]\x15c(\xc2\xf8\x96<\v+u`\x10n\xd1E\x81\xa4\xb2Q*\x8a;Jm\xe9\xc0E\xc1\xd3Q\xe0\xcf\xc19\t\xe3\xa1\"=bk\xafj\x82\x1a\x0f\x19\xc1[\xe4ŀ\x00\x82\nP\xb6\xdf\xd1*M\xd6Z\x9e+\x06E\x13\x9bU\xa7\x05\x94)ѠVd\x1bf\xca\xd3\x06y\xa3\x14\x8b\x8e>\xd0\xf1\x1a\xdbk^\x8f\xa6\x1c^\xff\x17\v\xb7\x7f\xe9\xfa\xbf\xde\xf2<e\xfd\xdfh\xd6\xfd\xd7\xf5\xff\x9f`\xfd_Ԉ\xd2\x10P\x86P#c\x97\xae\\\x87\"\xe8*#\xce$\xc9ϓt\xb6\xbf\xd9D\xab\xab\x8c\x14\xa6\xc8\xde\xe3j\xa3\x1e\xfb\x9cD\x9b҅M!%\xf1$\xda\xcc\t]\xc3Ƙ:!_\x94i,߽\x82\x93g\xe1@\xad,+\x04\xf7<\xfc\"\xa6l\x8cQy\x1b\x89O\xe9p.\xc0i~q\x87\x96|픋\xb8\x81\x16\xdcR\x14\xc5.\xd30\xcen\x93t;\x86\x8b\x0eK\x8aQJ\vNe\xc1r\x80\xf7{\xb4\x7f\xf6uK\xdf6\xfe\x17\xb4\xfff\xbd\xa1\x9e\xffn\xfa\xaf\xef\x7f\xfc\xb9\xda\x7fY#\xb0IP\x04U\xdb\x01\x7f\x96\x97\xe3t7\x9b.\xab\xc9\x1f\\\xfer6\xbdӜ.\x00ڭN\xb3\xeev\xbcv\xbd\xd1\xf6u\xb4\x87\xd1n\xbd\xd5\xf0[\x9d\xa0]\a\xd0^R\xbc\xc7-q^\u0e7e\a0Z\xa4A\xbdӨ{\x9eW\x0f\x00*\xf9\xd2\xdf\xf3\x17\x06\b\xf5*\x02\xf2\x10\bX\x1d\nD\x99\xf6\xf8\x91\xdbN\xcaD7\x1b\x01\xa0\xfer\x1f\xe5$c\x87\xb5\v\x84\xdfh\x04\xa2\x03\xe2a\xd8\x1b\x98\x85\x9d8ty&\xe6\xce5\xa9\xe1wJ\x87u\"c\xa2\xab\xa7\x11]L\x17\xb1\x04M\x90\x90j\xab)Rx\xcbw\xdb\xe5M\xf1\xbd0N\xe2h\xc5\ue457\xaf=\x17\xe8\x8eg\x82\xcd\xc3/l\xb5\v\x80^\xdb\x02\x1c\xc6\x00\x16\x14\xb1\n{\xa2Z{\x82f'\xa8\xd7\xdb\xf5v\xb3^ޤ\xdf'\xbb\xfc\x1eg\xddmЗ\xc5J@N\xd2m\x14\xb37\xa9\vL\xd3\xf3\xbdv\x91,^\x86\xe7IJ\x8da%\xc6\xf54\xc0\xdf\x17@[\x9e/\x8a\x91\xa7S\x8c\x1e\xb3\x94dl0\x93ό\xb2yl\xa6\\\x93U{ØN\x9c\xbf'Q\xcc6\xfe\xf8\xb7J^\x90\xbcv\xcb\xe3,h\x82t\x01H\xc8f+\xe8\x97af\xa6Kٽ\r\tS \x9d\xe7\xd5l\xb8,[\x87\xeb\xb7\xfcv#\xf0\xdb:Ce\xed\xf2\xbd\x8e\xdf\tZ^'\xc0\x8c\xd8\\Y6\xdbf\xbb\xd3i\xf8͎\x87\xe1\x93h3\x0ew\u074c=IP\x96\x85\xdfn\xb6\x02\r\xc9NL)X\xaf\xdei\xb9ME*}\x82\xa2\xecO\xda~\xa3\xe97\x03\r\xb2\x90K\x93\xb2\x8e4\x82v\xab\xde)k\n\xb7\xf2\x95\t\v@O\xc7i3n\xf1\x93\b\xe5\xf1_1\xe3)\xd5\xd6h4\x9b\xbe\xdf\xf0J\x88\xb0\xa3\r\xe3LZ\xd2p\xff\xd5n\xb4\xdbA\xbd\xad1膷2\x99-\xb7\xd5i\a\x1d\xb7L\xac\xcd\xe8V&\xcco4;\xadF\xbb\x01\x92\xbf\xdf\xe4\x11\xbb\xf6\xa1TvYO&I,\x1e\xb7\xc0\xed\xba\x11t\x1a\xbe\x0fZ[\x95\xb5\xa9\xec\r\xda-\xb7㷂V#\x80|\xcbd7\"\x9f\xc9fB\xbe\xa0\x84\xb8\b\xf5\x0f\x92&\xc0\x84\x00*\xa9\xdb\xf1\x1b\xf5B\tv\xd3\x14\xe8mZ~\xa3\xddi6\xfc\x96\x87\xd8Lv\xa9R/-\xbf\xddju\xeaA\xc7/#\xa3\x1dG\xfa\x99\xb0\x9b]\xe9\x8c\x16\xa8\xa8\x10='\xf4\x19\x16\xb1\x894&J\x97\x1c\xb4Z-\xaf\xccꜰk\xcf\xe6\xe1\x17\xfez\v\x18\xe3\x9a\x00Ti\xe9*\xab\xba\xdfi5\x9bm\xb7\xd1.;Nv\rI\xf76'i/\xd9$q\xa9\xc6z'0a\xe8\x15\x19E5\x05\x03\x88|\xbf\xff\x0e\xa8\xd5\xf7\xdcV\xcbkcHt\xfb\xa8\x8eCl\xf8\xf75X\xf5@\xd7\xe94]7\xf0Z\xad\x16|S\x04\xd7K\xbf\xee\x05\xcd\x06h\x12\xa5\xdd+f\xe2\xcb\x15F\xd99v:u\xaf\xe15\x9bͦ\xa7\xb3i\xe6\xb22\xaeF\xa7]\xf7\x83\xa6\xeb\xbau=>\x98ݠ\xe5\xd6\xdb\xedr\xb0,g\x18\xcah\xd9\xf0\xcbr\xa2\xa9\x8db>͢\x93\x81\xb2\x10^\x8d%\xff\x1b\xfe\x0el\xdc\xff\xf4/X\xffy^C\xf5\xffh6\x82\xd7\xfb?\xfe|\xeb?Z#\xf0\xf2\x8f\x87\x1c\\\xfd\x1d\xe1&\xc2\x16\x92\xf2\x12\x11\xb1\xec\xe3\xd3c\xf9\xd0V\xd9\xe3\x01\xe3\xc7)\xee\v\x11\x85\xcf\xce\x14_\xdfrA\x84\xd7V2\xf8\xe8\xf5\x15\xf7\x1b\xb9\x0f\xe3;\xb2.\xbb\xdaZ\xe6\n\xd3\xff['\xf3\xc4O\xe4\xec\xcc֩8kC\xb8\xb7{\x8ag\x832PI\xf7)\\\xdf\xf0w\xf6\xa8\xcdHu\xb8\x10\t0\x12\xbd\x82(<0\xa0\xbc\xd2\vC\x86>M1\x83\x87\x1dI\xa3-\x89\xf3p#&\xc7\xc5d\xb9\xd8\n*v\x80h\xea.\x88\x9c\xbc\xf0`n璺\x14P\xbe\xfdYZ\xbe\xe0y\f\xa4\xc5ң\x91\x06\x1e\x9dv \b\xe9\x1f\x8b㤣\x85\xd2\xdc\xd1\xc5\xdc1\xd9CE\"BA\xad>*F\xfc\xfa" +
+	"\x9d\xacd|\x12P4\xb5w\xec\xfb\x14V\xc7S\xd4\xee\xc0\x0e\xa2\xa8\xc4O)\x7f\xc0%\x96\xb1\x99\xf3\xee\x9d\xdc\xf3\xd3Q\xc38\xca#\xb6\xfa/\x96\n\xb5\x93\xd7)\xc8K\x8e\xff\xf4 \xfd\xbfb\xfco4\x9b\xae6\xfe\xfb\xaf\xf7\x7f\xfe\xf9\xc6\x7fZ#\xf0\xf8\xcfC\x9e0\xbc\x03\xd7\x7ff\f\xd4\\\xe1\v/\x98\x9b(\xe7\x17*\x98\xae\xd3g\xb7\x0fV\xbc\x13M\xbb\xba7J_W}\x10\xe1*\xfeg\x85\xcc\x13\xa7\xf6\xd9\xfe\xa8#\xf3\x1eM\xc9\xd5\xf2\xdc\rj\x0f\x05\xee\xd1\xe0\xd7\xdfK\xe2l\xbf%\xe7\xe1&#\xb5\x1b;`\x14\xe5$E/Mn\"\xe0\xfde{\xdcFpS\xb3V\x95t\xe1\x8czs\xf8\xb5\x1c\xc40'\xd9~K\x1f\xe4\x04\xc9Ji\x18)}\x1f\xe4\xc91\xc4(N\xab\xc9X\xae\rT\x1c\xb7\x98)\xa9 f*\xc5)[D\xdb\xddƖ\xa1\x13\x13Vxd\x1d\xc2r\x94\xa8JlHd\xb5\xe8\xad#\x19\xd9\t\xe3h\x1d\xe6\x84m\x01\xc8gɫ\x15\xc9Ŗ\x8a\xac\x94\xaf\xeb\xf6\x191.\xd3}eM\x03sW\x83J\xc4v,\xdc\xeb\x80\x0e?\x97a\xb6\xd8\xdf\xdeF\x0f\xec`g\x99rq\xc4\x13\xce{\x85\x88%yȑ\x03\xc8(\xbc!\x9bb\x9a\xfb\xcb}\x98\x83\x0f\x92\x12\x9b\xb7g!\xc8zH\x85\xac\x85\xfb*l\xc7\xc0\xdc͌q\xec<\xe6\rl\xe6\xf2q\x81\xe4\x13r\xdbe]\xce|\x1fC5\xa1\b\xe7\xe4\x96[\x97y]|v\xe7\xa4\x1fc\x92\x92E\x9d\xfc\x8e\x92\xe9\xc5\xc1\x95\x05\x88\xfa\xde\x12\xad\xb5!\v\xceX\xb9\x14\xec>^\x859\x99%l\xbc(Ni\x89G\xc8\x14\x1d\x8b^z\x1c>\xf6\x92\xdd#Ho\x94} \xe9M\x98G[\xd1,\xd0\x13\xd3e\xf3\x82=H\r4;1O\xbd\xf5\x00\xb6\x98\xa5Bذ\x88H\xf1\x87Ǡb?\xab\xf6\xe7v=2\x1f\xc7\xf8\xbeqT\xcf\xff\xbcf\xe0\xb64\xff\x9f\xe0\xf5\xfc\xe7\x9fb\xfe'k\x04\x9e\xf6\xf1\x90\xefi\xf6\xf9փD\xda<\xf5g\xe3qU}s\xff\x90\xbf\xae\xba\xd5\x7f\x10\xff\xbc\xb9-{\xfb\x89\xdcE1\xdfSe.\xb9 \x94\xef\xd2\xc8`\x16\x115\xd4\xd7nDb \xc1\xb8\xe7~\xf0\x00\x94\xbe\xff~\xc8cW;\x00Uu\xcd-\xbf@\x01?E\xb4˙M@\x15o8~$(5k\xf2\x01vFȧ\xf7QL\x0f>\xd1\x7ft\xc0\xe2S\xb4\xe3\x17\xe7X\x0fO\xd1$\x1e{4\x8a\xbfw\xc4\xc5\xd5䕞\x16lՕ\xf6\xe5a\xb18\xa5\xb7\xb4\xb0\xb33\xfa\xa5\x14e6\xf2p\xf5\x89\x99甋-0\x82Z\xac\x1ej\xf2\x85O\xaa\x91\xb7\xea\x95\x12\x98A\x0e\xc4'\x8e\xf8e;rUů\x177~g\xe9`q\x17\xdcG\x14w\x81e\xe2yY\x89\xf6a=\xceŠ\xf2b;|M\x92\x96\x82}n/\xa9\x02\xd5\xfd\x1cF\x1b:\x8d\xb7\x15\xd6 \x86\x9a5\x15\xd6 ~baa\x06Ca\xb1\x19{\xbc\xd6\xfa\x91A\xbcƽ\x88\x98ڣ\xeeP\xf7\xc2\xe43=H\xe3\xf6]\xe8\x18s\xa8s\x01N2\x15P\x1a'[\x17\xa3ތ\xf7\xa4\xb1\xec;OtbÓ䆇\xc9\xf4\x02\x95X(\x10\xe6Z\xfa\xab\x8a\xa1\xaf\xa8\xe2T\xe1\xe8<<\r\xb0\x1e\xe1\xe1Ę\xce\xcci\xafY\xf47|c\x95\xfe\x06\x1d:\x13\xfd7\xa7\xe3jtQ(\x02\xe0z\r\x88(\nR\xf2\xabT\x95\xbb\t\xe9\\\x9b\x92V\xf7 M\fi\x92Z\x874\xe6\x15#\xd9\\\x85Ķ\xb5\x05\xb1t\xe1\xa0\x01\\O\x92\xd6\xf0!\x8d\xaeBK\x91\xf2\xa1\xab\xd2\xd6\xfb\xac\x03[\x15\x9d\xcaqG\xb9*\x05\x14\x87\xbc\x98\xdb0U\x89\xa8B\a\x0f/1\x80h\x90J\xa5\x11\xa1l\x1dA\xf5\xaf,\"\nr/\x89\xf30\x8a\xb3\xdan\xe5\x14\x81F\xe4,L\t\xbbɪ\xe8\x01\x14\xc0(\xccr9\x98Y\x12\xc3'-\x1c\x94'\x9f\n;\x13\x16\xa9\xbb\xa1\x1c*6\xcd\xcd\xe4\x10\x83\xe26\xf2\x14\xf8v\x1b\x1ew\xf6\n\\\x04\x02\v\x94\x9d\x93Z\xe5\xd1\nY'R\xf2\xcf}\x94\x92\xf7䑬\x85M.;u\xe2$\xe66?\xda\xf9\xf3\xbd9|\xfa\x87n\x95\xc8\n \xfe5\x9e\xfa\xc1\x91ZO\xfc\xa80\xe3i\x1f\x96\x19\x99n%\x85\x86\x83ab\xac\xecm\x12\xf6\b\x0e\xec7%\x8dM1q\xb5\x13\x94\x90U\x18\x83=\xe2i\xa6W)\xce\xd2\xc3\njqkSM\x9a\x06\x94\xdb\xe4P\xa2\f\xe6\x97\xe7%J\x0e.\xf2hfë\x02V\x9c\xe0\x140F\xad\x95S\xff\x03p~=\x9d\xf9\xb4\xa7\x80\xc8\xfb\xee\xf6U 4\x17\xe6\xe7\n\xf6x\x88eb\xe2\xe2\x81;\xd8(\xf8\xe9,<\xab\x113'Yq\xe0\xd9HI\xb3\x94\xa7\xa0ʮ\xcf\xde\xf7\"\x9fT\xc1ŷ\x8f+\x98\xf0TNpݖ\\\xae\xf3\xdb\xef*\x17\x1f\x00<\x03\xc5\"O^\x14\xf1\xc4d\x88#\xdeO䂋\xb2cy\x05+8\a\\\xf1\u0095\x00\xa3\x93\xbf\xc0Pg\x12\xac/\xad\xa8\v\x99ȟrH\xdd\xd2\r\x97\f|#\x1d߮`]o\xcaɚ\xf6\xb4\xa6$\x9cP\xaf\x83$\xcdk;\xe7\x8du\x7fZ\x88\x112M\xdd;\x90\xcf0\xa8+-W=(U\x964\xc9\xfb&\xd2\x1aqʙ\xbb\xf1\x86k\xc9 \xa79k\xa7\\\x97\x19߀\x91\f\x17$\x97w}\xc8ȒC3\x1a,a\xb6\xd7$\x80\xe4\x1a\xe2[\xe4)\t\xb7Q|g\x9eR=9vM^u\xf42\xb1RU\xa5\x1589j.hʸA\xedU\x197OF\x9f\x1c\xbb&\xaf\"\xfaa6\x9c\xaa7=\xc3\xfd\x15ӥ\x94l+\xeeT\x90xVO\xcb\xe6\xc5}/N\x9d\x05!\xb1\x98\xa4\xf0'\x1b\x80\x8b\xcd\xef\x87n\xb5L\xd9J\xbf,\x82'\xae\xf9\xb1\xb0\xa4X\xf8\xbfQRia\xa0\x9b<\xe2\xf1;<h`\x98\x84\xe0^\x16c\xc2\xcfIt\x8c,:\xf7\x8ar\xc2\xdc\xd0\xf9\xac\x96;[\xd3^\xec3['\xbfA\xcf\xe1UJ\x99\xc6\x1b$d\x82\xaf\x0f6\xb2\x1e\xb6x`<\x9f\xdc\xcf\xc3/\xb5O\x0e7)d\xe1-\xe9.z\xc3!K\xe1[1q:\x17>I\x96k\xfb\xaa#9l[\xc1x\xf62\xe8\x84<\xe4\xec\xc8\x01\xd8&R\xb7c\xb5bbi5\xec+\xbde\xcf(8|U\xae\xdf\xe4\xaa)D\fY\x80\xdd\xe0m\xa0\xd7v>\xa1\xe09U\xed\xbb\xc2\xfa\xb7\xa6v\x1e.\xacb\xeb\xcd&\x9aW\x87\x1f#\x9b\xdf4\xf4]Ew\xc5J\x00\x18\xa3@g\xc06p\x91Z\xad\x97<\x8b\xbejM\xaa\xfb*\xd62z\xe1\xea\x9e8o~\xf3\x9a\xc1\xef\xea\xf6\xf0\x9aTuOO\xb4@\xaf\xc9\x13\xbb'\xccpK\xf2\x95\xde\xef`\x8c\xcd^\x8dQ\xab{B\r\x88\x9bh{F˒ȱ`M\x83\x1c\xd5/\xa52\u008a\xa6\xa7\\\xf6m\x8c\x1fE\t\x9a\\U\x94v\x93\xbb\x057'ېN&\xd3#\x18\xae\xe2<\xdaԊ\xda\v\xef\xbb\x0e\xfcj\xf6#\xec\xfc:\x03\xcf\bXS\xd2\xed\xa6wp\xfbײ\r\xa0\x14\x03\xd5(-\x06.o\x13f\xe2т\xca\x14w\xf3\xc1\xf4\\\x1d\xa0\f\x829\xaaJ\xd2J\xc2v\x89X\x90V\xa25g\x91\x82\xad\x16\x93/3\xfea|\xed\xc7(\xe8\x90\x12\xdf:;)\x12\xf6KωJ\xbaR\x15\x02\xb1'\xd53$bߠ\xaa\xd4?3\x02a\xc3\xf86!b \xf9\x97\xe8\x98\x0f,?6\xaag\x8d4\x19\x1cbX\v\xcfx\xd88\\\xddG\xf4If~D2aʢ\xbfY\x04\"\x98VtHc\x01\fP\x8e7\x19O]L\x880\xc6ˎ\x0e5\xd1\f\x0eGE\x0e38\xa7yb\xce\xe0\xa4^f\xf0l\x7f+@G<\xeb\r\x05\x9c8\t\xb3_v\xf3\xb2\xd2\xe1M/\x8c\xa6s\xdd(\xd9g\xdc\xea9`\xa3W\x15~\x1f\xdfҙ5ѷ5\xc1|\xe0\xe9\x8fd\x80\x18\xa2X\xfa\xc6\xcdD\xda\xf8s\xe5\x168-.v\xa9e\x91]C\x8fz\xacn\xd6Į\x1b\xf6\xc8|\xed)\x1c&m\x9a\xf1\xb6mG\x8c\xdeW\xee'c\xac\xd4\x1dk)\xd3TVe\xbdI\x89\xe6\x03\x9e\xd2_}bϔ\x879\x19\xc4y\xfax\xca\xf6\x1d\x9c2@i1B\x80\xb9I\x14DӖ)FDp\xcb\xf4M\x19]\x85<\xe5M\x0fe\x83\x153\xf0\xa6)\x87\x10u\x8c4a\v\xdb\xe7a\xa8\xbc\x13\xb6\x12J\x9f\x89\x12]\xf9\x01`\xb2;\x0e\xb7\xcf\xeey\x7f}P\x9e\x11\x86Qt\x05.\x96R`2\xa8\xac\xe10\xcb8|\x94\xf7\xc6\xd3\xf9\xe4\x11\v8\xccO\xdb.g\x851\x96\xd3R[Y\x0e\x8b.\x82^\xb9{\xb3!벓/\xecy\xca@P\xe9\xee\xa0`\xb5\xba\xac\x036\xb2\xea\xc1\n\xad\xa1\xeeH.\x1c\x1dײf+\xadVc\xd91\xbb\x85\x9d>'\xbbM\xb8\"\xb4M2Gֵ\xf0m\x88\xf0Zې\xa3\x82O&\x89R\r\x8f\xadh\xac\xab\r\tS\xcaX\x95\xae]\xb2\xab\"\xaf\x92\xdd#O\xaf\xe8\xbfn\xb4\x97\x844\x1e\x12g\xfb\x94\xf4\x92]Tp\xe9\x85[\x8e\xe4\xceo\xbf+\xc1\x06\xd1%\xbc\xaa\x90!\n\x17\x88\x19Õ\xe3\xbcyZ\xfcBee_\xcc\xfa;官\x92\xc0\u07be)*\x9d\x19\x11eE\xcf\x01\x87A\f\x91\x9d\x81\r1)f@\u008ae\x03\xd2\xf6;\xdcR\xaf\x93(\xe7ۼ\xd5X\xfb\xb4\xca.\x95\xee\x06\x83\xdeA\x9f\x8b\t\xaea\xbcJɖm\xa0\x1b\xe9kRM\x17=IQF\xca@V*oU\\\xc7\xc1a\xf6$\x953\x99\x83R\xd9>\x9a.\xd4T\u05cf\xec\xc8\x18\xf6`\x1d?ؑ\t\xd4S:2)\x18\xf44\xb5\xc3\xf0(\xceH*\xba4\xfe\xfc\x9b\xd5#\x1c)\\\x134d\x82\x8a\x98\x81\xa8C\x9c<\t8r.娈S\xb2M>\x13\xd1O\xbe\xb0\xff\xf7g\xefG\xc4q\xe8\xfc\x9f\xef\xa9\xe7\xff=\xcfk\xbc\xfa\x7f\xff\x19\xfc\xbf?{\xb6' \xbe\xe3\x93\x0fU\xef:\xa8\x87\xd6\xedN\xa1\xe8>\xaf\x03~\x86\x834\x15\xa7\xb15\xcfF\xfd2\xa9\xe3.\xd7ǗJ\x1d⡧MDHq8Z\xdf:\xb40\xcbK\xe6\xa3\x18_\x19ow\x11\xa8%\xfb\xdc~~Q\xc8\x13o#P\xa8vi\xf8[\xe7\t\x91\xd9\"\xe0\xaf\xfbQ\xf9`\x8f\xf0\x99r\x95\xfb\xeeK\x11\x95\x9e\x0f_U\xc6ebf]&5\xdb\xc5\xe9_\xd5{\xeb\xd5\xfb\xb6\x0e\x95\xbd鞩o\xbe+\xddt\xfd\xd3!\xa1\xf2\xfe\xa3\xef\xed\xf1V(\xaep}\xa3T\xe6\xfdY\x90\xe8\x17\x0f\xe7>F\xf8\xae\xf5S\xe7\"y\xfa\xab\x04(?vg:\x8c2\xbeI\xa0\xde)u\xa8H˫ң\xb8\xf4F\xd8\xe7\xcfo2\x85D\xf1\xeaK\x14\xebW\xf1\x7f\xaf(\x98\xa5,\x8a\xd1\xd6\xd83\x05k\xf7\x8e\x97B\xb0\x97\x91\xf1\xd1a\xc0Hoò1SZ\xcd\xf60\xc1\xd7\xef\xe0\xda\xf3\xd3\xff\x9a\xbfblޑ폊\xe3\xd0\xfd\xdf~]{\xff\xcbo\xbd\xde\xff\xf0\xd2\xf3\xbf\x1dٖs?\xf6\x81\xe6k\xfc6\xa5MR\xdaȖ\xdc\"\xc1\xcfn_\xb2\x8e\x84\xfb\x13\xf1\xb0\xdf\xe1UF\x99\xe8%\xbf*o;\xc2+\xfb\xa9\x1f\x1f\x8b\x80\x9d\x84\xcf\x15\xcb\x0f\x98\xad\xe0\xf7\xec\x19\v\xee\xce\xe5k\x8ac\xb2M\xd2\xc7Z\t\xe2\"\xff\x17\xdf3S\x94\xf6\xc3v\xf32\xed߭\xd7\xfd@\x7f\xff\xf5\xb5\xfd\xbft\xfb\x7f\u0602[\xffه\xba\xa0;\xbc\x84㷹\xe5\xe5[\xc9l\xb9E\xff#n?Vng\xeb݇i\xbf\xec\x03\xe4\x9cM\x06S\xe7\x95\x1d\xb5A\xca\x00ɖl\xa9\x8dN\xe5\xe2\xa1%\x13\xff.\xae\xbf\xd8=*^t\xd0\xf3]9\xddJ'\x84+\xee\xa0q\xeat\xf7y\u009eue;^\xe2I\x978\x8f\xf2GSwGӚ\x91\x9cϬ\xb8\x8b݊\a\x81ǽv\xbc\x1b\xe3 \xba3UN\xc5\xf8\xd4\xeaT\xae\x84\x17ҚǄ\x1f\xdc%}\xde\xeb\x81\xfc\xc7`\xc3M\x9f\xca\xcbd\xb4\xc5\xe4\xce\x1b\xb6\xb3) VA{9o+\x1ez\xa8}\x0e7p\xa2\xf7Ly\xf4\xfa\x0f,S\xd6\xcaw\x94\x04\"8,\x8bVP&By8?\xa4\x15\x97\x12\x0f\x8b0\xb1?+_\xb30\xbf\xaf\xe5Q|\x9b8or\xf1\"\xff['\xd3\x1e\xf5߱c_YQ\x03-\xd1Մ\x83\xc6Z\xb8z\x1a\xbc,\xd0\xc1h\xfb\x99\xe8c\xcfC\xe7i\x18g\x9b0'\xb5\xd8yC[:5Ŋ\xf4\xd0Ot\xa9\n\xe0˾D\xf9\xea~\x99\xf0j\x8f\xdee\xd3\xc1lw\xe5\x13]P\x16\xfb\xbe\xabO\x06\x18\xdd*\xb1S\xf7\xd9=\xf3\x9322\x1a\xfc\xac0\xa7h\x1c\xb1\xec\xd1,ɜd\xb5M\xb2\n7Ecߧ\xe5o\xe5\x8e\x19\xa8\x8e\xf2ٮ\xda6\xbbS\x9f21%X$(\xa7\xce\xf3\xeb\xa2\x06\x98s\x10\xca.\xac\xec\xfdd\xc1Z\x124\x0f\xbf\x1c[\x05\xd2㡴\x89}f;\xeb\xb6s\xee\xca\x06HI\xb8#\xf9\x8a\xc6p#|\x11\xed\xba<\xea\xd8=\x03͒\x8c\x8ad\x17\x843\xe7\x80U\xb2\xd9o\x15\x87\xe42i\xe1g\xb2\x86ru\xc8v\x9f\xe5\xc7't\x1f3썲\x8d]\xd0\xe9r\xb7\xc6\xf6\x16D\xea\xd8|\x19_x\xa3\xf2\xc4Y̷\xe3ʚZ\x95\x04\tΎ\xa8\xa3)\t\xd7b\xaf\xafV\xa2\xf8\xf0\x19\xa5\x84my)\xe9*\u008bQ\xb9\by\xce=\x03\xcf{\x9e\xf5I\x8f\xc0\xf2\x1f\x95CaU\x0f\xaf\xc89\xee\xa6\x02\xf3y\x85\x82\xcc\x1b.>\xfdY6ym\xaa\xf5\x15\x1d\xc3Ǉu2uU\xc50t\x04\xb5\xe0\xe0\r\x01\xf4\xec\xbf>\x17*i\xb69\x91<\x18\xce\u05c8\xceߜ\x9f\xff\xdf\xff\xefa\xbbq>\x934\x8b\x92\xf8o\xff\xf5\xb3\xfb\xae\xfe_?\x17#\xfa\xdf\xfe\xeb\xe7\xab\xe5\xf9\xffi\xff\xd7\xcf\xff\xdf\xff\xef\xbf\xe2\x9f\xffdO\xadj\xf6\xe3\x8f\xe3\x118\xc1TQI4k4\x9b)\x9b\xe4Q\x02\x18i\x9c\x1a\rP\x8dڔT\xccW\xd1,q\x04\x06\xa0\xaf\xdf\xfb\rT\tcUZ\x8a\x145\\\x17\xca\xcfƧ\xc9j\x18gEU]\x86\xe9\x1d\x81\x17 \x17\xab\xfd\xa2\xcdK\x96\xa2ߐ\x01\xc5e\xb7\xa5z\r\x8b\r\xa6\xd9\xdf~\xa7\xff\x14\"q\x89\b\xb10\xd0\f\xe4\xfeye{\xb3?\xce9.\xc6\xedSg$\x86\x94'=\xcc\x19\xde\xd1\xe9 \x92).\xf55<\x81\xe9\x16q-\xc3;\x17\xbf<\xe9A\x9a\xa7]\xa9\a#:\xfe\x91\xd02\\\xacmJ\xaay\xf8\x7f\xd6â\xd80\\\xe8\x02X\xf4\x0f=\xcc|\x8cM\x9a\xb6\xd9\xf2\xf0\xdd\x11m\x16\xc8SZ-\x94II5u\x19\xf1\xf5_\xf6\xf2\xa6\\@\x1c\xf6\xa9\x95\xdeD\xabO:\xf6\xeb\xeb=\xcc\xffc\xffX\x85\xcc~l\x1c\x87\xec\xff\x8d@}\xff\xb3\xee\xfb\xaf\xef?\xbc\x94\xfd\x8fՈ\xd2\xf2'\xbf\xf9\xedvY\xb9\xdb\xf7\xd6\xc9\xf9\xb0\x8dzm\xbe\xdc,|,\x8a\xae\n\xcde\x87&1\xe8Hs\xe1$A\x81l\xe3\xd1p\xb2mB\xbe\xd4\xe8\x92ȸ8\x16\xfb\xbaڡ\xb8\xd76\x0f\xff\xc8\xc3\xees\x98\xbeh\xfb\xf7Z~Co\xff\xaf\xfe_/\xd5\xfeY\x8d\x00\xed_|K\xe3~L\xf2\xbf\xde\xe7\xf9\xaep\xd0Jj\xe2\x15\xdc\xf7䑟\xfc>)^?L\xc2\xdc4\x9f(\x9f\x1bL\xc2\\\\!S;\x91w\xbc\xa9d\xcbm2\x92\xcc\xcfR(W\x97(\xd6\x10\x01\xed\xae\xd7\xf4\x9c@\x1eʨN\xb4\xb8HNM\xb9{\x02\x10</\x14fz/\x98\xf3Sr\x99\x11+\xc0\x9c\x15\xfa^c,\x16o\xac\x1f\xfb\x10\x96\x17l\xc7\xeb\r;#Bu\xfeN|\x16WjWjw\x18\xe7(I\x81\x8fI6\xbd\x0e\xe3#\xb5:\x8c\x91N\x95\x834\xc3\x18\xe9SR\xf9M{\xa2\xaa\x98\x9e\xf8\xe7\x84\x0f!X\x1e\xef\x8e>\x146\x0ew\xf6\x8c1\xe21\x19S\x80\xe3\xf0q\xf0\xb0\v\xe358\xabBX\x80\xd9\xf6\xc6\xd8\xe9\x8b&\xd4\xf8<" +
+	"\x0ew\x8a\xdc\xf5\xfa=y\xac}*\xb2|\x82\xe9\xb46\x00\"\xa8\f2{\b\xf0\xd6\t\xe9\x8d\v\xa9\"\x85\x16\v\x04\x19\x8bH\"Y\xe57\xc0\xb5V\xc2\x18\xfadCrbπ\xb9G\x90#6\x8f\vUw\xde\xf8J\xc80V\x01\xc3\x18\x90\xc7\xe1N!\x97:\x9e\x10q7\x9d\x82X\xc0\x9a\xc0n\x03\xca\xee!\xe4\xad#\x95(\x9fΉ⻪Ƶ(J\x87\xb7/\xd5\xc9L\x90mUQҏ\xa9\x8d\x85\xac\xa2-\x15j\x17\x97t\xc1\xe5\xa7\x12\xe3\x81\xc5\x1b=\xb2\xfb\xa3ǘ\x03\xfb\xff\xf4aCu\xfc\xaf7\x82\xd7\xf1\xffe\xc6\x7fZ#\xcaџ\x7f\x1d\xbb\xf1\x9fG[Rܵ\x9d\xde\xc93\x1f\xa8\xeewӻ\x8cm\xf7\xa0Pv\xd5 n\x8f\xac\xa6\xf3]\xcb}\x16\xdeIʉ\xf3\x06,\x11(\x1f\x1dZ1/Ŀun\xc5\xc56h\x95p\x02\x04|\b\xd3ڎ\x8b}\xeb\x1c\x97\b\xbe\xc4a\xe7\x8c\xe8\xf1И8\xec\xed\xe4\x05ɋ\x17\xad\x938\x8f\xe2=\x99\xc6ܰ\xc3\xfe\xcb\xc6oڳ\xb0\x1bW\xf9\xfcI\xbcrl\xca=\xd5\xe7;\t\xd0Ԁ\xa8\x8a4\x91%E\xc0\x13\xa3(\xd6q\x97d\xb3û\r(3Q\\\xe4z\xf0\x10\xe5\xb6\x1c\xbb\xe0Qld\xa2\x95#\xff\x15\x88\xbc\x9c\a0w\xe0>\xb95\xf9\x8b\b\x9dKi\\\x00\x9f\x13\x1c\xe1\x1b!\xb8\xe5uϵ\x93\xd2\xc0\xadc\xccW3\x15d\x94ٚ\xf2\xad\xc3\x17$\x17\x91&\xec\x9f2\xe6\x13\x1d\xfc!ʢ\xbc\xbb\xd9\xd4dMf\xa4\x13\x1b\xf20l\x94$\x9f\xf6;m\x10\x0e\xcd\t5\xd5L\xf3vt\xc1\x95=\x8bk\x96Fq.\xdc\\\xb2\x9a!\xddkNc\xc5l\xa2O\xe8/e+\xb6 \xda:$\xc8\xdfM\xab\xd8\r\xfd\x16\x00<\xbb'1\tzN_\bD\f\xe3\\$\x85\xed\x13\x1b\x84\xb1\xf0\x03\tQ\xe6avV\x16\x8d\x91?\xf0\xcbt\xd0+J\xcd\xe2\x02_\x11h\x91U;\x92]D\xa7K\xa1W\x9d\x8a\x04\xedm\x9a\xd9\x1f\xa3\x1a~g\xeaQ\xcc<*\xb3\x88B={\xbb~\xf6\xc7)\x88\x8b\xab\x1d+@Fih\xf1\f \xd2%%Y\x1b\xf3\xc1t\xe9\xd3\xf1J\x012J]\xd09_\x8c\x88\x84\x15\x97\xe7\x1a\x04\x17\xb4\x03I\x13\x12kG\x8b(\xa2\xd5e}\xe7\x81\xd7&\xfe\xfb\xcc\x12\x80\xe0%\xbb\x81\x8a\xa6\xd9\xe6?gL=\x02\x8fK\xe8!\x8d\x7f\xcbTM\xe9\"\xbf\x97,\x9a\xfb\xcf\xe5\\\xe3\xadc\x95i`\xcevt̺\xad\xdd·\x86\xdf:!\xb5X#\xbb\xb8\xadV߆\xd1\xe6\bvˈ\xb9\xb7\x8d\x84\xbb0\xcdȔ]\xd0\\+<\xff\x8c\xd9g\xaf\xa0\xd5\xc2\xf4n\xbfE\x0e\x85\xf6Q\x9a2\xa8\x17\xd7Î:RzF\x82\xe6\x81hbt\x02\x1fr\xf8\x96\x96\xf8\x9d:\x87\xe7\xd6)6\x19\xbd y\x8ev\x97\xe5Q6\xe9\x1e&\x8b\xf3+|\x9f\xe2\xa9\xe3\xeb7\f\x84\xdf<\x1e\x7fô\xe2ДS\x9bz\xe9s9f<b\xb5\xec\xf8\xfa\xa5-\xcbfa\x1c\xadl+\x14\x0f\xbe\fX;\x81_J\x857OU\x9f0Y\xfe\xb6a\xf1{\x8c\xd1\xff\x82\x9e\xff\xb9\xb3\xa4o\x9b\xcb|\x8f\x89շM\x14\xc55\x12|\x9dBM(\xbc\x1aJ\x1fLK\xa9\xd0\xc56\\\xc1\xa2;\xf8\x81\x81\x8f\xe4\xb8\xff9u4\x9b\x1fO\xc6\xd3G\xb6\xaa\xf5\xa3u\x15\xfa\xd3\xeb߿\xed\xdf\xed6\xff\xe9e\xed\xbf\xf5F\xd0T\xce\x7f\xd5[\xad\xc6\xeb\xfb\x8f/e\xff݂\xc7\x1eه\xe1\x1dE\xb6k\x01v\xc3\xf4\t\xab\xe1\xd1\xee[\xc0pp\xc2k\xe0\xdf\xc4\xc7\xc7\xc8F\xf6#\xe6\xd5\xfa\xa9\xf8s\xf8\xd4.\xbf>T<P\xfd\x99\xa47N\xba\x8f\x89\xe2e\xc79\xf0ԏ\x87\xd5nu^\xd99\x9f\xb3\x95\x03\xf6\xb26\xa4\xcf\xf6\xba\xf3\xb9Xx \xfe\xc3٭\x16\xb7\x89\x9f\x9f\x9el\x15\xc6\xf8=\x84\xa7r\xdfb\xf6o\xc8\f\x95\xb6yVj\xf8D>\xe1ŏJ\xf4\"\xb1\x8c\xb2l*X{B\xf2fG.\x19\xab\x05l\xe2\xa7ĹX\x85\xc7\xe2\xf9\x06\xe8*\x8cY\xd5\x05\x1a\xa0\x9a\xac\xdd\x14W̚\xb8O\xd9\r\xd9\xec\x95\xec\x13\xa7\x96\xb2\x1a\xff\xd6ɢ?\x88\x8e\xa4\xc7\xcd\x16\xfcpϩ8]\x96\xc9 a\xeb\x14\xdbȼ͉\xe7\xces\x8a4\xdces*\x1e\xce\x14\x91\x8b\x99\xd1/њ_\xf5\xf9%Z\xf3$ȃ*_\x81f\xbe\xa50\x16\xbc\xae=Q\xb71\xaa[\xactD_#\x15\x8f\xfa\x1bt\xf1\xccbg\xa9qx\x89\xf0,\xcb\xc4bg\xad[\x18\x97\xf1$\xa7\xcfR\x19o\xed\x90\xfb\x1b\xf4/\x1a\xfb\xd3\xd3\"\xcf\r\xa0J\xceV\x9d\xab\xf2J\xe0Sg\x96\x92UDϥ\xd0j\xb4K\xc9\nף\xaa:v\xca\xdf\xc5\xd0\x1eև\xe9\xf8\x8a\\\x0e\xc8\xf3\xb7\xf2\x9f\xf5w\x97\xfc5\xcc\xf2\x97\x9c\xff5\xdc\xff?{\x7f\xbaܸ\x91,\x8e\xa3\xfe<O\x81\xf0\x97\x1f\xa8\xd0ts'\xf5\xd3\x7fn\x84ZK7\xc7Z8\xa2d{\x8e\xff\x1d\x0e\x88(R\x18\x81\x00\r\x80Z&\xc2\xefu\x9f\xe2>Ӎ\xac5k\x03\xc1v\xf7\xc8\xe7\x1cu\xccXDeV֞\x95\x95\x95\x95\xd9\x19\x9a\xf1\xbf۽^\xffM\xfe{\x1d\xf9\x8f\xcd\b%\x02F%\x12\x01\x97\xf9{\xca\x7f\x9b\xbe\xfc\a\x97\xa1\xc2-\b\xdcĞ\xdf\x15\xf0Ā\xd2x7\xcd\xcbCpN\na\xbb <bZ\xd1_\xea~Vf\x87H\x94p\xf9&\xf3\xb9Pػ\x9cZ\x14\xf2\xbc..\xe1\xe9\x9fX\xfdGe\x99,\xb3Y\xb5\x92W\xc8\xe7\xf7\xa0\x02e5\xba\xc9\x1f\xa6y\x89k{\x93\x8b\xca\xd3\r\xeb0\xb8\x96\xe8\xa8ڒhM\xbd\x11\x8e\xb7\xe2\b\xa7\xacV\x95\xa89Sd}\x88\xe2\xe0\xea\xee_*~+s\xc8\x12A(\xabT\xb4\x86zQ\xd2\uabfeT\x85y&\x7fm%\x82\xaf\xaa\x12!&\xf3T\xeb\xe1\x0fQ\f\xbd\xb3s\x85 Sm\x85\x18BM\x85\x18\x825\xe4\x1f\xa2\x18\x8fw\xe3\nՏ\xa7D\xa8\xa9\x90=\x92\xbcBe2?Od\x8d\xa8\xaeƘw4\xed4\x8bq\xda\x0f\x89\xfc\xe6\x93Ѱ\x90\x90%3\xfau\x95\x17\x18\xfe\xda\v\f\xbb?\x93,*^\xf0\x18\xff\xcc\x17\xf4\xd5\xdah\xc5\xd5Z\xaf\xef?\x8d\xf5\xaeH\xd5\xd4\x15\xe1xk\x8bp\xec\xfa\x82\xe7\x1fm\xc5\x03O\"\x1aOJ\xe8\xfbC@:\f\xae\r0\xaa\xae\xa0TS[\x85⭬B\xb1\xa7F\x11e\xf3{\\\xd9\x06,\xe9<\xba#i\xb07\x89\t~m\xa8H\xd5\xd4\x16\xe1x\xab\x8bp\xac\xfa\x1eGi\xaa\xad\xf6\x8d`\xee\xe7\xd4_\x05\xae7\xd8SHN{\x9a\xa6ɺL\xb4\x86]\x1bYT[D1\xfe\x96(\f_;\x14\x865C\xc0\xa7\xe3q\x1amJ\xb9mA\x8ac\x82\xb0\xbaS\x17\xd6\x18\xfa!\x8f_\xf8\xf4\xc1u\x16D\xebj\xadp\xfc\xf5V8v\xff\xdfG\xd9IR\xb0;\x13\x99\x827`\x1ar\\\x1f\x86\"\x7f\xc2\t\x90\x9f\xd3\x11\x1cE_\xa5\x82dM;$\x86\xb7\x15\x12\xc3\xee\xfd|\xb5\xaa\xef}\xc0\b\xd8\xe2l\xd8\xf7\x92dM\x9d\x11\x8e\xb7\xd6\b\xc7\xee{\xee\x83H\xbc\xd3M\xa3\xf2^[\xa7ꡒ^3\xfa\xb6\xb4\xaeZ\xe8\xd51B\xc0\x85~,\U0008dd11\xe7\x93Sd\xb5\n\xa3\xc8[K\xe4Xu]\xa1\xb0\xa0m\xe6\xdbS\x8e\x01\xc6\xfb\xe0\x00\x00\xfa\xea\xf3/\x9f\xb5\x8c\x86\xa3&a\x1d\xcf?\xc3,\x80L\xfb\xc1<Ћse\xbb]\xc7QE\xc2<\x8dy\xa6\x8c<\xd1_-\xfa_W\x96\xb3$\xadH\x11fyL8\xa6\x82\xba\xf0\xf9of\x04\xb6\xb5\x1d\x96\xe1\xb7\xe8\x94u^&\x15A\xfb=]\x9e\x9cKZ[\xd1iZ).ym\x81'\xd9<_\xadSR1\x8d\x896ֲ\xa0ڱFX5c\x8d\xb0\xf0\x82\x95\x96\xaeH\x12\xed*\aZ):\xcbB\x96C$\x1e\xfe\x8e\xd0\xf0\x06G\xb3\xc1\x7f4\x17Vi\xfdƥ0|mP\x18\xd6\xc2=!\vR\xe8UX\x90Bc8Q\x9a\x06r\xc7\xc0\x15\xe39\xebj&Q\xfcU\x93(vݤ\x1b\x15\xb9\xff穴\x90Ռg\xe9~*>,\xee\x03PMp@\xadP.Y\xbc\xadP(\xdeV *p_\xce\xcd%\xc3_>K\xc8Q\xb14\x9ch`\x90h!\xffI\x9ba֘f\x92\xb2\x02\xcf\xe0\x92\x1d쳤\xc0\xf27Ra\xf8ڨ0\xac]\x8b\x86\x83œhFV\xc9\xdcܝD|\nc\xb1\xca\xcc5\x95S(\xde\xda)\x14k\x1eQ\xa1\xcc\\\x8c\xaa\x11\xbf#4܈\x9f\xcdN\xe4\xf0\x9azJ\fo5%\x86UK\xea\xaaB\xae\xc2\xdc\xe0\xfb\"T\xdb/\x9f\x99l{\x18 \xcey\x03w\xec\xe2\x94r(7c\x8d\x002\xfc\x86\x82\xfc\x8d\xe0`\xf7\x96K\x81l\xef`\xdai\x87e\x80\xe6\xabM\x8b\xcaAs\xd3\x1d\x9a7\xf3jM20>\xb1\xa5KV\x8dC\xea\xedG\xc30\xda\x01\xe8[\xda\xc2P|#\x82P.7+\xfaU\n\xc3\x1b^\xe9\x94\xd4\f˔k\x8aP\x13X\x1cd>L\xc0{a\xd8\xe0\xef!%F\xfdj`|H4\x8e\xb7\xb3y\xbe&\xc1\x1e\xfdCWN^Tl\xf0\xe9\xcf̙ٚʿ\xcc\xd3G\x12\xa3i!6js\x9b\x06\xa7\xe2?2\x17C\x96 \x06\x15\xa8\xeb\xc4\xd4\xcb\xfahթ\n\x8dz\xf2\x0f8\xb26\xe6j\xb28BC30tN\x18\x8b\xcd\x0f\xae>X\xba\x03\x15\nPE٨lW減l~\xb2\x01\xae\x13U\xa4\f.H\xb1$\x17 \xf1\xd0\xe7\x18\x18\x93u\xab\a\xb7\xafU\x80\x0fx\xb8~X\x06{\xfc\xa3\xbe\x1a\xb7YT\x96\xf9<\x89*\x12\xcb\xe1\xc1\x05t\xd5]\xaa\xa6\x9d\xc9\v]\xdcI*)\xf9g1_\xfbӼ\x14\xa9T\xfcWGj4\xbe\xf9\x16\xc6%\x11\xbc\xab$w\xb0-\xefM.u\x8d\xbfǨ\xd0\x19O\xaa\xfd\xe0Yw;\xb5\xc0\xbcDZ\x87\xf1n\xdb`\x99j\x80\x1e!c\xf5\x9ec1^\x93\xf9c\xa0\x96\xb4\xb1\x14)\xc7\xdc\x0327\xd4q\x90\xbf\xbfxI5\x1d&1\xbc=&1,\xad \x80L\x11\xb8i\xb5j%Z\x89PW)\xb7\"K\x14\x8f\x94\x17s\xed\x00\xf7\xb2&Ө\x88V\xa5ֿ\x8e\xa4kR\x82Y J\xd3[P\x7fzV\x18um\xf0\x9c\x9e?\x92l\xcb\x04i\xa2A\xb2\x945\xc0m\x81\x9f2\xae\xeb\xd7\xcc\xf0\xe2\xfd\x8d\x93\b\xbe\xb6I\x04k\xce|\xcc1g\xf8\x987\x91\xd0Y\x9e\x9a\xea\xe4\xf5\x8b\xfecn\xafy\xf6\xf4>6\xfc\x8c\x19}\xaa\x89\xe6Ww\xff\n\xf6X\x143\xf4:?\xae=\xf8s\xb0\xafb\x1c\x8c\xc7_\x87LJ\xb6)ٖ\xda\x1c\xc1}@\x9d,p'O\x16۹\xaf\xb1VA\xf6.I\xa0/[F\xb5\xa6\xb1\x8b\xfaa\x98,|\xc3 e\x81-b\xa3\xe4\x80\xe0\xe7l\xab\xa4\b\xee\x9cc\xcfqI\x15Y\xd3\x1e\x84\xe3m\x13\xc2)\xd7d\xeeh\x97\x10\x1a\x12.\xfc G\x8a|:\x81\x1f\xe0\xea^\xb9*\xa4;\xb2\x00Yw\xdd\x13ʏm\x01\xbf\x01S@\xed\x97Djڏp\xbc\xedG8\xf6\xb8BTWם\x82}\x81HQ\x03\xa4 yp\x8e\x9a XWi\x89⯳D\xb18/\x85\x01\xafo\\\xeddN\fݎ\xbf\xea\x82\xf0\x96\xea+\xb4\xda&(4\x8b\x81L2\x98\x8c\x95R\x8bI+\x1c\xa6#\xc3N^\xa5\xffm\xbcm\xcaD\xdc\xda\vR\xdd籾KzuW\x1aٺ\x06kh\xfe\x06khv\x83\x15\xa3\xd4\x1e' \x9e9)?\x92\x8c\x14 \xbc\x86\v8\v\xd92\xbe\xf0t\x81\a\x1f\x9c\x9ax\xae\b\\zuL\xc2\xdfj\x1d\xcb\xd7h\x1d˚\xab\xf4\x8a\x88h\xf7\xa0\xf8\xd6\xc8Qa\x8a\xaa3uD\xc4_]\r\xc9W[\rɾ\xe9>\xbfK\x91,<T^a\xf0\xb4\x03=3\xbe\x1c\x95}\xef\xeaj\x9e\xd7_m\x89\u0af2D\xb0\xfaV\x9djЃ\x06\x9a\xc8OJ0wJ\xe3\xec\xb4\x02|\x99\xb3\xc5&\x98z\xf6\xa2\x14ˡ\xf3`\x81\x16\xeb\xdcs\xf8uh\xb7\xc1\xd3JLl_+1\xc1On\xae\xee\xfe\xc5<\xad\xf3!\xd0^\x11\xb4\xc4n\xa3\xf0\xc5\xe1\xd0YQX=\xfa>Ǝ\xb0\x89\xd8\xef\xc4Ʒ\x1fl\xb2\x04\xfc\x01\v\x15@+\bUwi\x06`\xe0\xf2\x050\xe0%=)$:\xfb+\\\xe3B\a\xa9×1\xaa\x87\xe6<\x90>rs\b\xeb\xc7/\n~E\xaeb\xfc\n\x1e1Q\xd5؇<\xc9'r\xb1\x0e\x94L\x03u\xb5!F\x1a\x1a\xf5\xdf\x0f\x03\x1a*CK\xa1K\xc2\xf1\xd4,\xe4\xe3\xe4\x98\xf2\xb4\x12\xbck\x9dn\x19ܚ\x18[\x18aj\x1c{xU\x1dx)\xfeu'\x11\xdc뎃\x85\xba\x05\xaf!m\x10\xa6p>\xc1\xac\xd8>ш\x9d\xd9\x7f\x96\x91D\xea\xaa+Q|\x8c\x02\xa1X[\x0fU\x94K\x1dx\xb8\xc6\x12\x18\\{I\xb1N\xe2H\xef\xfc\xfc1\xdb\xc3\x12qƎ [\x90\xbc\x88\xe1N!\x9a?\x84E\x9eW\x9c7\x94<\u07b5\xb6\xadg\x0e`K\xf3\xf8\xce\f\x9c\xb7k4\f\x15\xc6\xf5\xe9\xf1\x8f\xf2FZix\xae\xa3lIjt<.\xce\xdd\xcc\x14\v\b\xbb\x86ثM\x90U\xf1\x8f0B\xf1\x8d0B\xb1\xe4\xd8kRm\n;\x8c\xa5hv\x0e\\[`\x9a\x91\xa9\xbc5\xd5C8\xde\xfa!\x1c{s\x9d\x9d^\x9e\xa0Q\xeaH\x1b\xe5\\\xed\xadW\x88\xb1\x1er~\xe5b\x00\xaa\xee\x9c\a;\x1f}j\x1b\x86De\xf1\x97!Pz YU\x18\xa5\x95\xfc23\xb8\x99\xe9\x8c\x00_\xd6/b Ų\x11p\xcd\a\x95\xd9\xdf\xe3\x18\xc7\xd7\xe3\x18ǚ\x12\f\x98\x17\xaec\xc2\xcca\xb8\x83\xf1\xb7UK`\xd5W,/l~ī\x96i2!L\v^3\xcbL\xc4%V\x89\xfcu\xd5\xcc\xe2m}\x97\xf9\f\xe5hx\xddf\xa7\xab\xf3\xfc\x89\xc3>%\xcb{\xfe\xf3\"\x12\xe7DJ\xa9\xc7\xed\xbb\xfd'/Y`M\x83\x14\x8a\xb7E\n\xc5\xda\x01fyQ\xf1\r\xd5#0\xf1\xb3\x86\xe8\x825\x99#\x96\xcb\xee\xf1\x94\xf6\xe0w\xe4\f\x1fw\x14|ۜ\x115\x94\xe3״Sbx\x9b)1\xecy\x05sʪ\xb5\x1c^Uk\xa8,\x16\xeaY\x8a~Q\x04\xb7S\xcdΑ\x8a^]\xbb\x14\x8e\xbfe\n\xc7n\x1b\r[\xa41\x1c\x9a\xe2Q\x9c\xc1=\xe5\x96]IQ\xac\xa96\xc2\xf1V\x1b\xe1\xd8|\xff\xe6e\x8dD\x87\x9e\xf0\xe6\xff\xb2&G%pb\xe7:\xb3\xa4(t\x03\xeb\x17\xa4t\xa2\xfeF\x99x\xbe\x86\x99x֘\x00\xc2.:A\x8ff\x9f\xd9_{\x9a\xbb\xe5\x86YT\xa1\xbe\xb5\xf5\x1aB\x85a\xe9\a)h\xb7\x99\xc7[\xb3\xe5\x9eL'\xbc\xa5\xf6\r&\xa1\x89g1\xf5[\xd3zx\xabհɼn\xb7\x1b\r\xdfn\xb7\x19\xbeu\x9b\f\xf3\xe7\xfctn\x87l\xec[\xf4\xbf\xf8q~\x83\xb9\xe61[\xa0\xd9KdJZ;\xa9da\xfe\x96\"\x14_K\x11\n\x9eW\x8c-\x80/Q\xc5\x16\xfa\xa2\x95I\x99T\xb9\xe6\xda\x04R\xb0\xed\\\xf8$\xb0\xe4˺\x9f\xa2\xf4!|\x14\xc9HK\xf1\xe62\xe0O\xf3o\x99\xbf\xbf\xdb$i\xfcz\xef\xbf:\xed\xf6\xb0m\xbe\xff\x1a\xf6:o\xef\xbf^\xed\xfd\x17\x9d\x11\xea\x05\x18\xffDo\xc0\xa2\xb2\xfa^K\xa0(\xef)\v)\xa2$3\xa0\xb5Oƒ\xfc\xfd\xa2\xfc^\xf0\x9f\xa34͟\xd8\xfb\r\xaeiR\x06\"\xdc}\xec\xfc\x1e\"\xaa\x86\xcb<*\xe6\xe8\xeaK\xf3\x06\xa4\x8c\xb23\x11 \x82^\x1a_\x1d]\x1f\x7f\x92\x8a\xad\x8fWW3\xf4q}uu\x83>\xa7G7\n\x15N\xc7\xe2\xf7\xf12?\xcd b|\xccO\x10\xb7%9JS\xa6\xfcb)`2\x9b\xa4D\xe5\xf9\x00\x1dt\x13-K\x142\x16l9\x8d\xa4k\x92\x92\xa8$F*\x84\xb9\x8a\xd2t\xb6Y\xa8" +
+	"0e\x874:\x06\xbdͤj\x1c\x92\x92\x15\xbcz\x15\xfd!\xb0f\xeb4\xa9\x00\x8dZ\x95QԔ\x9b\x9fQ<TJytW*\x82\xda\xc5\"kդ\x84n\xf0A?E\xe5ls\x17\v\f\xaaw\x12\x83\x12\xcb\xee\x83g\xe1\xc4\x0e\x80\xc9\x1e\x8eK\xf2\x1a\xfe/\x9f\x17%=\x11\xb1X\xa8\xe2\xb97X\xceA\xaa\xa3B\"|.\rLW\x98\xe1\xa7\xc2=>-Z\xc1\xbfx\x1fz\xba\xcf\xceP\xe2\xee\fK\xab\x1b\xed\x1c\x89\xe8V\xbb\xcf\\\xc8'I\xd1\x04\xf1^t\xf6\x8e\xfdlS*X\xbf\x1b\x1dH\xfb\xfc$)N\xb3\xaax\xb1\x9c\xc7\xc9\xcc9\x1f\x83&\xdd\xefj\xad\x9d\xd7\xdd\xdce\x0eHa]?ϊ\xf9IR\x94\xb58\xdc\xc6-)\xd0\x04\xe3\x97/\x8a\xd9\xf8o\x1a,J\xb8\xea\xfbAIk\xf0\x87\xc82\xa6\xf81\a\xbfS\x12y\xa7Bt\xa7}\x92\xf0ET\xcd\xefio\xe3\x96k\x8a\xb9p\x058\xfe8\xc1\x92\xd6j\x1b\xad\xfd J\x19gC:BF\xf7\x8e\xb2\xf6\xab,}\x11^r\x1dj\x0f誅\xb1\xde\x1dK\xf1>ߤ1e\xac\x10\xe5\xb8R\x01\xc0\xbd\xe5\xc36\xa1r\x05V\x95\xb6\xb7\x1dB\xbe\x1e/sz\r\xac\xb58NЈ͗\xc1^TV\xef\xf4\v8\xcfЬ\xa2\a\x02\xfc\x01\xf8D\x9en*\xea\x8cQ\v쌇\xdc7\x1e\x10\x87\x13\aC\xaa\xe9\x03\xf7\x12#\x10\x86\xf79P{\xf8;8\x93\xecL\x86V\xe6&2<\xdb\xedHd\x99\xe7\xf1\xd5\f\xb6z:\xcdv!\x05NԸ_>\xb1\xfb{\xdfR\xa8\xde2\x9e\x1a$U\x92\x83ɋ0a\xcdb:=4\x81D\x9a\xcfN\x96Y^\x90\x1fI\x16煎2\x16\xf6\x03_\x87Y\xf0\xe2(\x928)j\xe5\xf5\xb5\xf2\xbe\x84\xcd!\xe3#\xf3\n{R\xd2(\xa2v[\xb4\x9b\xb7\x8bMZ%\xebT\xdcuk1\r\xb1\f\xc5\xe1X\xcaa\xf2\x93\xf8F\x97\xf5\x0e\x92\x9ex\x87\x97\xf9\xc7\xdcS\xa4\xa2'\x91<D\x8c\xabP\\k\xedZT\x1f\b)(\xe6s\x03c\xaa\xba\xea0\xb8V\xbb\xf5!lY\xda\xf7\xf4ai~\xb3ȨZ\xea\x87$\xc3u\xfa\x98S\t\x80\xfb*yX\x82\xb1\xa1\x80\x1d\xa5\xa6\x80y\x9cg\x8b4\x99W\x98\xc2\a\x9d\x01\x02I},\xa8\xc8k&\xb1i\x1f۸\x93\xec1J\x93\xf8\xa3/\xc7UuO\n\x13vl%\xfc\xfc\xb3\x99ta&|2\x13\xcē\x99\x95\xf0\x94,]i\x8e\xe2f/\xa5\xab\x1f\x8e\xcfΏ>Ό\xb4\xe9ԕ\xfa\xf3ώ\xd43G\xda\xf9\x89#q\xfa\xb0\x84\xd1J\x96\xf8\xc8@\xca\xca\xee؟\xddɒ\xe1A\xa2\xfc`D|\xb0\x9f\xeb\x80\xea\x11\x861\xc3s\x8d\x1d\xff\xf2Yg\xa4\xacD;\xb3J\xddJ\xe0g7\x85\x9fw q\xba\xba#\xf1\x94\xfai\xcb0\r\x9cި!>B&\xacY\xa3|\xd4~ޑ\x9c\xc3\xc8bR\xf2\xf8$!\xde\x1c\xe1ԩV?\xe7yB\xda\xda\x1ag\xebMc\xf6?S\xff\x87t7\xffa\xfd_\xbbc\xc7\x7f쵻o\xf1__Y\xff\x87f\x84R\x05\xe24\xe6@)\x8b]7\x85\xa6g\x17\x8e\x06G~\xf8\x1b\xb6l\xc8)\x9c?\xf2\a\xa6ͩ\xa2\xa5&]\x1a\a\x05\x99\xc9m\x04b\xbcvm@\xd9r\xa1|(k*\x1a!\xdf\x0e\x86\xcf\xfc\x1aH\xd32Lʏ9;\x8b\xa6I\xe6\xb1\"\x9e\xa6\x9bҋ\xc3\x05X\xc7\xc5+\x16]+O7J\xc8.\xdd(3y\f\x13\x8b&C{Ux\xaatU\xec^\xa3\xab\xc2S!\xe4\x96]뻐\x1dRu\xf7\x98\xa2\x9b!.W)\x87+T'j\xdd\x03\xa0\x1d\xb8\xffjA\xb5\x12\t\xdc֝\x16\xf6\x11\xa2Q\xe8~\xdcy7\xd1\xd2\"\xc2Q\x1c='!\xbbt\x9d\xccd\xf6\xdd6\xfeO\xd7t\x94};'\x80\xdb\xe2\xff\x0eG&\xff\xef\xf4Fo\xf1\xff^\x8f\xff\x8b\x19a\xf0}\x9a\xe2\xb8\xd6aq\xf4\xd8!r\x1d>\xff*\xbc\xcd\xe7\xda\xc5\xfd~\xf0\xc2!<h\xa6\xccX\x9d\x13`\xaa\x1c\x86\x82\xc9A\x94\x97\xc00neQ\xb6R\x85\x8f\x83\x01\xbeT\xa4T\x10\xcd!4\xdc\xc8D\x05iT=\xfc~\xf9\x98\xda\x16=\a\xea%.%wB\xb2|\xa5\x8a\xe2\r\xe2\xfa\"\x1a\xf6X]\xa1\xab\x00%\xbd\xaeV\xf5p\xc1\x12\xf75ݼ\feba\x0e\xfb:\xe6d\x15-=u\x98d\xb2\x06==\xfa\a\xa6\x9ad\x98&{\xc8b\x18\xaf{,\xd7)\xf0\"z \xa1a\x02\x8c\x87\x16\xe00^\xe1\x1d+\xc2\x02\xf2\xa6\x86\xcf2Ҭ\x8dR\xe4+6\xae0\x98\xa5t\xa4\xea\xc2;O*RDi\x98&J\vZ\xe9f\xc2\xfb\xc1\xbfI\x91S\xbd\x96M\xc3ٟ\n\x9a\xb1\xaa\xd2N\xb3\xc1\xbc\x8bJ\x14\xb7WG\xe0A-\x9ey\xdc\t\aB\xf6\x90\xe5OY\xa8C.7+w\x9d\xaeI\x94\xba!\xb3\xfbdQ\x85Ͼ\x89^:\x9a?K\x96\xee5Ț\x15(\xe7>(\xfe\x88\xb9n\x87}\r\xac\xcd5m\xe2\xdc\xe4|Y\xb9\xeb\x7f\x93\xb3 \xc1\x1e\xe0$\xf3\x80D\xe0\x0f<\xc57\xfa\x1cgx\x9cUY\x1c@\xf4\x18\xf5\xf2\xabu\x12\xeb\f>B\x81r\xba\xc9\xe3l\xa4Z?\xa8\xd8\xdf\xce\x00\x1e\xa7\xcfѼ\xb2\xe4M\xa0\x19\xb6\xe8\x1f\x974\n\xddE\x1f\xf6\xf0\x80\xc3_\xe9(\xbe\xcc\xdf\xc7\xf9\xfc\xdb\xee1[\xf6\xff~\u05cc\xff\xd0\x01\xa7\xc0o\xfb\xff\xab\xed\xffq>W[?\xfd\xa8\xb3\xfd\x88\xf3\xf9{\xfeꬁه4\xf3`^a\xcc˔\xa34\x15oE\r\xcf \xa7\xcf\x11\xac\x01\xf7\v%\xdd2\x02\xeb\xe0\x8f\x81\n\xdc\xc51\xab\xe3i\x1a\xbd\xb0\xbb9Ж\xea\xbeb\xcc\x1b\xbbC\x1e\x17Vŧ\xcd\xe8\xbb\x1ai\xf7A=.q\x1c\x96r\x05pX\xeaB\xcc\xe7\xb5.Ê\x94\x15Sо{\xf7NV\x80\xba\x92\xe38[\xbd\xc5H/\x15\xd8\xd6\xd0y5\x01}\xcb[\xc9}pp\xc7 \x02\xe1\xaaH\x94\x97\xads\xf2HR~\xd8\xe1\xd5E\xd5\xfa\x9d\xa9\r'iJ\x96Q:-\xc8\"yFjfu\xb8-\b\xb8l\x88\n\x12\xa3M\x10_\r\xf17x\xea\xd9\x1e{\xc0\x05Ք\x17Q\x89ye\"\xdeF\xb2g\x91\x1cO\x91\x80m\x9f=\xa8\xacyo\xc8\xc7\x16?5\xd4J\xc8\xd7Ui{\x8c\xf7\u070e]敜\x84\x86\xa5\xaa\xe1\xc1\xe8vr\xa2\xeeX\xc0\xe0V\x8f]l\xde3\xf9\x06\xd3q\x8bdk\xc2\xcf\xf8}4N\x83\x9a\x1a:c8\xf2\x83\x7f\x95\x8dy)T\xb2%\xc0#ց\x85*\xfd\xbe\xa1\xeeX~\x8c\n\f\x859E\xbf\xe1\x87s\xcex\xe2-K\xcd\xf4<OS2\xaf(\xbd\x92\x85\xa8R\x05\xb4\xbc\xf8\xb4Za\x85+\xe7G\xa6\xb5\f\x17\xb8\xae~d\xf9H\x9c3\x9f\xb0\n\xb6\x90g\x8f\x02\xb8\xb3,?vJ_@\xcd^Va\xa1֟ax\xa1\x1d㍜\xfcS\x7fB\x15\xba\xe6\xb0i\xe0#\tQ\xad\t((\xf68\x97~\xc7R\x1c\x98\x05]\x01\x1a*K\xb2q?\xdd\\\x9cc{\x03㸅0/\xa2\xe2!\x06\xb9\xb6\x116u%\xda\b\x93?˕n\xa7l\x8c\xd9K\x96\x83\xbf>\x9d\x9e\xe0[\xfc\teAJR<\x92\xa3\xd9M`\\\xa3\xd7g\xe7\xc2(\xed\a\xcd\xf5\x93f\x84\xf1\x94\x17\xb1\xb6\x06\x8d\x18|9m\xaf?\xff\x9a\xf2[\xf99\xcfc2Փ\x9e \xfa\x04\x8dW\x81\xde\x06\xec\xb0Cp\x87;\x0e.P\xbf\xec\xc56]\xc7\at\t\xd8S'\xed\xcaҮ\x96\xba\x8az\xbb}\xfa\xfa\xfft\xf9\xedU\xe4\xff^w\xd87\xf5\x7f\xa3\xc1\x9b\xfc\xff\xaa\xf2\xbf\x98\x11X\x05\xc8\x13\x94\x17}t\xa6\xbd\x83o\xf5\x94\x8fJݶ\x99\x15\xb6\xc0\xa5\xaf\xccY.\xa9S\xa3f[\xe7_\xba\xf11+\xaf\\\x8a\xc7\xc7\xd2(\x90\xd6\x16\x1ckf\x0f\x94=\xc1\x8f\x13\xb2\xf8]\xe5\x81\x14\xad¿|\x86?n\x1b\x1e,I#\xae\xaaZ\xc7\t\xb6(/\x0f[v:o\xea\xed\xf5yx\x17\x95\xe4\xf6\xfa\xdc\xda_h\xcd>\x91\bb\xad:j\xa6\n\xe38fo\"\x00/mrby\xfb\xaa\xa24\x99\x1b\xc6\xce,Q՝b\xe2\xfe\x01SC~\xdeѺJ\xb5BUN\xef\x06I\xea\x84,\x9cVx\x8a\x04\xb5\xaa\x8fŋN\x9eQ\xb9M\x9dTdŇ\xb2\xac\xe0\xe3\x10\\G\xceɇ4\xca\x1e>\x90E^\x10^E\x9c\\=\x11\x92\x19\xafD\x99\xe3S\xb3\xefX*\xa2fzQ\xd3\x10(\xddP;\xed\x88z\xc9\xf3\xeafu\x87\xde\x03\x18sS\x9d\t\x8ahYD\xeb\xfb\xda\x11\x97X\xa8\xde\"{I\xe4\xa5\xd3On\xd9\xe30\xd0V\x98\xe5Ķ~\x99\x89ܳ\x97\x15\xcb\xe9\x97kC3\xd4\x1a\xadyI\n\x11t\\\x93\xab`q\x88f\xa4Q\x92\x19\xb3\x92\xa6\x193\x89\v\xa7\xa2\xbd|\xbe\xa3\xe3,O\x99\x9c\xb0\xaa\xde\ajM ]\x01LH\x98x\xfcqD\xf6\x10\xa8\x85j\xa0}\xd0\x16+\x9b\xff\x9a<ƒ\x8eM1\x8d%\xff$D5\xd4!\xac\t\\\x9e\x8eiɶ\xc8+\x90\xa4(\xbd\r\xf1\x1eu\x85\x16C\xdbƙ\x9c8\xfa\xc5Ǝe?\xb9\xbb\xc8\xce!\xdc\xf8o\xab+\x95\x80M$&\xc9\xc2\xfcP{\f\x1b\xfb7)\xf0+\xcb\x7f,\xf4\xde7,c\x8b\xfc\xd7\xee\x8e,\xf9\xaf\xfd\x16\xff\xf7\x15\xe5?6#P\x14`\xfe\xbdM\xb7\xcb}f\xc5$\x8c\xcbj\xab7\xe4L\xf7\xc9\xf5\xbb\x11\x1f?\xdf\x14s\xe6\xe6Z\xc6NDA?A\x1f\xf7\xb6z\xbf\xca\xfa\x17\xbe\xd7^m\xfd\x0f\xdaf\xfc\xc7N\xbf\xfd\xb6\xfe_o\xfd\x8b\x19\xa18\x80J\xa9\xe1\x01\x90\x04\xbaY'[\xe0g\x90\xb0\x15P\x9cw\x13IQD\xdd\x0e\xe7\xfa\xa3\xd9}\xae\x02\xe5\x02\xa7?\xa3xl\xeb\xbe\x02ؕ*\x93\xde\x19Nӗ\xa5\x7f\xf9\xef\xbc\xfe\xd7T&\xff\x86el\xb3\xff\xed\r\a\xf6\xfe\xfff\xff\xf5z\xeb\x7f\xcd\xef\a\xc4\xea\x17\xdfu\xb7\xc0\xb6@`<\xeb\xa7f\x92\xf2V\xb7\xd7\x15\xa0\x13zi\x18UI\x9e\xe9(\x9d\xa1\xfe䭤/\x94\x8c[a\xf4J\x8d\xeb\xf3Y\x9dY\x986\x9c\xa3\xa30\x8a\x92\xa8 \x14\xba\xce_\xb8dt\xf3\x12\xed\x05\xdf\x02]Ԣ\a\xea-\xfe\x8a\x14][\xc2=\xa7\xee/O\xbb\xf3\\$EY\x19&\xacP\rj\x15\xfa\xacX\x0fdr\x99\xbb\n\\\xb8\v\xf5_\x83j\x8f(A\xac\xd2bQ\xe0ڂ\xff\x9b@+\xccQ \xd0\xfe\x1a\x85-\x02t7\x8b\x8b\xe2\xe6G\x0fɚ9\x00\xbc&\xf4ul\x92gb̆}\x89\xb5\xde\x14I\xbe)}s\xec\x86\xc6M\x93\xaf2\xbf{\xfb\x87\xf9?;\x88\xbf\x1e\xff\xef\xd8\xfc\xbf\xdb\xed\xbd\xf1\xff\xd7\xe3\xff\xe2\xd2Wn\x00\"a\xb7\x1d\xe0{=\x14$\x89/ѵ\x80\xe1\x92\xf9\xf7z[\x1cu\xb1@_D\n\xa7߂\x91\x80c\xbd;y\x13ʂ\x02d\x95\xaejcY[\xc1\x82\xb6&̙\xddή\xa7T\x962K\xfe\xcdM+\x84i\xd1gj-\x18\xba}\a\xd0r\xcf\xfeP\xb9\xfa\xe9\xf8+\xd1ro\xa1\xd7\xd1\xd3\x19=\xf1[[';\x94\x83Ջz\xe1\xce 7\xd1\x1d\xefs\xb5As\x9bɒ\xcc\xd6ќ\xa0\xad\xf6\x8d\xed\xfe\x99\xf8\x7f9\x8f\xb2\xec\x15\xf9\x7f\xaf\xdf1\xfd\x7fu:\xc3\xfe\x1b\xff\x7f5\xfe\xcfg\x84\xe2\xff2\xa1\x11\xbbמR\xd9~5\x0e\x83\x8b\xd2z\rU\xf7\x96\x8a&~\x8a\xb28\x15\xe2\xf6\xda\"\xba\x1f\xac$\xd1\x16\xce'\x82)\x9ej\xceW$\xa8\x15\x1c\xc5\xf1vzv\xb6kR\x12t\xb5\x8b \xe7\xf4*\x10]\xb9 \xd8\xec)Z\x87\t\x00\xf7\x83\x7fq\xa3\x1d\a\x81\xb2ԑ\xb4\xabGL./\xf0\xf5\xb2V\xbdU\xfeH\x84\xeb\x8c\xd2YQ\xb3\xb3]\b\xa1w\xafP\xae\xe1\x19\x1d͆\xc9!\xc8ϣ\xcc<t)g\xe3tzɀ\xae\x90\xf18\xdfd\xfc\x05\x9e\xf71\xba\xf2\bN\xf3\xb7\x82L\xbfn\x97\xe9kB\x1e\xe0z\x16\xdf?I\xe0$K*\x1e\xd6E\xed\x9b\xecԂ\x14\xce\xda,\xc4G\x18\x9b mx\x98/\x16%\x1bC\xd7Lґ\x17\b\x9b)ە\xbb\x9bbi٦\xdaTJջ!\xf2\x85\x87'\x98B\xde\xd0X\xd9\xf08\x12Ϋa\xc6o\xd9\xf6\x03U\x89\x8a];C\xeb=\xa5Q_\xa6\xc9\"!\xf6\fR\x88q\xb2L\xaa\x92\x9a80\xba\t\xf3\r\x12\xec1i)N\x96%Y{\xaa\t\xa5\xb0[sh\x92\xfe\x82\xc5כ\x90紜Gk\x12\xfe\xb6\xc9+\x12\x14\x9b\x8c\x187\xf7\x1a\xf2\xf5&#5\r\x00\x14\xf7\xdb'\x93N\xf4\xb4\x1d\xef!Y\xfft\x9fT\xa4\x04y\xc85OK\xea)\xb7\v\x8dm\xebOS\xaa\xfc\xa1\x83SZ\xf8\xc3G\xa8׀\xd0~0\xbf\xef\xd2n\xa2\xb0\xee΅\xf4\xff`!4\xa5\xb7C\xb1^\x7f\xd7\x02\x01~\xc0\x941\xe2iX/\xc1\xd43\xb1\xff\xbdwIb#\xff\x96el\xf3\xff\xda\xedv-\xff\xaf\x83\xee\x9b\xfc\xf7j\xf2_\xc5\x16\xde\x1a\xc7u\x96\x9aܓ\x93\x80\xae\x1fؿ\xbb(\xf5ף\xd9l\xf2\xf1R\x02\xbb=\t\xbcDYF(\xd5\xca2\xc6\xc0˫\x1b\x05\xe9\x1a\x103kO\x95v}}\xf5\x93J\x97Jd#C_R\xfcp}z\xf4\x83L\x1f\xca\xc3\xee\xf1\xd1\xecT%K\xf4\xe3OG\x8aʰ\x87\x92\xafe\xb2l\xc7\xf1\xd5\xf9\x95\xc2\x1e\xa0\xf4\x8b\x8b#\x95\xde\xc5駗\xa8\xdd\np9S\xc9J\xf3y|uy3\xb9\xbcE\x15\x1dH\xc5\xfa\xe9\xb1ꆱJ=;\xba=G\xa4\x86\br\xaa\x9a0\x1c\xa1\xf4ɥ*\xa0/\x01\xa7\xe7\xe7\x93\xe9l2S\xa0\xb1\x02\xe1\xbeS\xc9Wgj&\xc8\xc4\x7f\x9c\xabz\x1e\x88Գ\xa3\xf3\xf3\x9bO\xd7W\xb7\x1f?)B\nz~u\x84\xda \x93\xafT\x03Fm\x99z{\xa9zb\xd4q\x045\xdf*a\xb2\xb7Z\xbe\x1d\x9eA\xc1\x12\xd4\a\x03\xbb1\xc3\x0e\x8b\x93L\xfe\xed\x06\xb0]\xce\x0eB\x0eb\x1b\x95\x8d\x9d\xb9\x8eb\xea\xf4\"̥\x03\x8b\x96\x81A\x83\xccQ\x1c\xeaGÁqN\xbdf\x80)\x96\xa3\xba\xa4b`\xc8\\2\x14C\xbc\xd2\x11\xe9\xf5,5u4|t\xbaJ\xa5a\xe1U\xbd\x1c\xad\xe7\xed\xa3r\xabj\xa3\xe3\xce\xc1\xd78N\xe08O7\xab\xac9\x19\xb8F\x86\x1c.\x92\x8b\xe4\x99\xf9\v\xc1\xbd\xee\x18\x1bpُ1\xec\xd6q2k\x00\xb9(\xd0a\xf3B7\x190l\xad=Q\xfc\xafMYq3Z\xb8l\xf9\x92\xf6\xad\xf9\x91\x98\x95l\x13\x051K\x1c\x9b[v\x9bi:\\\xef\xff\x81\xec\xa2ѵ\xc8\x0f\xe4%\xa4\xffE\v|F*{\x8d\xeb˚\xfa\x9b\xf5\xacO\n;\x8acv\xd9ev\x9e:֔ɿ\xf9\x94Ł\x1bu\x1a\xa7\xcfIY%ْ\xbfV\x94OAyx\x1f3\a;\xc0˂\x03\x1fޤ\xa2\x81I\xc3\x17x\x89ƴ#(:\xa9#\x03P\x13\xfd\xe9\xa9\xed\x19B\t\x17ޢ\xff\xd0\xe0j\x14\x1a\x8c/\xef\x94(\x0ec\x02\xaf\xa0XSm\xa5\xb6\xe9fW楪\x89\x90d\x8d2\xb3\x8d\xe3\xe3\xe9?\xd4\xde&7\x99\x8fWj3\xe9\xaa\xc4\x1b\x94,\xa5\x82\x8f7jC\xea\xcb\x1d\x0fbR\x91\x12\f\x87\xe7\x80-\x92''x\xeb\x97[\xfcD\xed\x99#\x95x~~\xfa\xf1Hm\x9cr\xb3\x9b\\\x1c}T\xf8*uzu\xadh\x8f\xa4\x900A[cO\xa1\xa3z ԛ\xd3볣c\xb5\xb1\x8f\x86\xbb\xc4\xd0E\x1a\x03?\xd2\x0f\xe4\x05\x9e̹0x0X,M\xf6d\x7f\x9c\x7f\xb8\xc65\x1bt4\x80\x12\xef\x06\xb2\xa3\xce\xf1\xe0\xcaF\x9e#\t\xa2\xa7R\xa7GקHv\x94r\xc8\xf9\fI?\xc2\x1d\x04\x8fz\x97\xc4\xca/l+\xb8\xc1B\xf4y\xfe\xa4\xc6_\xd6\xe8\xe2h\xaa\xbaV\x8e\xc5ŭ\x1a\xe5N\x1f\xa5Z" +
+	"ҳ\xac\xee%n\x9â\xe5\xe9~\x0f=\xa1f+$\xe4\xec`F\xe4E\xd0e>e\xeb\x11\xd7\x12uPg\xac\x12\xad\xda\xc8>\x9a\x1e\x1d\xffp\xf4\x11\xcd\x1a\x99kzz=\xb9R\xa39\x10Q\xa8\xa0DĊ)k\x98\x80\xe3\x8b$\xd6\x1fY\bV!CI\xea,\xfa0\xc0\x8e\xbc\xce\xf9^G\xa3\x1b\xf3\xbd\x0e\x19\xe2\v\xaec\x95$+\xc1\xe1\x96\x14\xc8\x1a\xf3\x8f[\xc5\x00:\x03\x94j\xf5\x8c\xe4$\xd7G\x97\xb8_d\x8f]\x1b\x93y\xa8\x01\xd0d\x96\xc5\\\x9f^\xa8\u0087(\xd5*|\xa4\x807\xb7\xd7\n0\x96\x03|\xadO\xf6\x81\xb2\xb28=?=Vsh\xac\xae\x05O/&\xc6IG\x163\xfb\xa4\xa6o\xb7\x8dR\xad\x13\x9c\"\xf7IM\xb1.N\xb5\xb2H\xf6;\xbb\xb9\x9e\\*\xbew\x80\xd2oq\x95U\x86\xdb\x0f\xaa\xc3z(\xd5\xea0\xd5\xfc\x9f&7\xc7\xea02\x96\xb9n&\xe7'j\xb4\xc6\xeaN\xf4\x9fS\x94,b)\xb1\x044\xbd\xb9\xce\xcbs\xb8\xe0P\xe0\x15$&\xd9\xdc\x10S8xR\n\xdfG\xfa\xac\x95\xe0\xab5\x88\ty\xe1\x83\v\xbe\xab3[\xea(\x87\xb3)9\xab~\xc4\a]9\x03\x7f\xc6|\xe1\x00\xa5Z\x83\xd6\xe6\x1d\xf1@^\\\xb2\xd9wo\xff\xfe\xc3\xfa?0\x80}5\xfd_\xa7\xddn\x8fL\xfb\xef\xf6\xa8\xfdf\xff\xf9\x8a\xfa?\x98\x11H\xff\xc7>\xc5E/\xf5\xc3\xf6}\xbd-\x90\xf0\x17Xo!t\x1f\x95\xf7\xefW\xd1\x1a\xfe\xa2d*\x97gQ\xca\xea\xf1\x9e\x8a\xe4\xa5\xf3\xa6\xf9(M\xa2\xb2\xee\x80G\x11Z\x10\xd1\x0e\xc4\x1b\xf0\x8c\x00Z\x1a\x13\xec\xd3\xebp\xf0m\x16\x93\"}a(@\xc3\"_$\xcb$\x83\x12h\x82\tV\xe1%E%\xe8\x17ܱZ5!\x15®\xd6\xf4\xaf\xf0uBS[.\xeaG\xc5R\xd2v\x91\xbd\xbe/=U\x9f\xa7$\xca6k\xf9\xa4\xf2\xa8Xn\xe0\x16Q\xbb\xc3\a˞g\xe5\r\x95\x0e\b\xeac\x9c\xc5w\xablb\xddfOE\xb46n\x97\x8f\x8a\"z\xa9\x1dN@@7\xecþ\t:M\xc9\xcaj*\x03\xd5\f#CpO\x03\x16i\x14\xc2}\xdd\xe4\xe1\x8f\xc1\x9et\x15\xb3\x1f\xdc\x04,(,\xdaQY\x94M\x89\rp\x13\x8f\xbd\x9f\x8f\xcad^\xd7T\x8a\xd0\x12^\xe1\xe8\x17\xfc6\xe1T\x85\xc5\xe1\xf0ۄ\xbb\x95\x92\x1c\xe8\xef\x11\x8e\xe0\xf6\v,\v\v\x94\x1fhYA\xcb\x7f\xa7\x82\xa8\v\x7f\xf0\xce[%Rlϩ\x81\xaf\xee\xeaCք\xa1z\xc5n\xf0\r\xa9\x951\xe6e\xd0\xe0\xd55\x1d\f\xf0\x16\xc4H\b[\"\xfe\xb9\x01s\xcd%\x06\xf1w\x1c\x83\xbb\xfb\x8d\x97\x82ķ\xe3{2\x7f\xa0f\xdenssp\x8f\xa5\xc2@\xe9י\x86\x95v\x02\xe3\xb1\xc7\f\xd05+D^4\x99?(\v\v\x8aw\x18\x90\xec1)\xf2lE\x1aYYp\x1a\xad #O\x94{\xb0`킩\xee\a\xc5}\xc9'\xba\xc1\a\xad\x9c4\xa0\x1dȲF\x9b\xf2\"Y\xf2\xcc\xfbA\xc5\xc3 \xb15D\x9e\xd7QF}!\xecAi\xf1~0\xaf\x9ei\xa4T\x1e3\xc8WfDW$\xb41|\x0e\xf6r\x90\x83\xb3X\xacI\xd0{f\xf8\x19\xba\x9d?ɒ\x8a\xba\xc6\t\xd3\xfb\x92\x96WV\xfb\x81\"\xe5\xc9\xf2cT\xb0\f?F\x05FoPbz_\x8a\xdcb|\xadY\xa65\xce\xc4fC\xa1\xbev*\x9dn\xba\xb3\xcdj\x15\x15/,T\xa0\x18\x82ǨH\xa28\x99\xf3\a\x0e\xf7Qy\x92W,\xe2\xa1\x1d0O\xaf!\xdb\x14\xa0V\xbf|V\xf5J\x99\xf6\xb4е\xce2kA\xaaM\xc1\xb3F\x95\xd4C\x13\xc8y\xcf=\x13\xf1\xb6\xfe\xf2y\xfbp\x94!\xce\x05S\xedW\xb3B\xacD\x1aR\x19\x12\xe1G\xab\xa6\xd3\x05EE\xc0A\xd5A\xa0\xbc\xcf\v\xa8\x11<}\xa1K\xc0n\x9a>\x94\xb5\xd4\xee\x18\x87\xd4f\xf7<J\xb9\x1f\xa5\xe3(M9\x87\x88\x05\xea$n\x05\xe1\xaff@@A\xef>\x82\xe9G/\xa6*{\x83\xc3\xfd\xb0^\xa7/\x80pF\x9f\xc00\xa5*\xcb\xc0&\f\xae\x91^\xba{6\xc37\xb0\x86\xf0&ثh\xe0#\x8b\xebi$\x9f\x83\xbd\x04D\x13\x12K\x1eH\n>\x1f\x7f\xf9\xec.#a\x82i\x12U\x04|\xdeFզ a=k\xad^\xd6\xc1\x9eD6\x19\xd33_#j\xa9\x86\x05)Q\x06G\x17\xcf\xf9\xa0l\x193\x16\x8aZ\xdb\xf6%\t\x80\xd0\x18\x94\xc4._T\xa9fM,IF\x8ad~ZC\xa5 \xe5\xb9N\x877\x9d\xa7\xb2\x0ep\xad\x0f.ꕡk\x1a\x96\xc9\x12uζ\xde\xe4\x06p\xb8\x0e\x91\xc8\xc1+\x10\x84\x85Nӵ\xe2HJ\xe6U\xaew8aU\x9bq\x18+\xee)ʨ\b\xee[\x1d\x9b\x92\xc7\xe7{\xf7\xee\x9d\xea,\xf7\x02ٔ\xe4\xfc\xd3l\x17\xf4K\x11\xfb\x0f1\xc9\xfb\xd2\xe5\xee\x1f\xe7\xea\x84$h\x9e!\x8ac`<'d\x1dVy\xc0\x1eW9\xb9\uf280\xf1\xddm\x06;B\xec,\x82\xdbA\xf2\x1e\x05sH\xbeZ\xa8@\x06;F*]ǿ3\x9c;\xaa\xed.bn\xfc\x98\xbc\xd1\n\xf6\xa29\xf0B\x1bq\xbd)\xef\xa9\xcc\xe1\xad\xf3:_\x87\x8e䌐\xb8<\xe6'\x9d9;\xf3\x90³S\xe0\xfb9\xe48\xb4\xe5\xae\x0f\\\xea\xea\x1c\xdc]-\xc0s\xf2Z\xb0'\xfd\x10%i\xbe\xa9\xa8ضg\xbe\x1e\x11\xa8\xbez\xb9ߜ\x88\\s\xf8\xb1C\x93\x8a|N\xca\xf2\x84\xa4\xd1\v\x89\xc3*_{\xf6ftn\xb4'\xce</bz,\xcdb:蓌\x05\xcc\b\x9f\xd1\x1cr\xcf\x1c!i4\x987\xac\x1c\x1a\xe2\xea\xea\x01\xf2\x95\xcer\xaa6'Zu\x02\x0f\xc3\xca\x1fI\xb1H\xf3'\x8d;\xd01S\xbb\x82\xb3\x02낔$\xa3\x87C-\xafZ\x03\xb5\xd9*\x16Mƙ/\b\xf5\x0e\xe0\x8f>\xcbwǚ\xd11\x9a\xbaԲ\xf68\xcf\x1ey\x98\x1azщ2\xe9\x9b'\xdb_}=2\xa7d*\xb1\xf6w\xcdX\x9a\r\xbb\xf1\xe1\xc74\xba\xdc\xf1\xcb\\3Rw\xc2Ê^\xed\xa0\x03\a\xe8\xab&\xf13}\x87%\x93?\xbb'lR2\x97\xf3\xd56\xa9\x86;1\x0e\xcby\xbe&\xc1\xde\f\xfeP\xf1\x85\xae\x1bz\x89\xb9\x1f(.dH(\xae\xd9u\xf7/*\xe8\xd5r.6x\xb4\x99\b/\bi\xbao/\x9a\x03>\x93\x8f\xe9\xcf\xe0\x97ϢR\xa0լ<k\xf7)J\x1f\xa0F%\\\xa5\xa7b\xb7\x81\x94\xfd\x80\xb3a\x00\xb4j\xb2\x86q\xd08\x0f\x9dǲ\a\xc4\x01\n\xa6:>\xbd&\x95\xf6yO\x8aD\xda\x11\xb8\xba\xabP\x14\x7f\x8c\xf8\x96$d\xfb\x1a\xdaΙ\xc1\x9d?\xc4\xc72\xbaU(x\x91o\x96\xc0\xbaP5PS\xb2\x8a\xa5\aSH\x9c\xad\xc9\xdc\xd9%ҟ1W\xf5\xc5e\x15\xec\xe9\xbaA\xb0\xce)+F\x8b\xfa\x19\x86D\x97\xfc\x9fo\xb2\x18\xb1<\x9ft\xcd'\xb6,\x83ݱ\xe3YM\xa7\xbc\xce\xf5\x90\xbe\xd2\xdb\f\xe13Y\xeb\n\xff^D\xd2\xf86K~ې\x8c\x94\xfcm\x00;ҷ\xdc'\x02\xd5\xcdp\xce\xd8\x0fX\x0f\xc3\x7f\xa9\xb6\xc3\xddP8\xba\xa1I\xea\x14\n\x9e\xf8\xd21\xf8d\x8b\xef\xc1\xbf\xfa\xb6k\x96-\x11JQ\xca\x012\xed\fg\xb3^\xf5\x06d?(\xf3E\xe5\x9b\xd8\xc4uڭ#磱\xd8Nd\x97\x97&\x928T\xfe\xf4[\x16\xc0\xb7\x0fw\x19\x8f\xc1R\x84a\xfbB\xf2\xcc\xfb\v\x1bB%p\x948T>\xe2\xedL%v\x9eT[\xb4\x1c\xf9:\\\x81\xc4P\x908\x99G\x151N\xaaz\xa0\x11\xaf$\x0f\xb7\xad\xae\x13\n\xbd\x86\xf5p\xaf\xf9}\x94QE\xa5\xa9G\xc0t\xa8\x8fF\xd6\x027o`O\x82\xa0\b\x80\xeb\xf2\x93\xec\xa4E\x92E\xa9\xf7\\$)\xb0h(\x8a@#a\x0e\xe2\x9b$\xf3\xa4\xc2b\xa3W\xec\bB\xa9\xbb\xdbAB\x9a\xd3\bPIi\x88&/\xdeq\xda\xe7/\\\x8e\x81Ey\x9a\x9dd\x8c,\x88\x80\xc7\x11\x1c\x0e\xd5\xce\xe1\x9b,%\x0f\xcf\xe3\xae\x03>g\x19\xf3\xc6\xc1\xfb\x13ǜ\xd1\xcfi>\xa5\xa0\xd9\xd6\xed\xf2.\v\xedN}\xf0\xbb+\xef\xc8SDOP\x1c\xd6\xe8\xf8*{\x9fd\x15\x9fiQ\x9a\xe6O\x1f\x99\x8a\x82\xcf[\xbf&$\xcb3\x8e\xea.\xa5\xe5V\x9eL\xf8-\xe3NU\xab\xab\a\x88\x04\xec\xb2\b\xa4_\xe2ѿ\n\xbd3`\xcf\xe8\xf4\xf2n\x05\x8d\xfa\xad^\xb7\x13no\x91c\x9c\xe1\xd5(͍s\xd01\xe1\xa7->&aj\xea\x87\xe6\x18\xeenҕ`1\xee\x8a\xd9C\xef\"3O7\xb1N\x03\x8e\x93%\xa9X\xd4&;K\x99d˔\x98lŅH=+,\xc2\x06\x9b\x8bw\x81WEd\xdfm|\xd1n\x15oV\xeb\xf0\x8br\xfe\xb6\x89Rfl\x89o\x91\xfcu^E\xc5\x03\xf7\xb3\xa4\xe7p\xf1\xbc\x98<;f\x17Vƶ\x820)ϸ>\xd77\x8ee\x9ả\x8b\x10U\xcb\t\xa0w4\xe9\xb54\xf3V\xa4\x17-f\x93\xa7\xea\\tC\xaa\x88HFu\vu%\x04Ku\xd0a6\x82\xac\x06\xfa\xf5\x8a)\x04=\xdd㱃\xd9}I\x96\x11\x04T\xaf\xd5\xd2Q]vhI\x96.\x93\x00S\x87\xcaQ\xf6n6kx\xc8l)P\v\x022\x16\xe1\x1a<\xa5t\xfa\x15\x06\r\x8a-H\x1cx\xb5\xbb\x05\xb5\xb0\xbc\xe1\xe7\x16c\x92{\xaa'\xb7\xc4P\ax\x8a\x80\x13\x1b\x8d\xa4\xe4V/\xc1$eGe\xfd\xd4\xdb\xf2i\xfb=\x17\x8d\x9cOr~\xbc\xdbm#\xd5\xf1{\xaaO\xcf\xecQEn\x8e\n\x1a\"\xcf([{C\x00\xeafv\x88X\xe6\x95\xfd\xd6_\x13\x8b\x93\xc5K\xc3\xfe\xd6\xdab֜\x96\xe6\xd3\x17\xaa8s\x86\xf5\xc2>\x8a\xfd\xccg\xce\x1c\xe4\x9d`\xcf\x19\xc6\x06\x1f\xb3\xa4\xc5\x04\x98\xa8\xc8\x0f\xd7`q\x10\xdd \x12\xaa\fC\xd6\x16\t\xfc\xe1\xe7U\x8c\t'\xc2E\xed\xc13\x8d\xeeHZ\x86w\x10\x83\x89\xe6\xa7>\xd7=\xb7{ԣ\xfa\x87\"\xca\xe6\xf7\xa4\f\xe9\x05\x06\xd7\x01\xad\xa3\x82dU\xb0G1\xf6\x83\xb4\x84{BJ\xef\x1c\n 1P\xe4\xa7v\xa6S\xa1E\b\x95+\xa3\tI\xae\x1afK\x1a\xa1\xb4\xa2\xc1+\x19>\xe8\x01\xcf\x13g\x1d9\xc8\xc57k\xb2\xc1\xb7'\xd7\xd9&\xf3d\x02\xf9\x16\x18\x10\xf1\xe4<F\xf0z\xb9B\xf0\xe8\xb4*C\x92V\xfa5\x87\xe2\xbb)ɖս`Ⱥ\x95\x8fڮ\x92\x126\x01\xa6z\xb0\xa6jYE\x95\xbc\xa7&\xbfm\x92\xc7(\x85\xa1\x03*\xe13GzA\n\x1ek2\x87+JY\xe8\x1c\x9e\x8a<[\xd6]\v\xddG\xa5\n]\xf7\r\xea\xe3\xdb$\xf8:\x98V\x05;\xd2\xdel9{\xc07\xb7NY\x88ƭ\x1f\x96Ԛg\xebE>\xb6\x832xCCVp\U0010726c\xf2,_\xe5\xc5\xfa\u07bf7\b\xeb\x92\xf3<_\x87\x8f\x1e%hF\x9e(g7\x15x\x8b\"_]\x7f\x9a\xf1*\xad\xf4 G-\xbe\x1d\xf8\xc9\xd5[\xb6\xf0\xbd\xa4~\xaf\xf1\x17\xc2m7\u0096d\xe7\xae\x03W\xb6$T\xfb\x95p\x9f*\xab\x8a#\xef+ [\xba\xd7\xe2s?\xc8\xf2K\xf2\xf4cTL\r-\x02\x18\x86#I\x89E\x1f\xc5\t\xa7\xcfU\x11\xa1\x04Zď\x91f\x9aTB\xb8\x0f\xcf\xc2a\xb78\xf529\xc3\x11\x97\x11\x8d\xae\x9c\xbe\xc5U\x137E\x83|\xe1\x02\xf7\xc1\xb6\xdbg\xfb\xa6J\xab^T\x7fU\xcfrˉe\x1a'hsIJZ>2'd\x11z\xef2\xfc]_\x92/\xc85\xe1:\x9d0\xc318\x1beew\xf2\xfc\x16ɾ\xa4\x7fH\xb28\x908?$L\xaa\x9d?\xf21\xc5\x1a<&\xebӇ\xca\xf4\x83\xde'\xd5OF\xbaݛ\x95\xc6\xd7AN\x9b\x87\xa4z\xb9\x00\x05IX\xb2\x1aӹ\x04\xfa\x7f~\x03\xa1'\xb6\xbc\xfa\xf9\xe9\xc3\x12̕\xd9\xeb1ߝS\\\xab\x00\x17/U\xe9\x95\xefe\xce\xc4J\x1f_\x17\xb1^X\xb8%Cߪ9\xbc\x8d\x93BEk\xd1#qڗ\x02\xac\xa6\xce;\xbd2/\xea\xc0\xec\x1d3\x04Y\n\v\xfd&\x87A\xd8\xc9C\xbe0\xad\n\xbecқ\x04\x85\x8cDcى\xce!/\xf3\xf4\x91\xc0\x03`\xb1\t \x9a\xc6\x15\t+\xefW\\`\x9d\xdc\xc9\r\xe8kۺ)I,\xce\xdd>]\xfe-Ś>,\xd9f5}Xz\nL\xca\x1bR\xac\x92,\xaaX(r!\x83\xee3\x118\xd8\"M\xe0\xdc\xd4*\xc8\x10e\xbf\x80\fSq\xb9D\xeff\xc4\xe0\x9b2\\\xd3l\b\x96\xfb4*\x8c\xeb\xb1\xfd`A\x8f\rB\xa4\xf5\xdeR\xd39\xca\xe6\x18\x9d'\x88\x8e\xfb\x1e,dW\x8b\xfa\x15\x9d\x97\x85L\xe9Q\xe1\x9e\xc0\xc3\xf0\x18\x8aa\\\xdfaXV_C~ʦ\xfc\xeeǨ`\x9c\xceu-\x18\x84\"\xfa+f\x19j\t\xd0\xcak\x06\x9c5\x1c\x88\x15ڀ\xa0\xa3\xa3<\xc7a\xda\xd5\xe6\x15\x05e\xd9@\xc9sV\x81\xa0\xb9\xa1~\xddg\x1c\x9f\xcdY\xe1\x9cgI^E\xdb\xf7\xf8M\t\xfco\v\x9b/\xe9\xf9\x18Ȣ\xd5\xe5«V\x15]A\xf4\xf0\xad\xc9`\xe6\xf1У\x7f]\xa7\x84\x87L(\xc3\xedY\xf25ɜ\xdb\x16\x8f\xda\xe7\xbf,\x9c\xa7yIXVW;6\xe5\x9ad1\x89\xc1~/|`\x8f\x04e\xef;,\x16\x1d\x05D%\xe1\x81~\xf1\xe9Q\x06\xfdEr\x14!\x19K\xbf\x88\xd6N\xeev\x99\xa4H'\xed\xe3\x19s\xce\xcbKӶ\xc6U\x1e؎\x00\xf2\xe7m\xd7\xe7\x92\xea\xaf\xf3M\x01\xab;}\xf9\x95\xb1o\xad\x98gSmQS.\xf7\x06\xbf\xadd\x98>\x8e\x89\xb4e\x02\xc2\xc5%\xe3\x9c\xcc~\x95&(\xed\xaa\x84\xfb\xf9\xc0$\x83g\xe19}\x99\x90\xdf\xfd\xabt\x98\xe3b[\x15\xf7`T\xd124\x15\x1a\xfe\x9b\xb1\xcd]YY:.uK\xbb\x8a\xd6\f\xe7\"Z7Pҹ\xbb3#O\xca\x06\xc28\xfd!\x1d\x17\x7f\xc9Pc\xfe@E4\x97J\x843\xcbm\xa6\xa4\xd5\xcbZ\x9b\xcd\xee\xea>F\xec¤\tf\x9a\xc4?Z\xe8\xb5\xfb\f\x1f\xea\xd8ʣ\xeb\xd4<%\"\r\xaa\x96\xd98ɻ3W/ky\aG\xda\xfe\xa2\xc3\x1b\xbfBI\xdar\xb3\x06\x18\x16\xe1\xf5j\xda\b^}\x9dSe\x93ֹ\x1e\x8dS\xc5\xdfօ\x96\xa5\xf4/\x9fkƃ\u058b\xae@\x9f\x8d\x8bDk\xfbg~Fh\x99b\x9eS\xb1\\|z\xed|ᮁ\x1bK\x84T\xcf,m'\xea\x95\xcc\x1c\xe9\x0f\x1b_\xe0B\x8e\xe5-y\xa8\xbf\x86\xe3n̨m\xdes\xa7;\xd6ߋ\r\r\x84a_\x87\x0f\x9cn\xe19\x03\x90\x9c\xe00\xf8(*\xaeB\xe8/\xb3\xbc\xa0/\x17>\xe4qBJ\x11-4z L\x1c?\xe6)\xec%$4\x02\x19ڊ\xb8\xb0\xa4\x90?\x0e\x03\xe6\x14\x9e\xfe\xf708IJh\xed-\x12\xf0i7s\xaa\xce\x17i\xdcQ<\xc5\v\xf5X#\xbe@\x1b\x9aA\xaf\xf1\nL=\x1d\xf3x\xa4\x8f\xd2d\x99\xe5\v9\"\xc6\xcc\xe7X̡TI\xf1\xf86\u009d\x83y\x91%M\xed\xfc\xed+\x00\x84d\xbb\x16b\\˪\xc93A\x8a\u0602@\xe3aː\xf5L\x1cPGQ\xa1&\x9b\xbf\x84-\x13\xeay\xba\xa7^M5\xf2\x17'\xb7 q\x01\xf5)\x02\xdbuP\x02\xda\xd7M\xf6~(r3c\xa5P\xcd\x03/\x05\x9d\x03\x89\xfc\xcc(g{\xfe}ZϠ\x8eԒ@\xfc\xe0J\x8d\x91\\\xd8\xd4R8\xa9{\xf1*\x02\x80\x81y\x94\xd8oHY\xc1\xe2+\xcd\xe8ϡ^\rۡ\xf8\x99\xbd\x14\x0e\x03\xed\f\x82\x1d\x8c\x1f\x063am\xe7\x9c8u\x8f\x96O\x1f\xa3\xf4K\x1ff\xca{\x84_\x03\xac\x96tDӁ=\x84O:lé\xbfr\xa5\a\xbd\x1f\xa3TWza\xb7<\x1c\xa3\x10\xc7D\xcd-b\x9aGU\xaf\xab\xb3Ξ\x065\x19\xabX\x800FM\xd6\x1fӎ\xab\x17U-t,3\x90\xce6i\xea\xf1\x95\xc8" +
+	"\x88\xb0\xc3\b?\x84\x19@\xf5\xd4\x1e\xbe\r hH\x1c\xaa)\x06\xbc\x8f\xcaiU\xd0s\xa8鱐\xc2=l\x81\xd7\xc9\xc9\x15`Q\xabG\xb5\xb23Xr\x8b\x82!\xb6.\xf7p\xf0\x0e\xbe\xf7\x03\xe3zL \x9f\x82\xc1\x86\xf3.H+\x8cm[7Ѳ\xf4\x14\xab\x10v\xaa\x00\xceVW\x15\x9ag\x12\x87\xfa\nмui\x83ʜ~ͷ\xd1\xe3H\xaa\x16\xb5\xe8\xae;ju\xe4\xd2z\x8d\xed\xbc\x17<\x00\x8a\x96H\n\x14\x13\x85%\x19\xb1\xfe\xcc\r\xf4w#?\x04ܲh\x80$\xc0~\x018\xf4hP\xf9\xa5\x84\xaa^]q\xb0\xa9\xf3Ѧ'P\x19x\x06X\xc5g\xcc_ \xf2\r\xdbp\x18\x92:\x8e|\x16\x80C\xe0\xb4\x16\xf4\x8a\xaf\xedےxaB\x9fo\x04\xbe\x11`ɚ\x10\x01\xac\xb6\xff\xbc'1\x0e\xd9\"7\b\xb1\x05\x7f\x18L\x84\xf9\a\xc86\xf0\x91Di\xf2o\xe8W`\xc0\\\x90C\x85@\xeag3\xf0<\x93\x81\xd4\xc5Pi\xaez\x86\x00\xa0\xab\x85\xffpŰX\x13\xaf\x8c\xab\x13\x91n s\xf5,`\xaf\xb8*\x92\r3\xd5\xfbK\xf5\xad\x1c\\\xd9>1\xc6\xe7\xf2)\xc3!x\xee\x90UÍ\x93\x99|\xbcI\f7\x9e8\xe0&D9\t9\xa4-\xa5\xff\x91\x94E.\x9f3\x8c\x89:q\x85\xdaa\xbbF\xb4\x10\xe60Bc\xcfR\xf5\xe8\x10\x93\xac\xd2\xf6\x9f.\x02t\x86\x1a\xa8\x8f@ƞ6@ cC\x1b\"\x90~\xc6\xe8\xc9\x0e\xe3븱\x90\x878\x0eP\xe0>\xf4~\xbda\xbf]h\x17\xccȎ݊\xb5\\\x18\x97\x9b\xd5\xe93C\x10\xc6\x04\xba[R\x84\xab#\xb2\x88!\xe6\xc6h\x90^ݑ8&\xb5D9\x8a$\xa7\xdfE;0\xd9ɛa\x1bk\a\x97\xbd\xb59\r\x9bq\xbaZW/\xf6b\x96\xf0I\x89Κuh\xac<6j~,5\\^$\xf9\xb0\xcciބ\x10\xfdnC\x10\x92\xcf\x19\x11BA\x8f/Ť\xa6\x8bL\x9b\xd7\xca#h\t~XH\x94!\xc7-\xc8\xfd\xf6\x84?\x8c{\xd6\xc1C\f\xcfJ\xa6\xc8\xc2\x18\x83\x03\x85AEH\r:V@\xa8\xf8\x92\x14\x1a\xb8\xab\xbc\x82\x8af\x19\xcf\xf2D\xee\xcb͊\x9a\x02k\xb9Q\xe5\xe8FAb\r\xae|\xbbOJ֝\x1a\xb8\x87\xc0\xb7Y\x99,33?\x86S\xa3\x03\r,O\xa7\xe7\xe2\x02k\x9btL\x11\xbd.l\xce\xf3y\x94\xea\xa2{Ws%Je\xfb\xab\x82/\x10q\xb2\x8e\xe2\xb8 %\xd52\xf0\v\xc9\x1a\xa1,\xdc\xedN\x9c\x95\xabnῬL\x99\x7f_\x10gA\x84\xa2\xb5\xe8#Wg\x81\xf2?\xf8\x81:s\xd6\xd6\bMw\xf9\xe4\xa1\x00\xffڢ`O׳.\x85\xcd\xd5:Y\xc9\b\xb3\x82M\xa8:;\xf6\x02\x89\xe5_\xc0\b\xc5\n\x11\x85\x81G\x95䁲NN\"L9P3\x008?n\xaf\xeb \xd9\xe1E\\x\f\xeb\xf0\x95\x062g\xdbn)G;\x91n\"\x8d7V\xae\xd6\xe4\x0eͭ=\x88\x83\xa1\x16\xa0W\tW\xec\xe7ET>\x18LZ`\x92j\x06\x18\x1a\xa6\x89d\xbfh\xe7\x00\x9f\xf38\x01\x96'Rg-\x9b8\x7f㨻8\x7fCԽ\xce\xdf8\x8ew\xef\xe5\xf0\xba}\x97\xa3\xb0\x9b\x96\x06\x883R\xa1\x85\xb81T\xef\x1c\xe9(\x16\xa4V\x8c\x8a\x85\xc1\xa6\x14\xb3\xae\xd7\xee{\x17y\x1a\xab\xe7X\xae\xd6\x14\xb6\xab;\x0e\xf1s\bQy\xcf\xc2\x15\x94\x99\xa5\b&c\xa20}\x1do\x9cwaZ\xcd`&\xd9\xee\xae`]\x7f\xfdi\x16\xc2mƽ~\x9bq\xb9\x9b'.@\x87K\x8f\x90\xa4d\xa5\xacm\x85\xa9-\xf3\x83\xa7p\xc1_Y\bGV\xee\xb8l?\x90\xf9\xc0\x02\xf1>\xca0.\xbdB\b\xe7y\xb6\b\xb8fף\xb6\xd6\xfb\x05k\xac\xc5E\x04\"K\x1do\x19\n\xb6\x9a\x8e\xad\xb5\xeb\xe3o\xcf5\xean{J\xea\x15\x9b\xa4\xf1\x17\x95L\xb8\\\xcc\a\x17\xceq\x88\xac\x15\xbe\xb0\x96\xaaaZ\xa8-\xbaKlYo\x18\xaa\xaaZ\x94\xe8\x9eȒN1\t*\xc6ג\xe1\x03\xef B\xe5\x9b\xc6\xcdj\x05L R\xf9/\xa25\x18\x17\x88\x0eD\xb3\xec\"Z#4)\xb1\xdfH\xb8HRXN\xb3ލ\\\xb5\x8d,{/\xc9\x13\xaf\xb9\xae\xc0\xd1[\xa1+\x1ai\x1e\xb8S\xde}Ҙ\xb3\x84\xab\bv\xa0\x94p\x17\x06\x81z\xe9\xa5\x14\r\x92lN\x95U!\xee`\x9e\xa6\x90\x98\xfaU\xbcu\x10O\x1f\xf4\x8a\x90,Ɵ\x86\x91\x89\xae\xb9\x05\x92R-,-~\xacWJ\x05)\xc1\xd6E~\xeb\xb6J\xb66\x19\x93\xa5S\x17\x91.\xb8\xc1\xd5\xd4\xf3\x18\xc9\r\xf8\n\x15\x82'kZ\xef\xd2\x14\x84@\xe5\x9ep\x01\xbc\x05\xb9\xab\x88\xa8\xb6D\xf5\x1e\xc5R\xb9\xc0\x9c.\xac\x924\x1627\x9a6\x00C\x98P\xd5\xf0\x99\xc6\x15\x01\xb3*^y\x84 M\x1c\xbfd\x8e\x1a\"\xd0\xe5\x1f2\xa4\xb8$O\xb7\x19\x9c-*R\xf0\xb1 Ū\x15\xec\xd1T\x85\x04\xce\r\xbf|E1\xe931NiH\xd4LR\x9f\x06\x8d\x9d\x96\x90~WƸh\xf1\xfb\xa4I,\xf3\x1c\xea\x9eV\x0f\x03f\x01(\xaf1\x0e\x8d\xcb\tze\x85\xc3\x18\x1e\x9a\xb5`\xea9.\xaa\x1c\x069{\x0fG߷\xf6\xba\x87A\x19\xad\xc8d\x17!\x83UYX\xec\xe9E\x97\x84\xbf\xb7c\xd4[4\x85\xb7\x80՟%\xcdDnm@\x18\x8c\xd6\x16\xfe#\x05yց\xbcY\xf8\xfc\xf9\xdb\"\xf8\x87x\x9ejt\xfaTD\x1cnxT\x90\xdcn\x1aU\xf7\xb6\xe8&\xc1\xee\v'\t\x9e\x91\x8ab`\xfencI+\x82:J\xee\xdb+\tG\xca\"\xed\xa8\"\x11@G\xa8\x90l\xb84\x16\x86%c^u\xe1\x06\tD\xf1H\xdbzЫ\x90݇c\x0e\xd75\x13\x1d\x05-\xa2\x95\x0e\x13z\x11\xbe\xf14ьpT\xd1,\xba\xb8\xecV\t$\xf7B\xe5[X\x9dN\x81\xa3\xb8\xf5\a\x12\xe8?!H\x14w\r\xe4l\xe6\x01\x98\xac\xd7\xd6<\xb2\x06\x99?^e\xe9\x8b\xf4\x04\x8c\x14\xdd\x00\xd3{S\x84h\xb9&)}.|\x93\x1bO\xb9e\xa9\x8a\x06lY:\x15\x15\"d\x93\x11S[N\xe9Ϣ*)\x17\tip\x9bF\xe7t\xe3\xb5I\xb1[&'4\xc1\xb6.\x84\x03`\xc8\xd94\xb7\x02\xea2\xf8fu|\x9f\xa4q\xe1#@\xa1J\x97\xe2,\x9c\xe9P4\xb1θ\xd4\xe1\x98\xechG/(\x93l\t|U?\x99\x12\xbe9H\xf7\xa3n2\x93\f\x1e\xc0i>\xbe܈\xbf2\xcc\xf3\xe8\xdf/zA\xfc\x18\xcafZ\xcbm}\xc9i$\xac0-\xbb\xeb\xf1\rG\xa7\x11\xb2nr=\x92w\xc6}\xf4\x92\xf9\xa6\xb0ܠ\xf0\x8c\xfe\xc8Ǩ\x93\xf94\xd0*\xb36m칸驠\xb1o\x99`\x7f``6\x1d\U000ac292\xccܼ\x9c=7\xc92R\xacr\xf3쩦\x11[\x0eB\x87\xd6|I\x88\x1cҳ\xbb\xa6\x87s\xe1q\x8b\x05\x8d\x1d!0\xd3N\x99sH\xc1\x91\x10\xe1\x023\x1d\x8b\x19\xecQG\xa0\xeaas\xbfB(n\x9e\xa85\f-O\x99γ\x95HM\xe9\x13\rx\x00\x1e\x92\xc5\x06\xfb\xec`\x18\xf4\x14\x82\x8983RP\xaf\xdb \xd0\x19\x9bw8:\x91i\xe0&\n=\x83ڎY\x10*\x92\xde\r\xaa\xf4pR\x8b\xf3#?\xb0X#\xa7P\xfc\x1b\x1eB\xf2\f/5vT\xc2\xf1\x91˖P\x04\xe0\x02\xe3A\xed\xd0#\r\b\x99դ\x99O\x8c\v-\xe4\x8c\xfah[\xad\x93\x94\x14\xc8\xdet\xae\f5(\x9a\xa8\x17\x9c\xb8jG\x1a\x10܂\x00\a\xd5\xf4\nC\xf0\xf4H\x15\xb3N\xe1e\xfe\x04O\ty`Hh\xe8E\xf4L;Iob\xb8'\xf2\xb5\x02\xb3\x0fCv\x065\xbd\x90\xa8\f\xdbzבe\xe64\xb6\xe4\x8b\bݕ\tqo$[\a\x8d\xaa\xbd\x03\x11\xb6\xa1\x97\x9b\x15՞\x99\xfaf\x01\xa7@\xb9/kˌc\xdcDK\x017\xb7\x14\x8eQ3F\x1cû)q\xf8ʐ\xb3i+\xe1\bZ7y\xd8\x11\xf5\x06\xce\xe2\xe6\xb2\xe2 \a\xbbe\x10\xf7\xa4\xa1\xab\xb7\xb6W)\x86KL‣\xcaٕ\x1c\xea\xef'\x8e`U\xeb1*\x00\x11\xa6\x12\x9d\b\xa2\xa2\xf0\x1f\xb5ܭêU\xd0\xef(\xa30O\xc2\xc6(\xfc\\\xcb\xd2uM\xad\xf3\xa8\x80\xe9\xd0 }\xb9\x15\xa3\xcfD\xe1\x83Q\x87\xc2\xdfto\xc1\xa2?\xb6\xe0\xc0ۤ:\x8c#uuZ\x8f'#\xc8Ԣ}\x8aʫ\a=\x1a\xa2\xb8\x00\xaa\x9fN\x1c\xc99\xa3$LM*}\xceH\x048T\x86\xd6\xd3\a\x05\xf7\xccv\xae\x1f\xf2icԣ\x8fI\xc9.6\xccE&\x11\xfc\x05\xd0\xed\xb3v\x11\v$\xff\xdd\x1eB\x11\x12\x92\xd9Q\x1c\x8c|\xc6::\x8b#\xcdH\x85\xf0\xa8\xc3V\xe3j\f!\xd7,YD\xd1\xc3\xdd\x10\x8a}\xa1\x89\x80\x89\xdf\x1f\x0f\xc6*\x85_\xf6\xbd\x8a21\x87\xc7*\x84N\x9f\xba\x91J\xe4\x11\xde\xfc6ȰÚ\xb0R\x12\xda:k%\xa6o\xea\"\x04\xc4\x14\xed\a\\\x06\xae\x9c\xca\xdbQݽ\x0eH\x1c\xa2n\x81\xea\x04\xd9\xdb\xc40w\x1ba\x88a\xefv\x80a\xa6\x11w\x1b\x03M\x1b\xee\x0e\x06\x8e\xcd I\n\x04\xcfݵ\x8c\xc2\xfa\xe46\x8b\xe8\"t\x98\xe4S%m\xed\x90Q\f\xd7Pq\x00\xd5j\xcbARjl\x89\xe0_\a\x1c\xc1\xb9u\xddf\t\xf3n&Nj,\xb1\x8c\x16\x04)\x96x\xe3i\xb2P\x1fi]\xa0:\x88\x19\x00١\xac\x0e\f\f͊Jؐ\xf4\f$d(%P\xba\x06\x8ae\n\xd96\x10@\x9b\xad!\f\f\x04K\xd3\xd3\xed\x18\x18\x0eA\xaf+\x94w\xa0>j\xa0\xb8\xa3b&?\xc3rm\x93\x0e\x9b\x91\x8a\x82\xf1\xdb\xf5\x96\x8er\x94\xe5\xd9\xcb*\xdfXl\x9eB\xa5%\xa2\v8)\x99\x14\xe9\x82)\x8b\f,\x10Q\x90\xc7ȟUع\xa3\xf0\x9aS\xbd\xfbXd\x10\xcd\xf10\x04\xaa;\xa1~\r\xef6\x8b`\x8f\x06L|\xf7a\xb3X0\xbf\xbc\xa6\xebN\x8a\xae\xee\xc8\x1cy\xccg\xee\x1as\xc1T\xa8\xc4\xe3 \xe0\xe1K\xbc\x8dl\x9c\xeb\xb6̜k\x99|\xba<\t\xf7hh$\xdc\xfdBC\x80\xddZz\x01u\xc9\xd6\x02f\xdc\xcdXptUc\xc1|{\xa9\x80\xebW/\x16\xd8u\xa5b#\xc9\xfb\x14\xedf\xb5\xe5B\x94OFo^<\x18첆\u058b\xd7ʉ湮\xb1Q\xbf\xe4.ɢ2_\xadû@~\xaa\xe7\x16(\xcc\\cE\x19\xca#t\xafܿ\x81fa\xe8S\x18:\xb2\xbb\x95\xbcl\xfe\xb3\xf7۵\v\x80\xa1\xc8\xc8\x18\xfc\xbd\x1bui/\xedV\x9a\xb92\x93\xa4\xe0\x05H\xd8\u008e\xa9l\x1cx#nNy\x01K\x9c\x81\xca\x11<\x13\xa6\xf7aˆzg\xac@\xf0.\v\x81Pr\xa96|\xd0=I\xa7\x89\xedo\x02U\xda>()h\x84\xc3g6\xec\xdcP\xfa1Eο鸪\xc7\xcb\xe8\xe4j\xb4[\x1cSep24q\xb9\xcfq״\xa8\xd8qX>5o<\xb1\xb5\xe7\xe9\xd6\x18\xb0\xf5\xc2]L\xa3\x9a\xa09\x12\x00g\xe7\xc9\xd1n\xda\xe7\x88+lcR\u038b\xe4\x8e,,\x8f,\x8d\\\rӢy\x10%Ա\xf2\xbc!\xba\x87znQ\xf0\x8cMw\xf1\xdc\ac\xe1\x17N[[!2\xd0'}\xe8%\x8c9\xa9\x14\x1e\x8dx\xb5\xd6\x03\xb0\xb0\x9e\xa6no\x1b\x97\xcc\xd0)=\xebq\xfc.N\x9a\x11\x9d\xa3\xb4\xf2\x06\x87\x11h\xc4\xf5\xe2A\x00\xc1Cp\xd8BU\xb10V\xa5c\x11\v \xf3\x98(\xf5_K㝼\xc0\x97\xe9>\xae\x83\x10`\x1fx\tp\x0e4\x8dU\x84\x04\xfa~\v\xf1\x8d\xcf(| :#\"\x17\x13h.1\xddQ\x8d^].kp\x03\xd4x\x80)\xb6\xbc7+-\xe7\xae-\x13s\x99W\xf9\r\xe5\x12\xfa\xf6bf4\xf3\x91\f\xfc\xee$ٲYf\xda\x14\xe4\xfc\x85n\xfa<Y8̡\xfd\x89\xa6\xdb\xe7_>S\x10:\xb41'.\xd8\xf5\x8cqo\xc4\x10d\a\xb8n)YU\xb8\x0f\x16\x15y\x89v\xbc\xa6\f\x128\xee\xf9\xab\xa0|w\x06s\xe7\x00+\x13P\xbdœ\xaa\xc6\xe3(2\xc09\xc1\xf9^\b#\x1c\xa5i\x1d\xd8\xfbL\b#\xa1'G\xbaW!\x87\xbc\xa4\xf2y-\xb3\x15J\x9d͵\xc2:\xff\x8avǊ\xaaO\x00P\x18\xf7\x11\xf5\xf5V\xfa\xbb\x06ƙ\xc0\xcbɲ\x1b\xa0d\x0f68\x99j\xa0<\xb2\xf2\xd5\xea\xa9\xf8\xe2\xe1v\xffR\x8a\xa7\xc9\xd5\x16}\x7f\xe5V\xddkPB\x9fK\xbf\x04\xa8h\x03cC\xed\xdc$F\xf8+\xfb\xb5\x1f\xf0\x1f-\x03\x9f\xae\xe2\x12.]e\x1e\xfa\xc7B\xa3a\x10Jw@3\x86#\xfb\xbf\xae~qR\xfe\v\x94 &\x92\xe2/7/k\xe7\xa5\xdawo\xff\xfe{\xff[\xe6\xefy\xc0\xa5oWF\xbb\xddn\x0f\xfb}\xfa\xb7\xddn\x9b\x7f\xdb\xdd\xce@\xfe\xa6\xe9\x9dN\xb7\xdb\xfe.h\xff':`\x03\x91\xf2\xbek\xb7\xbfF#q\xe3\xfe\x9b\xfc[\xe4\xdcr)x\xec\xfc\x85\x9a\xba\xab\x19\xf1\x97\xbfp\a\xa6\x81L@\xbe\x9c\xc0\xceX\xec+/RvQ\xbb\u0604ˉ\xcf.\x0f\x9f\xe7Q\xb6D\x10\xf6\xf7\x8d\x9b\xfc\xe7\xff\x81_\x90o]ƶ\xf5?\xe8w\x8d\xf5\x0fio\xeb\xffu\xd6?\xcc\b\xb5\xf2\xd9\x17{]\x12|\x9f\xe4\xdf\v\x87X\xa9\xa1V\x0172\x87,9l\x05!\x83\x9b\x9eL\x00\t\xe5\x91f\x86\x87\x01s\xd7\n1\xe7)\x0f9\x04\v'\x10\xb9\x0f\x03\x9c8۬»\xe0\x97Ϡ\x02i\xf1\xbf\x98v\xafk\xd5h\xb6Y\xf5\xbaRy\x8b\x91\x87}\x17\xf2\xb0ϑ\x87}\x81\xfc\xf3ՙ\xbb\xd2I\xfe\xee\x9aDq]\xfd\xff\xec\"\x12\x8c\xef\xfb(NI\xd1\xeb\xbe\xd6\xfa\xefv\xcc\xf5\xdf鵻o\xeb\xff\xf5ֿ\x98\x11\x8a\x0f\xc8\x04\xc1\n\x00\xed{!\x10\x80\x86\xb6ܬ\xc28\xaa\"\xb9<\xb5\xeb\x92K\xf2\x14\xb6\x02\xe9\x85J\xb9`\x805C\x9f\x0e|\xf7\xf6\xef\xb5\xd6\xff\xbc\x98\x7f\xbbտ}\xfd\x0fG=s\xff\x1f\x8d\x06o\xeb\xff\x15\xd7?\x9d\x11j\xf5\xf3Oc\xedsϬQYE\xcb,O\x13jip0\xea\x8c\x06\x9da\xbb\x865\xc0\x8bǻ`\xef\x06\xd4e\x06\x9b\x10\xe8\x93\xd3\xd3S77\xe1\x8eYNOO\xe1\x89\xcc\xc1x\xdc=\xe8\xf6\xc6}f\xe7\x01ɔ,\xa7.\xd0\x7f\xc8\xf3\xf5*\xcah\x8e\xfe\xe0\xa0\xd3\xed\r\x87\xc2\xdfE\xf4@(n\xb8\xce\xd3\x17^LK\xe6\x97\xcc\vWYcc\xf2!5T\xb9\x9e\xc5q\xab'Z\xc1_\xba\x83\xe1g\xad\xed\xb7\xccY缘\xf3Z\xe0~\xda\x0f\xd6fW|\xd5\xf5?\xec\xbf\xde\xfa\x1ft\x1d\xeb\xffM\xfe\x7f\xe5\xf5?\xeck\xeb\x7fط\xd6\xff\x0e\v\\٘\x9f\x1e_\x1cQ\a\xa3\x83N\xbf=\xea\xb6\xdb\xed\xcexp0\xec\xf6\xdaʩ\xd3\xec\x8a\xfav\x1e\f\xfb\xfd~\xbb\xd7\xe9v\x0e\xba\xfd^\xbf3\xaaY\xb3\xd4\x7fE\xa35;\xec\x1b\xebr\xec^\x97þ{]\x0e\xfbu\xebr\xf8\xdfL\x90\xa1\xa3\xbd\xc8\x1e\xbfe\x19\xdb\xd6\x7f\xaf\xdb7\xd7\xff\xb0\xdf{[\xff\xaf\xb8\xfe\x17٣Z\xfd\xf4õ\xf6/\xc9S\xa7;ƛ\x9e\x06\x88ܐ^\x17\xa7\xe3-\xb4\u05cd|\xa0a\x1fC\x86}\f\x89\f\xd0wo\xffv[\xff\xdc?\xf0+\xad\xffN\xd7\xda\xff;\xfd\xd1\xdb\xf9\xff5\xd7?\x9f\x11\x8a\a\xc8\x04\x17\x1f\xf8\x00\xb6\xa6p\xf1\rA\xf9 >ŝ\xb9'\"\xf5_\xd3;|@f\xa1\xa0\x81f\xd82\x00T\a\a\x05\x87w\x01+\x8a\xaa\x1a]XHe\xe8\f~\x8cP\xe5\x9bTi\\\xe6\xcf\xc0\xaaE\xffX\x10Vg\xd9#fV\xae\x1c4R\x17馼\xb7Ru\x9d\xa4\r\xb4\x14\xa2&\x8aTJ\x1a\x00Cei@\xa5&\x97rV]\x9d\x8b\xc40\xad\x13\xf8\xbb_RcI):X\xcd\x15\xd5\xd7\xff\x1d\xe5\xa7\xff\xee\xff\xee" +
+	"\xabU\xfa\xad\xcb\xd8&\xffu\x06#\xeb\xfeg4z\xe3\xff\xaf\xc4\xff\xabU\x8a\xee\x7f\xe8\x17\vTQΣ\xb5\xcd!5\x9b\x98ی\xd4b\xbd-\xb8?\xe1\xfa\x7f_\x91\xd5:\x8d*\xf2:\xeb\x7f\xd0\x1d\x99\xe7\xbfΨ\xf3\xb6\xfe_q\xfd\xcb\x19\xa1\x18\x81J\xd1.\x83\xd5\xc7\xfbE\x89\xbe\xc1JUR\xf1\xa5\xbf_GEI\xe4\x95\xf2lf\xbc\x93<-\x8a\xa3\xd5]\xb2\x14V\xaf4J\x0f\x18\xf8c\xcf\x1f\xa7E\xf1!\x8a?\xdd\\\x9ck\xf0.\x86\x17Q6\xbf?\xcdb\r\xa3\x870N\xb3\xd8UH\x1f\xa1\xfc}vë\xad\xd7\x03\x17t\x99\xcf6\xf3{'\xde\x00\xa1]m\xaa\xf5\xa6r\x158DXӨ\x00\xd3\xf6\xe3{\xe8$\x1dmd\xa31\xee\xaca\x8d1VAb\xb2H2\x123\xc4Bo\x04\xeeM\x1a\x15\x1eb\xe7_\x13\x92Uŋ\x86y\x80\x10giT\xde\xd3\x01҉\xb5]a\x9b\x14\x86\xfcu\x18\xc0;\x80\x80N\x02\xfa$\x809ѓ.\x05Γ\x8c\xb0\xcb\xf4\x13\xfa`a])\xc3tuN\xf0DpR58փ\xbf\x80\xb5+\x98.\xffMN\xe9w<I\x9cR`*\xe9q~n.Ώ\xaa\xaa\xd0\xf7:He\x9d\xa9{L\x92\xf2\xb8\x85W\xbfw*\xbc\"t\x86\xd3Ӱ'\xe5M\xb1!!\xb8\xb9Րª\xd8T\xf7\xdcQd\xfe\xa0y\xe1\xfe\xfbL\xa7\xf1\xf7Y\x93\xfa\xff}֤\xf6\x7f\x9f5\xaa;\xaf\xc7\xcc\xec̋MY\x85\x15<\x04fc\x82\x03e\xa9T\xa4\xdb\xd5-\xe6\x15\x02\x9b\x9dW?hSR\xdcFMa\xaa\x9d\xcd\xc2E\x19,\xcawg3\x1aI\xb1\"EF+\xac^\x95i\xb5P\a\x1e\x96\x1d\xa2\xee\x85\"\xc4\xfa\x0e\x19?\xa6\xf9]\xc8\xcb\v결cT1/Iex7\xe0\xa8ҍFAH\xb0\xc7\xd6\x0f\xfc>\f\xf6\xa0N\xb3u4w\xfbА\x85\xb5$-\xeeH\xd0\xe8a\x8cyE\xd7]\x98\xaf+\xdcֺ\x1c\xf4\xe1\xd6q\x94\xd1f\x87\xe6\xc9\\\xe11Y\xb1\x06\xe1\xf4\x99\xcc7\x15\t\x9f\n<A\xe9M\x836\xbb\xb6\xe4\x17\t\x06\x1d\xcd4\xbe9Q\xf6`\xe1(\xe3\x9cT\x12\xd7_&V\xabu\xea\x9e\xce\x0e\x92'\x8c5\xe311\xfd:H\\֩\"\x1aa\xfd\xbc\xc3\xf9\x8e\xe2\x98f\x85\x89\xa2{a\xab\x8ciԐ\xa0\xd4\x104A\xae[\xb2\x16r\xb6\v\xb2\xfbͰ\x82\xb3(\x83\v\xce\xf5M\x9e_O\xfb\x84\xa4ɪ\fS\xb2P/\xbb\x8ady_5\xaa\x99˹`\x1d\xfe\x17\xb3\x17\x8bHcV\xe3\xa9×pH\xe6\x16\xe2\xfa\xdc8\x10^\x9f\xffcC\x8a\x97曃\xe4`[5\x85o\xa7\xb7?\xfe/YEK\xf2\x8d˨?\xffu\xbb\x83A\xc7\xd4\xfft\xdf\xce\x7f\xafu\xfe\xa33B\x9d\xfb\xf8\xa7<\xe7\xc1\xe7\xfby\x9e\xe6\xc5\xf7.\xbb\xe0\xa3t}\x1f\x89\x05;M \xca\x10\xf5\x9c\xc1\xdc[\xc7D\x18\xc7Ω\xc7\xdb*ʖ\xa9\x8a>\xb7Gs\x83O\xa54/\xe0\xad3\v\xe6\x9b\xe6\xc5;\xfae\xe2}\x00GJ\xb0YJR&\xc6\x11\xc4g\xa7*\xfc\x97\x80\xbe\xa0c\xd4h\x01&\xee\xf5\xc7\x0fGþ'\a\x03Z\xe4\xe1\x8f'\a\x85\x99\x19\xa6\xc93\xf3\xd9gd\xc1\xfaw\x8e:3\x90\xf6\x839\xae}ˁ\xcf*\xe9\xc9ŀ\xael\xf4\x97'\x17ò2m\xee&0\x11\xc2Bu=\xb8p\xc6>=8\xea\xd5:\xfa\r\xf9NS\x93\xa43\xfccӤ3l:Q:\xc3\xedS\x05p\x9aO\x16\xc0\xdey\xba\xd0\"؏\xba)\xd3\x19\xda\xd9\x1aO\x9b\xcep\u05c9\xc3s\xec>uxF\xfe\xbbn\xfat\x86\xae\xacM\xa7PghM\"0\xf5\xfb\x90F\xe0\x1c\xe26K\xe0\xe5\xbdP!]\xfc\xf3\x87/\x9eU\x90\xb9\xc1\x94bh5\xf3\x89!4\x9aL\fu\x97\x99\xc4+y\xf1\xcf\x1f|\x05\\\xfc\xf3\a\x03\xbb\xc9\xeca\x98\x8d\xa7\x8eD\xdfi\xde\xc8\\\xf0\xc3W\x10\xe0\x989\x1a\xcc\x15\x86\xe9\xe26,\x18\x91\x98\x16j|\xf1\xe8\x1e\x06?%qu\xcf&\xc8'BE\xfc$\x93\x9e\tO\xc8\x1c\x9cW\x14\xea\xc5G+\bi\xf9\xfb\xca\x018\x16\xa9Y\x06V\xb2\x91M\xc4F2\xf3\xc1\xa4>-\x8a3\xe6G\x82\x1f>i\x03>\x16\xd1\xcb\x17\xcfj\xc8\xdc`V3\xb4\x9aY\xcd\x10\x1a\xcdj\x86\xbaˬf9\xe0\xbf\x1e\xfc\x8f*0\x95\xc0n2\xab\x19f\xe3Y-\xd1w\x9a\xd52\x17\xfc\xf0\xe4\xa18f\x8e\x06\xb3\x9aa\xbaf5@\xfe\xc0\x16ʲ7\x9c\x18[6P\x81\xd2xr\xec\xba}\x8a<\xeco\xcd\x14A{\xa7\xc8\xd3t\x9a\xec\xb4s\xa2\f;O\x15\x9e\x8f\xfd\xac\x99.xה\xf9\x1aN\x99\xce\xd0=i(&v\xda]3b\x87\x8e!?\xf4\xce\x17\xf1V\xee\x12\x9a\xfcų\x92\xe6n0)9^͜\xe4\x18\x8d\xa6$\xc7\xddeF\xf2,\xf4\x8f'\a\x85\x99\x19\x9a\xccF\x8e\xdax2*\xfc\x9d\xe6\xa2\xcaF\x7fyr1,+S\x83y\xc8Q]\xd3\xf0\x92\xd5\xe5\x8fM\x13\xb0\xf8n6Q\x86\xfd\xedS\x05p\x9aO\x16\xc0\xdey\xba@\xa6\xcb\xda\\\x97\xbel\x8d\xa7Ͱ\xbf\xeb\xc4\xe19v\x9f:<\xe3em\xceK\x7f֦Sh\xd8\xf7L\xa2\x7f\x1e\xdf\x1d\x17\x92\xd7Я\xc3\xe0Hͤ#5\x95\xd0\xd4a\xb9\x9aL\x1d\x81\xd9lZ\b읦\x85\xc8\xc4\x7f\xf8\xa6\x05\x83:j\xb6}R\b\xd4F\xdd-\x90\x8d\xeeV\xa1FAO\xa0\x11\xd8\xc3Z\x0e\x81\xd2\x19\xba\x90:C\x14x\x13\xe4\x7f\x1d\a\x9d[.\xc9\x13\x15\xa5t\x84\x8fZlR\xbe}\xda(\xb8\x18\xc6\xd8t\x1c̖\x05ʰ\xefB\xc2F\xe6\xbck0\xda>\xf5\xad\x13\x81\xd3\xdf\xeb\xa8J\xf2\x80\xa2̴4 \xa5\r\x1e\x8d\x11\x99\x92\n<\x95j\xb4\xd6|\xac9\x94\xfaHex*\xa7\xa35zc\x9cm1\x9b\xc2O̡\xce\x14\xd0IZ Ҋ\x7fA\x8bi*;ϰ\x89d\x9e\xd2E\xcbv\xe4\xff\x87\"\xa3\xdeU8\x16\x1c\xa3\xdb`q+Ԛ\x8dA!5b\x01\n}\x17\x1e\xa0r5a\xf2\n\xbb1\x97ײ\xec\xc4\xe6\x8d\xfe\xa4\x8e\xf0\xadFi~\x91\xf5\xc2T.\xa3@\x16\x7f\x9e\xe6tֳ\x01\xafRخ\xbdA@My\x97~\x1fnm\xce\xef8\xb0\x9c\x98\xa5?\xb39\xf9Om?\xa1\x18^\aa\x1cz\x14\xc7\xe1o\x01\xff\xa0\x7f\xcc웻z\x84\x8bM\x1a>\x04^\xf0I\xf2X\a\x9edzOb\x0f`\xa2\x80<\xd6q\\tN\x7fS\xb5D4\xa6U\xf83\xeb\xc3\x7fju\xa0]\xf8\x87\x8e\x03\rO\x03[\x0f\x03;\x9c\x05v?\n\xa8\x1c5\xf8\x06v\x93\xa5\xbe\xe3)\xe0\xcb\x0e\x01Z\xae\x9a<V\x8e\x06K\xd4/\xff\xffA\xf1\xbf\xb1\xf4\xdf@\xf8\xdfI\xf6\xff\x12\xd1\x7f\x17\x11~g\t\xfeK\x05\xf8]\x84\xf0:\x19\x9c\xc1<\\\xb6A/\xfd.C\\Ϋ\xf0\xb9\xcd\xf1\xf8\xdf\xe7\x0e\xff\uec0ch\xf0X\xe1\xe2[L\xa4\x8b$c\xbc\x87\xc6\xdab?\xe5\xacA\xed\xf3pk\x84qb\x04|\xc1\xa0\x17/\x88\xbf\xa5\x99\xe6n0l\x03k\xc1?\xad\x99\x88\xe9l\xee\x9a!B\x88\xc8*̂\x06\x88¡d\x89\a\xba.\v\x8b\xcd\xdc\x18\xdd\xe5e\x15\x83\x7f\xd3IyЮ\x1eI\x91F\xeb\xb2\x11\xf2$k\x84v\x1ce4`o]훬~\xdc\x11\xcd\xd7?\xca\xe5\xe7B\xb8\xb6\xf5<\xed\x9a,\x93\xb2\"\xfc\x96@7\xe3ZE\xcbd.\xbfbz\x03\xc1\x9c\xa2:n-ح\x83@\xe3W$\x16\xb2\xb8\xab`\xd8\xfc\x92⦈\xb2RD1\xd0%{\xfe%\xefZp?j\x91a\x00\x8b\xf5#\x18\x90\x15\xd2\xe1\xc5R\xfe\xba\x93\xbf\"+ā$\xb0u\a@\x98\xd4m\x7f\xa8\x9dy\\<^\xe6\xa8\xd94$N\xa3]Ckn\xd3mCfr\\}\xfet\x9fT֡\x8a\x9e\xba\xa42Dm\xa8\xc7w\xe8w\xa1~\xff\x13\xef\xb4\xc7\xf8c\xb6\xf5\x90\xe7ߚ)r\x83q\xe1x5}\xcc1\x1a\xf50\xc7ݥ\x7fy\x16\xfaǓ\x83\x9fc\x8d\f[7q\xd1\x05M\x11\x9b\xec\xc1\x1cյ\x05;\x86\a\x97\xe2<\xa2{\xe2};p\xfb\x9d\xb6\xb3\x04\xf4\x9e\xc1\x99\xab\xe3\xc9կ\xcb\xd5\xf5\x95խ\xcd\xd5\xf5\xe4\xea\xd4\xe5\xea\xfb\xca\xea\xd5\xe6\xea{r\xb5\xd9\xca\xfc\xaf\xa9\xd8\xfd\xe9\xd75\x9a\xd7\x7ff\xfb?f\xcf\xf5J\xf6\x7f\xed~\xbbӱ\xfc\xffu\xdf\xfc\xff\xbc\xa6\xfd\x1f\x9b\x11\xc8\x01\x10\xfb\xb4M\xfc\x8e\xa4Ƅ\xf1\x1bdK\xb7\xd3\xce\xee\xb2\vc\xb4;C\x9dxg\xf8\x05\xe4a9\xf2\xdc\xcc\xf6\x83oG2\xddJe\xf6EB\xa3lY\x17\x1d\a|\x1f\xbd\x10?\xfe)~\xfc`t\x892\xf2\x81*\x8bʹ\xff\xf2\xaaB\x16\xadF\x94\x12\xa4\xde\xe4\xd7\x1f?\x84\xcc\x13\xd1x?X\x89\x1f/\xe2\asp?\xe6%\x8d\xf7\x03\xfcGt4\xdd>щm\xc7\xfe\xfc\xdda\x98\xf2O\xa3\xd5\xca\bd\xf7y\xa0\xdb6\xfcӜ\x06\u0604`\xe7Y\xc02[\xc3\r\xc9Z\"\xad\bKQ\xfd$\xa5ȀK\x90\x9adK\xb1\xe1]\x81\bF\x80\x91Z\x1aa\xed\x9a\xfcZ̜\x8f\xe2\xc7\a\xf1\xc3\\^\xe8\xaaz\xf7n5\xae]\xafy\xbf\x8ar;CQpg(JF\xbd\xae]|\xee\xdc\xed<\xb7\xd5\xef4\xdd\xeex\xdf\xe5\x9e\xd1\x1b\xf8ҭ\xf1\xe2\u2e6c\xaa\xf0\xcb\nyY\x855\xc9\xc1/\x9f5\x91S^Ӹg\x84\x85ƴ\xe0\x12ɭ\x1f\xdda\x1e\xfc\x91i\xf0\x87f\xc1\x1f\x9a\x04\x9e9`M\x01v\xd4\xfd\xf8\xe1&\xe7\x17\x85\x9c\x87-ŏ\xbb:&\xb7\xaf\xdf,P:\xe2.k'B\x8e\xf3\xae\x9c\x1c\xea\x1c\xa6m\x11\xc6)L\x9d\xc1\xd4\tL\x1fHtti<\x81i\x1e\xbb\xc3h2\xdb\x1f\xe4n0\xbf\x93\xbf\x8a\xfa\xa6~\xf7\xf6\xef\x7f\xe3?$\xed\xbd_3n\xf5\x9f\x96\xff\xdbݶ)\xffwG\x83\xb7\xf7?\xaf/\xff\x8b\x19\xa1\xce\x012\xc1\xfd\x1e\b\xb8\xd34\x8d\xb2\x83\xe0\x97Ϛ\xa2\x86\xb2Kr7\x8b\x16\xc4\x00\xbd-\xc1?\xc1\xfa\x8f\x8b\xe8\xe9ە\xb1\xcd\xff_ߊ\xff\xd1\xe9\f\xde\xe2\xff\xbc\xea\xfa\x87\x19\xa1\x96=\xfb\xd2\xd6\xfc\xf7>\x1e\xc0\xde?\x14\xd1S\x18\x97U\xc0\xaf\x1b\nF\xf6\x1d\xb6%*\xe6<\x91\xe3\x94k\xfeMUh\xfbA\xbe\x0e\xae\xd6-D\x10·\xfdQ\xa2+\b\x01\xa7a\xac<\xc5Ry\x0e\x8a\xd5\u009c\xfc\xf1\x86\xb5~g\xec\xf0,\xcd_\xe2YE\x92\xec\x8e\x14K^\x92\xc7N\x1b\x91;\xdcz;,N\xe7Wk\xac\b\xbeZ\xb7\xbe¨\xb4\xa4\x83\x8dG\x02'&\xa6\xfa\xa4\xc5\xfdc\x13e\x15\xc4aE\xf5\x16i\xa1a\xde\x06\x8a\vTJ˲誹]\xe6UV\xb0\xed=r\xd8\xf4\x82\xfcw\xe9\x1a\xba\x98\xb3\xd6u\xfe\xf2\xbf\x81\xff/\x93\xc5w\xaf\xc7\xff;C\xcb\xff\xdfh\xf8\xa6\xff}U\xfe\xbfL\x16\x8a\xfdӏz\xee\x0f;\x84\xf9 \xdc\xfftN\xe30\x8e\x87s\x10\x92Uϱ\xf7qr\xd6\xfc\x8d\x1d\xa3\xaf\xdf^\x8b\xa5}\x92\x94뼌\xd2\x0f\xd1\xfcaY\xc0\x05$\xbea\x12\xd0\xcb<Ӽ\x8c\x89\xf4iA\x1e\x93|S\xb2\x9b\"\xe6\x171\xa3-\xd4|(\xadt&\x9a\a{̋N\xa9\xbb\x959\xcdDk\xb5\xdc\xcb\x00\x9a\xdb\xd2\x1f\x01N΄^\x81\x12\x05\xa7=\x9c1scGp\x93\x95F/\xc1/\x9f\xe95.x\xf0:\xce7Y\xc5]h\xf1\x06p\xe7N\x87\xe2Y$\xee\xaa\xc3@u\n\xd5X\x058\xc0\x16o\x81\xa8\xc5\xe5fE\xb9k\xc9\xe8+\xf6\x0fS\xe1\x9d\xfc<\x14{(Mf\xbf\xdf\\w\xfc\xc9\xf8\xff\xbf\xd6d\xf9j\xfc\xbf=\xea\xb5-\xf9\xbf\xf7v\xfe\x7fU\xfe\x0f3Bm\x00\xec˻\x03\xfc1f\xbf;\a'\x8bh\x93V\xff\xd8DiR\xbd\x80;\xc4\xc1\x1fb\xc5\xcc- \xb5\xa8\x92.\v\x91] \x82x\xdc\f\x1a\x9cQT\x8c\x99\x1fr[Eh\x97\x1eE\x10\x9c\x97\x8b\xf0\x81\xb2\xe9\xbfKK\xaar\xb3\x86\xee%\xb1\xabN&ت\xd8n\xeb\x7f\x9d}\xc3\xe5\xbf\xfd\xfc?\x1c\x9a\xeb\xbf\xdd~\x8b\xff\xf5\xaa\xeb\x7f\x9d\xa1\xe5O?jW?[\x98\x1fHYAp\xe0\x82\x94e\x92g\x01\xfa}N\x1eI\x1a\xfc-\xf8k\x0f#\xcfք\xc4N\xb4\xae\xbc\xa96`\xeaH\xfb\x1fe6[\x9a\xd5nʀt\xa6\xc3\xd0\v\xe5\x9b\xc2 l&\x1c\x06\x1f6\x8b\x05)\xa6y\x9e\x8a\xcc*\x05\xf9De\xa0\xd6\x17և\x91\xdc\x1e\xa9\xc1\xceC+\xa6\xb8\xdcG\x88r\x10\xeci(\x87\xc1tS\x85z\x9a$\xf6\x85\\\x98\r\xd5e\xbem\xf2u\xfe\xc3\xdc\xf5\xcf\xff\x8f>\xc5z_n\x16\x8b\xe49*\x8a\xe8\xe5\x15\xf8\x7f\xa7o\xc6\x7f\xef\x0e\xdbo\xf1\x9f^\x8b\xff\x9b3B\xed\x03Z\xa2\xd3\rxA\x96\xe4y-\x9c\xc1\xb13\xa4d#\x8e /\x14\xa3E\xc5#\x9d'\xeb\xceW9\x1a\x8b悘\x99\a\xefË\xf0k\xab\xc5C\xe1Ш\n\x13f\x8d\xeb\x86s\xff\xaei\x1a\x962\xb2J\x92U\xa5\x89\xc7\xdd{\x962\xe8\x1d\x7f\x88\x11\x16\xa4ܤ\x15;\x87\xb7\xcc\\gI\x16\x1f\xa5)\xfd\n\x8b`\x8f\xf5ػk\xfa\xc7AC\xa3\x02^T\xb5\xa8\x98\x8c*\xefn\xa8dmo\x03B+HIfD|a\xe9K\";F\x0f5\xc3\xc0\xa5\x00\xef\a\x8f\f\xa1eb\xa4ɜ\b\x9c\x7fIJ\xe5\xdbi\xff\xcf\xfb\x8f\xee\xd5Y\x94\xbe\x8f\xee\x92oU\xc66\xff\x9f\xa3\xb6\x15\xffa\xd0~\xd3\xff\xbe\x1a\xffW3B\xb1~\xfa!Y\xbe@Y\xe6Q1\xbfG\xfc\x7f\x93\x95т\xc8\x03\xc1Q\xb1,\xe1U\xdcm\xf6\x90\xe5O\x19\x15\xec;\xfdQ\x7f\xdc\x1b\xf6\xc7\n\t\xccH\x7fH\xa8\"\xb63\x12\xb6\xc0\x90z\x03\xbf\x84\xb3\xf3\x9759\fNS\xb2\n\xf6\xd8\xef\x19\xf0\x1b\xf1qN2jʴ\xae\xd0K#I\xa4\x15\xc4<\x84@^@\x85`w\b\uf8920f\x15\xc5q\x80o\xb7>\x80\x18+j\xa4\x12\xab\xfb\x93\xa4\b\x8e\xef\xa3\f\xfe\xa2\xb7\x02\xec\x1d\v\xb7\x05\xe4_F$\x06\x16(\x8dB\xf8\xc1味z\xf80\xf3\xec\ae\xb2\xccH\xcc\xfd\xf8?O\xcak\xb2\xe4\x1f/\xf8\xe3\xf9G\x16\x00`?x\xe1\xbfZZY\xfc\x00\xf0\xb5\xcb@\x16\x8cfk\xb9C\x00\xdc1L\x0e\xb0\xbb\xa5\xad\xa3]D\xcf\xc7\xf4\xf3oA\xafc\x81\xa0>\x10\x15V\a\xd09\xd0;\x9aGk\a}7nJ2\an߅\xfb\xc1\x818t!\x1e;\x10G\x0eDOE\xbb.Tw=;\x0eTW5\xe5܄\xe9*粈u+\xe6\xb0\x1aFH\xa9_nh\xee\xab\x15&\xb25^`\xe2T\xbfʳ\x898'\x8a2O@\xb4a,\x84]7\xc3i\xd1\x0ec\xc0r\xcf\xe8\xbdrEKCs\x927:\x87\xd79ϝ\xeeX6}h\xc0\x86}\t\x92\xf3\xe4xS\x14$\xab\xa6\xc7\xe7\xd9MtwA\x1fTj\x1f\x7f\v\xfa݃\xfe\xc1p\xd4\x1d\xab\x90)\x8b\x05\x99W\xc9#9K\xf3\xa8\xba&K#\xae0}\x1ak\xb5\x95\xf6\xb5\xe8Y\xbb\xdd\xf2&\xfa\xec\xf6\xf2\xf8\xe4\xe8\xe6\xe8ףb9\xc9\x16\xda\xd3+\f;O\x1e\t\x87\x0f]\xf0\x92\x13\xbe\x88\xd6%^z\x12g\x92\xa54\x9e\x04\x9a:\x12v\x9eϣԠб\xb0\xae\xd6$\x83\xd9\x17\x9f\x90\x05)xe\xfa\x16ڬ\x8a\xe6\x0fWw\xff\"\xf3\xaa\xc4s_\"\xfcTDk\x9e[\xae!ڷ\xbd\xae\x1c\xb2\x9e\x06@c\xd9\xd7\x00G\xc5\xf2\x9a,Km\x90\xc1B_\xa2\x1f\xa0\xa80gi\xb4\xd4ٗH=*W\n\x03\xb7\x89\xa7ͦ\xf4<\x82q\xba&\xceM\xbe>+\xe0\xbd.B\xea\xa0\xd2''vٓ\x93KP{\xa4\x02\x01\x8f\x1bM" +
+	"\x81\xaeZ\x93B\xc1\xbb=\x1d\xe1\xd7\xe8./ć6j\x1c\\\xae\xe6K\x18]TF\xd7\xc2y\xc9\xe6ӂ\x90\xd5\x1aQ2\v\xe2d\xee\xe0ٌD\xea\x9bHy\x91\x83\xc0\x85\xe8\f\f\x94\x98\xdcm\x96\xc7Q\x9a\xfe\xd8UHC\x03i9\xff\xb0\xbc\x88\x8a\x87\x9f\xf2\xe2\x017\x7fd\xe2\xe5\xe49A\x85\x8d-\xf82W\xd0\x03\v\xba\x8e\xb2d\x8ez\xcf\xe8\xfe_\xef\xa3,N\xc9\x11t\xd0\xe9#\xc9pG\x9b=\xbd\xd2;\xb9c\xf6\xf2*/HYi\xbd\xd71\xfbxe\xf4]\xc7\xec_Zߧ\"Z#\x1c\xb3\x83\x8b\xaa\xfd+nu\xc7\xec\xdcb\x93\x1d\xa7$\xca6\xeb\x12a\x1d\xd8XgI\x16\xa5pɋ\xf1\xc66^\x95\xacȯ\xab(\xc9\x10\x9a9Re\xb24:\xbbkvv\xf9RVde\xf4Q\xb7\xe3\xc7\xfa\xb5|J\xaa\xf9=B\x16\x11\xa5!ez|\xf4a\xd2\x0e\x17z\xd4\x17.C\x9ax\x13.\xf2z\xd1U\x1dn\xa2\xbb\x0f\x9b\xf9\x03\xa9\xf8>\xd0o\x1f\f\xd1:\xb77\xd9I\xc6\xee\xe9ţ\x8b\xabM\x85\x13\xd4f+\xb2S\xdf\a\xfc\xa8N\xb7\x10\a\xc6\xe5f51\xcf\xf9\x1a\xf4jSy\xc1\x00\xdbB\x7f\x92QуG\x0fr\xe3\\m\xaa\xedH\x93\xf2ǨH\xa28\x99\x1b\xee#\x10\xceN\xc2\xfbǼ\xd3\x196\xd9\xc2\x0f\xda8˸Ѯ\x8f\xb3tۻ\t\n\x90\xa5Q\xbdĶ0\xb9\x89\xee\xa4\xd1\a\xcc:\xd0\xf7\xf0\xc9Ǧ\x0e\x96\"hte\xf6P\xe4\x10fZ\xf0K\xe7\xb3u\"\x02\x92\x8e\x98\xc0\x90\fT\xae\x16\v\x87L\xc5m\x12Iu\x9fK\xa7m4R\x1b\xfc\xe7j\xb1\xa0\xf5\bx~)\xb9L\xb2Jl\xb3]\x94\xd6\x19\x8a\xd4>JU[\xfb\x00\xa5\xaa}}\x88R\xa5L\xd7\x13U\xcb\xf8F\xff!\xa9V\xd1:\xf8\xa5\xfbYwKf 0\x0f;I`\xe8\xe7\f\x9c\x8f\x12\x87OKY\x01%U\x1c\xa0T.߉&\xb7U\xe5\x18dƘ\x91\xb8\xeb\x89\xe6\xf7$\xd83\xa04\xf50\xb8<\x8eJ\xc2\rk\xd8\xcf_:\x9f\xf5\xb1\xff\xddM\x9e\x91\xe5e\x80\xc1\xac\xe0P\x87\xc1iV\x15\t#\xe5\xca\x04\xe0\x97:\xaa\x14\x01q.E\xf9\x98\xaf\xc5\xc3`REw\xe8\x18\xaeӲ9\xdf\xf4a9\x05\x9d\x03̣\xc3\xe0\x82N0Ч\xf2\xb9\x86\xf5Ĉ\x06e_\f\xd9\xe0`\x06\xdaNlc\x92=Fi\x12\x8b\xf1k\x1b\xe9\xfa\xc9_\f.E\xd6\xe6\x1a\xa4x=,\x00\xf0$)ȼ\x9a\xe0\xb9ғ\xeb\xe3br\tb\xb0\xb6+C\xd8*1\xa9:(\xf1\xb8*Rz\xb6\xe0\x81\xb3a:v\a\a\x83~\xb7\xd3\xeduG\xbdq\xa7\xdf\xe9\xf7Q\x86\x8fE\xbeY\xcfҜ\x8a\xddc'\xe0CR\x95X\xb6\xbb\x88\xd6\xc0V.\xc0!\xfe\x94n\xcfh1_\x80\x98\x1e\xd3\xe6\xd0s\xa2NT\x80~ /x\xad_D\xeb\x8b\xe8\x19𩖞JBc\x1d\xf8\x03yq\xc3.\t\x89\x7f /\xb7\xeb8\xaa\b\x12\x9f/\xa2\xb5=\xb7\xa0\xdc=\xfb\x14KۊY&)\xd8c]\xfd\xfc\xb5/fqKMs\xd6M\xc0\x1ae\xd2\x0f䥼Z,\xb4\x04\xeeKE\xad\xbb\x94\xact$HqaiHpD(9?W\v\x817\xb5\x15h}a\xee\x9c\x12K\x1f</\x1a\x1a\xab\xad8PQ/\xd2N+\xee\"z\x9eV\x05X\xe4\xcb\xe1\x16\x82\xd2E\xcd^sQ\xa9\xcd\xe60\x98,\xb2\xe0\x86<W\xf4\xe3F}H\x87\xe4\xdcE\x12\xd0a\xc5\xecq\xa3F\xfe\x868Z\x81W\xaf\xa8\x8a\x8e!j!\x89\xc3|\xb1`\xf5}\xba\x7f\xa1\x0f\x96d\xec6|[\xa4\xf2\x89\fN\x84Iy\xfa\xccn\x92\x8d>c\xe0OQy\x13-\x9d\xa0Iy\xba\xba#q\xec\xc9\t\x17e 8e\x95*?\xe4\xde5\xab\x96\x8e;)?\xa4Q\xf6\xe0\xa4\xe3\f\x9f\xc7@\xacf\x9a\xa5\x13\x1f\x84\x80\xceJ\xe4\x1e\x17\x88d*\xa0`\xb4\x94\xbf\to>\xd7%\x12\xde&\xfa\xc9J\x17dr\x1e\x8ctm\xa8BZƷ\xa8J\x9e\xb95+Tj\xa2\x82N\xbdfej\xe8N@G\x89X\xdfTS\x8b\bp\xd7\x00\xd3uŴo\x98\xcbM\x91\xaa\x83\xf1B\x9a\xbbc\xc0oi\xcdh\xc5Ц\xa2\x89\x85\x1b\xde\xd5<\x1fk\x83\xdc\x0f\x84\x99̴*\xea\x94w\xc8GnU|\xc1J\xbd>\xfb\xf5\xe4\xea\xf2\x14\xfe\xce*\xc6{\xdb\bv\xfa\xf3\xa7\xa3\xdb\xd9\xcd\xe9\tF\xe8!\x84\x8b\xc9l6\xb9\xfc\xf8\xeb\xf4\xe8rr\x8c\xfb\xe9\xfa\x8c\xa7\xa1\x8c]\x04\xbc>=:\xf9'\x06\n\x96/\x93\xd4\xf6\x7f\r\r\xa3\x92\xed\x03\xf4\x0et\xd1~\xe0S\x10^\x93\xf9\xa3\xbe\xa5K\xcad\t\xea2$w\x97\xc1/\a\x9f\x11_\xce#H\xea\f>\xb3}\xf7\x10z\x9f\xe1\x18\xaa\xcbkRm\x8alRN\xab\u0094Q\x917KV^+8٬\xd6a\xcbN\x9fd\x15\xfb}\x14\xc7EX\x90%\x1f\xa3b\x89\xf7\"{\x95\xf0\x97,$3d\x17ٿ\xec\xfeDL%!L\xd3\xd4f\x93I\xa2\xee8\x9d\xe8\xb2\xf8\x90,\xe5\xe1\x18%_\xe6\xe5:M\xaa\x0f\x90\xf9o\xc1\xb8\xdd֠\xb3\x15(Q4\xb9\x80\x89Z\xb2\x19}\x94\xbe\x99\xab\x13\xc8@4\x8f&\x9f%$\xb5\xb7\x17v\x8e\xe1\xb2\x01\xf3\xdfe\xdf)!\x02\xe0\xf6\xd0Ţ\x11\x8aGK\x8d\xaa\xb2M*\xa6d@(FT\xcd\xda쮁\xbf\xb1\x95\x9d4\tK\xa77\\K\xa9\xd8\x0fM9}\xae\x8ahVE\x05\xfb\xc43\x8a&|<\x86s\xc7UvBVQ\x16K$%\xd0\xd2\x14h\x9c\x02\nc\x03\xfa\xed>\x84\xa2\xbc\xd7d\xb9I\xa3€\xf2\xe2E\xd2\xd0\x1br\x9b\xcd\xe95\x81*_\xb4\x9c\xc9\a\x81\xc6Wo\x8ahN`\xb9\x9d\xe4UL\xffG\xe7\x8c\r?\xcd\xe2\xa3%m\xf3\xa0\xe7\x02\xce\xc8o\x148\xb0\x80\xe7\xc9*\xa94\x1d\xa2\x04]D\xcf'd]\xdd\xe3\xd3/\x06\xc2\xdd&\xa8\xcc:\x16\x90Mқ<?\x8f\x8a%e\x9e\x03\x1bi\xb6&\xf3$J\xe9\xea\xb0\v\x87\x91\xacD\x9b\xfa\xa2\x93Ф\x84q\xf8UɥӪ`r\x94L\xd1\x14\x0f\xac\xbb\xe9\x7f\x0f\x83\xa34Yf\xbf\n\xe7\x17t\xea\xeaI\xb0:\x7f\xa5\xff=\fN\x7f\xdbD\xa9G\x167\x04\x02Xk\x87\xc1\xc7c\xba\xbd\xef\xb1\xf7,\x10R\\ʈ\xb0\xcd\xe57\xf7I\x89\xb4\x12\"\xd6-]+Pd\xc8\xfe\x18\x90OQ\xc9e\"M\xc4e@^\x83\xd2\r\x9d\x94h\xf9\xb8Q>ΙF\xec\x8e,\x13ya\xbd\x1f\x90,V\x8c\xdc0Kb\x19\xcf-\xab\x1d\x96\xce.\xc3BSQǁ\x8c\xe9\x87\xf2\x97\x01\x17\x8b$\xa4\xfe\xff\xd9o\a\x19.\xf2\xbbJP\xfc\a\x10ԗ\x81\xc6\x0f\b\x80\xc3\x7f\xba;V q1\xc5,L\xec7\xb4,\xf1a \xc9;\x7f@\x92\x1f\x8e\x8e\x118ⷁ\"\xb4\x8f\x80\"~\x9b\xe3\x8d\xd5\ra\xcb\xd0Ι\x95g:7]\xbf,+\r\xab\xc29\xbej\xd18\xc1\xe2t\xc1u'T\xd3\xca~\x1b\x88>\x95\t_\x0e\xf4\xe0\xe7\x1a\xe0\x13mS\t\xf1\xfe\x05\bGeI\x8a\xcaPj)\x00\xd7g\xb9\x95\x97\xc7Qv\x16%)]&\xbf[4\x9bi\xb2\f|M\x89\xe5\x829\xf5W\x86֊\xedD\x94o\x84FLx\xdeC\x92\xbc\xb5\x91\xdc&J\xeb9\u0089J\xedy\x80\x93ѕf\x1b\xa7\xa3\x1b\xcd\x0eN\x97\xba\xcf1N]W\xf2H\xd0\x11\xc72\xb1\x9eѼ\x1b\xf6\xa5\xfd\xb3Z\xeb\xd2\r;\x92:\xd89{\xae\xddJ\xfc\xac\x7f^䋅d\xfa\xf6\x1d}\xa8\xf1\x93VP3?uĺ\t\xcd\x1b\xac\x0eMׄ^\x88u\xb4\x87\x056\xbc\v\xf0\xbe\x1f~T\xb1\xb9\xf1\xb7\xe0\xaf\x03\a\x16U\x020\xebq\v\xc6~\xf2\x17\v\x16\x14\x9d\xd4DX\x9aے\xb8\x94\xab!\x18R\x05̞\xea\xddQ1\xbf?\x8bVI\xcam\x97\xb0\xfa\xf9\xbfH\x91\xff\x18\xa5\xfcn\xa9\xd3\xee\xf6\xff\xf2U\xec\xffXѯ`\xff\a\xb1\xbe\xcd\xf7\xdf\xddng\xf8f\xff\xf7\xca\xf6\x7flF\xa0W\xe0\xfc\x9b[\xed]\x9c\f\xfb\x81>S\xb1Z\xe0\xe8\xfa\u0086v\x10ԕ[\xae!\x05q\x16\xc1-\x045\x00:$|H\x96\xa7Y\x9cD 5/\xa2\xb4$\xc6c\x9e\xe9\xfdK9\x8d\x96D\xbb\xa0\xe5wtWG\xd7ǟ\x82\xbf\x05\xdfG\xabxؗ\x96\x8c\x93\xdexhW\xa5\xa7\xddU\xd1]\x1a\xb3\xe6I\t\xb9\xf0]By\x04TqO\xf0\xa4u\xafk \x16++a\xd8w$\xdd\x11+\xd1L:\xcf\xf3lif\xbeH֥\x9d\xe2\xc2\x1a\xf6S\xe2J\xb5\xea,\x93]\xf8f\xdat=\xb7\x12\x86}G\x92\x99\xf1:)珎$3\xf3\xacwжS\x9e\x8d\xa4uT\xcc\x1dI&\xb1\x9f\xa2R\x1b\x8f\xf3\xab\xabˏ\xae)\xac.:&ә\r\x1e`\xb0+\xbf\xba\xf6I2j/\xc4'i[\xa9\x0e\xe9\xdb\xfa\xcdJ\xd3'N\x8f]Ĥ\x04\x02\x876v\xb53\xec\xa34e\xa9ƒ\xae'\xb3\xe3\x1f]\x84\x94\xb6\xa5w\xd0\xfeن\x1fhZ\x1ak!\xfct4sq\x83\xf6\xdbˀ\xd7\xfe'\xb9=7\xd3y_\xbe\x94\xff\xe1\xfd\xbfӷ\xde\x7f\xf5\xba\x83\xce\xdb\xfe\xff\xca\xfb?\x9a\x11\xe8\t؋x\a\xf5\xa1|\x8aֽn\xf8,\x9c\x93ʫ\n\x05\xa6\xfe\x96\xd8\xc9C\x9d@(\xf8drs\x9aEw)R\x9d\x82S\xac\x93\xc9\xcdL<\xbd\xd4\xc4\u07ba\x9d\x9b\x11LJ w2\xb9\x91:\xf4\xd3L\xa6`U\xccGR\x81y!)\xa6\xc7\xd6y\\\xc2fS',\xcd\xcbMA\xa6U\x11Z\xa6_\xa6\b@\xf3\x9c\x93(N\xb2%\b\xed\xa5\xd6\x19\xea\b\x8eQơ\b\x8b\xa7!dZV\x1e\xf0GA\xcd\\\xbe\xfd\x83_\x9bU\x93\xec\x13\x89\xd6\xf6\xf3^qv\xbb\xcaHI\r\xd0\xec\x1a;\xb7 \x9aiZ\x90\x05\xa9\xe6\xf7a\x14ǅ\xd4e\x19\xc0YU\x10\xd0\xfb\x9aHu{\aM\xfd\xb8\x89\x8a\xf8b\x93V\xc9:MH\x81ʽ)\xa2$\x15\xfd\xa7MGՃ\x1a\x8eg\x184\x1c\xa3G\xff7\xf0\xff2\x01\ap\x8b\x14\x8c\x05\xfe\xc3\xfc\xbf\xdd6\xfd?\xf6\xfa\x9d\xb7\xf7_\xaf\xcd\xff\xf1\x8c@\x1b\x80\x96\xca\xdd\xf5\x83I\xcdVo\x012>}\xbe\x01_\x84y\xf8@^\xa4\x99\xc0\"c\x9a\x7ff\xc4 \x14n2\xf2T\xc0^Ki\xe9\f\xb6\x1f\x94\xf7Q!\f\n\xecR@\xbb\xbbSI\xff\xcf_\xe7\xf0b\xe6\x9a>\xc05\xe9\xc59l\x0f\xe1<\xd8\x03k\xee\xfd`\a\xc2&\xa9\xb3\xbcX\x12\xd0\x1f\xd1\xea\xa3*\xea\x11\xf6h5D\x9f\xf2\xe7W\x82\xf6!\xf8%`\xf4\x0f\x83\x99\xea\x06\xa1\x00\x85:n\x1d\x97\xef\xde\xfe\xfd/\xfe'W;L\x98\xf2=\x9dK\xe5\x7f\x94\xffw:\x83~\xdb\xe2\xff\xdd7\xff?\xaf\xcd\xff\xf1\x8cP\xfc_|s=\xdd\xea.Yn\xf2M9#)\x99Wy\x11\x88Ǐ\xeaIc\x14\x9f\x90y*\x00\x1d\xf4\xb43\x8aO\xf2\x8a\xfdo\xf6\x92Uѳ\xcc=B8\x13\xfa\xb6\x98\xdeMX\xef:\xa3\x98݀\xe7\v\x9d\xc0\xc1\x18\xa1\x80\xad\x8f\x00h\xa5\x83\x1e\xe2\a\xf2\xf2\x94\x17\xb1\xac\x1ez`\n6s\xf4*\x97[#r\x94\xeeHØ>P[\x86\xc0z\xcaY\xe4\x0f$c\x95\x0f̷\xa3\xc7Q\x96\x81 \xbe`W\x9e\xd4\xe0Hv\x90|\xa4\x99\xc7ؾ)\x84o\xafi\xf1I\xf2\xf8\xe1\x05\x84WA\xa7/\xaby\xb2Y\xa7\xc9<\xaa\b\xb5Ӗ\r\xedYpm\x9c\xda\x0e0=\x83)\n}\v\x85\xddVf\xb1\xdec=\xbb\xa8\xf3莨\xb2\xbava\xe7\t7\xd2\x11]7r\xa1\x80\x91\xaf@\xb0i\x18\xb5P\xef\r\xe8\xa0\x1c_\x93\x8c\x1a\xa1\x98\xefu\x01\\\x96\xc9]*n7ŤBO\x13\xe6\xf9j\x1d\x15p\xbe\xbc\x88֨\x16ݱ\x81Sq:p\xaa\x118=ö\xfch\xbd&\x99\xac\xc7\xd8\x02\xc3%6\u0600\x88\xfcC\x13A\xaff\xdf\x00\xd3i*{a`@A\xa0Q\x031\xb2\xa0kY/\x93.\x88VF˺&JJ\"\xc9\x13:\xfd\xb1\x05\xceՌ\x1c[5c\x0fu%ܪ[\xae:\xadӶsge5\xc9\x125_{.\x8c\x1b\xb9\xd2\xf0S9\x8c\x01B\x97l\x81\x8d\xf0H\n\xeeo\x8a\xf5\xfe\xd0BY\xcb\xd916\x81\xb0\xe2\x8e_\xe6)Z\x96\x16Ƃ\xa8\x1e\xec\x1eX\xe0\x94T\xaa\v\xcd\x0e\x96\xfcUb\x98\xf4O\x9f\xd7\x05\x7f\x99\"\xab`v\xf4\xc7\\\xf5\xa1\xf9.\x822Hc\x16\x98e\x80\xa71Y\x01\xb3\x05\xcc6Vp\xf6\xae\r\xa5&5\xa8\x87\aV\x15\xb2\xa4\xd2:\xf1\xc0\x81\x80y[υ0Kd\x1d{c\v^VQ6'\xfaP\xf5\xad\x8a\xe4U$i\x98C=\xa9H\xf1#Z\fm\xb3\x10\xb4\xc2\x0fL\xca\xe7j\x1a\x8f:6L\xe7\x94\a6\x82\xd6\xc9C\xb3\x93/\xa2$\xc3\xfdc5\xec\"zP\x9dk\xe5\xa6\\\x16摚\xa6\x03'\x0e\xac\xb5iTD+\xb5\xd9\xf5\xcdEy\x91d\x17\xd1\xf3՚\x14\x11Z\xdd֘\x8bm_\xd6\xcal\xf4\xf4ay\xabx\x8b\xb9n\xa7yY黝9\\Ӫ\xa0Ɲr<\xcd\x1a\\Gْh\xadn\x9bu\xb8&\x8au\x1c\xf4,\xe0\x9c$\x8f\xb2\x86\x83\xb1\rWR\x8b\xd9\xe7L\xdc\xd2\xf7\U000f1163:p`Vmv\x9f,\xf8+S\x812t\xa1\x18\x0310Y\x03\xb5\xc2½00\x87\x9eل\xa1\t<\xb40\xa8\xe8F\x9d\x0fp\x94\xbf\x9as\x9c\vI\x8a\r\x1d8\x10\xf4\xd5\xd9u`\x98\x8c\xcel2\rz\xae\xe6f\xc7\x02\x83e\xc7Q\xac\xe6e\xaf\xe7D\xa1\xbd\xa2\x90\xfa~$j\xc1(\v\x1c\xb8\x11\x99\x85\xb3Ĳk\r\xe2\xa2k/\x92\xa3\xf5\xf7\xcdj=ɪ\xfcC\x9a\xcf\x1fT\x17\xf51\x1cB:hkB\xc9)\x17I\xb9\x8a\xaa\xf9=\x89\riׁ\x01\xfd,\xd7w\x1f]g\x96\xeb4\x9a\x93\xf8CA\"U\x85N\xdbB\xa0\x9bn\x11%\x197C\x96\xed\xee\xbaP\xab$۠Eбp\u03a24\xad\xee\x8b|\xb3D\x03o\x932\x04R\x9b\x8ed^\xaaB}\x84\x04z1ʆ\xaf\n]\xec\x1c\r\r,\xd8l>\xe4\xb1\x14\nz#\x03\xc1b\xe8C\x1b\x01\v\xbe\x1d\x03̞\x1d(\xb6\x84\x1a\xfbL\x1c\xebQ5\x03\x14\xec?F)\xdbί\xa4\xe4\xa7\xc4\xf3\xcb\xfc\x92<\xe1]\xec@A2\xbaɀHl2\x8d\x0eB\xbaܬH\x91\xcc'\xd9\xfc\x84\xcc\xe54\x19#\f\xba.\\d\xfa\bI\xbc\x19\xb7\x04\x9c\x11\"U\x1d}$\x19\x94\xa6\ty\xbd!\xc6\xc0\xa0\xee\x00C2\xcc\xd6\xd4\xe4\xe3\r\x80ղH\xf3'\x89 kw\xb5\xa9\xae\x16\xb3y\xbe&\\y'\a\xa2\x8f\xa3\xa7\x94ɿ\t\x93\xec\xd1P\xa8G3dM\xa2\x8a\x18Gg\xf5t\xe3\t\xfc~\xd0}\x19\x9f\x1d\x0f:\x06\x02\xedK\x90\xa1\xe6jM\"\xc3uR\xaa\xda\xc9\xc4<\xbf\x882\xf0\xf4\xb4A\xeb\x18\x83/ɓژ\x15\xa0\xd8dp\xe8\x8a\xe9\x03\x19\x99Q\x15\xf6\xb2&\xd2P].!e\f\x99\xc1\x9d\x10)\xe9-\x1e;F\xeaBe߉i\xcc\x11\x8d\x1e\xcd\xef@R;\xe5m\x06*R\x12\x7f\xd8$i\x95Ȳ\xc6Ȗ/&\xf3\x14\x14\xab\xec\xccHb\xcc\xfdF=\x1bO\xe7#\x9d\x03\x1bC\xa30\xc0\xf0E\x92\x91X-\xbb>\x02\x8agt\x16o\x18\xdb8Z\x01\xd8\xecQܬ\x9e\x91\xa8\xda\x14hE\xa0\x82\xe8V\x82\xc5Ͷ\x01\xbbLR$X\xa9-\xf46۔D\x17\x03\xdbm\x1d\xa8+C\xc6:\xd0`\xc0]\x1dʖ\x92\x92\x1b\xbbFnM\xb2\x96s\xf2\xa7\"\xcf\xe0\xe5\x94&\xe8t\xbaC\x1dN牎2\xd20X\xe1:F\xbb\xa7\xa1p\x01E\xc7\xe9\x8d\xfe\xf4\xda\xf5$" +
+	"\xff\xf6el\xb9\xff\x1b\xb5\xcd\xf8?\xed\xd1\xe0\xcd\xfe\xe3\xd5\xf4\xbf\xb9R\xf6\xc2o\xe6\xe4O\xc6*\xc0Q\xc3I\x14\x03\x80>4\xa3\x1ep\xd9U\xd7\xef(\xd3l\x1ee\x99\x96\v\x87=\xb8\xcd\nE\x83f\xc6y\x99\x8f_\x94\x95&P\xecy\xc0\xfc\xdfjy\xa86\t\xe3\xd3\x04D\x99{\x8f[\xbf\xd0 h\xc2\x1fzY\xcc\x03\xe9\n\xfe\xa9H\xaa\x8ad\xcc\xc1-\xbafl\xa1\xdc\xccg\xb9\x87\xc6~p\xb7YH\xff\xbc\x8d\xe8]zI\xf1|\xf5t\xa8\xddLRΣ\"\xe6DX\xda\xe9ՙp\x8fL?\x8b\x82\xf6G<M\xd6\xc4\x00\\\xe6\xd3\"_\u009ej\x00f\xf7yQ\xb1\xf6\xba \xb48\x03@\xf7!2\a\xdf\xe9\xaa\x02\xccT\x05^\xc0\xb1\xa6\x85\x85\xddF\x96\xc0ǒ⒘\xa5\xa9X\xd5b\xe2\\\xb2L\xeaVY\xc3g\xb4µ\x1a\x86\x8c=\x82\xb4\xba\x9fJ\xbc\xa2J\xf4O\x19\xbc{\xf7\x0e\x93!\x05\xc6e\x1dL\x87\x83㲔\x96\xecz\xf1&\x9ei(\x04>\a\x1fA\x80\xc1\xc5B4y\x0f#\xa9\xac3\xa6\xf02\xbaJˋ\xfamOC\x17T\xf25[\x0e2;k\rK\x14qKP\xe9~s\xa0P\xab%oh\x93\xceuf<\xaadV\xad+\x1aS\x99\x11\xf2\x10\xe6\xecq.\uf2a7{\x92͉\xf2\x81\xc0W\x88\xa2\x01S\x1e\xb8\xd4\x1e\xfc\x10\x13\x8f~p\xaa\xe2\x19\xbd\x04\x8b^X'\xda\xeby\t\xe7SLs\xc6]\xd3\x04\x9cQ\xe3J~\x8c\x9f\x92ꞅ\x1dP\xf4\xb4`\r\xaa\xfauc\x87\x1a\xc9Gn\x97:\x8b\x9c\xfe:k\x18uu\xa6y\xa0\x85,\xb8\x9b\xe4\xb8b.h\xa5S\xbc\xea\x9cDe\x15\x16\x0eκ\x1f\xac\x12\xe1*\xdd\xd1\x02\x19s\xc7\xda\x12\x04\x03a\x80\xdfQqg\x9b4u\x96\xb5\xa5\b\x98\x8f\xdeb\x00\xa8\x15\xa7e\xabɂQi\x17{\x8b\xa0Pg\x11\x14\xe2-G\xe4\xf3\x94\xe7\xcf\xe2\x8di\xb4\x95\xe9\xea9\x8f*#os֠\x13:+\xf2\x95A\n\x92\xf0$sl\x9c\x92\xc6&s\x8b6\x00\x00\xa6Q\x04\xc5&\xa3ޒ\xffM\xbc\x15\xd9d\x0eQG\x91\x16\xa2\x0e\xa7\xa9\x89-\x1a\xfb\xae[\xca\x1ab\xf3=\xce\xca\xf8%\x1c\xd4Y\xfa\x17\xf0r\xb3*\xf2M\xe9\xb0\xefù\xda\xc0\x0eJ\a\xa2v#T\x1e/\xc8\x03w\xe4\x8b\xf5\x1a\x90|\xaa{\xa3\x83$\xfa\xfc\x1d\xd9\xc7Z\xebe\xa7\xde\xfa]\xb9vH\xb2\xa5[\x80e\xb0\xb0\x94\xe6^\xce\xf9͌R\t\xb1\xe4%!J\x18\x02\x93\x9bI\xb8\x99\x83\x9b1X\f\x81V\x01W\xf9I\t\xa95\x95ǅX\xf4\x1b\xf1\a!+\x99\x99wg\x10\x8c\xd2MnR\xba\xc9CՏu\xdc\x01\xf6\xff\xba5\t\xf0V\x00K;\xbck\xb2\x14\x19\xfe\x1c\x86\x83\xae_\xcf>)\x10\xa9\x9c\xb93e\xd6\xd3[H\x17b{\x14\xb1\x8d\xbc\xe5;\xb0\xbe{\xfb\xf7?\xe3_\x92\xbf_\x94\xaf\xab\xff\x19t\xfa\x1d3\xfe\xf3[\xfc\xffW\xd4\xff\xbc_ {\xbfE\xa9\xa2{\xc0s \x11\xdb\xe9$)أy\xc5Y\xc1g;\xec\xd3gIJ]ыM\xf1\x909F\t\x85\xdf\x16\xcd\xe5\x1c\xf3a\x1b\x82\xa3\x8b\x94\\\xe41\xf9\xddPW\x18\xea\x85\xd3礬\x8c4\xe1\xba\xd3TjT.\xe4))V\t\v\x11\xa7\xc5\xff\x9c\xa1\x96\x80'\xfb0\xe3\x9e\x03\xd96\a\xd53wyH\xf3\xa9\x9b\x0e\x99|&\x19\xb7`\xdb,\x1c}\x15U\xae\x9e\u0084!\x1dw\xaeօ\x17y|\x93\xd0^\x841y\a\xbfi\"\xeeH\xab\xa3\xb1\xb0u\x18\xcc^ʰ\x85\n\x90\x9b\x9a(\xfc&\x17c\x1c&P\x17\x91ޒc\x8fj{!B\x7f\xc8\a`\xa1Hv\x98,\x9a\b\xb8q.(w\xf8\xe4ŀ1E-\xb7\x11\x8c9&ZJc\n\xcaV\xc6I!\x9bfԕa\x8a\x1e0\xfbCC\xfd\x98\xe6w\xe1\xa2|)\x83\xb3\xd9~\xb0\x8e\xaa\x8a\x14\x99\x9aG\xec\x96\x1c\xfcyI\x0f\x89\xa6\xe4\x04\x14\xb4\xe9x6;dd-j\x1a\x15$8\x9e\x970\xc3d-쩌g\x9d\xbc\xfe\x85X\xe4\xcc\x14Q\x0e)\xdct\x8cz\xa3~g\xac.\xe0\x00p|\x1f\x15'\xe41\x99\x13\x8c\xdbm\x1f\x8c:ʆ\vRm\xa4\xe1\xa8\xd3\x1e\x8f\x87\x1a9\xf0L\x87\xe9XA\x82\x00p\xfa<O7%\xd8\xcc \xd4Ao8\x1e\xb5\x0f:Z\xa1\x93\xa2`3F\xc3\xec\xf6\xbbc\x8d \xf5AFա\b\xad\xd7\x1b\f\xfa\xfd\x9eF\x0f\xe6\x97F\n\xd9\x19\xe41\x99\x91j\x99h\x9d\xd6\xef\x1c\xf4{\xed\xbe\x81\xb4ёƽ\xf1x\xd8\xd6j4\xcb\xc1q\xbc\xd6\xfd\xc3\xd1h\xd4\xed\f5\xac*\x99?\xbc\xe8\x83\xd4\x1f\x0fF:\xd2\xcb*M\xb2\a\r\xab\xd7\ag\x1b]\xad\xc8\x1b\x02\xdc=*4z\xdd\xe1\xb8\xdf\x1b\xf4\a\x1aś\x17\xbd\xa7\xba\xfdvg4\xec\xf5\xba\xc2`\x19l\xb4e<M\x10\x94\xaf֒\xf9\x00L~\xc8g+HO%\xf2\xda\xd1=m\x8c\xdb\fb\x1c\xd8\xfa(\x85\x01\x1c1\xdfT:\xc7\x00\x8e\f\x8cƳ.~\xf9,V\xff\xbe\xad\xe69I\nkM\nz\xdb\xc8\xfcn\xd0ѷ\r\xf8D\xb4\xf8\xb1\xd2IE)\xab\x92\x94\xf8\x9b\xa1+\xd4dѐ\xc9\xd9\x06J\xad\x8e\b.\xfa<\xc9\x1e|E\xeb\xcc\b\x15\r\x99\xac\xa2\x19\x93\xaa\xe7M\x87\xaa̺\xa2\xb8\xd00{H p#\xde\xf1!\t\xb8\v\x9e)\xb3\x9d\x98#?\xd0G\x95Հ\xd9\xf6\xfa\x8b\x9e\x9bmЦ\x00\x1b\x8d\xca13\x8b\xda\xd8\xdc\x1f\xb2{r\x89\x12~\xa4V\x8bQu\xaf\xd7\bM\xff\x9f\xa2\xf4A\x9b\xfeE\x9eW\xf8\xf9\x1aG\x00og\xba\xaa\x19\x01\xd8\x1b\xb7\xb5Z\xcd\xfbA\x1chSU?t\xbe\x1d\xb1\xfe\xec\xe7\xbf$\xdfTI\xfaz\xe7\xbf\xf6hh\xc5\x7fl\x0f\xde\xe2\xff\xbe\xde\xf9\x8f\xcd\bl\x06\xc0\xbe\x9d!\x7f\xe9y\x11}\xe7\xe5\xf7\x8c\xf3\x8a;i\x19\xad\xd7q5\x89\"\xfe\xf2\x9f\xe2\x82Ҹ.B\x88\xfe\x1b#`nqR\x98;٢|gsuc+MRR\xbf\x8br}\xecj\xcd\v\x91\xdcϒ\xc9\x11\x1d\x87\xa2\x0eH\xd0\"ki,\x82\xbd\x9c\xd5\xdaA\x82\xf6\xa6\xab\xda\xfb\x01\xba\xdb\xdb\x0f\xd6 \xb5\xf2Ƴc\x90͒a\\\xbe\xf5\x1c۶\xfeۖ\xff\x97v{\xd4}[\xff\xaf\xb4\xfe\xe9J\x95K\x1f\xbe\xbe{\xfb\xf7?\xf5_\x9a/\xbf{\xe5\xf5\xdf\xebt\xfa\xa6\xfdߨ\xf3\xa6\xff}\xa5\xf5\x9f\xe6K\xb5\xfc釶\xed3gK\xec%0\xb8\f>ϗK\xb9_\x9fEU\x94\x86\x8f`\x16\x85\xdd\xe6b\xe8\"\\P]\x9aܲj\xb1\xd3\xccO\r\xe2ㄚ_\xa2\xf3ۛc\xed\xb5Թ\n\x14\xc4\x13\xd2<[®\x89\xed\x8f\xcfWɼ\xc8K2ύ(\x1e\xe7\xabr\xb9.\xc8\"y\xe6\xcf$\x98i\x1amqݥ àQƮ6\xd5zS\x85OJ\njYh\x1c\aL\xd1c\xea\x06\x9e\xea\x8a\xd5\x15\xab\xae\xe4\x10\xb9r\x96k=W^\xc4\r\n\x11\xd5\xe51\xea\xec\xd8&\xb4\xd1⯛\xf2\x94F\x95\xf1\xf5\xbb\x81\xd7x<\x8d|5#\xab0\xeb瓁\xf7\x055\xd96\xc7P\x9di\xfc\xa4\x86x_\xd2'\x90\xafY\x9f\xe0yoO8\x06^\x80\x13~-\xbc\x9d\xea|\x98϶v\r\x91\xe0(|\xe6\xf3Yh!r\xcb\xc6\x16\x96\xef\xf9\xb2)\xc16\x94\xaf2\xa5\xb9</\xab\x98\xd6\x0e\xbf\x1b;\x87\xfb\vf\x93!\xac\x1f\xc3|S)\xa2\xfb\x81V\x8f\xfd@6\xcd\xe0=\xbb\xac\xa2\xfa\xd1\xdcm\f\xb7\x8d\x9c\xbb\xc3\xeb\xd7\xd8n+k\xdbz\xf2ΊZ\xfe\xb4e\"8\x86\xffM\x9a\xfao)\xff\xbd/\xbf\xb1\x10\xb8\xc5\xffϨ?\xec\x99\xe7\xbf\xe1\xe8M\xfe{=\xf9\xef}\xa9\t\x81\xa5&\x05\xce\xf3\xac\"\xcf\xd5\xf7\x1euP\x9a/\xf5/J\xec\xbd\xf4*tG\x1f\x13|o\x19\x15P\xa6r\x94\xbdh\xbe\xda\x1e\xe1\x11\xa2\x1e\x81ᨒ\x0e9\x8f\xf8+E\xdd7\\+\xa0\x89\xc2IxUIQ\xed\aI\xf9\x90\xe1\xb0\xffJ\xb9\rp[,\fMx\x17\xb0/|\xdf\xccR<\x97\xd9\f\x98\x944&\x9d~\xed\xf4!\xcfS\xbdY\x14\xaa\xb5\x05pDc\x18\x90\xb7\x82\xcb\xdbw\x9be\xb8*UX\xbd\b^}\xba\xd9=E>f\xa3\x14Ϋ瀏\xd8;\x9e\xb6\x1f4&dI\xf9H\xaf\xf7\x89\x86>/\x02\xfeW\xe4\xd9\x14\x11\x18o\x1a\xed\xa5V\n\x02\xa65\\$\x8a\xc6\x1b\x98\xb8\x17ؽ`\xc3\xcaS\xe4\xaf\xd1\v\xf49\xeb\xb0o4h\xc1R\xb5\xa6pL\xd1\x12\x89\x82\xdb@\x9d\xffi\xb4\\Ec\xaa4\a|\x97z6H\x81|l\xe2\x99\x19X%\"\x84\x81W\x85\x18;U\xa6p\xc6k\xf5\xd29y$\xa90;a\xf9l\xa4k2ϋXZ\xbe\xc03\x00VcV\xcb_>\xb3*\xf0b\x19\x02\xeb\tM\xe9\xca\xe1\xbf뵼Z\xc3T\x90\x01\xf9\x8e\xe2x\x96o\x8a9\xe1u\xa2\x15d\xffe1\xf7\xe8s}\xba\xee\xe9\x99g\t\xe5`{\x8b(P]&\xd6>\xb5\xeah8!\x00\xf7kL\xacIV\xb9\x99\x9d6\x9aԭ\xb0\x1boط1\x11C4\xa7\xde$\xab\x10T\x9f\x10\x7f\x9f]]\x8aI\xc1\xfby\x8fEeQc\xc2\xe5\x7f\x84\xda\nĴ\xf9\xd5\xee\x85T\x8d\x8b\x11\tK\xa3\xb0e\xae\xf83\xf9\xe7\x8f;\x0f\xfb\xe1\xaai\xa1\xcf_\x1c\xda\x19\x1d\xb2\xb6\x06v>\xca^\xac\xe0\xd1\xf0\r\xac]\x00:\x18 \xb8\x9c\x15%\x1d\xbe93A\xa1\xce\x15\x8c6\xdd\n\x04\x04\xdfZ\xcc\xf4>\x86\x9c\xe7K:\xe0\x85\x15\x86\b\xbe\xf5 \x8a\x03\f\x02K\n+\n;|\xeba\x8aFB;B\x87\x1d\xf5\x1b\x9f\x01\x9em\x93C/\xa2\xa2\xbc\x8fR\x18\xb0\xd0w\xf9\x12\xeeq\xe4\xdbl\x85е\xe7L\x9aB\x83\xa33\x93&\b\xfb\x87\r\xa9\xddE\xe8աy\x9aW\x87\xa2\xfb\xab#\xf0\xd7QQ\x12l\xfeo\x1bssL\xfa'\xe4\x7f\xe5\x89\x19>\xe8\x1e\xcf~j\xb1\x8dh\n3\xc1\x11\xc0\xb1\x06\xa3V\x85\x02\xd4\xd6@,P\xf7\xf7t\xdd~\x8fG\xf3\xc7H2\x05\xa7\xb6\x8b\xe3X\xf5\xb5\xe0\x10u_\xb0\x04\x17\xd8=E\x10\xc6.C\x89\xb2\xed0\x9c\x14\x7f\xfb\x88\xa2n\xfb)*2٣}\xdco\xda\x06\xab\xf5\x8d4\xd3˗\xee-\x041\xcf\xc6\xfb\xc9y\xbed\x8ctG\x8a\x9a \xa1t\x9c\x9c[\xa0\x16\xf0\xb4\xb0%\xe5\xe6\x1d\xf5\xa1\xf34\xa7ϟtō\x82s^\x1dڜ\\*\x9d\x92\xea>tJL>\x9a\x9e\xad\u0087.\xe5\xa0\xeay\x97-Md\xff\xaa\x03\xaa\x91\xfd\x1aCkP\xdd\xe9Tad\xfb\x1a\x02\x90\"\xb9\x8b\xf8\xa5\xe7\xfa\xba\xf5\x80\xb5\x1c~Q\xae\xaf[\x8f\x9dN:F\xb6\xaf[\x93\xf4\xdbL\xe8\xf4\xebLhn\x9b\n\xbe\x80\x96\x84\xef_\xabr)v/\xfab\xdf\x10k\x9d>\xe91\xe26\x1e\xe0\\\xff:\x01qL\xaa\x9e\x1b\b\x9bN\nM\xa5b;\xd7V\xb1\x184\xec\xf7\x81̣3\xc2K\xf2\x84\x84f\xac\x16\xde\x0f\xf2uU\x06{\xfa\xa1\xac\x15`![\x119ϗ\x8c\xae*\xca`\x9f{i\xbe|g\x96\x8d\x9b\x13\u07b3\xbft\xbcUu1\x8a\xca\xc8\xfa4\xac\xd4s\x88\x9aY\xa4\xae\xccZ|0\x14!\xd8\xf1wi=\u0097\xe6\xae@Q\xc6\x19\x05\xe9\x19\xbf\xd1`sU\xea\xa1\xd0\xe9\xf50\x98\x1e\xab\x98\xa4\xdb\xe2(\x88Yt\xcc\xf7T\xad!\x12z\xb9Y\xb1i\xa4\xdd\x17\t(\x03-\xd89Y\xe9\xbbp\fz\x8e\x18\xc7x6Z;\n\xc2\v\xebֿ\xc0c\ax\x1aŗ\xfeRW\x0eB甊\x89\x89\xae#\xe4\xa4b\xb2\x946\x9d\xc2<\x8dQ_J[ZJ^t%د\xd2\x03\x17\xeb\xfd\xe0\xfb\x7f\x95y\xf6\x7f\xff\xdf\xef\x17<\xfd\xff\xfd\xfe\xfbC\xf6\xa8\xc7DHRB\x81\xe7IF\x8f\xde\x12\x92&\x19@\xd0#mZb+\xa0j\x87P?\x81+\xa8\xa9'\xe4\xef\x91)\x94\xf3\xb1\x92~|\xafBʃll\xeb\x01\xc4\"G\x8a\x00\x86\xcb\xcf\xfa\x1a\x12>\U000a367b\xedȏP\xbf\xf0ȯQh\xcaܬL\r\x8e\xfcZ\x9e\x1d\x8e\xfc\xcc6\x0f\x9eT9\x14\x97\x90.z\x98;\xb3KVb\x9c\xb0\xfe\x1a\x925E&ˉ\xe7\x00;5\x1b\xa5llm\x0e\xc3\x13\xc46\xba>\x87\x0e\xe0\x8fbh\x9b\x84]\xa1\xc8ΰ\xe7\x1c\x02\xaaw\xed9\x98\x01\xf7\x1c\xce8\xb4\xac\n\x1f\x88)\xb0\x8c7\xfe\x1c\xc6;È\r&\xa0To\xaeO$\x0e\xb9\xf3B\xa4\xfe\xb9\xa5k\x92\r\xb4\xb8\x19\x9a\xd0\xfd\xb6\x842׀\xd3T/\xd4|\xa1g\x80\xabz\xb0<\x82\x85跁\xf3\x913\x19\xb6\x82\f\xe0\xb2\x0e\xc8n9\x9e\x02ԡ\x06\x06gQ\xa2\b\a\x06\xb3/\xa1Ϋt\xc3\x12\x03\uf694y\xfa\xc8|i<\xf2\x12哄\xe6\x02\xf7\xd7\x12\xb3\x1b\x9c!Y\xf4\x1c\xcc\f\x1b\x877\xd2r\xa1#\xaf\x96\xee\xc3&\x9c\xb7\xba\x99\xa8\x81\xfc\xa4ب\xe2\xa1\xcd\xcay\xf2\x1c\x8a\x1befrY\xe8\x13d\rl`\xf13\xb2\xb6\xb5:\x06^F\x9e\xd8oxTCBp\xbf\xb3\xc7\xee\n\xdf1\xffc\xfb\xc1\xa2 \xe4\xc3fA;e?(\xc9ZV\xfc^\xe5䃇R\xea4\x13\bM\xcc\xe7\xcb<\xa3\xee@'Y\xa9\xda\xe8\xce\x00\x15\n=\xb0|M2\xda\xc5\xe5V\fm\x10ܸԭCSd\xd6\x0ekjhsɓ!\x8c\x82fȦ\x97\xa7:\xdc\x1f\b\xbeխE\xbdy\xca\xd9FS\x86\xca\xe2\xa7QN\xe1U\xa5*\x9a`\x8b}UcF\xee\x1a\x01\x97F\xe7\x8a\xd6_\xfeG\xda\x7f\x98\x17\xf4\xff9\xfb\x8fv{0\xb4\xe2\xff\x8d\xfao\xf1\x9f^\xd9\xfeÜ\x11\xca\x1eD|3G\x9d\xf4\xc3\xd8\xf8\xf7Xj+8\xd39\xa4H\xbe&%\xa9\x1c開 \xe45\xc1\x8dl;Sښ\xc5v j\xe1\xf9n\"\x04\xfc\x9cd\x86\x05\xa6\xccI*\x00j!ZA\xe3\xd2\n8\xca_\xfe\x84\xeb\xff\xe5\xdbZ\x80m\xb3\xff\uf386\xe6\xfb\xbfn\xff\xcd\xff\xefk\xae\xff\x17\xc3\x02\xecE\xb7\x01cF^<\xeer\x94\x86\x19\xa9\x9e\xf2\xe2A\xee\xd8\x05\x8d\xef+\xbe\xd6E\x92\x17I\xf5\x12L\xf9\x8f\xfd\xa0\x8a\x96j\xc5\xee\t\x1d\x9b\xee\x8e\xe2\xfc\xea\xe3\xafG\xe7\xa7\xd772\x9ff\xc9\x0f\xd0ۛO\x18\xd8\xeb\x9a\xd0\xe9\xf5\xe4G\x8c1nc\x8c\xe3\xeb\x89F\xbc\xab\x03\xaf.1p\xa4AO\x8eN/tx\xb7\xaf\xc1O?\xdc~Բc\xe8\xe9\xc5\xe9\xb5\x06ժuz}\xad\xb5\n\xc3\xcen\xa6Z{\xc6\x188\xb9<\xbb\xc2\xd0!\x06\xfepz}\xe9-\xf2\xfc\xea\xf8輭utwl!t4\x84\xde\xd0B\xe8j\b\xfd\xbe\x85\xd0\xd3\x10\x06]\v\xa1\xaf!\f\xedJ\x0et\x04\xbb\x92C\radWr\xa4!\x8c\xf5JN\xb5\xae\xefk\xe4/\x8e&\xe7z\xe9\x18zy\xfa\xd3\fC\a:\xf4\xeafr|\xaa\xc11x\xf6\xcf\xd9\xf9\x956#\xfaZ\xd3og\xa7ZŴz\xdd\xde\x1ek\x93b\xa85駣\xeb\xcbɥN\x1bmL;/N\xa4\xe2\a\xfd\xfe\x1aeL\xf3噴\xce\x0f\x91\x86\xdf\xf0p \xabBwP\xec-\xb0\xe6\xa8&\xbc\xf5\xcd\xf3,#sz\x91\xef8~\xe8\xaen\xef\xea%\x89z\xef\xb6\x02z\xba\"\xc52\\y\x9e\xe3\b\xac\xa3\x94\x14\xd5V\xac\xe3\"َtZ\x14[q@\x19\x02\"\xca6\xbc˼J\xe6d+\x1a\xbb\x80݂\xc4\uf2f7`Q\x97\x81GY|M\xc0\xa7\x12\x9e\x1e[\x844\x8d\x80\x96Q)v\x8c\xac߽\xfd\xfb\x83\xffV\xd1\xfa[\xbb\xff\xfb\xa2\xf7\xdf\xc3ћ\xfc\xf7:\xf2\x1f\xcc\b%\xf9ѯ\xb7e\xf2?x\xfdW\xf7\xaf\xbb\xfe;\xddn\x7fh\xaf\xff\xe1\xdb\xfa\x7f\xad\xf5" +
+	"_\xdd\xe3\xf5_\xdd\xf3\x1d\xfa\xe8\x0e\x94\xb2\xf2U\x83~\xe7u4Ϸ@\xefk\xc0e\x92\xd5C\xeb2WQV\x0f\xed\x86/\"u?\xa8Ŭ)\xe6\xf8\xae\xa8j\xa0$Ik\xa0\xf9\xfa\x05bL\x85\v\x01\x00o\xf2\xcb̏_\xd6\x11\xab\xebʓd\xa5\x80\xa0:\xb7\xf1\xd8\xd1\xe04\xf8[\x10vG\x9dqw\f\xff\xef\x0f\x0e\xda\xfdA\xb77\xe8\r\xdb\xdd\xf1\xa8?\xea\xf4\x06\xdd\xe1\xb0\xdb?\x18\x8d\x06\xa3n\x7f\xd4>\xe8\r\x0f\x0e\x0e\x06\a\x83Q\xff`8<\x18\x8e\x86\xbdw\xed\xe0}\xd0a\x13\xfeK\xff\xa9\xf7:\v\x7f\xabN\x8bż\x1e\x9ad\x8f\xb5\b\xf5\xf0\xe7u-\xb0[\v]u\xfcೋ#\xe7p\xec\a\xff\xf6f\x81\xaf^\xf7.\xa9J1_zݖ\xee[\x95\xe3,\x8a|E\xf1\xee8\xb8%\xf0\xf5\x17I\x98\x16\x14\xa8ݶs\x1c\x83\x96\xb3^yQ\xd3҂<\xafq!ᢈ檽\xe4y\x8du\xa2\x1f\xa3\xd5*\xf2S\xfb\xf4\xb2\xce\xc1ٻ\xcc\xfe\x9b\x0fs\x92\xe6\xcb;LHie'\xd9\"\xa4\xcb\f\xca5\xb3\x95\x005\xd7#EDW_\x93\xf22\xba\xd4\x1a\x95\x94\x9a\x89\xd4\xdf\xdb\xfe6\xfc\xbdf^\xfc=\x13\x9eܽ(\xe71\xedPg'\x9a\xa8K\xb37Ô&9\x9a'U\x03Y\xa7MY@\xaf\xdd\x1d\x8c\a\xed\x83\xee\xc1A\xbf\xdd\x1f\f\xc7\xe0\xd5\xf3\xe0\xa0\xd3\x1f\xf4\xc1\t\xe8\xb0\xdfm\x8f\x86\xe0\x92\xad\xd3\x1f\x8f\x87\xdd\xf1h\xd4=\x18\r۽^\xaf;:\xf8\n\x1c\x00ը\v\x15\x1a\x1e\xf4:\xfdQg\xdc\x1e\f\x0e\x0e\xfa\x83^\xfb\xa0\xdf\x19u{\xddN\xb7\xd3\x1f\x8c;\xa3\xe1`8\x86\x00q\xedv\xa7\xd7\an5\x18t\a\xfdN\xb7=\x1c\xb7\xdb\xed\xafQ#Ŕ\xc0\x9c\xdb?@\xf9\xb2\xe3\x1e~\xde\x1e\x80S6\xfbǪC[\xf4ǆ\t\xb5\xa7\xb3\xaemQ\xb7\xbeAݯ\xd0\x1eޢ?8ΨIw\xfe\x16]D\xcfͶË\xe8\x993Uи\xf6\xdb\xddq\xb7\xd7\x1f\x0e{\xe3Aw<\x1e\x1c\x8c;\x9dQ\xbb\xdf\x19\xf7\xfa\xe3\xfe\xa03<\xe8\x0e\xfa\xfd\xf6\xbb\xb6\x99{ا:ǃ\x114\xae\xd7\x1f\x0f\xbb\xbd\xce`\xd4\x1ew\xfa\x83\xee\xa8\xdf\xed\x8dz@\xa67\x18\x8e\x0e\xc6\xedQ{0\x1c\r\xba\x83q\xbf\x7fp0\x1c\x1c\x8c\x0f:\xa3\xfeh8nC\x9e\xee\xb0=\x1a\xb7\xdb\xdd\xf1\xa07\x1e\rۃ\xf1\xc1`0\x1e\xc2U\xc9p<\x1awF\x9d\x01̂\xf1\xc1\xa07\xe8\xf4{\xe3n\x7f\xd8\xef\xf6\xfa\xbdn\xa7\xd7\x1d\x8e\xc7\a\xfd!xl\x1e\r\xc7\xfd\xe1h\xd0\x1f\x8eڽAo\x04\x15\x1f\x0f\xdb\xfd\x83\x83N{0\x1a\x0e\x06\x9d\xee\x18fM\xb7?\xe8\x1f\xb4\xdb\a\xed\xde\xf8\xa0\xd7\x1d\x1f\xf4\xfb\xed\xd1`<\x1c\x0f\xda\xe3\xfe`\xd0\xe9\xf5\x0e\xfa\xdd\x1e\x94\xd6\xeb\xf6\x86\a\xed^\xb7\xdb=\xe8\x8f;\xc3\xc1\xb8=\x1e\f\x0ez0f\xbd^\x7f\xdc\x1d\xf5G\a\xa3qw\xd8m\xf7;\xfd\xfe\xa8\xdb\xeb\fǣ\u07b83\x82a>\xe8\xc0\xdc\x1d\x8f;\xddA\xbb\xdf\uedfb\xc3θ\xdf\xe9\xf6ǃqo8\xd6\xfarB#\xb7\x1ct\xbb\xbdި\xdb\xee\rǃ\xfeh4\x18\xb7G:NgHu\xfe\xa3\xa1\x91\xde\xebb\x17\xd2\x06p\xd8oBzL5\xe08\x11L\u0558\xbe\xb8?\x1cA\x0f\xf5F\xed\x83\xc1\xa03\xec\f\f,Z\xad\xe1`\xd03\x01\xb4^\xfd\xeeA\xff`8\xea\x1e\x98\xd0a\xbf\x19\xf91\rT<\x10\x13<\xc9\x1aN\xf0$c\xdd\xfaW\xbb\xf1c\x1d\x896\xe0\xafб\x06\x806\xe0\xaf\x0e\xe7\xdc\x14:\xec7\xa2\x0e\xf5\xff+\xbd]`\r\xc8\xe3m\r\x10x\v}'\xce*\xb2$\x85\xca\n[%\xd8\xf0E\xa9\xc4\x12\xda\xea\xe8\xd22һ$\xcfU\xb4\xa8H\xe1)=,,*\"GO2\xc9^W\xe6\xe9uU\x9e^Wnj\xd3\xfb\x048\xe6\xb8}\xd0\xee\f\x0f\x0e\xfa\xbdQ\xff\xa0?\xeaw\xfb\x9dv\xb7{\xd0\xebw\x98\x04~\xd0\x1e\x80/\xf0\xce\x00\xd6\xf4A\xbb;\x1ew\xfa=p\x831\xea\xf6{\x1d\xca/\a_g\x97\x9d\xd2\xfa\xf4:\xfd\xce\xe0\xa0;\x1c\xf4\x06\xe3\x83\xd1A\xaf\xdb\x1b\xf7\x87\xdda\xbf\xd7\x1b\xc3~1\x80\x1a\xf4;\a\xa3\xce\xf0\xa0wp\x00\xac\xa3=\x18\x83w\xf7\xfeA\xbf?8\xf8\x9a\x82\xff4\x7fj4\x01\xa6\xf9S\xa7\x1d:\x85\xb9k\xb2\x8a\x92,\xf6\x8e\xa5\x81\x9do\xb28|\xae\x05\xdf䧏\xa4\xe6X9K\x96\xd9]\xa2\x1d\b\x91\xd88Kj\xb3f\xc6Y9,\x93L\xd5{\x9e\x97\xe6̛\xf9\x0e\xc0\xdcl|\x05A\xac\xcb\xea2\xcf\xfeM\x8a\\\xedc!\x9b9\xa3NoH\a\xb87\xe8\x1e\x8c@~\x18t\x0f:\xfd\xee\xc1\xb8\x0f'\xbb\xfeh0\x1cw\x0e:\xbdQ\xaf\xd7\xe9\xb6\xea\xa8\x0e\xfb\x8aj\xb7\xdd\xed\xb7\xbb\xddA\xaf\xd7\x1e\xc1T\xed\x8c{\x83n\xff`\xd0\xeb\x0fG\x1d\xd8\xcbz\xedQ\xbb\x7f0\x18\f\x87\xfd\x83Ѱ\xdf\xe9w;\x803<hwz\x83q\xbb;\xea\xf7ڽ\xde\x01l\x864\xd7\xf8\xa0s0l\xf7ƽQ\xbb\xd3\xef\x8d:\xdd~\xff`\xd0\x19\x8fڣ\xd1x؇\xbd\xa4\xd39\xe8tz\xe3\x83q{<\xea\x8dz\xe3\xc1\xe8\xa0\a;\xe6pԆ]\nZ\x06\xa9탃ngЁU\xd6\x051b\xd8\x1bvz\xc3~\x7f8<h\x8fF\xfdn\xfb\xa0\xd7\xed\f{\xfdN\xb7w0\x1a\x8e\x86#\xe8\x87Q\x7f\xd0\x1e\x8e\a\xc3n\xbb\rܭ\xdf\xed\x0f\x0f\xba\xb0_vƝ6\xc4\x04\x18\xf6\x0f:\x03hN\xaf\xdf\x1ew\xc0ji\xd0\xe9\xf6\x06\xe3\xd1\xf0`0\xe8\xf6z\xbd~\xa7?\xec\f\xba\xbd\xf6\xa0\xdd\x1d\xf4\xba\x1d\xba]\x0f\xda\xe3a\x7f\xd8n\x0f\xbbC\xe8(hL\xbf\xd3>\xe8\x01\x17\x18\xab\x01\xfeͣ\\\xe0C\xf1[Q\xd1\xf1\x1c\xb5G\xc0\x14Ɲ\xcex8\xe8\x8f\x06\xdd~\xbf\xdd\x1e\xf7\xfb\xbda\x17\"\x00\xf4\x0fڽ\x83\xee\xb8?\xee\r\x0ez\xa3\xe1x\xdc\x1f\xf5۽\xe1`<\xee\xf5\x0eƣ\xaf\xc9F\xa0JL\x16\x1c\xf6ǣn\xa7;j\x8f@\x977\xee\x808:\x1e\x0e\xda#\x90S\xfa\x9dao0\xea\f\a\xbd\xd1h\bn]\xa9|\xda\xeet\x06\xa3A{t\xd0\xeb|%N\xa2*\xc5\x19\xee\xb07l\xb7\x0f\xc6\xdd\xfeh0j\xf7\xfa\xfdq\xb7\xd3\x1dv;\x9dn\xaf=\x1e\x8eG\xfd\xc1\xa8?\x18\x0fFc\x10D\xda\a\xddv\xbb?\xeet\xfb\xbd\xf6\xb8\xd7mw\x0e\xbevO1\xa6\v\xa1\x17\xfa\x03:A۰\xb5\xb7\xbb\xa3\xee\xc1\xb83\x84\xf9\xd6\xebw:\xfd\x01\xc8l\a\xa3A\xff\xa0?\x18v\xba\xdd\xdex\x04\"Z\xa77n\x8fF_\x95\xe9\xde\xd4\xe9\xccnj\x15a4ο\x1f\xfcϚ\x13\xf1?kN\xc4\xff\xac;\x11\xbf\xe9鿥\xfe\xff\xfd]\xf2\x8a\xfe\xbf\xe0\xf4ѵ\xfc\x7f\xf5\xde\xec\xbf^Q\xff\x0f3\x02\xd9{&\xc8\xf4k\xb1\xf2\xba\xfe\xa29\x8b(\x8b\xbf\x17\xbc\xef\xe8.\x7f$\xc1\xd1|\xbe)\xa297\xdfbn\xb9D\x12\x1ch\xa4\v-\x9e\xe8\xf5\xb2r\xf4\x14\xbd@d\xd7\xff\"E\xce\xe4\xb4$[\x8a\xb0=\x02\xe9\x03I\xf3'\\\xe6_\xa5\xcd\x18h\xe9\xcd|\x03^#\b\x18\x16]\xd6\x18\xa00\x04;:\rW\xa7?G\xf3\n\x97*\xe2\x89R\xb9\xa9\xf1\xeb\x11\x8a-\x9cp\xce\xc1\xf3\"{\xab\xda\n\x18ȁ\b\xed\bW\xd0\x18\xdc2_\x06J\x96i\x80M\xd0E\x92\xd5AYp1\\\x86\x89r4\x87̢\x0f\xac\xaa\xc2\x05\x88n4+HGY\x05*\xf8U\x94U\x81H\f\r\x85\xb1\xc4.\t \x83\x81\t\x95\xe39\x1a\xd5q\xde%U\xb0q\xe5\x81n\xb2\vA\xfaTO\xefr)\xdfx\x0e\xc1\xa1L\x85\xec\x87\xf9\xf2\xd1XuQ\x85\xed\xa2\rH۶<\xe6\x18\x05m\xb3\xbf\xa1%\xa9>$U9\xec\x87\x19Y\xf2\x872\xcfR\xa1\xef\x9dC\xfc\x1d^\x03\xcc\tG܆'^Ρͼ\x8ef\xf8\x1c\xecMj\xa7\xf9uD\x91\xae\xa3\xaa\x96\x12\xd3\xf8C\xff0/z^T ƿ\xdc8pi\xb7\x15I>`\f7|\x12J&fM\t\x81\xb9\r\x91\x9f\xdf\x00U*\x19ꑇ}\x89\xbc\xad\n\xe1\xbfyG\x87\xf0\xa7\x06\x17\xfa\xfb\u07fc\xbfC\xf8S\x83\xcbn\x86\xeb{\xea\x92,\xb7\xe2l\xa28\x96Hp\x82\xe7\x00\v\xaf\xdc\xdc5\xc2[m\xd2Fx\xbfm\xf2Fx\xf3\xd5:\x94P\x17+;r7\xc07\r7w;`_l\xd2\x1d\xb0\xff\xb1\xc9w\xc0>\xde\xd60\xf0\xae\xe0\x04\xccHe\xbf\xc1\x10\xc5\xea\xde\x04\x04\x8f\x9aG\x19\x8b\xe8\x02/1x\xd4\xf4\xfd\xe0.*EH텬\xf7\x9d7\xdc1'\xb6Ο\x06a\xb6\x85oM5\x8f[_Z\x14\xd44,\x83Ūz\a?\xe9+\xb1\xfd`~O\xc3Û\u0590<\xcf\xc7\xfc\xee4\x9b\xb3\xad\xd3\xe7wJ\xa1\x9e\x10\x8a\no\x1f\xdd\x1e\xc4\xc4,\xdb\xc5\r\x96\xdcd5\x1fX\xf0b6@9<\xb9tOX(\x93\xa7f\x14\x8d\xbb\xadf\xb4\xa9\bC\xfbڽ\xa3\xf9\x9e\xdahME]\xb2\x0f\x03`\xd16\x9e\x1f\xf1̋U\xf5A\xebM/\xda\xcfZ\t\r\xe8N\x1b\xd0eACŔaӅ\xf7\r\x9d2VW0-P\xfdJ-\x7f+\xaaI\xf6H`F?+FŤPzGoJ\xb8\xc2'\x19\xa8\xff\x9bʡ\x93\x8c\x8b?Ƣ\x9fdb\xa3\xd5ŀ\x89\x03\xc7\x16*\\Xj締 \xc9\xd0\xf7\xeb?!\xcf&2#\x85Fw%\x87\xbb\b\xf0}\xc9C\x9e\xefH\x1e(\xe7\xe4t\x93|\xf1bq\x0e\xbe\x05\x8bs\xee-X\xb0Y\x95\xd5C\xb0WV\xd1\xfc\x01\xe46-\x87E\xf2:ʖ$\x8c\x84\xf4{\xe7\x1f\x8c\x0fI\x96\xaf\xe8;\x1d\x81\xfc\xe0G\xe6\x1bǖ\xca^\x93U\x03\xac\x7flr\x1bq?(t\x19\xc4Ѽ\x93\xe4\xb1I\xbf\xe6M\xc6\xe8$y\xb4\x11\xf7\x83U\x93J$1\xf1\xd4~?p\x9c\xb9\xfc\xb4\xf8\x06\xcbJ,\xf4\x83\x8d@\x80\xe9*p\xacE\xe7t\xa3\xc1@\x1e/\x1a<_)rjg\x11\x0e\xbbM\xbc\xc0f\x92\xa5X\x8d\x86\f\xa0\xed\xb1\xacStY\x80\xe6+I\x05\xc7y.\x02\xd4\xcb\x04^\"\xc0\n^*Rj\fٱ\x06(\x8eŪYS\x934\xb5i\xb80?$\x95\xfd\xf6\x93Bn\x8a(I\x93l\t\xba\tθ6\x16\x1234s\xcfC\xbb\xca\xe4y=K\xe9\xed\xd8\x0e9<\xd8\xfb\xe0\xc5\xfdI\x1c\x8a\xac\x8c\x1f\x8fO\xac\x8c\x11\xff{\xe7-.%\xf7+R|Y\xde\xeb(\x8b\xc3\"\x8b\x83=\xd0\x18\xbd\x83\xcf\xfd \xab[\xeab\xd7[r\xda~\xe4U\x1e\xc3^ڻ\xc8\xe3\xfe\xb4\x80\a\xfc\xa2~\xebmy\x06\x17y<\xde1\xcfM\x9e\x914Mf\xf7Q\xf6P6\xc8v\xc1\xb25\xc0<\xa7\xa6\x9e\xa2\xb9\x9bču\xdd\b\xebCR\x85\t[L\x1bז\xfe!Q5J\x98,|\xe7%v\x945\xe1\xbcG\xe0M\xa3j\x80xU4@\xfa9o\x82\xa5\nt\xee\u05ff\x15u`&RK\xa6c\x88\xa4\x93\xcc-\x90\xaa\f.\x96\xe1\x13p\x19\xe7q\x88\x88\xe2Da\xf0ʭ\xa7&>t\x8e\x97U\xac\x1e\xbb\x1c_&Y\xc3\xc3\xcb$kxtA\x9dg\x1e\\\xb6\x9dEآ\xd9\xed\xfc2\xc9v9\xbd\xe0\x12\xb6\xf8\xa0\xd6\tS\xe4m\x84\xa7E~\x17ݥ/\x8c\xad\xd8V\xa6j\x84SrB\xb2|\xe5\x14\x03\x17A\xa6T\x12\x7f\x8f\xe6\xf9]b-\a\x14\v\xed\"z\xfe\x00s\xe3o\xc1\xff\xf9\xff\xfc\x1f\x94x\xfa\xbc\xf6YG\x81\xf6\xd7m\xa1\x94d,\x9bf\xfb#\x1e\x82RA\xc1\xab\xe8\xbb$OL\xbfg\v\x9c\xe0\xb71\xaa\\\x12\xec\xb5\xccN\x8f\xee\xac\x04[\xb6\xe0'\xb5M\xe2\x13\xc7jO\xf7\xcc[#V\xce;\x0eB׆>\x13Y \xa1Zr<\xac\xb9S\x06B\x84^\r\x18\x8e\x15M\xdd\x1d\xb6\xf1\xf5\xa0C-\x8ah\x1eZ{\xabU\x0f\x8e9\xec\xd7\xf7\xad\xc2\xd64\xb0\x1e\x04\xed\xbc\xe7±\xcf{.,\xa5ĵ\xa0\xfc\xb8\xe6\x81\xf2\xe3\x9a\a:\xa1\xf6\xfd>\xa8\xe3${\xedUӳ\xd26\xab\xd0\xd8!h:[\x9f.H\x96\x17\xab\xd0U8\x17\xfd\xafM\xcd\xda5R\x18^s%\x9d\xaf\xfe\x9b\xbb\x06X\xfc\x98\xb9\x05\x8b\x9fﶕ\xb8\xcbnq\xedW\x04^\xdbj\xc0\xeb:\xb5\x0f\x05r\xe6Z'\x8d_s\x1du-\x1d\xba\xc08\x86W\x0f\xa50\xf9헰C\xf0.\xc4&\xfb\xe2uS\x95\xde\xf5\xce\n\xbd\xeb/P\xe7]7S\xe61vO\x99\xa8\xa9E\x12\xf7\x99Z2\x1e\x12\x9d\xf5znpo\xf2K\x12\x15\xa4\xac\xe0*\xd7,\xa2ca\x81\x11\x9e\xa3\"\nk\x19U\xc9#D\xf6v(\xbd\x04\xd64/\x13\x0f\xd6@a\xb9\ue525{\nj\xf3\xe2\x802\xd7\x05y\x11s\x91\x9a~\xd3}\xbbΓ\x01E\xb0\xbc\xc7\xf1\xd42\xa2\x9e\x121\xa3\xe0\x10萼 \xbao!\t͢\x8aC\xe0'\xafJ\x16U\x86\xfa,\x8bعE\x1d\xa6\xb2\x88\x1e\x1b\xb2H\xe4D\x88\x9eՕ!~gfYE\x0fD\xab\b\x82\x95\xa4\x82\xba\x84ϴϜpk\x13q\xe0\x84\xcf\xeeڲۣ\x8c16'\x06\xbb7\xaa\xc3`7<\xf4\xa7\xa1\xa6\xe1=\x97U\xcb|E\x8a\x17\x8d\x0e\x9c\xb2\xe9\x9f\a\xda2\xe8Og\a\xac\x84\xd6\x0e\vxQ\xb0\x91\x9b\xb4\xaf\xd5w\x0e\xbd\x03\x05T\xf5j\a\x8a\x93\x94\xd3\xfc\xa9+.)\rvL1Rz\x82\xa4-(\xf9\x99ϬA\xb1\x1d\xa5\x14\x87H\x8a\x95p\xb9\xec\u0383}Gϣ\x1b\xeb@\xcaHU\xc9\xfc\xe1\xc5\x0f\x8f\xb2mC]q35>\xbd\xddu\x88\xc4\x01\xb5\x8eR^lAxފ\x01*\x8e|\x15\x16\x91\xa1\xefH\x93UR\x89*:kH\x9eח\x97\xc6y\xc01\xef\x90r\xc7I\xe1B\xce[j\u05fc\x85\x9e\x87\xc8OI\x16\xe7O$\xaeɞ\xe6\xcb\vO_\x1b\xf5ؽ\x0ew\x96\x86\x8e\xeb3\xacIh!Z3\x15\xd4\x00V\x05\x9c\xfc\xe2\"\x8f\xbb\x97\xc6b\xf7M\xa7-gt\xf6D\xe1\xea\x81\x0fTX\x90\x92\xd1㇓y\xbe\xc9*\xdf-$-`S\xe5Q\xe8S7\xb0\xb5\x0e\x18\xca\xf6\xa3\x16w\x9e\xc3&@Yr\xa9\xf5F\xa9T\x1b\x82\x9f\xc5\xc92\xa9J\xae\x16\x12\xa9\x15x\xab\r~\xf9\x1c'\x8fIi֕<\xaf\x7f\xfa\xc9\xe8d\x96\xed\xc5\xc3\xfe\v\xa2\x9f|7\xacw\x1e%;F\a`\x96#N\x1e\xb5\x1c\xff\xee\xb2,F\xce\xdfا\x9b\xc0OjpY\xb5\x14\xfaO\xd8\xf5\xab\xd89\x7f\nc\x81\xe8\u0088\x93\xc7\xf3\xa80X<\xaf\xcff\x92\xf1\x8aM\xb2&U\xfb\x10\x95\xc9\xdc\xdf%&\xfa5\x99o\x8a2y$_\x90eV\x91\xb57\xdb~ #*\xb7\xcc\xfd̻\x8e\x9d\xab\xaeɢ[mң8\xfe\xc9\x18\x179\x1e\x16\xfe\x1a\xabW.\x924%\xc5ut\x97\xe8\\\xae k>{\x17y1']\xbe\x06\xf1\xc1\xcf&v\xbe\x99Gxe\b|*[\xf1I\xbf\xf5J\xf5\xbf\x85\xfdoU\xbe\x9a\xfdo\xbb\xdf\xe9\x99\xf6\xbf\xf08\xea\xcd\xfe\xf7U\xed\x7f\xab\x12\x1b\x00\xc3\x17\xf3\x92Ael&ٽ\xf0\xbf\xf3\xa8(^\xf8\x96\x18\x96\x9b\x15N\xbe\xda薊GqL\x9f\xcf1\x7f\x05\x82F\xaf\xcb\xd1\xf9\x17\xa2\xd3\xeb\x1a\x94z]D\v\x1d\x15\x04\xada\x9fg\x90´\xa45\xec\x1b\xb4\xd4\x13+\xb8p\xbe\x17\"k\x9ak-\x046\xbd\x11I\x05Yi\r:I\x1e{]\x91\xb5ו\x99q\xe3\x10\x81^W\x91P-9I\x1e\x87}AdؗDp\xab\x10\x91a_\x11QM8'Q\xccO\x02%\xef\x15|^\xc0\xe0ΐ#t\x86>\x14" +
+	"4J>\x14\xed\x9c\xe6F\x19s\x8c\xb1\x8e\x909\xeb\x97i\xd5\xd2Ϲ\xe7$\xd3jdA\xb5\xcaXPg=\x98\xbe\xcc\x18is\x12\xb4\x14\xb2s\xe2\xaa,\xda\xe0\xa3l\xce9\xaa\xb2i\xc3-\xb2]e\xa4<\x06q\xd0\xd1Q\x12\xe6\x19E\t\xf7\f\xa1\x84{\xc6O\u009d\x9d\x06\xf6\x1c\xfe\x85\xb2\xd1\x10\x1b,\fͭ\xc95Y5X\x06\x9a\x95\xc35\x11\xa6P\x8e\nP\x90\xd6K쯎\xa0u\x93Q\x1f\x8a\xa0\xf5\x93\xabt\xd4Q\x1b\xf4\xa0\x81C\xd9q\xc4_A\n\xdfZK\x8a\xb5\xb5\xaa\x14\xab\xa6\xbey\x15U\xe4\x9c,*9\xf1\x1f\xac+a\x85\x84*\xa5!v\x86\x16\xaa\xb66\x10j\xafk\xa1j\xeb\x01\xa1:j);VC\x14\xfd˴\xe2\xfa^t\x97\x17E\xfe$\x16s\x9c,\x16\x1a\xc0\u070ef\x9b;\xcfv\x84\b\xf5\xba\x98T\xafk\x12S\xab}\xb6\xb9\xf3\xecH\x88ܰ\x8f\xc9\r\xfb&9\xc5\x05\xb0M\x89\x8b\xa5kp\x0f7\xd0p<\x1cA\xc3\xf1p\x05\r\xc7\xe4\fLK\n\xba\xbbY\xf2o\xc2\\\xd0~\xf7\xf6\xef\xbf\xc1?*\xed\xcdW\xeb\xf4\xf9\xd5\xe4\xff\xcehض\xfc\xbfw\xdao\xf2\xff+\xca\xfftF\xa8\x03\x00\xffDn\x00\xe7\xf9j\x9d\x92\xe7Nw\xec\xf1\x04\x88\x11\xd4o\xc3\x1f`=\x12\xf3\n\xb8\x15g;!\xe6!p+\xceVB\xc7y\xf6\xaf\xed8e\x03\x94\x06EU\xdbP\x981a-\n{\xa0f'ӗkz^\xcb\xeb\x99\x17̜bՖ+\\c\xd5\"1\xaf,V\xf2\xf4>\xa2\xf2\x9c\x7f\x86M\xf34*\f\x8c\x109\x82\xf9\xff\xfd\x7fM\a\x1a\xccǈ\u0087\x9d\xb9\xb6n\xd7d^yH\xdaȳ\xed\xf3t\xd6`\x9ar۴Z\x9c\x9b\xed3\xf9\xa6v\";\xf9?\xdcH|\xf7j\xfc\xbf;\xecZ\xf1?ڝ\xde\x1b\xff\x7fE\xfe\x0f3B\xb1\x7f\xf6%\x98\x8e/(\xa2\xb2~r:\xa1\xb4\xf0\x99\xe9\rv\xd7X\xf5:,\xa5\xd7\xc5i\xdc3D\xafk\xc1\x86=\xc3\"\x9e\xa6e❃\x05\xcb\xc4\xcdt\x82\x8d\xd0²\x98\a\"2\xeb\u07b5j\xec%yb\xc9aIH,hj!k/\xc9\xd3\x7f%\xebEX\xb0\x8cpw\"\x99ƣ\xfa\x99\xac\"d\x17\x059D\xfe\xbcX\xf9:hJ\x8a\x95\xa8\xf0/\x9f\x95\x81\x01\x14Tg_\x00\xf0V\xddH\t\x94\xba\xc2\x05Ό\x90\x187\xdf\x00\xbb\x06A\xc0\x98ϳ\xd08\bb\xa8\xebՂ\xa4kM\x06\x04\xb3\x8c\xb9d]<\x83\xaf\xd1\xf5L(\x81\x934\xc0qO&\x01\xdd֩\xbe\xa5\"\xe0\x8e\x91\xd7\a\xe5~\xb3X\xa4DX*\x95Oњ\x05q\xe6\x86\xdb\xff\xa2Yͱ\xba&Q\x8c\x83ke\x9e\xbbƦx\x9e\xa9Ѹr8B\xb3\x8aéͨC\xab\x90ߵ|\xc3>\xcaɒ\x0e͙\xf5;\x8a\xa8kOGk\x1aR\xea\xb0B\xeb\x96\x18\xc0[A\x8d\x97\x1c\x89R\xebxX`\x99\x95\xf8\x0f\x9f\xff\x80\xbf\xbf\x7f\xec\xbe\xd2\xfe\xdf\xef\f\xfb\xe6\xfe\xdf{\x8b\xff\xf5\xea\xfb\xff\xfbǮ)\x02еq|\x1f\x1d\xdfG\xe3\xba\xe5\xc1Q\xf0\xee\xf1K\xaf\xcb\xf8\x89\x8d\xe4\xdb\t$BS\x86\x84i\n[\xc7\x0fI\x16\x15/a\x1cU\x91\xc7\x02S\xe6aV\x98<\xc3v;L\x99\xefB+ɗ\xe1\x1bKN]\x87\xe4Խ\xf4KN}\x87\xe4Կ\xf4JN\x97;KN\xbc{\x8c\xd1\x0fD\xb7)\xc4\xe9\xf1G\x8aԑ\xfaP\xf8\xea*qiz\xfc\xf1\xdbJ[,(\xd5\xf1Ǻ)==\xfe\x88\xa6\xb3\xa7\xae:\xf6\x8e\xf3\x89\xe6i6\x978\xf2\x0e\x93\x9c\xe2g\xdcZ\xb8\xee\xe6I\x90v\xae\xc9?\x85D\xea~\t\xfbuD\xce\xee\x8e\"'P4_z\xa2Z^\xd6\v\xa3\xb7\x89@r\xde\xd6\b4\x96\x985A\xebu\x05\x9auC\xa45\xf3\xb2^\xba\xbdM\x04\xd26R\x97~!\xf86a`ۄ\xf3O)%\xffA\xb1\xb5F\xe8\xd4'\x88_\f\xad\xe9q\xf7\xcc\xdd2\x7f\\\x03\xf0\xbfK\xba}\xfb\xb7\xed\xdf*Y\x91o]\xc66\xfd_w\xe4\x88\xff\xf6\x16\xff\xfb\xb5\xe4\xffdEP\xfc'\xfa\xe5t\xf7\xc8ބ\x94\xdf+k\xac\xd3\xe7\x8ade\x92g7/k\x12\xc2\xfb\"\xf1b\xb3zY\x9ba#\xb9\xb7F\xfa\x92*ɖԸ\x93~\x90\x02<\xf4\x8f\xff\xf2\x97Ǩ\x00o\x8b\x93\x8c\xfaŻ q\x12M\xa3\"Z\x91\x8a\x14\x9ax#\xcb-?\xbcТqq\xe1/\x9fE-4\x19\x8a=\xbd\xa6TY\x1eY\xd95\x14\x02\x91\xef~a)\x9f\x05)\xed\x15\f}\x99\xaa\xb2?\xaa\x02W\x90ȟ\x02!\x8a\xa5MR?ð\x1e\xf9\x87\xa7G:\x9d\x9e\xd0\xf0\xbf\xacɇ\x17\xd9h\xd4Ѫ\x8a\xf2e\x12{\x80&\xcdT\x8f\xef\xa1\xda\x15\x9c\xa8H\xc1\xf6\xb79K\x92\x95M\xb2\xf5\xa6\x02#v\x86\xd4\nB\xf9[t!\x8aʪ\x8ah\x05\xecG\xf8\x04\x0f\xa2do8;ߙ\xf1\x13-#\xbc\xa7\x7fv#\xc0M٩\xd1\xff\x1e\x9f\x98\xef>l\x92\x94V\xdal\xe1<\xcf*\x92Y\xaf\xdfd\x9f\x89N\xc7\x16\xf3(\xbd\x15\xb0\x1fVϕ\x81s\xa6\xe8y\t\xfd\x01I_\x96_\xbc=l\xd6TI\xd2I뷯H+_\x93\x8c\xb6\xaa\t17\x89r\x9d&\xd5\x0e4\u07b6ﯲ\xff\xbf_m\xd2*YGE\xf5*\xfb\x7fo\xd07\xf5\x7f\xddN\xfb-\xfe\xe3+\xee\xffjF I@%9Ł\x8cT\xef\xe1M\xf1\xbaȫ\xfc{\xb9{_\x90\xb2\x8c\x96\xe4&\xcf\xe9\xb3\x18\x8dמ%)>B\xc9m\xe6P\xed>G\x15\xfd\x98\x11\xf2\xc0\xd3i\xb0\xeeB\xec@@☱\xf3\x93\xa4\\ӧ\xbey\x16.\x12\x92\xc6Y\xb4R[\xf0\"I\tJ0vJ \xf3\x89Dh\xa3<\xd3\xf1\x0f\x03\x0e\x96M|w1\xb98\xfd\xc4\xebK\xcd\xde\xf8\x8d\xc5v'ϲ\xb0Vp\xb5\xa6\xcf:CH3\xa2\xa4\x83\x80\"H\xfd\x18\xa5\x1b\x82\xe5\a!\xd4\x1c\xb2N\xd4 \xa8\x00Th^\xac\xa8۶\xfc\x91\x1c\xa5\xa9\x11\xed\x1c\\q\xd0\f\xec\xf9\x9a\xd8\xed\xef\xe0\xc5sT\xbc\xc8N\xdbc\x10\x95\x8b\x05\xed\x0e\x9f \x17\xfb\xdd\nx$o\xa1W\x8b\n\xe9b\xa3\xb6\x0f\xb7\xf6\x1bPb.s.\xa3\x15\xb1\xdf\xfcsx\x92\x92:\xf8\x1a\xf6\x10ǔi\x99x\xf9z\x93F\x15\xef\xc82\\EϪ\xb6j\xbc\xf7\x03\rP\n}\x8f\xa1~\xa3$\xa9\x1e;n\xa2\xc7f\xf8F\\z\xa6j\xd3f\xe9\xd6>\x132\x1c\xfc\x85\x9e\xa3\xcd \xab\xbcx\x11\x15eS\xc3\xf62\xc03\x16\xfe\x8c\xbf\x06*\xab/;\x04M\x82Ƅ-ު\xfd:\xcc\xeb\xe8\xa9\x19r&\xc8\x16,\a\x7f\xee\xb8\xe3\x18m)$)ϒ,J?\xf0E\x10\xa6IF\xe4\xe0\xe9.Cd\x0e\x81|B\xe8\xdb^R\x9c'\x19\xd1s\x86\x05\x91n%\x98\xc8I\x97K\x9dBF\xac,Y\x15kn\v\fp\xe4%\x90\xac\xd5\xeb\x0e\xd8\x0fcx\x12U\x11_\x13\xf44\xe3\xa5\x7f\\\x90\xa8\"\xb4\xeb\xef\xfd\x8b\x99\x9d\x19X\x1eo\x9c\x7fF\nJ\x87%ۄe\xefH\x94\xa4\xb1E\xb5\x11\x11\xfaם\x7f\x1f\x9c\xb4oȖ.\xd5\x17\xee\x9f^\xfe\xfbm\x93W$^\x17IF_\xf4\xfe\x87\xe5\xbfN\xa7k\xbe\xff\xebu\ao\xfa\x9fה\xff\x8c\x19\xa1\xa4@\v\xa0ɂ~Y\xe2\x8bD\x87?\xb0\xd7m\xb9.v\xf0]v\x87F\x193\x96E~ws\x87f\xd7\xd1n~`B\x9f\f\x82n,\u008f\xeaA\x1d\xd2\xfc\x9e\xcc\x1fΣ\x92\xfa@\xf0\x16\x98d%)\xaaY\xbe\xa8`w\xfaP\x90\xe8a\v\xee\xf1\xf5\xf9\x99\x17e\x91n\xca\xfb\xff\x16\xdc\xee\xed\x9f\xf9/#\xd57/\xa3\x9e\xff\x0f\xdaݑ\xf1\xfe\xa3=\x1a\xbd\xbd\xff~-\xfe\x9f\x11t\xe8\xa7\x1f\x82\xc5S\xb5\xe9\xb3\x16\xfe\t\xce\xeeY\x94\xbe/\x93l\x99\x92E\x9a,\xef\xab\xefk\x14\x04\x19\xa9\x925J\xcbK\xf4Q\xbe\x80\v\xcc\x14\xa5TɊ\xa0\xcfG\x92\xc5y\xf1~\x99\xa7Q\xb6|\x97\x17\xcb\xf7\xcf@\xf1}\x9c\x95\xf0\xff\x15S5|/bL\xc5q\x81\xb4\v\x97\xa4zʋ\a)\xda\x1e\x9aޭ~G\xf9h\x90'\xb1\a\x9c\x16\x85\xccC\x89J|\xce\be\x0e;:\x94\x8dq\x93\xacH\xbe\xb1\xbc\x0eb\f\x02\xedeb>r8\xf1a\xb3X\xc0\xe1\xe5\x97φ/<\x0e\xe0;\xd3M\xaeo\xa9\xca\xe9\xbbc\x83\x92Y\x9b\x9bX\xc9,p]\xb1\x11\x0eM\x95\x01\xc9\xf1\xe4\xe4\xfa\"*\x1f\xc2<#\xc2a\fw\x1d\xd3\n&S\x00\tC\xb2<\xcb\xd0\x00i\x9b\xe4ap\x9e\xcf#p\x04\x02\xbd\t\x7f\x0eY\x1d\xefj\xeaxH\xf5\x1b\x15\xd1r\xcdHuB\xa2\x18\x8e`a\x15\xc0\x8cz\aC ˙\xb1ېm8\xb4;k\x91(Fm\xfd\xc4\f;\xb9\x9c\x1d\xe7\xd9\"YZӌ⩉\xa5#\x82\xc1\xcfS\x11\xad\xad\x8d\xd8D\xf3LB\x13\xcd3\x13-4\xf7t<\xb9\x9ci\xd5gu\x93\x8d8\f\xf0\xb2\xb9Ě\xb4\x19)\x1e\x89\x82MJ^\x11.{MJY\xa2L\xb9̫3\xea\x95\x10\x12\xb4\xfe\xd9\xda3[\xfbdkox\xfb\x01T\x9c'd\x11m\xd2Ꚕy\n\xad\xda\x13\xbf\xa4k\x87(\r3\xc6{\xe4\x192\x8aゔ\xc8O&,\x05\xe38\n\x19'S+k\ny\x83\xbd\xc9\x14f\xf7~P\xe0O\xea\x04z\xea\xa1us\xec%vs\xacS\xbb9\x96\xe4n\x8e\xbd\xf4x\x7fշm?\xa8\xf8\xe0\xd2\x05s\xb2)\xa2*\xc93\x7f\x93oO\xbcռ=ѫy{\"\xaby{\xe2\xab\xe6m\x96<{\tfɳN\x91'P\x92Y\xf2\xac\xd3d\xd3>\x89Ruh\xb8q5\xee0\x10|Bq\t\xc4\xcf8_:\xd9D錺\x8ad\x93\xfc,Jӻh\xfepB\xd2\xe8\xc5$\xf8\x03!\xeb\xa34y$^\x00[\xb2\xe6\xf7a \xa6\xa3\x9a\x98\x87\xc1q\x94\xcdI\x1a\xfc?\x7f\x9d\xdfG\x19:U\x81\x06\xa8\xc8Sv-\xbcm\\\xe7\x01߯\xdf]GO\xd0Q\x92\x11r2\xc7L\\`\xd4\xe6\xd5s\xc0\xe5\x87w\x1c\xb0\x1f|y\x11\xae\xf3\x19\x1b\x99V\x10\x8b\x8e\xb5\x96\xb2@\x10\\\xdc]\xa9\xfc\t3\xf7\x90DE\x9a\x90\x12s|\x8bb\xc1\xbb\x96\xfa\xff\xd5\u05ffBZ\xe0\x01\x0e[\xfaHZ\xd8\x17캥\xba\x87u\xebi\b\x845\xc4h\x9b\x92\x18\xfej\x05\xe6\x17s!\x9d\x04\xef\xa4/\x19\xcdm\xe4c _=\xbbH\xb7\x82\xd0*L&Щ|\xb6\xc9\xe6\xee\x1aC\xb74\xaa-c\tT>}\a\vt\x9a\x17\x95\xe0\vz\xaa\x9f-\xeaEߞ|\x93\xa2oO\xb6\x17=\xf9\u0092\xedR\xbd[\x8a\xd1V\xe0\xb2;\x14\xb9+\xeb嗉TD\x8c\xc5\x1e\xcf\x13\xb9\xd4\v\x19ȼR`\x11\xd65ǧ\x00\xce?̽\xfc\xd0\xd8\xfe\x8d\xeb\xc5\f<\xc9\xe7\xe5;\xf8\x84\xd9\x18\xc8\xda\x196EIJΓ\xb2\"\x19)\xf4,i\x16\b\x80'\xdf4\x9a?\x90\xcaU\x98\x82\xb8\xec\x85\xce\xd2h\xf9\xa1ȣx\x1e\xf1\xaf\x12\xbb.\x86\x84\xf3<_\x03\xe7\x91\xd0>\x86R\x16\xa2e\xee\f1|\x9a'Y\x05n\x94\x93L\xa1\x8c1\xc6\xf5&\xcb\xc0`I\x00{Z\xe9\xb7kEX\x06Ņol\x12LS\x1c\x0e\x87)\xfa\xa7\xa8\x88\x9f\xa2\x82\xca\xf5\x86#K\f\xf2e\x9fL\x8dL\x93)\x8d}\x94\x95k2O\x16\t\x89\r\x06\xcb\xe0\xa2ל\xc0i\x91<\xd2\x18\xae\x0e\x98\xecP't\"\xe6\"\x15\b\xeaqϓ\xeca\x17\xb4\xdb̏\xf41\xcd\xef\xea0nr0ѝL\xf5\xb4\xce\xd0J\xe4\x12/=\xe3\xa9\x13\x9d\x82S\xc0**\x1f8\xcc\xcc\xefq*\r\xa0\x88\xf9A\xcf\xd1)\xca\x1e\xb9]\x9c\xa5\xb3\xfah\xae\xd2}q=\xa6\xcd\xe3\x85\x00\xee\xe9o\x9b\b܇M\xa6vW\xae\xa2j~\x0f3\xf2,Z%鋆\xc5g\xa4\xd0#\x80\f3\x99\x06\x93\xe9a\xf0_yF,Ղ\x10\xecM텅\xe0\r3\xc3\xe1I\xf9S\x92\xc6\U000e822dh_\x1c#_\xa3s\xb3\x05]\xb0\xa6\x18\x91\xaf8\xb0\xcc\xe7\x0f\xc0\xc6C\x86\xc5\x03\xd2\b\xe1mơ\x8e>g٫\x9c\xce\xde0#Ȑ\x91g\x92=F\xb5\x05\xbc\xc7\xe6y\x96\xe1>b\xb2ጕ\a\x1f!*\x9eˎ\x8e\xd2Y6z5^\xe4\xab\xc9\x14ϧ\x84\x05r\xe3'\x8e-Y\xad\x8c[s]\x94KT\xde~\x90\xe7\xb6\xe2 \xcf\xef\xf8\xaf\x05\xe5\x97\xf4\xa7~\xfcsE\xbe\xe1\xa5\xf0mQ+\xc58,:c\x05\xe9\xd9\xcd\xccMs\xfa\xdbg\xd5\xc2jmM\xa3\n_\x87o\x1b\xa9\x82\xf5\xf97\xed\xf1'w\x975\xeb\xef'\xdek_\xa1\xcf\xf8\x82\x01\xdek\xb1OƐ\xc14\x02\x96\x88CEg\xa1z\xb6\xd4KR\x19\xfc\xebB\xb1|\xbc6/\t\r\xa6\x9dUQ\x92\x95a\xb2\xb68\xa6\xc0\xa9aq\x14\xee\xe6p\x93\xe9#\x84\xb9\x11\xee\xaa\xd9\xdf9\xff\x1b\al|'S\x84M\xf7\xa7\xed9ض\x062&d\x8aҴ\xc87\x15)(-\x94Z\xbe\x94Z\xd2\x1d\x95\xa4&S!\x01AZJ2&t\t\xa4\x7f\x93\"ǹ\x86R@M\x81\x11Fi\x9a\xe51)u2CF\xa63T\xd9\xd2${\xb0s\xb8\xa0\xac\xf2\x06\\\x88\x858q\xa3\xa4\"-]VYĒe\xf5\x95s \x8b\t\r\x0ft\x18\\\xdcܲ\x1f\x9a\xd2O\x93\xe1\xf0\xc7!\x97\x06\xe9\x7f\xb5\b\xb4\xac\x04\xb6\x1f\x95l\xebv\xafq\x85*\xe5\xa4\xfa<2GC\xb4\x0f/\xb4}a\"Z\xd9\xc2\xc5\xfasA\x0f\x84\xba\xf5\xc9\xf6l\xbc>\x0e<\xde\xf5\xf49\x84vK\x81\xc5(\x03\xec\xd3|\xdaxn\x05\xa8\x03\xcf҃\xb2\x90dy\x92}\xca\xcb\nΩ\xe1}^\xa2\xf7\x14y\xe1y\xa0`j\xaf\x84&<\xa3\x8e\xe5\xb9\xf27N-\xad\x17\xed\x9b\xc7(5ӏ\x85\a}1\x8fؙk\xbb\xe6C;\x9b\xa9\xbef\xc9z徒\x92\xec\xcb5z.\xdd\x17\xaeh\xbd\xeeH\xc7ܮA\xd2\xf1\xd9\xd7\x17\xea\x7f\xecN\xae)\x83\x1dz\xbf\xb0$\xe3Ĭ\xcab\xb4\xb7\xa9սJ\x0f\x96]2\x19\x97\xae:Y$\x01^\xe3ˆ\xbaj\xad\xd5\x7f\xb4\x81\xdbu\xfd\\\x89\xe5\x19\x12\x96\xbc]\x15\xbf\xad9M\x94\xefB\xe1S_\x95,y^\x16Ѫ)1\x87\xe2^\xd0Gʠ\xa3\xf9\x9c\xac\xe9\xa1\x10\xe3\x1f\x06\xe8\x1ct\xa8\xdb\xeeH\xbe\x92\xe7\x0f\x1bv^\x8aԍp+\xa0\xcc\x1e\xaeh]\xef\xbbP\xce\xe3ˣ\x8bS\xcc%[A8\xd7\xcc\f=\x19\x81\xc3\x1a\xf9\xa0\x02\r\x8a\x9cL\x8d|\xbf|\x9eL\xf7]\x98\x17?\x1b{\xd6/\x9f\xf7.~v\xa2^\xcel\xd4˙\x13\x95\xee\v\xe6\xf0\x95\xa4xL\xe6(?\xdd+ܧ\x00Ffv\xfdc\xa8\xe7\xda\x0f\xa8\xf9\xa9\xfc\xd2+\xa4\xf7\xaa諽\xd9\xf5\x8f\xde\"n~\xbe\xb1Ze>B\xa3S\xea\xe2giخz\xf60\x98\x16d\xc1=\xf1\x8a\x1b\xdf˙\x03S\x00\xaf\xd6\xdaE\xea\xd5Z]\x9b\x12E\x95\xbf8g\x93\x12\xdd#9\xae\x8d9Aﭨ\x84{.E\xafֵ\"\x01\x82\xbb\xaf\x86\x15w\xda\xf5z\x9f\x9e\xedl3\x04y\xf4\xd4o\xfb\xff\xb3W\xfb7\xb9\xac\x99~\x18v\xdd\xf3\xab'\xa2`\x0f\x81C\"¢cG\x1ac6Qlm&ܨ7\xa4\x8746\xb1\xa5\x1eRy\xbc\x83\x89Q<㩡\xb8\xa5;\x8a2\x99\xa2\x86L\xa6\x18tqt\x8c\x1by\xff\xa4\xc9\xf8\x8e\xa56M\xd6Dq^\xf8\xafr?F/\xd0\xd8V\xbc}+t\x9f\xfc9\x91\x9b\xe3\xa6Tn\x8ek\xc8ܞ4%s{RG\x86\xefRM\xe8\xc8\xfb\x11=\\\xad\xb8\xcd\xe53\x04X\r)>\xe6\\V\x86#" +
+	"\xf2\xbc\xa2#Y\xf2$\xb8\x9d\xf9\xe2+Xkg\xf4\xd8ݲJ\xc9{P\xa88l\xb7\xee\"\xf3\xb5,\xcdU\xba\xfc\xb8O\xb2J,\xb1\x88St\xbc\xd5\x10e\x93g\xb8\xcd^znuKls\xb2\x1f\xfc\x16({\xb1w\xffؐ\xb2J\xf2\xccc\xab\xb0\x1flJ\x98H\xfc\x8dI\x14\x8b\xf8]\x88\x04]\x03\xc5>\xa6\xfaI{1\xed\xa8pU\xbc\\e쩒\xb3\xca\xf3\xc52؋\xb3\x92\xc9\xc5ھ\xb6\x1f\xfcF\xa7\x03*\x0ex\x85\xbbN\x9e\xf7Ӫ\")\xdd\xf5<\xf3ck\xa1\xf4.v\x81j\xfa\x85\xb5X\xe6J¹*bR4\xa8P\x0ex\x01\x885,/\xcd\xe7\xaa\xd0V\x19\xc9U\x93\xa6\xb7\xa8\xdaaw\xe7*\xd5(\x16]U\xa2\x92c]\xff\x18\x95\xfbc\xfd%*Ǥ(4\xac0m\x1b֙ɺ\xce\xca~Y\xcf5\x9eJӛkw\xb9\x1a\x9fi\\\xee/\x9f\xb7\x96\xcc\fbIU\xcf\x013\xf4\xa8^?>4\xe0sk\xce\xf2\xad\xb0\xd5\x12\xa3D\xbb\x80\x1fkIx{?\x16\xf9\x06d\xc4=l\xc4\xfb\x8e\xa6:\xb2\xa1cȶ!\xddu݉)N\xb7\xc8\x06ĵ\xb5SOr\xe7\x85\xec>\x1f٤/I\xf5\xa5Ա\xd9\xc5\x16\xde\\\xd7'\xdb\x1bѨ\x8f\xe8\xf1\xacQ\x01\xbb\x9c\xd9\xecr\x9aq\x83&\xab\\\x9d\a\xbd;~\xd3C\xa2HE\aú2/~\u07ba7yN͎\t4kH\xebr\xb6\x95\x16\x1c\\\x1b\x11kط\xfeI\xa7s\xad\x06\x04\xc1\xbc\xab\xf9\xf4\"\x85[\x00\xad\xe1\xf5_e\x1eTQ\xb1\xc4\x0eO\x8a\xc7\x1ae\x81\xab\x16_gf,\xf3\xaf97\x96\xf9מ\x1d\xe9\xb7\xdb\x03\xd2?\xc0\xb0w\x92\xa8\xd2?\xca\xf5\\\x97\xb9&\xf9\x1af\xe7f@[\xe8\xfdg\x99]\xfa\x15\xa7t\xfa\x15't\xfaM\xa6\xf3\x973;\xe6\x10\xf2\xfaG\xa9\xb6\xc1\\\xe40\x809\xc6Ձ\xa0\x1bL\xf2\"\xa9^d\xc2O\x04\xc4-\xa5.\xa4\xb5\x9b\x81#$\xed2M\xbbC\xf3^\xaf9\xec\x00\xb8^ø\xab\x9f\xf2-\xdbcu$/\t\x84\xe1i\xd82,QmT\xef\xf5\xbd\xc4\xf0\x99(\xdd\x1co\xb5Q\xba9\xae5R\x92`\xa7\x95\x92\x84~\xa1\x99\xd2\xcdq#;%\x8a\xcdqA\x8f)\xfb\xcei\xc8+\xa8\xaaa\xf2\x1b8\xdd\x1c\x7f\x99\x85\x93\xcc'U\xab\x85\xee\xd7M\xbe\vs\xe6r?'ے\x89\xaay\xa1\x04K묣Pz~\x9c\x19\x81\xb7\xc9KR\x84%\x99\xb3\x81\xf2c\xca+\xd2\xf0\x81\x90u\x04\xbf\xb8\x8e\xa6A\x9e))\x92<\x0ec\xf3\x81\x8a?\xebe\xceL\xf83\xf6\xb7\xbe(\xe3.6dJ$_\xef\x15_4PO_2P\xb8\x0f\xd8\x117\x9c;o\x9cu\xff3\xe8Ұ\xf1\xc3|\x94\xe7K\xe6\xb0\xca\xcb.\xecxOz\x8d\xf0\x1dYB\x9f \xa9ẟ\xe9\xeb\xe4\xdc\xdc\a5\xaf\xf6N\u009d\x87\xfa?i\x05\xc8\xee\xdb)\xbchy\xf2\a\a\x8fT\xe0H6\xbbY7ͷ7}\xc1k\xa9\xd5Qm4\\\xf3\xbd\xdbF#\uf477o4\xb7'\xdb6\x9aۓ-\x1b\xcd\xed\xc9֍\xe6\xf6\xa4v\xa3\x91`\xe7F#\xa1_\xb8\xd1ܞ4\xdfh8n\x83\x8d\x86c\xaaa\xf2o4\x1c\xb8\xf3\"\x95\xf9\xc4F\x03\xa6\x03w\xeek<\xed\x8a\xc4K\xa8p\x102\bH\xd3O\xf7\x9d\x8b\xa3V\xcd\xcct]\x8d\x91]\xeci\x94\xf9x\xa7\xaei\xdc\xfcWoَ֨\x8d\xfaP\x15dU\x7f\xd7\x02wi\x1f\x17\x1b\x1a\x8c\xdcv\n\x8e\x8a\xbb\x9fE5\xa1\xb7\x83-\xb3\x9e\xb5f\xb4\xec\x9651gv\x91\xaf\x1d#_\xb3w*\xcc2\x9e\xfe\xe2\x05%(}\xcde\xf1G-\xb4w)\xcbg\xa9\xddp~\xf2\xec_gr62\xfc\xfe\xc2\xe9%h\x7f\xfd\xb9\xc5\xf6\x12~I-6\x13\xcd\xe0Wٮ\xa0\xfd\x85g\xa8\xdb\xc4%\x8aw\x17\x97\x185۸\xc4\xf1\xec\xe3\x12\xee\xde\xc8%\xf8KwrI\xa0\xc1\xdb\x16a\xb0\xe6ٓ9t\xf7MYf\xf4\x9f\xc9\f\x1c\xf7\xa1L!\xc9\x1d\x11\xec\xfa\xac'\x18\xb6͂\x97@ÝX\xcb\al\x12\x97\xbb\xfb\xa6\x89\xeb\xe7/K\xec>zY\x96\x9d\xa1sQ\x9bDv\xd9r\xf4\xbcu\xaduԦ\x11[\x90%\xf8\x9f\xd14\x18\xc3?\xfc\x94\xa6\xe18x\x99t\xd31h\xc6Y\x1b\xf6\xa1\\\xa9\xfeӯ\xbef\xeb\x8ej:\xfc\vֵy\xbe\x85\xb4\xd0m\xfcZ\x93\xcb{*֑\xdd\xc7b\x83\xa0\x87\xc9\xee|0\xd635<\x19\xeb\x99\xd0ٷaw\xcc\x1b\xb4\xd0\x7f\xfc\xf55\xf76\x83\x178W\x19\xeb\xbf\r\xfd\xd2\x1c\xbf\xdef\x0fY\xfe\x94\xf1\xbd\xd0\xf5\x9aÁ\xe13\xf4s\xa2\xba\r\xfeܨn[N\xb1Wa\xb3ij\xae\x897\xceC{+>\xdcq\xeb<\xac\xdd)\x85\xa9\xec\x1cm\x93\xae\x857\xa7\v\xdf^ps\xb9\x8bX,\xcf\x18Źb\xc1\xcdPݫ\x83\xc1L#W\xab>\x86\xb7*\x03\xdep\xc9H\xe4-Ʈv\x86m\x96\xaf\xee\"\x98\a\xb0\x10z\xa6t\xa9fu\xf2Ͱ\x1b.\xf4\xb9b\xec\x8cn\x19>\x06\xca%\x99\xa9\xf3\xa4sF\xd8\xd4\x04썗\xa0%\x92[\xc1\"/\xf8ePXc\x90\xa8\x8f\x10ʝ\x14e\x15\x96U\x11Ud\xf9\xc2L-نB\x97\xba'\xd7:**\xe6wܟ3\\\x17\xc9**\x12R\x06\xca.Hx\x91Qi\xa2\x9d\xd2T\xa9\xb1^V\xe6h\xd1K3 \xa6\x1b\xbe\x8b\xbb.W\x8e\xa4\xe4\x8e\x00.g\xe6jCX\xec6\xffj\xb1(I\x85b\x9e\xd1\n\x1b&WX\x027@\xf6a\xe0\xcds\xe7\xd7\xf9\a\xee\x13\xef\xabj\xfdz\xfe?\x87\xfdaߊ\xff5\xea\xf4\xdf\xfc\x7f\xbe\x9a\xffO:#\x94\x13P\xf6%\x9cp\xdem\x16\x9a[O\xdb+\xe8\xbcxYW\xf9\xfb*-}\xae@\x93\xfc\xfd\x02\x03\xd3|\x89\xbe\xf4\b$\xba\aQß(T\x8d\xfe\xa7*\xa291\x80\x9b\xc2\xe1K\x94\xc5*K\xd3\xfc\xe9\x1f\x1bR\xbc\xcc\xc8*\x99\xe7i\x9e\x95\xe1}\xf0)\xcab\xea҈\xff\xe0\xd8\xc7Q\x96g\xc9<J\x99I\xf7\x0f\xe4%,\xdd\xefY\x8fӄd\xf2]\xfcM\x11e%-\xfc:\xdfd\xf1M\x91\xac\xd7\xd4\xfd\x1a8i\xbe&qR\x909w\x8fV\x90߂\xbdk\xf2ۆ\x00\x8f\x7fL \x8c\xab\xf8\x96\xafP\xfe\x1e\x15\xc1q\x9e?$\xe4\xefQ!=\x16\xe9\xf7\x96(\x063\xad\vp\xe0,6\xe8ǖ{:\x1a\x95\xa0\\\x03N\xb9γ\x92\xec\aq\x12\x8b\x12ؾ\x11ȫK+\x122/J\xd0\x15\xce\xcdnh\xf08\x03\xa9\x12\xbd\x12\xb6\xb4~\xb1\x10?\x92*\xdc\x14\xa9\xeci\xab\x8655\x99\xe3.nһ\x16\x85\x93\\\xcb\xd6\nB\xbddg\xfb\xad<\x05\xa9\nR\xe2J\x17\xa4\xaa\xa9\xf7*z\x10\x01G\x8e\xf3uB\x8a0\xd1):f\v\xf4\xcfzF\xb22\xa9\x92G\x91\x9b\x0f\x16\x85}\xc8\xe3\x17\x9cl\x17;\xcdK\xdc\xd72B\xda\r\x0ebw\x97\xc7/ڭ\xf4\x0e\xe3\x01\x05\xd0(\"\xb8\x10\x1a\xaexS\xa4\xefht\x9br7\x8aР/\x9e\x1eTf\x87\x87\xe5\xdciX\x02뿥-\xe2c\xe7I\xc3#H\xa9,\xbe\xf3\x00\xc68-\x8aZ\xb8\\\x15\xbb\xce@\x96\xfd\xe81JRx@o(51\xce$;\xa3f\xde5(ׄ\xcan\xf55%)\x89\xa0\xb5Nhy\x9fo\xd2\xf8z\x93\xcd*\x88\xa3\x93\xe7\x0faY\xe5k\xe1-\x8c\t\xb9\xb4+q6'\xa9U\xf4rG4Jn\xbc\x19\xa9\x14\xca\xc2&\xae\xc68/\xc9e^%\x8bD{\x11\xac\xd2\xe1d\xcb\x1ddr\x87p\xd2g1-\x01\xf7\x9bL\xf4\xb9Ka\\۩&\xff\x11\x85\x139\f\xfeA\xc3+\xf0\x03\xf4\x14\xb6c\x019\xc9WQ\x92\xc9\xcf\xd3\xe7uR\x90\x12;\x18\xbd\x8e\x9eD\xaa\xc0\xba\x88\x9e\x8f\x96\x84\x9d\xd0gd\xbe)\x84S\x85OU\xb5\xbe\xcaR\xe1`w\x16\xad\xc8,\xa9\x88\xfcq\x18L\xc5\x01EV\xe7:z\x92\x84o3\x1aF)\x96'\x04\xb4\x16h[\xfd\xea{\x01\xff\x11\xdcJ\x18\xc1\x8d\xe4\ue187\x84\xa6\x95\xe1&\xd8\x03^q{}\x0e\xe7\x12N\x06\x9aU\xd9\x18\xc0\xc0h\x9a\u0094Η\x8f\x8b\xbc,\xaf\x8ad\x99d\xd3\"\xaf\xd8\xeao\xbe\xd2]\xb9\xe9!\xef\xa6ؔ\x15\x89\x19,\xcc\xe9\x1fO\x98\x18?\x91IV\xd2a\xfa\xf0\xb2\x8e\xcar\x1aU\x15)\xb2p\xcd\xfe\x9a\xb1\x13}t\xa8\xda {\xe1B\f\x92k\xb6e\xa4\x82\x89\xc1t\x1a\xd5<)9\xfe\xe93Y\xad+\x83\x84v6\xf4P\xb0+\xab\x840\xe4f\x99\vX|I\v\xe78\xd2#\xdd3\xdb\xe6>P]\xc3߂N\xbb?\x1e\x8c\x86>4\xba\xf4\x98ː\xbf\x05\x83v\xdb\xc6\x13[D9%\x05}\xb8M\x1d+\xa2\xfaPW\xd6\x17\x9b\xe7`O\xfc\xd2\xc0n\tPx'&\xd0z\x1cuI\x8b߯1\x90\x93\xc4\xd4\f\x9e$\"z\x9cn磌&\xb9\x8ḅ\xbb\xbc\xa8x\x7f\x1a\xde3A8\xb8\xcc+*\x11\x93\xd8\x01\x84\xed\xfehQ\x11\xe6|\xd3@\xe0\xb5?'ٲ\xba7`\xbc<!D\x1a@:\x02\x10\x150ϖ\xc1\x1eL\x85|\x9e\xa7\xa7:R\xf2/xIn\xd6\n\x82\x96\x88\x9c:\xe4\")\xe1\x11\x93\x88\x80\xe5\xa3\xcb\xd1\xf4\xca\xd7\xe3B\xb7\x1a\x85]札\x9bɠ\xf5\xa3L\xc5\x04\b_+E\xe5+\xed2\xaff\x9b5\xcc\x18\x12\xfbpf\xf3{\xb2\"\x17II\xdd\f\x1a\x85\xd0i\xe8\xf6\x93:\xbbϋ\nF\xd4K\xf8!Y\x1f\xa5\x95\x00\x1a\xd9o3\xf2\xbc\xa6\x0eVo\x8a(\x81\x89\xe4!s[\x12\x88@#d\x15\x97\xb7V:\x9f\xce l\x8c\x1e\xc7\x19~\x87O\x81Ȫ\x85\xe9B\xb2iL\xb0\xab\xb2\xb3Y\xb8(_\xca`Q\xbe;\x9b1M\xe2쥬\xc8\xca\x1be\x92v\x0f\x8e2\xa9Ř\x84\xa48)¹p&D\x8d\xbe\x17L+9\xc9\x16\xb9RYæO\xb5\x966\x10{\x90e\x83\x12\x16y^\xa1\xea\x99\xc7L\x85y6c\xb8\xbcE\x12M6\x87\x11@\x8d\xda\xc2\b\xc4\xdeG\xbb\\\x13xh\x8a\xda\xe0v:sQ\x92\x9fnn\xa6]]\xefx\x11\xc1\x9d\xcb|S\x14$\xabfUA\xa2U\xc9e\x10\xfa2Q\x83\xf3]B<W\xbf\x88\x9eyPg\xce!@\x90\x15\x91\xfc(\xf84\xab\x05\xb3\x1b\xe6he\xa6\xceI\xf2\xc8u\xc6SR(\xb9ߋ\xc2*\xce\xebM\xb2x\x9adK\x8f\xbb\xf7\x1a\x10S\x81\xbfTė\x95\x14\xab\x04\x96\xdc}r\x97T$>N\xd6\xf7\xa4\x98m\x92\x8a\x88.\xa1;\x14]\x1aL\x9e%E\x81\xceer\xe8\xd8,1d\x85\xfd\xe0\x9es}C\x06`\x9f\xe07ۛ\x83\x16f.E\xb9\xab\xcb\xf1\xe7\xd8jJ\xd19\f\xd3\u009f\xf9w=3TcKq\xca߯\xcc\xd1\nTI6\xcf(\xac\xbc\xbb\x9d\x18Y\xfd\xe8$s\xc5]\x95\xf5\xe1\x87\xe1\xa38\x0e\x1fȋ;J\xa0\x89<#Us\xe4\x8f\x1a\xb2)N\v,v\x84\xd6\x10}U]6\"x\x1f\xe9\u0530\x10'pNH\x8aqL0\xbbDӌ\xd25iR\xe0=\x99x\xfb\x01U\xe7\x05{R\xb3\xf7\x8e\t|7\x05\xf5\xf2\xe7\xa4r\x9c\xe6T\xf3\xf4\t\x87\x99\x93Вn\xa9?\x90\x17\xdeQ\xe4y\x9enb-p.\xbfty\xa0o\xfe\x1e\x04\xe6~p_\x06{,\xd6\xe5\f\x88\x14\xeev\xce6w%\xa9\xf4Vx\v\xf1w\xc3\x0ed\x1a\xf6\x12\x9b\xc8L\x92\xc2˔%\xc1\xee\x95\x11\xfa\xd8)\xdb\x0f\xf6\xa8r\x97n\x8az\x88Lݟ\xdeQ\x16ӥ\x17\xea\xfe:\fN\xa3\xb5R\xcfxs>\xd3\xf3\xceIQ\xd1}Z$<\x90\x17\xed\xdbG\x1c\xa4\ny\xcb\xca_l\xfd@^\x82\xbd\xb9\xfc-\x9c5E\xcf\xf4\\\xa0y\xd89\x87 \xad,~\x91:\xe3i\x98\x9e\xebv\x81c\x9d[\xf6\x03\x11\x0f\xc9\xd8\xdeE\x0e\x1e\x1b\xd1űx\xd73\xf1\x04\x11\xd2\xd2\xc5)\xe5\x82T\xf7y\xcc7\xb2\xe0o\xc1\xf7\xc7W\x97\x97\xa7\xc77\xdf\xeb\b'$%\x15\x01\xf8\xc9\xe9\xf9\xe9ͩ\x01\xfeHhޏ\xa7f>\x98\x95\x00\xf9tztb\x80\xae\xd6Ta\x06Ы\xe9\xcd\xe4\xearf L\xa9`\xfa\xb7\xe0\xfb\xe9\xd1\xcd\xf1'\x13\xc8\xceQ\xdfO\xafff\x99\xd3\r\x83ܚ\x00:\xa3\x01ts}t|\x8a\x02M:O\x93a+p\x1f3U>\x98[\xf2p\x16.JM,s\x9c\xd5\xcc,\x86\xcc\xe9\xce\xc1\xf7\x9fpE\xdb \xa72V\x81Z:U\xa5\xd6\xd54|\x8a\xdaOIu_\x1b\x86\xe3k\x95\xc6&'\xf7v\tS\xbc0\xa7l+س\xd1\x14\tq\"\x86\xe1\xd0OǗ9=\x86d\xf4\x8f\xc8\xc0#<5\xda\xca\x05\xb2X}\xe6Zc\x8e\xb7\xe8\xe9\x8c\xc5^\xc6oKY\xba\xd1d\x9a\x03D\x89\x1fIQ\xc2\x1cz$\x05\U00082d0a\xfe\xc5\xc2H\xec\a\xab$\x13?\xf3\aM\x9fNiH}\x94QpM\xb1 \x1a2o\xf3\x12\x1b\x99b\xb8|g}\x1bu\xfdWP\xd23Gf\xf8X\x88\xa2\x9b\xe5\x05\xd3\t\xda^\xccp\x06\xbf#3\x1dkR\x86\xaad\xcd\xfb\x1c\xc7+k\f\x85$N\x8b\x1e`:\x96G4\x05\x9f\x91\x8a\xa1\x18\xa3m\xd2\xe8n\xa7ѭ\xa3q\x9b\x91\x8cޜ\x92\xb8\x019\v\xbb\x862\xc8\a\xbd\x1ar%\xab]Ϣa }H\xaa\xf0.ao\x95\xc7\xfbAM\x89\xa0\x044\x1c\xc8a\xb0[5<-\xf2\xe7\x17\xb0J=\xcd\x1e\x93\"\xcfV$\xab\xac\x1b\x10\xa9\xe0\xd5g.d\xbd\xbd>\x0f\x17\xc93\x89o\xafϑ\xae\x98\xdd\x01ԑ`\xb3fSދ\x8dM\x9cc1'=\x14\x87\x01\xf6\xe7w\x94M\x13\xab \xc1\xf4#\x91\xaf\xab2\xd8C%\x18\xdeDa\x89\x8a\xcd\xe2\x0e\vauL\x9aeb\x8b0,\xf4\\\xfbAÛ#yEZ\xc7u\xf5M\xc4T\xbf\b\x12\x82\x17;q\r\x1d\x06'\xec\xe9h<~\x87l9\aʷf^\xe5\x17\x82\x1b\x8bo\xc1\x92\x8dA:\f> &(\xb4>\x1f\tӂ\xf1[\xedА\xba\x84nG\xd7\x0eRI\xec0\xa0\x02\xc0\x82\x14T\t\x01\xacL\x1c\xb2\xb8\x9fXq\xbd\x82݁\x02KE\x1c\xf4P\xf2Y-Q\xaa\x06)dO\x9a?\xbc\x83\xef\xc3@\xe8\xdcDÔ\xe9\xa0,\x87w\xea\xed\xf5D&ݜς\xbd*-\xdf)}\a\xbd\xa7\xf2\x87\x80\x13\xb3@1xz\x0f\x85\x14\x04\xd8\x01\xb5\xd7! \x9fwBPi\x99b\x8a\x8d\xbaE\xaei\xc9\xd9\xe8(\x85\x9e\xfcv\xccD'\xceQuN\"\x90\xd0\x1cۻ\xe58\x8bg\xbc-Iq\xb4\x04\xded\xedP\xa8\xdd\xecF\n\xddTy\x91\xe0606\xcd\xee\xb6d2}\xca;e\v\x94\xeb(\x8ey\xc6y\xc0\x91\x1dX\xd7dA\nRԴKNR~\x88\x81\xb2\xd5L\xbd\xf6y<\xe4\xb9WF\xee\b\xee\x1c.\x80c\v\x83\xc7\x1d\x88%\xe5\xa7\xee\xedzYD1\t}C\xb5M\x01a`\xd2]\xa4\x11\xbaCc\xb1\x81[\x02JA\xd8\xc9<WE$\xcc.>\xf1\xd6<E\t,p\x98\x9fI\xb6!ز\xa6\x15\x84N\x875\xbc\xc8\x0fQ\x99̏6\x15\x04Z\x0f7%)4\xc7~p[\xf8\x94\x17J췶rIhF*Ek\x1b!G~*\xb2R1\xd1\xdb=\x14Ech\xe1*z\xbe \xab\xbcx\x11gZOV@\xa6,\xb1FE\x85\n✴y\x0e\xc0\xa6\xb6\xc6\x189Dܖj\xcd\xf7\xf4\x84O[\xe6\"\\Գ*h\xeb\xd2W\x87\x19\xa9\x9c9<\x8a@\x95q\x1dU,\x0e\x85V\f6\xe0\x90\xa8쮦\x14\x13ͻD\x9e\xa2\xac*\xc1\x1a\xa0\xd3V^4j\x91\x85}\x8b\x1b\x85\xbe\xa4\x80\xed\xd5?;\xe0\xb2x\x9dF/\xdcD" +
+	"\xc5M'\xdfT\xcb<ɖl\xfbef*þ\x8dW\x90\xdf6IAJ]\x8a\x17\xber\xd9V\xc6w+\xd8\xf96%\x8a\xfe\r\x9f\xc7\\B\xf9\x06r\xc6\x1f\x11 n\xb3y\xbeZ\x17\xa4,\xa5\xf9\x85-\x01\xd0.\x90[\x9c\x7f\xb7\xd7<\xf5\xd2.ٶC\t,q\x93\x19\xfaEo\x8c\xbe\xfb\x9e*r6\xe0\xd4\x02\x15\xcd0\a\x14N\xbd\x93\x12h\xb8\xa7\x97\xc0KJq\x1e\x99=%\xd5\xfc\xde=u\x94Z\xa3\xeeщ\x8d\xdd\n\xf8\xbd\x9a\xa7\x15\x18s'=l-%\xf3\x05\x88\xcb\xccs{}\xacg!_F\x86\xc5p9ۤ\xe9\xc9f\x9d\x92g\xc3\xd2G?m`\xb5\xb4\x90,\xc4<gS\xc3\xf5\x10\x87\xc3x\x8eR[\xcf\xf2\x8a\t\xab\xe6P129\xac;%\t\"\xd2>*QF'\"MX\x8b@\xc1\x12\x8f\x86\xba\xd4\xf1Σg\x13\xa7k\xe2\\\xe6\x191\x91\xfa&\x12\xbfD5\xd0z\xc2u\x18U̧\x01L&\x15R\xa3V7O\xb3pn\xe5:\bj֦\xda~\xb5\xcac\x98\x14XY\xc5UQ\x82\x19\xb2K\xf5\x16.\x89n\xbf\xf5\xe7M\xbc\xbf\x99Y\xcff[2+ŬI\x88\x8d\xa50\xd9ij\xf2%2(#\xa5\x02\x1f\xad\x91\xe6\xdfg\xa7\xa5(,\x92,n@eV\xa9\xab\x91_\x83=Nw\x9f\x05\x96D\xf1E\\EP\x9c\xabB\x9e\xec5_q\x86rx\xad,\f\xf7\x03l`\x17\xfe\x1a\xec\x15\xf9\xa6J\xb2\xe5e\x1e\x13\xbbd\x98\x16\xac\x80\x9b\\\xe5\xf3U'ɖ\xec\x8c_\xea\xd5A\xe5;\x1e\x1b)\"\xbb]\xf4\xdaC\xd6\xf4V\xdc\xce\xf9\xc7n\xc8-\xa2\x05Y\u009a,\xbe\xa4B\"/\x98\x0f\xaf\xa3\xaa\xac\x1a^\xbd\xc9\x1b\xb7-La\xeb-\x9c\xce\xc1g\xd2O+,\x1eM\x13\xf1I'\fA\bJ\xd8\r>B\xb9Qʕ`\x02\x8b\x8b7\xe73nE\"D\x18\x1e\xbe>\x8a=\xa6\x13\x00\xe2V 5\xb6\x17\x1e\x18X\xf6y@\x86\x19\xa1\xb0\x0f\xb1l\x06)\xe0\xe6|vI\x9e\x99\xcd\x14\xbe\xa0eZG\xd6G\xfb\xb2Iپ\x1c\xa2CdJ,B\x89\xbdS\xc10(\xa0u\xc8t\xe1\xe7\xf92\xd8K\xf3\xe5\xbb\xf3|\xb9\xa4\xe2f\xc46[\x19^\x1f\x0f\xac\xa5laEm\x0f\xc7?\x0fD\x1d\x1c$\xa8\x969\xd8C\xe6>\x87HӮ4\xc5r\xb0\xd9]\xb4\xf4w\xc9F\xb9\x19\xb3eMX$\xcbMA\xb8.\xdcB\xe0J\xeb.r]G\x15\xdd\xdd\xd3gXXQ\xca\x10\x19ؕ\x9ds\x14 \xe0\vh\xa6:d?@\f\xba\x8c\x9eD\xe3\b\b\x13\xfc\xc0\xbfaڈk\xfdq\x06\xa9\x80\x89\x96¬ӪHF\xe8+\xff\xb0x\xc2\xddO\x1f\xbaZ\xb8+mf\x1a\xb6\xfb\x16\x92\x9a\xaa\x1e\xcc$K\xaa$Ja\x1d\xd1{p\x1e\x15T?i\t\xe4*\xa5\v\xb6\xbc\x8f\x1e\x88zU\xae-\x1d+\x8f\xfb)\x84\x80\xce\xee7U\x9c?en͝;\xcf5\xe7\x81W\x99\xccͭ\xfc[v\xd7\xce\xf1+\x0f\xcb\x13\xbe\x86%\xd6Ny\x9e\x83\xc1\xa9\xb7Ά\xfd\x83\x0f\x8d\xbd|8\xd6\xe6\xf0Y^\x88L\xcez\xb8\x047/}Rm֒c\x86[\xad'2\xc1\xa2\x90\xe8\x10\x84\x88\xd1Z\xe7\v\xadV\xaeͣ\xe9^a\xd0[\v>\x11\xb6\x14\xfb\xb0\xb0\xaa\"\x9a?\x88\xb2\xc24\v\xf6\xf4£X\xa8\f\x9d]I\xb3\xb3u\xcdV\x12\xcaa\xaf\x828m<\xa1\vs\xcbٚ#ΥZ\xc5;\x03\xcb\xfb\re\x12'0\x9d\xbd\xb3Cy\xeb,\xd9\xd9*\x0e\x1f=MJ\xf3\xe5\"\\\xe4\xc5*Bq&\x8ae\x19\xbc{\xf7N\x1d\x81~om\x99\xde0\xec_8\xcct\x82\xd2i\xff\xab$\xd5\x14\u05cb\x98gs\xb8^\x97\xfb-?y\x95\"[\xe3\f!\xb7\x8c\x97[\x84\xd3j\x88\vO\xe2:ߖ<\xd9\x1b\x13\xa4P\xe7\xe74غ\x8f\xe6\xf0\x18\x0f\xed\xf1\xf8D\b\t\xdcD\x1b#\xf45\x04i\xfc\x8eQz\x1a\n\xf06\r\xdc\xd5\xc0\x97\xe4I\x83\xb61tS2\x1f0\x04\xac|\xba\xed\xae\x01Ka\xaa\xbf\\\x13n\x8a\x0e(c\x1d\xe5C\x14\x7f\x8c*\xf2\x14\xbd\xd0\xd7\x13]\v*\x14T\x7f\v\xfam\xa3h`:i2g\xb0\x03\v\xc6t\xe3\xf0~\xc3\xccW\x90HT\xa7\xa3\x83N\xa3\"}\xf9\x94d\x15{\xf8\xd13\xa0T\x1dJ\x97\xe8\x19\xa8рD\xbf3ґ\x18䄬I\x16\x93l\x0e\xed\xeaw\xfb\x06N^\xdc%q\xcc\x02:\x9b\xa5P\x9b\x14\x18%\xb37xG\t\x81\x13\xfaˠ\xfb1\xcf\xe8\x14\xe8\x18-F&)\xda\xdb\x00 1\xd0Q'\x17\xb7%\xeb\x9b\xeeЀd\xe5f\xb1H\xe6\t\xb5\xc7\xce\vx\xd4\r\x04F&\x1a\x96\x9c\x98\xf5\x86\xf60\x86\xa11\x85\xe65S\xbc\xb2\x8e4Ƃm\xa2\xb4\xfbz&$_\xb3w/\xbc\rƤb\xa7D\xf4\x1e\x05z\xd9h\xe7ER\xb2\x93'\xc1S\xack\xd4\xe1\"\x7f$1XWG\x19ɪ\xf4\x85\x0e\x8b\x89\x03Z~\xf6\x93N\xa9\x91\x03\xbcN\xc9\xf1}\x9e\xcc郢\x9e\xd9\x17܅\x0eܥ\x90\xacJ\x98\xb6\x14u\xcd\xc0\xec\x1ax4\xc2\x14$P`\xdf\x04f@\t\x04\xe6\b\xd8\a\xbc'\xa0x=\x13\xafb\x8b\x17\xb6\x01\xdaEC\v\x01\xc4\xe0,\xe6\x95h[`1U\xfbv\x1d\xaa\xc9j\x9d\x12\xb0\xbe\xe0cd5\x01\x94O,B8t\x89A\xe0\xea\aZe\xa3H\xfa\x9c0Jq\xe3\x87&\xc6ˊ\xbf\a\x10\xf3\xca\\Fr8\xe5\xe3y(ߘBӂ\xcc\xf3,N\x8c\xb5\xde\xf5c\xe1\"\xbb\x16\xb5|N\xe8\v \xcaV,*\xf9\xf3\v\f\x99Vkc\x1a\x89\xa7qY\x95T/\xf0n\t\x8cCh\x9dzND&`\x9c%$\x8dK\x8c\xde\xeb8\xd1\x15S\xe9\xb7\xc7N\x8c\xdb\xeb\x89x.\x05\xa5\xf6\x9dH$\xbe\x86\xe8y\xc0d\xa2*)\x17\x89\x98[\x9d\xa1\x89_\x92\n\x8f\xa3\xb1<g\x84\\U\xf7\xa4\xa0c\xd33a4L\xc5m\x16\x897\xcat\x82\x99XT#ϮS\xf9\xf9\x12\xfa\xdeh\xfe\r\x89\xd69mvglB\xb8'+m\x9a\x8cĎ\xceq\xc0\xce@\x9a\xa7\b͓F&\xcf\xe9\xa6B\xa7\xc5\xc0\x82]Dً|\xbc\x02(\xc6>v\x9bEl-\xff\x9bO\x8b\x8e\x05\x17\x9dp\x96\x17\xe7dIOb%3\xac\xed\x0f,\xec5\x9b\x89\x80Ϧ\x12-\xb4k\xa2\x95b\x8b\xb8 q\x12Q\x8b@\xe8\"\xa3\xfe\xb7\xf2\xac\xaaf\xbe1η%\xbb\x10\xa7\x9dgd\xff1*\x92(\xab\x8e\xd22\xbf$˼J\"\xf6\xe0r\xd0\x1e\xca^.\x925\x9c\x8f\x93\xe7pM\xff(e\x95\xe3\x89'#\x0eS\xf9\x8c\x89\xad\x7f\v\xbe\xbfȳ\xfd\xa0\xdd\r\xfe\x1ee\xc0O\x86Ag\xf0\x7f\xdb\xfd\xff\xdb\x1e\x04\x1f/n\x84\r0\x9f\xfd\x0e3\xec\xd8\n\xe3\xb8*\x97Rl5Kf\x97k\xac\xc2\xd4И%\xfc\xdf\xef\x85\xdb{\xf9\x92SFބ\xbe\xd9f\\v\x18\\e\x14\x93_\xc9\xc9;I\xbf\x16f\xcdmٴ\xc7\xcc<3\xd6,\xc8D\xe4^B\xba\n9\x11\xbc\xb6V\xe1S\xeb\xed\n)\xa4D[T,\xd1/\xc9\xc9ΫߠJp\xef\xf9E\xb5\x82\x1a\t\xdf\x00\x96\xe6\xf1\xe6|f*<LM!\xd7t\xa9\x83\x99\x8c\xb9Jӏ\xf9\x15n\x92g\xeau\x9b\xd4GHŢ\xf5\xc8X\x00\xecD\x81\xeaՈ\xb2iP\xab\x15e\xa2\xb0\x90\xb2=H\xb5jM\xce\xd7*\xf5\x80i.\xbb.k\x19\xcen\xf0\xec7.\xcd?\x92\xca\x01\xddieh\x86\x96-\xf1\x96F\x8d0}ׇ;E\xeat\xe12\x1e\xf9\xa7S\xaf\x05\x95\x87;\x95v\x96\x17srTU`\xd7\xca\x14\xa1\xdcί\xb1Rt{\x8c\f\xc1`Z\xf4]\x83\x98\x96\x1e\xd5dI\xdf\x00\xd7\xc4\x0fV\x04\xec\xb8\x0f\xaa$C\xcb*\x953\xaa\x11\xcelT\xcfJmʲ,4\xabbM\x8e\f+6C<;\xea\xaaF\xcb\xd0{B\xd3\xc7&\xe2\xb0\"\xdc}\b5`\x10.IF\x8adެ\v\xecr\xc0\x04\xb9\xd6\x121\xdcہ\xb4PG\x9fx\x03\xe0\xcdW\x82\x97\xb3\xd3\xcf\x0e\x9d\xf4\x05\x9ebP\xee'}\xfa\x9bQ\x93\x14b\xa1-\t?\x9eT=\x8bҝ\x1ae\x94A\xbc\xd9Si\xce~\x8c\xca\xe3MY嫛\xf3\x19t#5\x0f\xd0C\x9f(\xe4:\x9d\x8f\x8d]\xaf\x8eD\x88\x9b\x92\b\xdd3\x89\x05f\x9d\x9f\v\x947J\xe9\x14\xa8\b\x1eV#\xaf\xd3\x13\x16\x1e\x02\xdfH\xff\xba\xc5\xe5\x11\x9e-\xbc\x01\xb2\xfa\xc6\xd25\xdcT\xb4\xdcSa7\x1af@\x19m\xec\x9d>\x98\\\x83D\xd6Q\xa1\x1e_1\xdbe\xc3\v\x168\\a\x00\xb5\xc2\xe8\xe7q\xb4)\t{/\xec\x018kGQ8u\xbd\xcf=\x8cT-\u0cfc\x10\x19+\x9aS\xba<S\xc3f.\xfamc\xb7\xdeTW\x85\xd6e\xe1\x1a\b\x04{kR\x94Ii\xf8N\xd2\x17\xa5)a\xf8WpU\xbcL7U]\x11\xfe\x01\xfdmC6 \xbe\xcb\xdcO\xc1\x1eX\fr\xc6\x1f\x13\x10\x91\nb\xea\xe1\xb5ɵ\xca\x1fk\x1b\xe8]czV~\x8b\xb3\v\x81\xe6\x01J\xeb\xa5J\xe7\xec\xa0܋\x8b\xaa_R\bm\x87VRMiK¶K\xf7\xdcs\xee7\xbfj\x9d\xb4\xad\x001\xd0tKÃ\xecB.\xab\xa8\xa8\xf8q$\x83\x83.\x1b\x9am\xd9b\x95c;.\x99k\xb3\x1bL{\xb5\x16\xfe@^j\vi\xb6/\xef\aI\xa96~~\xe9[#\x86س\xcf\xf1VN\xbe3\xdfn\x8eD\xf1\xf1Ct\x95\xc5BS\xf7\x18[\xbces,\x9f\xe32\xf6DSf\x159Y2w\xa8]\xe7#[ :o\x82\x05P\xc4J\xa8\t^G+\x83lf\x1a\x19nq|\xbf+2\x89\x90Fe5#\xcb\x15\x7f\xd5\xc1~\xd9hs~mQ\xc2c\x95p\xdd\r\x14Hc+(\xc3\nv.\xb0\xf6.\x8f\xb2X\xd8@i9\v\x92\xd2CWy\x9f\xac\xbd\x14\xbe<'-\xbb.\x1f\xedZd\xebո{Q\x9e\x16\xf0+\xe9\xad\f\x19\xac9|\x8f\x99\xd9x\xbf\x97aI\xa8\x99\x04\xef\xfd\xfd`\x17:%\xa9v-\xf7\xf8>Ic\xed\x19\x00Fqg\x03\xf3\xbd/\xc9G\x8d\xe0\x9aZ\xe2\xb5\xf4\xdc\xfb\x0ek?\x9b8\x9b G\x19\x8c\xc2}\xf8\x95\x89S\x9a\x9a\xad\xa0e\x88\xf8%d\x1bX\x05\x8a~\x9a\x91\xca\xf2\xa2ш\xb2]\xf5\xd2I\x8a1W\x9f\xfd\x10\xb6OFFG\xad@;\xf9O\xfc\x96\x04\x87\xd2\xc86\xa3\x16\x855\n\xb0o`tԪ\x0f\x9f\xe0Y\xdf\xcc\xd7\xfe=\xbf\x86vGT`Pi4\xa3Y1\xed\aw\x9b\x85ǔ\xdd\xed\u05ff@\xef`\xdd'\xef\xa7`\xaf\xa8;\xed0:\x8b$\x8bRn\x81o\x80x\xa4\x0fW*\xf3L\x96\xc5?EIea\x94ܱ\xa8\xae\x84(!Iݱ\xef\a\xc5&\xa3B\x80>A\x19\x89\xa5 Acp\x1b\x197Y\xf2<c\x91n\x87}\xbbp\xb0op\xf5\x88\x81Hݤҙf\xbd\x0fw\xf6'z\xf2`)Mp\xc0\x0fݣ졾\x9d\x1fb\x87\xb6\x87\x86k\xd9C\xe5#\xf6\xd0t){\xb8\x83\xfe\x02\xcf`)\x91\xed4\x95\x91\xbc\xbb\x16\xd7v\xb6H``>\x90\x97\xb0e\t\x93>lv\x16\xdeJ4b\x01?\xea\x91\xc0ގ\x1d\xd54\x81\xcc\x14\xe7E\xcb\xd5+\xa0\xad\x9da\x9fF\xf1SE˃\x92\x03\xbf$\xcc\x1f\x99\xeb\xc1\xa2\x8d\xdd\xdc\x1a\x89IyJ^n.\xe9\xe1\x13\x1ew;L\xaa\xe2\xc5u~\xc7|\xc3\x10\xdb0\x95(\x8e\xfd\x875\xfc\x06b\aWUz\tʹeZ\x16*o\xaf\xadX[\x8eaв%\xe5\x87\"\x7f \x96q\x99\x864\xa7\x1a\x0f\x87\xed\xa3A\xea\x9alJ{;p\x90\x12\x9d\xbf\xadv\x94\xf9\xc2\xcf\xc9bV%i\n\xdbh\xd8\xf2\xf5\xd9Z\xf2\f6\x05=\x87\\z\xec\xa4\xca~`\xeb\x15ᾔ\xb4\xc1\xafi&\xecD`\xab\x12\xb6\xea\xe1SB\x1e\xc0\xc8@(\x1c\b\x81pJ\xb5\xcd-4\xbf\rs\xfa\x82\x91\x94\xd5\x11H\x96Q\xb6m>5\xf1r\xae\x15g\xbc8\x0e\xe7\xfc\xc7\xf1\xbd\xf9\xf8\xbf\x85_#{\x88\xc1\x16Y\xd7/OE^\x111\xf0\xb5\x94X\xb5\xf8Β\xa4I\xf5\xd2\xc4\x0eX\xefI}\xf7ph\xd9v\xed\xacUT<\x88\xf9]3Y\x9bMi>'\xac#\xbf\xd0e4\xe6nJ\x89\x06\xbd\xc6N\xb2Z\xcf*\x04\xd0\xfdT\xcfB7\xe3w\xbb\xa0rT\xc5\xcb\t\xd3̅\xeb\xb9O[\xb1O\xadd\x8f\xb4\x00Q\x9e\x1a\xb0\xb5\x1fVH\xa7\xdfr\xed\xdd\xf5\xaa\t\x13է\x9f\xe0\x87E'%\x8a\x80Ϻz\xd6\xc2x\x1e\xbc\xfd\xbc+/\xd9w\x7fh\xa9\xe5\xdd\xf5y\xa5\xca\xec\x7fTi\xa1R\x19\x98[186u\x85\x18\x93yʴ\xeb\x80\x1a>X\xaf\xd7\x14*gU\xc2()t\xe1\xc4\xec\ue6f6Q2\x9d\xf2!Y\x9f\x14Q\x92\x19\x82\xb1\xca&\x03\x83\x96\xc5\\\xf3Z\xe5`\u070e\xecƫQ\a\x06~*j\xba\xb0\xc1x\xf0\x80\x98\x1b\x1f\x9a\xcb\xcc \xa6\xbb\xeb\xaeً\x8d||&\xd3\xdcJ\x8d\xdc 7\xf3:\x91\x92\xec\x04\xb2R| \xf2AF\xb4\x84\xaf\xd9n4\x17I\x96\x94\xf7\x92c;0\x848\xb5)\xd1M\x8d\xbfk(\xeb\x13\xa78\xd0\xefQ\x1b*?\xbeyL3 \xc2\x17\x98o\x9a\x97$\x8b-\xe3^'9\xf5\xce\xfaK\x0f\xa9\x8a\x967L\x84\xafC\x04\xae\xe4=x\xcf\xdfʁ\xfe\x03\xf1\xbf\xdeϗ\xc9\xeb\xc4\xffjw\xba\xfd\x91\x11\xff\xab\xd3\xef\xf7\xde\xe2\x7f\xbdn\xfc/\x98\x11*\x06\x18\xfdp\xc6\xf2\xd2cw\x89\xdc\xdf\x1b\ue845\xd5\x1c\x0eir\x9d\xe7\xcaK\x96\x8app\x18\x9cf\x8f\xc8/\xc6$\xbb'ER\xe9\x89\xec%\xa4\xfe*\xf2\xa8X\x96\beVŰ\x88\xe5\xad\x02\v\xa8\"\\Z\x88\x8aAe\xdf\xf1\x0f\xb5\xd7K\vŒ\x12\t[\x8a\x8c\x8d\xa3^'\x17O\x8c\x9e\xf3\t=\x87\x18\x97ȒʺH\xb2\xca:(?z\xdf\xecȌ\xec\x19\xaf0\xdeWA\xaf\x9a\xd6E\xd7\x0fK\xafnB\x86\x0f\rd\xc3\x7f\x1cM\x85\x9d\xfb\"Z\x87\xeb\xa8\x00_\xefH\xbd\xaa\xd4\xc35T\xd8\xfb\x9d{ǈ\b\xc6\xff\xddۿo\xcd\xff\xe9#\xa6\x7fE\xc5\x7f\x9c\xffw{\x83\x9e\xc1\xff\xbb\xc3\xfe\xf0\x8d\xff\xbf6\xff\x173\x02\xed\x02*\xc9\xc1\xf0\xb7\xc6^\xa4;\xc2\xdf#\xb9\x1bl=r\xfd=*\x94\xab\"\xcd?\x05\x8a\xefD\xf9\x85\xe9Ԏ\xe6\x9c;\x02Ce\xf9\x93\x16\xf6\xb0\x11\xa1\xedA\xa6\xfcy\xcb]\xf2\x9a\xf5\xd3Ie\xe4\xe94\xab\x8a\x17x\xd6\xea\xcf\x04\xf1\x1d\x17S|\xe5\x85n\xd7ZAH\x02\x02D\xf6\xb9͌?\xac#-3\xa6qǎ\xb2\x98F(\xd2\xee\xe9b\x1c\x92\x8c^j\b\xcfÜ\xa0\xe6Q:̃=\xe9\xfe\x94\x127\xae\xf7\r\xef\xab\xd3\xcd]\x9a\xccg\xf0p\x8d\x86U\x0f\xcc\x04\xe5\x82\xd5@\xc4\xceX\x15(4\xaa\xab\xc4\x04M\xb1 \xa8\xd2>\xda5b\x9b\x11\xa4M\x93v\xa4\xaf\x1e\x99P\x13\x90m\xca\xd40$\xab\x90GQ\x04w\x84\x7f\xa3o#\x93<\xc3i\x10\x87\a^\x8a\x95\x1a\xa6\xcb\x14\x846\x17\x1eC\xdb\x17\x13\x1c\xc4\u0381\x10\t%\x84\xb9'\"L\xfa\xfd\xb9h\xe7!N\x84\x8d\xc1\x85y\x1d\xeeF\x06j\f\x95\x9f\x97\xa6\xdbȳ\xe0i\x9c\xbc\xac$\xc7}\xdb\xec\xeb\xf6\xffŷ;\x00n\xd9\xff۽a\xc7<\xff\rۃ\xb7\xfd\xff\x95\xf7\xff\x85v\x00\\h'@w$\xe6\xefq\\\xb8\xcc\x19\x84\x8c\x1f\x00h@:\x05\x14N\xbc\x81S\x9df\x8f\xe0\x1aK;-\xd9'\x8aF\xde\xce\xdeN\x12\xcd\xd7?\xfc\xa7\"e\xf5\x9f_\xff\xbd\xfe\xc0\xd4\xfft\a÷\xf5\xff\xda\xeb_\xcc\b=\x10<K\x91\xc1\xe0\xe1\x86u\xf7`\xf0<\xf1y" +
+	"\xd0>\xf0\x85\x88\xf7r\x18\x91\x04\x15I\xb2\xe5\xf7F\xd4N\xe4\x18\xfdo\xc1\xf7\x9dw\xddw\xbdw\xfd\xefq`\x93y^\xb0\xbb\x02y1(Ҷ\x86n1\xfc\xfa\x9b!64\xa6\xf5\x87#\xb7|Ya<֠\x9b\x05r\xcf(\n\xfb\xe6|\xb6c\x06RV<G\x15\xf0!xw\xf3a?h\x98\xff6\xa37\xf3$nV\xac\xe6+T\f\x93\x10_\x95\xef^v\xafs\x11\xad9\x1d\xec\x95w\x8f\xce\xd1w\xec\xfd\xd2!\x0f\x81\x187\xf5Gf\x96\x8d\xae\x9aPQ5\xe8O\xe8\xde\xe9N\xde\xd4(\xe7}\xad\x9a\xbc\xec\x9a\t.(\xeal\xac}\x19\xf9\xc1\x06\x15\xb5Sv\xef]\x99\x8do\xde\xe1\xd8\x18פܤU\xa8f-Cp\xba\x12\x84\x17\x9eR\xcd\xcb\xf7tm\x83?\xe4\xf7\xa0\xf8%&\xf6}L\x8d1œ^Z\x1e+`\a\xf7st\x92\x1e\xe7\xabU\x9e\x81\x8ft0y\xb8\x8b\xe6\x0f\x1e\x7fK3\xc0\x0e[N\ak\xcc\xf3QX\xa5\xa5况\xf3쭑\xa64\xdcu<\x8b\x1e\xc8%q\x15~lX:\"\xe7P\x14\xf4)ʖ'\xe4n\xb3\x04/#\xbe\xfc\xb2Ύ\x17Z\x12\x91\x14U\xb2H\xe6̴q\x0f\x98\xfa;\x94\xe6 \x9c0;{\xaeà\x9f\x16\xd62\xe7\xb2]\xa2\x8d\xbd]\xfcS\x11\xadC\x8f\xf37\xee\x03L>?\xf5c\xc1=\\\x88\xaf\x05\xe3<#\x01\xdc\xeb\xfd?\x7fU\x162\x7f\xf9\x1f.\xff\x15ќ\xfc\xc7\xe5\xbfn\xafݵ\xf4\xbf\xa3\xf6\x9b\xfc\xf7g\x90\xff`F\x18\x02 K\xdaQ\xe2\xb3\xc59\xc8E\x9f\xdfz\xf4\xc4\xc8\xecM,\xc0\x8f\xece\x1b\xb3S\x03\xf5є;\xe4\x80\xcd\xed0\xf8\x98#0\xff\x00\xe6\xd6:\fУF\x19DW:\t\xf8\x98WgI\xa1\"V\x83\xd9 \xc3b\xc0N\xbbm\x04\xec`\xc9\xcfφ;\x0f\xbeU\xee\xf3WZ\x81l⻋\xc9ũ\x88\xf3)\\t\\\xce(sgy\xc5\x17\xaf\xef\xc9\xe5\xec\x04\x18\x90\x80\xc1\a\aqf\x8c\xf2ֽ\x1d\x94\xf8\x8a\\\r:ފt\x0f\x18\xa88\x03\xa2\b;B\x0f`W\xedyE\x90k#\x96Ŏ\x82+\x1f\x8ehYJY4X\xe7\xbb\x06\xe4'dy\xc8\x12q\n\xed;\xb5\xcdk&\x95\xf0P\n\xb6\xca<\x8d\x03\r\xe2F\xbf\x8f\xc0\xeb\x17X\xf9\x1b\x0e\x1d\xb9\x1c\x8f\x90=1\x89\x10\x86\b\xef\xafF\x18;T\x86\xdb\x01ؑ&S\xf8\xa2v\xf6b\xf6\x1c\xe7QJ\xca9\x17d\x7fWt\xe4,\x12\x84P,*\x81\x86ֆ\x92\xa5\xd1\v\xd3À\x19Ar\xd9ꧨ\x84\xc5ÿ\xc4+\x17\xfd\x81\x8b\xe8[zȩ\xef\x01i\xe2j\x8c\x82a\xa9Hkj\x8e!\x8a&(B\xa8}\xf7\xf6\xefk\xed\xff\x9b*I\xbf\xfb\x8f\xef\xff\x83ިm\xe9\x7f\x06\xa3\xb7\xfd\xffO\xb0\xffÌз\x7f\x96\"\xf5?`7\xf7}Sˠ\xef\xff\xc0\xb51\x8f˟\xe7)\xba߃\xd8\xe8-~8\xa6\x9b\xbcx\x8c\xfc\xbb&D\xecdm\xad\xb2`\x9bA|40\x82-\xb6\x9cYݾ\xaf1\x06;\xddۦA5Y\xa6$\x8b\xd9e%\xf6߀1\xa0J.\x9a\xdc\x14^;};\x0f\x9e\x98\xd8I\xee$堢(\x9clVk\xedٍn\xf7C\xb5X<ܙP\x86\xf8\xf2_m\xfe\b\t\xf1\xc2\xc2\xd5\xee:\x12\xe2\x06\x83\xdd^\xb0\x9cZ\xe8Y\x89r\x9edD8V\xd4o9\xf8\xf5\xed\xe9\xd5Y-\x81i\xb2&\xd4\xf4ۉ$c\\\xdfo\xb2\a\x12\xb3\xc9\x16\x16X\a(\x7fZ\xd8tr\x15zD%\xf1S\xc4\x11\x97Y\x90\xb3\xa9\x9ai\x1e\xa0\xb9\xa123\x0f^\x7f\x84\x82|h\xbb-\xb3BD\x99\x93l\x99\x12\x90q\xae\t\x84l\xe6A\xfc\xb8\xeeT\x19\x8c\xeca\xb0\n\x14\xfc\xfcb\xbc\xa3\x9bd\xfat;\f\xae6\x95\x9e\xa4\xc5-\x96\x04XT\xde\xebs\xabh?\xf6\xcfgy\xf1\x14\x1513X\xe6\xaaNUKQ\xa5kBu\x88\xdcנF\xe5\x10\xf9%dU\xd4|\xafQ\xbd\x1c5\f|\x8cR\xcb\x0f\x9c;\xb2\x84b\xb3\xea\xe7a@\x1d\xbe\xbe\xe8\xc7\x1d}Q\xe1Ǡy\xf1\tGHq\x9a!:\xed\x01\xa9\x19(\x99Wy\xa1\x17\xc1Z\x8b\xb5\xb2\xaa\x9b\xe0\x05\x03\xd5\xfc\xe3\x82w\xb1~tF]\xc4\xf4\x97D\xa7\xddڵY\x1e\xba+\xadSk\xaa\\Z\f\xcc\xc1\x96\x8d@g\xb8\xa0?d\xa0\xaa\x93Z\xe0)\x15\xdaU\xf3\xf9\xc3ש\xcc\xf3\xb5jw\\V\xeeJiOA\xf6\xf5\xa2\xf5b\xec\x80dfil2ӲP\xd0N\xa3\x04Sӎ=\x908)\xef\xech_\xcf\xce.?\xa4/֭\xf3\xc0\x9a\xba\x8e\xfe\xd7T\xea;\t>*\xcb֠t\xb6\x1e\xb6N\xe8\xc1\x18\x90{\x9bQ\xb1\x9e\xc3-\xf3`\f\x8f \xb5\x1f8\xf6\xfe73\x00\xcf\xf9o\xbd.\xf2ŷ)c\x9b\xfd\xcf`\xd87\xcf\x7f\xedޛ\xfe\xf7\xb5\xcf\x7ftF\xa8\xc3\x1f\xfft\x1c\xe0\x98\xf6kŞ3\xfa\x98\x97{{\x11[\xaa\x16\xd0\x16\xdf\x05s<\x16\xf6v7\xda\xd3\"_\xb0P\x83\xbb䚽\xac\xee\xf2t\xc7LL\xd7\xd54ϟm\xfd\xaf\xa2$\xfdF䛬\xffN\x7f84\xd6\x7f{\xd8\x7f\xd3\xff\xbc\xe2\xfa\x87\x19\xa1V>\xfbr\xaaxVLC\xe3R\xd8\x1c)\x9fĖ\t\xf5\x91\xe6\xafX\x89 <\xdd\xf7ڛ\x83ixqi.\xf0S^\xc4'\x04\xee^\x8a`\x0f\xea\xf3\x0e\xa5X\xa4Y^\x1e\xa2<\xb4\xfd&s4K\"qe\x87;\xe90M\xb0m\xfd/\x9fm\n\xfc\xb0\xcf.2.\xf3jZ\x90\x92d\x95\x16L\x91\x01\xb1\x99\xa3\x19\rS\xdc\x1e\x81\xca\xcb\x1f\xe8\\`\x9dp?C\xe8Q\x80\xde \x81\xc8kK\x9b\x82\xc9:[B\xabzA\xca\x12f\x85\xb8^\xf0\a\xc2V\xddO\xbb\x8b\x93k\xdc\xe98SîV\xf9h\a\xc4Q\xa5v\x15o_@Uy\xa3t\xedJ\b\x01\x03\xf68H;'~\xf7?\xe6\x1f\xac\xf6\x8cT\xc9\xfa\xf5\xf8\xffЖ\xff:\xed\xc1\x9b\xfc\xf7\x8a\xfc\x9f\xce\b\xb5\x01\xf0OɃk\x1cw\x00\xb8\x15<\xf6\xc3$\xd8$Y5n\xb1?\x06x\xb8\x05\xbc\xe9\x18\x18\x9d\xa1\x8e\x92\x94\xffE\x8a\xdc\xf0*\xc0@\x93\xf2\xc7(Mb'\xecC\x02\xe1\xf8\xf5\xa3$\x83\xfc\x17sD\xaesS\x06:f\x8e4\xc3d\xdd\rx\xe9f\xees\xe0k\n\xee\xaaT\xdfS\xd9\xfe$\x1bz@\xee\xf4\xdbl\x05\x06O\xb4(\x1d\x02w\xaf\xb4\x1d\xff\xce3\xc5\xf7l\xbc\xa7\xa4\xba\xcf7\x15o\xb2\r\xbf\x8fJ\x0es\xd5\xea<\xc9\xc0\xe1_\x94\xdef\xc9<*+\x1f\x1a7\x99\xf3\x80il\xad\x9a\xec\x13\xa1=\xa1EmÖuچ\xf81\xcd\xef\xb6\xd5|Z$\x8fl\xfbtAo\xb3rM\xe64\xfa\x95\x13\x83Ǽ\xb9cq\x85B\xf6i\xee\xbe\f\xf5\xa8\xec\xc0\x10\x87Qg\x18\xfc\xd2\x19\xea\x01b\x05J\x9fb\xf4\x83_\xfan\xf8,M\xe6D^\x82\xe9Pp\x82\xef\x1c\xe1iA\x1e\x9d\x00\x8f\xa7\\^\xd8zM\xb2\xf8&\x97v\xac\xeeR#\x8e\xd6\x0f\vR5Ä5\xd0\x14y;&k\xc4\xe9\xf3:ʨn\xbd\xae1\xd0C\xaa9\xee\xfb$\x9e\xe3\"*\xca\xfb(\xbda\x9d\xeaA\xddS\x8bTaӘ7\xa2\fM7\x86\xeb\xf2!ɢ\xe2\xa5im8}\x96\xc9\x0eˠU\x99Sn\\i\xab&\xb8\u0380\v\xef\xfd;C*ɩ\x99\x8b\xa7\x93@\xeas\x9c\xbe\x1f\x85M\xe0\x12\xfe\xabZ\x9e\xac)|?P\xbe4\xe5\xee3EQ\x98<;Д\xba\xf7>b~\x16\xcdY\u0380\xf0\xdfе\xb90\xb0\x7f\x17ap\xb1)p\x9e\xcf\x12\x8d\xfeg\x895K\x8aW\xb3ɲ\xd2P\x1bOZ\x96\xab\xf9\xc4e\xf8\xbbL^\\\xaf]&\xb0V\xb3F\xf3Ө\xdb\xd69\n\xf8ԣ\x94\x9cKT\xcca\x03ޒ(B\xc14}\xec\xeb\xac\x1dM\x9b\xc9\xf4q(\xb7\x98\xa34\x05O\xc3e=\xcau\xbe\xa9H\xe1BR\xfb\xa2\x01\xf1\x16\x7f\xb1)+y\x18\nK\xd7ή\xa1Щ\xad\xa3\xa1\x96JT\xbeS)D\x96`\x9e\xbe\x10B\xc8\xfa\xd1}J3J\x95\xa3\xec·\x8a\xb6\xf6I~A+\x02\xc7y\xd6:Cp\xaet\x01\xfa\x90TF\xa4t\x01q/q\x01uJ\x98*+\xbbw\x9eL=\b\x88=H\x82v\r.\xa2\x92\xf9M\xd6z\x1e\x11ɪ(\xc9J1\x83\xddE]=\x92\"\x8d\xd6e\x98ˢ\\h[\xb9\x8c\x81؈Ǩ\x864\xe10\x02\xbb)\x7f\xd1kԔ\xbb\x18u\xda\xca[\xacZ\xd5r\x16I\xde\xcd\xd6\x19Tg;wIU2i\x90\x0f\xf3\x9f\xed\xfc_\xac\xe7ߴ\x8cm\xe7\x7f\xdb\xffK{\xd0鼝\xff_\xef\xfc_\xac\xe7\xea\xf4O?\xbe\xec\x8dfA\x16)\x99c\xa4\xf2%\x9b\x8b+#\x16\xc3\x18\xd4z\xe6\x13\x1ff\xbb\x17\xa5\xa9`\xf9<p\xec\x05~!\xc9\x1d~\xa1{v\xb0[^\xa7/z\x12\x8bc-\x8c\xeeū\x9e`\x0f\xc8#\xab\xbf(M[\xf4я\xb4\xc5a\xb6J;\xda\r2\x8f\x84\xe1\x1c\xeaΈZ\bI\xb6\xdeT\xa1\x9d^g1\xd8\n>\xe6a\xe9\xe8\x04no\x80Z\f\xd7\xdeF'\xa0\xd7L\xbcR\xec\x8f]\x85(M\xbf\xbc\x98\x96\xa6\xd2\x16\xa6^1\x99#$\xc3s\xfc5r\x86\f\xaa\xe3\xd0&\xa7#\xf1W\x89v\xc4Sz\xdb&\f\x0f\x95\xafo\x9b\xde\xefƃa\xfa\x06\x8ez\x16\xf9[\xf0\xfd\xfb\x18\xbe`\xfe[\uf2a7\xc7\x12\xe9\xd7e~==\xfe\x95\xbb;\xe0\b\xfc\x11\xa3\xfe╺\xe1u=\xb8pEk4\xcd\x18\x93(\xa5F;\x7f0?T{\v\r+D\x88I\x90\xdfa\xcc\xee7U\x9c?e\xda\x1ce7\xb5\xb4\xa6-Ө\x90E\xae®5\xe9\x04P\xa6\x80f\x06\xf6H:&\xec5\xcd\x1c\xcf\"G\x1e\xfe\x88\xd8|g,\x02݅\xc5\xfc\xb1p\xceP\r\r\xae\xa6Bͧ\xbb7\x1f7\xd2\xd3L\x06ݼiF~\v6<Ψ\xed\x96\xf8\xdap>\xbc\x9d\x06cc\x02b\xcb\xc2<\xbe\x88\xea9a!\x03=\xa7\xe3d\x99wX0\xa2XL69\xad7\xf4ǻ\x8d_\xd86\x1c#;\xc3\x0e\xa3eP(|E\x1a\x1e\xfd7%\x81B\xb8\x81\xb0\x9b\x16py1\x88a\xc9L\x94\x82=\xd8\xdd\xde]l*\xf2\xcc͵\x90)\x92œ\xad~\xa5\xcb\r\x05\xa9\xb6\nm<~V\x96\xdaia`o\x1fw#ג\b\x97\xeb̩\x02\xf6H\xa0\xb0\x16\x05!\xf5\xf1\x145z\xbck\x91\x97\x06\x0fE\xcd\xce\x1b\x99\xc1Y\x83\x8fb\xcb\xd8-\x13\xbb^\xb0\xc7\x7f\xec\a+:\xbd\xf7\x98W\x06\xf0~f\x8eiT,\x1f\x03.⼣^\xc1\xf88[\xa9\x0f\x84\xaca\xa8`\x92xݭ9j\x8a\xde\xe0[\xf5}\x9c\xefRםj\xe0\x17\xcc\\\xf3\x85r\xfe\xdd\xedJ\x05\r\xb4}\x14\xeb\xb9\xe6\xb7.\x96[\xb3\\\x11\x9aqc\x03\xd1B\xba\xa1\xae\x91,pW\xef\xe9\xaf0\xa4\\\xc1g\x19\x8a%\xe0\x94,P\xf50\xc3\x16\x8dF\x90V \\[\x1b\x0e\xed\xd94l\xcaN9~+\x98\v\xd1M\xc9 \xfb\x81\x931=\x89oxJ\xf9\xb1\xc87\xeb\xaf:\xd7]l\x86RW\xc4E\xa3T\xad\xa8,OA\x82\x1a|pyޑ\xeera\xa7跂\xcb\xcd\ndْZ\xb0\xd2=\xb4\xf5f\xf2Ys\xfe\x7f\xff\xaf2Ͼ\x95\x1e`\xab\xfd\xe7\xc0\xb2\xff\xec\f\xfbo\xe7\xffW=\xff\x8b\x19\xa1\xf4\x002\xc1\xa7\v\xe0Ǧ]\x0e>\xc5z\xfe\xceyxir\x82P\x99\xed\xb7LL\xe0\xf1\xe4T\x19)\xa2\xfd\x14ikn\x84\xd8\\\xb6\xfe\xb3\xae\xffrU}K\xf3\x9f\xad\xfe?\x86\xfd\x81\xa9\xff\x1bu\xde\xec\x7f^q\xfdÌP\v\x9f}պ\xf7آ\x16ľ>\xd8=\ue9baG\x12\x145TX\xc0e0\x13`\x84\xaa~\x95\x17\xe2\x18\x16V\xb9\x01Į*\x98\a&]\xfc\xa1>\x1f\x82\x90\x96\xab\x9c\xaa\xf9\xa9\bI\xe2\xf8\xfa\xe8\xe2\xe2d\x00U\f7%)\xb4\xb3aI\xe6\x05QV\x88\x80\xe4T\x17\xc2\xd5F\xb0\xa7\x1c\x800\xb7\n\x8d\x95\x88\xf5\xba\xc0{\x92\xa6\xb9\x17\xfa\x89BS\xb8\x88\xbc\xc4f\xf5n\xec\xf9*\x0e\t\r\xa0r,ݗ4x\xd1\xc4]\x89!\xb7!\xf6#bZQ\x7f=\xc9}\rP\xfa\xbd\x9a[\xfe\xb2|Yn\xceg\x86\xf7\x11\x15i\xd3\xe9\x9aĊ\xd3)(\xfdH\n\x88֢\xc5`w\x97I'ID\xff\xf8P.\xa2$\xa5s{\v\xa9\xeb\xf9\xba\n\xab|\v\x16\x84\xfe\x81f\xe9oy\xbd\x03\x00\xc1l\xb32ɳ\x10\xe6\xa3\xdcw\xd9\t\xd0T3Ț\x90\x92\xbe\xabw\xd7\xe0\x92\x86\x7f\xf3\x00\xff\xb1I̜T\x12кң\xaf4\xb6|\x14\x05Ws_\xee\xce<M\xc1K9\x8cF\x12\x93\xacJ\xaa\x17\xac\xce\xd1\xd7\xf0:*˧\xbc\x88\xdd\xfe\xd1ْ\xe6{z\x16\xd3\xe1\xd3\\\xe4\xb0\xe1\xde\x0fИ\x02_\x91\x9ek\xf6\x03\xd0\xe1\x18\x97\x87\xe8`\x88\xfd\x98hV\xf1\xe0Î\xf9X\x81\x02$\xbdo\x1e\xffGr\xa9\xd7\xd9\xff\xfb\xfd\x9e\xe9\xff\xab3\x1a\xbd\xdd\xff\xbd\xe2\xfe/g\x84\x12\x02PR\xad\xf3\x0f\xb6\x85FY\x0e6\x96\xa9\xf2{\xf5\x03yA\xf6\x1e\x9a\xce\x03\xbfR\xbd\xe6\xef\bd\xc8\x1e\xee\"\xc1}\xe4\xafu\xb5\xc1`\xab8l\xb6\x9d\xc5TM\xe0Ћy\x8f2\x98'\x89x\x89\xcaxE\xea\x7ftW\xa5\x17R\xbf\xab\x9aQ\xa7\x10R=X\xf7:DaQ+\x18\x87_-\x83\xd9\xe3\f3R햡\xfe\r\nƤ:\xa1RC\xae\xab\xfa\tI1.\xda\x18\xea\x0eV\xc1\x9e\xee\x13Bz\xca0\x1dH\x98\xbe2\xa4\x93\f\x8eȾ[\xc1\x1e\xfb!\xac\x90\xf8\x14l\xac\x93\x13\x19\xa4\x01\xee\x06\xbf\\VP*\xe3\b\x9d4\x9f\x7f-\a\xdei\x167\xc0\xe2Ը\xa2r\x1b9\vMx\xc4P\x1eH\x8ca\xd5`\x9e\xc9\xca:X\xaee}\x00\x1ayۍ\xb8\xbb\xd8(\x06\xef*!\x8a*b=\xc4\xd7Qi\xac\xdf\x1a3\x1f\x81_p|fv\x9a&+\x1er}kF\xf8+\xbc\xce\xedR9-\xcf.\xb5\xd42\x1aե\x8b4\x81\xf7M\xd4s!`0\xa7\x14\x9aر\xbd\x1c\b\xcc9[Gs\xc3zبGLh{\xf5c\x02\x8d\x9d#N\f\"\xaap\xcc/\x17Vꑘ\xe1V\xd0\xd3Gۋؑ\xa4\x9c\xe2_\x87\xe4I^]\v\xc7Ϧ\xd7\x1d\x85E\xddɞ\xe4U\xe8\xae\xd3I^5\x9d\x00\x1c\x1d:\x85\xa37\x98j\x8a\x95B\x16\xf5\xe5ʹY\xcf\xf3U\x92-\xe5\x16\xcd\x15\xe6\x88s\xdc\x14ɊU\xdac8\b\b\xc2մ{\x7fg\xdcT\xbe\xd7Թ\xad{c\x17\x9cxJ\x83\xe2ѩ\xd1d\x17\xd7%\x00A\xe4$g!wq\x18?\xcd\a\x92BE\x03\xf8\xdd\xff\x8a\x7f\xdc\xe9\xda7-c\x9b\xfc\xdf\x19\xda\xf6\x7f\xdd7\xfd\xff+\xca\xff\x9b\x02=\xff\xa6\x1f\xb6H{\xb5\x96\aW\xec\xb1\x1dy\xe64\xc4\xdbی\xb9\xea6V\xa9G\x9eС\xf0l7\xdfX!\xe5\x05\x94\xc0\t\x84\x99\xf928\xabn9\x8f\xd6\xc4%\xcb \x88G\x92\x99dt\x83\x05\xc7b.\x02&\xd8S\xff\xbf\xe7IFͯ\xee\xa2\x12m3)Y\x01\xeb\x92Ǉ\x82z\xc7\xf7oC\xec\xb1z\x11=\xa9\x8e\x86C\a\x8d#g#\xfecC\x8a\x97\xf07\xf8\xafBf\x82\xb8\v\x9d\x8b\x96\xb7דf%T\xf7\xac\xf7\x1c\xdc^b\xdcf\xc4\xc4q\x8bJ\xb4\xae\xf5\xf4(J\x13\x82t\xdcx\xfd\xa9M\xd5\xfc\x9e \xed\xca\xd5:\xfa\r\xc5m\xbb-AS\f\xffM\xb2E\xce\x02\xac\xb9\x03\xb7\x9d\x15\x91\f2O\x13\xae\xa3\xa7\x7f\xa0ޥ\tZ\x8e\xeb\xe8\xc9\xcat\x96\x17s64\\\xc7s\xb5J*Z(w\xe0˧4" +
+	"\xf5VW\x12\x1a\xeb,\\{\xb4\x81\x14\x89u[L\x11\xadu#Ȉz\x84\x8b\x06\xa4$\xb2\x9b\x9c\xe7%\x13\x03^\x938\x9aW$\xf6\x80'\xe5\xd1]i._\nᓛ,j\xe7\x9d,\xa5\xccS0QZ\x90\x82ds\x96\x8f\x81\xe0\xbf:2[\b\xe2\x10j\x12\x92\xb3\xde]a\x18\x1a\xd0\x1az\xc0\xfc\xf9\x98\vd=<@/\x1c\x9c2&͵\xdb#\a\x96\xc7|\xb2\xe0\x7fJ\xc1\xf0%;29\x90\xddw\xff\xf2\xa1\xd6\f\xceq\xca^\r#j\xb0\xbe\xccK\x94\x96Zv\bkʕ\xb2\xe16u\xad\x96\x9d\xady\xfeY\xf3PHfi\x05\xb7\x9c\xbec\xf4$\x8e\xac\x8c\x11ղ\xe5\xc0u\xbb*as\xaeNe\xc30\xb6)S\x04V#\x1d\x8d@n\xa4\x01\x12\xc8n\x9d\x8b\x04\x7f\x8at\xf5\r^\xc0\x02G\f\xbc\xbe\xd0\x04\xf44\x83\x13\x97\xea\xa0\xef\xde\xfe\xfdy\xfe\xe5\xe5\xb7/\xa3^\xfe\xef\xf6:=\xc3\xfe\xa7=\x1a\f\xde\xe4\xffW\x92\xff\xf3R\x89\xfeyih\xf7\xd5\xc7\xfbE\xa9=\xeb)\xc1\x00\xd1v\x05\x05\x0f\a\xb4\xb8\xfc\xe2\x86\xe0>N\x8a0N\xdc\x17\xac\xc7\xf7\xab<֍\xbcW\xa0\xb5Y\x94\xefΒ\x94\\\xe4113\xe4O\x99\x9ea\x93\xc4L˳d?\xcc\fP\xc1R\xcf\x12U2\xae\x01\xf3\x13\xb4\xd2\x13\f\x12)\x89\n\x92=Je\xcfq\xbe~9\x9b\xa1F\xed\a\x8b\U000a5935\x9e\x19y\v\x02\xf7\xd3ڮ\x18\xeeAی\xad\x95!\xc2\x01G\xa3\xbb\x8e\xaa\x8a\x14\x997\xafx\xba\xf2x\xb9IS\xfe\xb0\xe5\xf1}\xb6\xa1C\xc4BE$\x05\x8d%\x1d\xfc\r\xea'\xbe\xe4\x95G\xa15\xa4\xc5\xda\xc0\xa1\xa7\xd9cR\xe4Y\x88u\xf9\xba\xd7l\xdd\x1b\xf6\t\x89\xa8o\xc0\xd3\xe79!\xb1\x05>}N\xca\xcaH\xe3\xe7+#\xf52\x17\xa4,\x00\xb3\"\xb6\x92+\x17\xf1))VI\t\xb7\xe2&\x80E \xa5\x8f\xc4\xf0h\x9d>\x93\xf9\xa6\x82\x90c^\x9d\xef\xe9sRY\xb1Ҙ\v\x8aP\xbd\xb2YE\xebu\x92-\x99\xaaV\xdf\xf0\x8d\x8d\x9fe\x85X\xa8\x1e\xa5\x1a\x8c\xb7\x90s\xf6\xc0ϡ\x92u\x00\xc4\x14\x82\xb0\xc62\xae\xf6\xfc\xe53_=p\xffl\tpZ\x1e\x98\x96\xa5\xccH\xbf\x02M\x01\xe9\xcf}b\x96(f\x96\xa7\xc4\x02ג\xafr\x9e\xc6V\xb9U|\x9c\x14$\xab\xca\xc0\xa0\x0e\xc2\x18O\xd4\x1a\xe9.\xf6\xd2)\x00\xaaf`\t\x9cW\xad\xb6\xd9G\x15\n\xee\x97/\x16\xf2R\xa1If\xfa\\X\xf7<\x96\x05\xca\xfd\xb0;#\x8f\rؼ\x9a4\xc3\x17ד\xe6\xbe\xc9u\x87\xf2\r\xaa9#\xe4!\xcc\x17\x8b\x92T\x02\xf7\xe9\x9eds\xa1\x8c/H\xb5\x95\x86\x16\xcdP\xb1\xbcmU\x06m\xd3iQ\x84\xf9ڡY1\x8eG,\a\xdbv\xeaw\x1aհJ0\xa3\xb0\xf2m\x11\b\x99.\x8e]2\xd0V\uf403\xed\xc0\xf4\xa2\x14\x98\x14\xfb|w\x1d=\xe9w\xd3Z\xa6\xb3\x98\xdfN\xae+\xab/\x9c\x97\xeaj\xd1\xee2\xf5\xd6Z\x86\xdd&\xdeӮ\xf3|\xad\xe7ح\xb4\xf9\x0e3\x80\xc9\x1c\xf5b\x86\xc0\xbd)6\x19\r\x90X&\xff&\xa26\xeea\x84\x1bQOy\xc0(ݰr\x97\xd9X\xee:\x1b˝g\xe3\xfc\x9e\xcc\x1f\x98{\x8dܧ\xc4b\x98\v\xff\x14,\xbf\x02\xef\x00S?X\x0f\xdb\xf7\xbe\x14,\x05\xa3ʐ\xc9\x1a\xe7\xbb\xcc\xd3|\xb9s\xe6''[\x85ԊH\xe6\xca\xdc\xc4\xc7\xfew[jU:\xb6\x92/#V\xae\xe1\xc2\xf9&\x87\xaf\xafB\x10\x9c\xf0ï\xeb([~1E)\xf9@\x972\xc9U|! ,Y\x05\x84/^\x97\xb3$\x8b\xb9\x8c\x17\xae\xc5r\r\xf7x\x921D\x1fIE\x96I\xac]\x8cCZ\xf6\xe8W\xd7\x00|c\xe7q\x90Y»+~\xb3k\a\xe5\xfdH\xaau\xb4$\xa5\xe9\xd6\v\xd2mZkG\x9a\xa3\x16O\x90\xa2\x9d\"\xac\xc1BJO\xfdd\x841AX\xa6\xee\xf9\x8aͺ\nf\xc92\x8b\x84FhRRy\x1bEW\xc4\xfa\xa2I)\x04r/\x02\xa8\x1cgd\x1d\x15Q\x95\x17\xe1\\\xb8\x80\xd4q\xa4\xf8\xee%#\xae\x8b,8\xd4\xfd\x87$M\xf5j\x9f\xcfw=>\x82\xab'\b\x18\xa8e\xc9\xc8S\xe60\xb8\xa6\xf3\x122\xf8\xaeϮR\xf5\xe4\xfb\x92<\xd5]\xa5I2\xde\v3\x84\xe1\xbcp;\xcf\xf3\x87\xcd\xfaԘ\xc5N-\xe7y\xb9\v?\xbcx\xa0r\xbc\xa6\xb9%\xc5ʿ\x8f\xd2\fGi\x1a\xae\xf1\x9b\xcf\x06y\xb6\x9f\x86\xcd#\x1a;\x0e\x039\xa6f\xc7\x05\x04\x7f\v:\xedQo\xd4\uf33b}\x8cz|\x1f\x15'\x84\xbe\xc6\xd4ѻ\xed\x83Qg\xd0ŸN\xbc\xe1\xa8\xd3\x1e\x8f\x87\x1aѓ\xa40\xa9u\xfa\xa3\xfe\xb87\xec\x8f1\xde\xe9\xf3<ݔɣIs\xd0\x1b\x8eG탎V\xfa\xa4(\xc8r\x93F&\xe9A\xb7\xdf\x1dkd\xe1\xdc\x13\x83)\x98\x81\xd9\xeb\r\x06`\x87\x8bq\xa7\xc6H\x00\xc1N\ac\xcc(k3p\xfa\x9d\x83~\xaf\xdd7\xf06\x16\u07b87\x1e\x0f\xdbZ\xedf\xf9\xfc\x81T\xe6\xe0\fG\xa3Q\xb73\xd4\x10\xabd\xfe\xf0b\x8db\x7f<\x18\xe9x/\xab4\xc9\x1eL\xc4^\xbf\xdb\x19\x8d\xbaZ\xd9\xf2\x0e\xd9\x1c\x9e\xe1\xb8\xdf\x1b\xf4\a\x1a]\xfa\"\xd5\xc0\xeb\xb7;\xa3a\xaf\xd7\x1d+\xfbB\x00\x87\x8bX\x887\x9a\x9b\x81V@7F\x85\xcc\x05x\xb6\xac\xb9\xf8^spa\x95\xb9\xfa\xf5h:=\xbd<\x01\x0eE\xbb@M\xe1\xab_\x8f\xafO\x8fnN9h\x88\x00\xa7?\x1f\x9f\x8b\x1c\xaa\x17\xae~\xbd>\xb9\xba<\xff'\x87\xb4q\xfaO\xd7<\xb5\xabRg\xff\xbc<\x96\xe5\x0e\xba\xc3\x11\x82\xdd\\\xdfJࠃ\x00?]\xa3\":\xbc\xf5Wk\x925Ѕ\x01\x1a\xedR\x8d\xc9,\xd2h\xc98\xb5\xcd:\xbcd&\xd9u\x9eW\x1a\x13iV>\xcdf\xa0B\x9a\xe9\xb9\x0e\xae\xc9!\x81\xc9!\xf2St\x04$\xc0\xb3z\xb9\xd7\x05\x7f\v\xfe\xcf\xff\xfd?\x18\xac\x81\xde\xff\x1fq\xbb\x9e\xac\xe9\xee\\\x04\xbc\x86O\x81\xaa\xaaQ\x87\"\x9f\x1fU\x95\xdcqN\x12\xe5?\xe44{\x94*\x95C*4\x81\xee\x84R:\x84\xa3d\xb0\xc7g\xe0\xbb\xd9K)\b\xfd\x8e\b#\xcf\xfeS\xb6A\xba\r\xb98\xae\x88uDÕ%$\xab\x8e\xe6\xbfm\x92\x826E\xae\x8e5\xc3\x05\x91}S\xb6\xb6\x92\xb8&)\x89\xb4\x10\xf7\x12s\x9dČJ\xd8ҩڨ\x92\x8aq:\x91\bq.P2\xf2Ĩ\x185\xddV\x04\b\x1c~\xfa\xf0\n?Db\xa8\x167\xd9Fg\x82KX&K\xfe\xd3O8\xa9\xee\x99Z4\\\xf0\x88e\xf4K\xb0\xa3\x967\xe7Cm\x85\x9f\xbe\x98\xee\x13o\xea\xba\f\xec\xd6z[\xbcN\xe2\x1d\xfb\xa8l\xdcG0Q8r g\xfc\xd6,\x8b]+D\xf3\xc0k\xa2]\v\xbbK\xf3\xf9\xc3mV%)\x94(4\xd0\xf2`\xa4/vQ\x8f\xba{p\x8c\xc7\xee\xc2AV\x0e}a\xdbt\xf4\xd9KY\x91\xd5\x0e\x19@+n\xf9\xc96\x89n\xe6\xf0\xb5\x05\xe9\x85;\xf1\x14\xb6\x9e~\xbc[\x1aȡ\t\xf2\xd48#\x99pҠ\xf6e\x93ڗ\rk_\xeeR{\x9f5\x8e=\x02\xc7\xec\xfa\xdd\xdf\xd0\xcdnӠ\xdc6\r\xe4E\x80\xb6O\xd6]\aP5\xb8\xb1\xb5\xfb\x8c_\xae\x99\x16\xea\xc1\xc0u^\xcb\\\x93U\xfehP\xd5]\x80\x01\xdc8\x84\x98(\x90\x1b\x0e{\xda9%#O\xae,\xec\x19D\x9e\xd7y\xa8\xa6\x02\x83\xef\x0e\x82\xc1\xdc\xdaW\x06k(-IRM\xaf\x1a1\xfd\xaf farۥ&\xbdʻ^\xff\x8a\x8c;\x9fA\xb5\x8c0\r\xbe \xef\xcew\xcf\"\xe3\xf9\xfcKs~\x85\xdbkAj\xdb\n\xd1\xf1\x8c.r\xa3\xcevS\xa3\xf2\xce(\xbf$\xd7.\xbc@\xe5\x11\v\xba\x89\xf6F\xaf\xe4\x0ej\x1f\xbdK\xd8A\xf4\x8b\xf2\xee\xc2\x1bE\x1e\xaaJ\xb6\x17q\x1cU\x91\xbc\x17i6\xbb\xd3|i1\x1c\x1b\xc5\x1ar\x03\xe5l\x16J\x1b\x02vę\x9d\x9e\xfe\xf0\xeb\xf1\xed\xb5:\n\xa2tu\xa2\xedj\xe9\xb3\xd3\x1bu:\xa5\x05̢\x15k\xe7\"\xe9\x04\xda|Y$]\x9c\xa0m\xd03S\x8fk\xd8\xc8鯶\xa9\x88\x86vd\x96\x10R\xb7\x13\xda\x1e,}1\xc2S<\xa1h\xd6\xd7h\xb1T\a/X\xb2U\x11\xec\x89\xf3\x95_\x17\xddtE\x81zsVŤ(\x84v\x81\xa7$\x99\x91\x90o*\x91\"\xc2\x1e\xa6\xbb\xea4\xb1\xc6B\x1a]k\x8a\v\xa7\x0e\x13g\xf3\xaa1u$\xcf\xd3\x01\x1d\xc9\xf9F\x00\xd4:'\x89U\x80\xbc\x8f\xd3\x1a뻜\xbb\xcdJ[\xf1\xaf!\x94\xa48\x8e\xe6\xf7\x84\x15\xe5\xe6?\x14\x89z\xb0؆\xf5)_\xd5R\xfaC\xab\x9b\x0e\xdd\x02Y\x8f\xb8$\x94\x05\xbb\xb3ѥ\x10\x9a\x13\x19g0\x89\x92\xa6j'`z\x10\xecu\xdf\f=\xff\x1b\xfc\xcb\xcb\xf7䙼\xaa\xff\xf7^g\xd86\xdf\x7f\xf5G\x837\xfb\xcfײ\xff\xa43B\x19\x81\xb2/\xe9\xfe)\xcf\xe0\x99\x81\xcf\xf7S\xde\xc8*\x94\xb9}X\xc5R\x85\x88_\xcfh梦\xaa\x12+1پ\x86ޗ\xf3}\r\xbd-\xe5\x9b!J9}\xae\x8aH*<\xf3\xf2\x9d\xd4yJ}\xa9K\xf7y(\xb5\x9e\x90\x87\xff>\xd4\xd5.\bB\x13\xd0\xeew\b.1\xe6$e\xfa2\xe5\\4J\xaa\x13\x92F/\xfa1\xba\x89\xa3\xa8U\xecW\x01P \x88\x1a\xa1\xedn\x81\x01\xe7\xf7I\x1a\xd3\xfe\xa3Z,\xde\r\xb6\x1b!\x8c\xcb\xf6ֆȤ(B\x941\x10\xb9\xb6\x12\xa0\x16\x11\xc5\t)\xe7E\xb2\x86K`\xdd4\xa2>\xf3\xf5&\xb3\xfd\x80\xb0\x9e\x8a\x8a\xca\rz\x8a\xaa\xf9\xfdq\xf5\xcc_\xe1ͩ\x9b\xfc\xff\xe7\xaf\xc1\xbcz\xbe\xa6)F\x19\\\xf5\xe7\xa0\x14\x81~\xf3c^䛊>ۆ1-\x82=y\x00+\x9c\xb9\xae6\xd5zS\x176\x85b\x1d竻$#q3l:\xb2\xe2\xaa`\x9b\xab(\x9e!\xdfT(\a}\x95_\x9f\x81\x14\xc5\x0e\x19\x884\x1a\xf6\xbfe\xa7\x88.\xebbn^\xbdZ\x819\xad)Pk\x0f\x99\x8eW\xb1\x8e~\xcc\xf8U8\xaf\x9e\x03λ\xde\xf1\xb4\xfd`;)a\u009cW\xb6m\xf1Y\xbe\xc9L\x13e\xb5\xa2\xb1Ф\x89Ț\xcf'\xffs\xd9\xfa\a\xb1\xa6D̊yN*\xad(\x9b#q\x86\xc8&\x0f*S\xe4\xf4\x94\vV\x03\xf49\x98\x10\x1ck\x9e`ʅ\xe3\x120\xdf$\xae?\x9d\xfc\xc7nK^O\xfek\x0f\x06\x03+\xfeo\xaf\xf7&\xff\xbd\x9a\xfcWr\xe3(\xe9\x00\x94\x7f\xfbe@*\xf61\xe3\xabe\x96\x17\x84\u07bd\xbd{\xf7./\xc5\r\x97\x06\x8e)\\\x01\xf1\x89\xfd2\xaf\xc0\xf9\xa2܇%\xd6~\xe0#ʲ\bN\x0f\x81ײ\xcaf\xf6\xac\x15\xa5N!po\fe\x95\xafU*\x15\xdd\xce6\xd9\\]+\x94\xa4\xf2\xb6qV\xe5kG\xfd\xff\x94.E\xf2\xf2=\xdc\xfe|\xd32\xb6\xac\xffN\xaf3\xb0\xfd\x7f\x8c\xde\xd6\xff\xab\xad\xff\ru\x8d#\xfd\x7f(G9Ǜ\xa2`\xfe\x01\xd8\vhc\xe7\xa7\xc1\x04\xc4f\xff1AV\x8dJ\xe4\xf9]\xb3C\xb4߆\x9b\x84\x112%o^#\xf1\x00\x06>\xf4I\x1c.\x93\xb8\x11\xfe$\x0e7\x1a\xaa\xa3\x85\xb7\xd9C\x96?e\x94\x88\xcb/\x88\x05\xf7\xb8\x17\xc1x\x93x\x1b\xa5I܀\x16Զ\x86\x90\x04o\xa7\"*\x84.\x8cm\xa8\x8fL\xa9<=ݢ\x19\x80gí>\xe6\xda\xf48\f\xb8\xf2\xd3r\x92xK]\xfd\xf1\xfep\xfb\xc4\xfa\xee\xed߮\xff`\xc9\x7f\xeb2\xb6\xcb\x7f#\x93\xff\xb7Go\xf2\xdf+\xf1\x7f\xf8\x8fb\xfe\xec\x8b.\xc1\x0f`\x03\xa7\xd9<\xe8\xc7\xf3\x94DY\r\x1c.4\x9cP~r\xfe\x10\xc5Snǭ\xbf\xbd\xadj\x882\xb72\x1a\x1c\x89\x92\xe0\xef\xc4r`\xa2\xe5\xbf\x00\xe5O\xa8ۏ\x9b\xa6\xa0+\xc0\xf1?p\x99\xad\xd3Ĩ\xa2\xfe\x04\x1c\x9d\x98\xff\xf2\xa7\\\xff\uf84aߐ\x11l\x8b\xff\xd0\xe9\r\xcd\xf3\xdfp\xd8}[\xff\xaf\xb7\xfe\xe5\x8cP\x8c@\xa5\x98> X,Ws\x19\xbao._\x89\x87<F)\xbf\xe0nT\xcbz\x9e\x03o\xecfiT\xde\xd7\xe0|L\xf3\xbb\xd0z\x96\xc28I\xed[\xfaOQɃ\xa3\xabW\xcbk\x19\x06\xdddp\xf5\xecoR\xd2h\xf4_\xc6\x1e\x99Յ\xd3F\x9d\xcb\xcc\xf3(\x85\xf7i\r\xfa\xf3+p\xd9k\x92R'zZ|\xb8**\x96\xd3\xda\xe2\xb9\xed\x88iJO\x8d \x1e\x92\xf5Q\x9ab\xfd-$\x81\xe8\x89\xe7\xceN\xec\x1de\x81\x9eӳ\x19\xea\xec\x9b|\xdb$\xfa1O7+\xfad\xa7\x06\xe9\xa7(}\b\vnp\xc8j\x94\xd1D\xaa\xafК\x02\xa9\xb0\x82L\xecE\xf9\x8e\x83\xb4<\xccw+\xa7\xc4.\xae4\vH\xear\xcb\xe3n\x81\xd3\xf8\xee\xedߟ\xfc\xdf:\xdd,\x93\xecۖ\xb1M\xfe\xefu,\xf9\xbf\xff\xa6\xffy\xb5\xfd\x9f\xce\bt\x02\xe0\xdf\ua956\x11+zJ\x11\xcc\xf7\b4\xb1\xb9\xcb|\x8a\xde\x12Z\xa1\xf2e\xa5\x85.\ng/\xab;\xeb\xcd\x03K\xd4L\xe5\xdf\x16\xf4\x8e\xffxX\xcdW\\\xff\x9daώ\xff6\x18\xbe\xd9\xff\xbc\xd2\xfa\xe73B1\x00\x99 \x05\x7fXpY\x94\xbe\x8f\xee\x12t\a\xb4\xc9\xcahA䁀>\xb4\x0eˀ\x87\x85}\x06!\x93\xfe\xe6~\"5<\x16\xde@\"W\x81\x8e\xc9ĸ\xa3\xa2\x88^\x82\x1f\x92,\x06\x83\xe1\x91 \x00\xa9W\x8b0%ْ\x05\x95\xe3\x1e\x96Y$X\xf8\xaf \xf0!\xcfS\x99_%V\xf7 \xf4\x1d\xdfG\x19\xfc\xfd[\xd0\x13 H\x92\xf8ca\xb6\xc4\xf1\x90v\x94'\xf9\fr\x00|\xb5\xa0R#Ǆ\x16\xda\xd5;\xceW\xeb\x94<w\xbacY\xe8Ѐ\r\xfb\x124@^\xe7¸\xacDߕ\xc5\\\xf4\x9e\xaa\xe2\t!\xeb\xd3\xdf6Q\x1a>\xebAȵ\x98\xe4\xe2t\xc0\x8a<K\xf3\xa8\xeaue\x81=\r\x80j\"_\x16SAQ\xa4\x1e\x88\xe3\xd2&\x9b_-\xc2$\v~\xf9\xcc\"\v\x83=\x90\xf8\xfd\x18\x15I\x14's\x1e\xe5\x8fu\a;\xbddqR\x90y\x15>:'\xc3$\xabDQ]\x94\xd6\x19\x8a\xd4>JU\xad\x18\xa0TՄ!J\x95\x9d\xdfC\x89\xac\x87d\x81m\x05b~\xea8\xa0\xcd\xe7\b\xfd\xc4Q_ \xc17=.\xa2\a\xf05\x90\x85\xd5\xcb:`\xbdr\xb7Y,H\xc1\xde\x7f\xe0\xc5\x02\xa8П\b\x15\xe4wH\x89\x98\xd5\x1c\xef*n̤Rl:\x17\xd1Z\x92qB\xe1\xfd\xe8\fNx\xaa\xb0\xcc]%\xb6z\x15ZJ\xb8\x9f\xa5y\xb4\xd6r\xf0\x17\xf4\xd1Z\xf6dG\x849\x8a\xd6\x13\x14$\xc2e\x93\xccQZ\x01\x84\x92\xd2+\x81\x804\xd9\x0f\xe6\x11y\xb4\xc7z\x12Ȯu\xc5|\x93\r\\_-\xa8\xe57k\x9c\xc5[X\xfdi\xf0i\xa7\x89\xcf\xf4a\xa9\xd97B.\xfa\x9fC\xb6`hi\x870\xdf\t]\x9c\xb2Ōf\x8bzU\x01\x16k\xbeE\xbc\xfc\xff\xb3\xf7w͍\xdb\xc8\xc28\xbe\xd7\xe7S\xb0r\xf3\xc8S\xb3Y\xbd\xdb>\xf3\xdf\vY\xa2le$R!)ۓyR*Z\x82m\xae%R!){\xbc\xff\xcaw\xffU\xe3\xb5\x01\x82\x92'gsf\x9f\xad\x99\xaa\xc4bw\xa3\x014\xde\x1a@\xa3\x9b\xbcԴ\xa0G^\x06%j\x90\x9d\xc3&\xe7\x1f\xe7\x19\x1d\xeeF\x83p\xa8l\x94\xb6xl\xce\xe0QְMW\xf3Ғ\xa2\xac\xa3\x0e\xc8\xeaY\x9ff[\xf21\x06,/\x8dU\\У\x11\xf69\x8c\v\xe8ƫǬ\x10\x9d)'\xabgG\x86\x1e_=\xfb\x1f\x85\xb7\x12\x1e\\O\xa4\xc3\x0f\xde\x19t\x94\xe4\x1f\xd8l΅\roq\xd9\xef\xdf\xd5!\x01\xa5$\xf7\xf1~S\xaatl\"@9ȹ\x1f'\x83\xdaii\xda:\x9e\xe6\x87\xf1\xea\xbd\vI\u05fa\\T" +
+	"R\x18VR\xc4\x1d!.\x80\x1el]s\x9c\xb2\xc2C\xb2+->\x13Ē\x14\x8f\xb6?8S&g\xb0T\xdd\xe1nH\x13\xfa\xf7\xd6Fe\x13\x9a,bW\xe4E\xc1o\xc8\xecw\xc4h\xbfR\x13zO1گ\xcaqB6_=\xb4\xa2\xf8\x81'\x8fb\xf0\xb1\xc3܈\xc9\x02\xb0\xd1F}/}p\x06i\x96\xben\xb3}\xa1\xfb\xe2G\xb9\xd7\x0fBF\xe4\xdf7\ue04ev`\x9c\fM\x11\xb24\xc6\x05\xad\x84\x1f\xf3\x05\x8e\b\xf9>\t\xd36\xf0\v\xa6Jp\xd3\xf0%\xde\xedH\xde(h\x9f\xd2\xd6|\xba|$l\x8c\xfd\xc3A\xd1\xc0\xa84\x15\xe9`\x93<\xa4\xec\x1d\xf1\agPPs\x9a\xbb\rx/\xdb\xf3\xae\xc1\x02\x1b\\$e!Ȇq\x1a\x92\xdf\x1a\x02\xc5>\xdb\xea\x9bu\xfaƉ\xf8\xf5\x81*:q\xce_\xbas\xaa,}&y\x99\xd82s7d\xdb8\x11\xd3)ȜU\xe5\x04\xf7\x1d\x8e\xd1*@!\x17\xaf\xb4\x1f4\x12\xd5\x1bl\t/^\xe9!\x9c~`\x89ȸ\xdb$\x8d\x9a.\xd3\xf4PS\xf7\xf6ʣ\x19\xdbRO@\xc5ے\xb4,\x8cJNRQ)V\xcfIq\xcd\xd5&)#\xb6&24\x8c\xa2\xc6\t\xfdCG\x9a\xa82[O\x1a\x94\x11\xfb-`\xbc\x82\xb2n\f*\v\xa6\xbdT\xfe\xe0x\xfb-\x934\xe7\xeb\xed\xb7\x93\x14}\xf0|\x14\xc0ߗ\xe2\v~ju\xf1\x9fI~\xbf\xc9^\xb8\x82\xdb\x00c,\xa1\x06\x8b\xca\t\x1a\xaax6\xbe8\xf7L\x015ѓ\xb4d\xeam\x15\xb5H(n\xaf!\xf9\xec\x81j\x162\x87kr\x960\x14\xb6\x0fP\xb6-\xb5M~\x17\xdf%?\xb2\x1a\xecS\x1d\xba\xe0߀\x15s\t\xfc\x06%X\x1fyx*\x85\x12\x8a\xf9\xef\x14\x03\x95F{\x8e\xc1H1ob8\xd2\xcb[\x18.\xd5\xda3\fݩ5\\y\xe9Y\xd0\xd5\xc4T\n\xfa8\xb2\x81\x98\x8d\xe1U\xb8\xd5\xf1\v\xd7CA\xb5\xa2#\ff*\xcb\xee\x0f\xd1\xf1\xe8c\x9f\x7f\xb5\xe1CR\x82\x8a\x06\xbd\x9c\xc6\x12\x93:\x9b\x9d\x8e~\x1d\xa6\x9c\xc5;\xe6\x8a\xf0D\x92TyU\x8b\xcf\xf5?MC\x14\t\xca\xd7\x1d\xee\x19\x06v\xc7\xe4\t\xddK[\xad\r\xb2\xc1z\x9d7\xecB\x82\xedk\xc3\x12\x81\xe1\xc4\xd9\xc5i\xb2\xf2\xb2\x92Q\x98\xc9x\xc89-j\x9b@\x02\xa8\b7\xd9K\x1dA\xbeOyj\xf8e \x87q\xca\xcbk)\x14\x9d\xec\xcb:\xdcfö\x84\x1c`ov c\x9b\x8b\xe3\xb4\xf0b\a\xfb\xfa}\v\xf7\x9d\xee\x16Cr\xdayY\xca\xf2\xb5\xe1\xb9s\x86ju\xc5\x1cf\xaf\xb2D\xaa\t\xce ዙ\xad\xac\xda\xd2VK`]ώQ\x83\x8fd-AC\xf4t\xedu\x86\x9e\xb2\xba \x1e\xc8\xe6\xf8zho\x9e\x94M\xf8Vq\n\x14_\v\f,\x17C\xbd\xb8\x86q:IkX3\x04\x9dK\xad\xa9\xd8\x04^\x9bV\xa2\x8d龎\x92\x87t\xff\xdc\xfe\xd5p\x83+\b\v/\xa9\x19\xf7\x93\x82\xf9حA\xfeB\xf2̎\vIɐ\x06\x1c\xa9\x0e\x06F\xea\x11\x06|C҃\x83E(\x1d\xf5Mah\f\xd6\xf4o\xedp\xbajb \x8f\xab\x1a5\t\xaczG\rmE\t\xa9\xa1\xb3h$\x06\xe5\\-\x18\xd6~\x01{N\xe8e_\xc4\xcad\xa8\xfc\x82\x0e\xf6ʍ\xf4Nh\x9e\xcf\xf1\xe6H\x02ؒ\n\xae&\xae@\xb8\xf7\x8e\xe2Zн-\xbf\xbf\xaf2,\xeb\xf8\x85\x84-Z_\xea\x12\xb2\xd5\xeb\x8b\xf0\xff])N\x19\xecSN\x00\v\x94\x85\x83\xb5\xb9\xabdf#[4\vԴU,\f\x10-r*.B\xbc\xab\xc5\xe9\x1d\xa1\x8a\x17\xdd\xe0\x8by\x86S%\xe5\xea\xea\x17k,\xa7\x13\x87\xaf\xa4x\xa7g\x1dD\x94\xae\xa3\x11\xbew\x9e\x0e\xd0۟\xa1\x8a&\xa2@\x98#\x0e\x92E\xf9\xeb[;t\x94\xbfj]\xd46x@\x01kh\xe7\xc9HK#\\\xe1\xb1`㻄'\xad\xd3\xe38\x05gQG5\x8cSڰ\xd6\xc2q\xcc\u07b6\xc40\xe5\x9bkU\xf6\x81\xaf\xe9\xe7\xc7\xf4\xc9\xcb<{\xb1\xf7\xbd\x87Z\f\xf9R\xca;\x99\xf4\xc0\x1a\xba!q^YBbz2\x10e\r\xfe|F\xb9\xa7(J%.f\xbcCʺ\x83I=#v\x00 \x8f\xa0j\x16t\x93\xacF\t\xd3N\x17L\r\x8cޑ\xec\xf5\x8e\xa5\xb6=\xda\xe3Ju\xe4K\xb7\x86t\x9f\x04\xffS'\xd6*MͳJJP\xdd\x17\xe2\xfa]'\x05\x1c|\xd0U\xad\x90U\xd3N\x998%]\xd0+\xe7\xc1\xb4\xf0\xd4y\x97љ\x00\xa6v\x0ep\x1bp\xa2\xa3\x9e\xaa\xba\x00CP\xa5\x02~\xea\xe0\xed\xbe(/D\xbcm\xc2dqb#Q\ai\x87\xb0l\x80\xd9(\xd4\xd9\xd31<\xe7\xf1\xfd\x9a\xbf\xf6_N\x1eȗ\xdd_\xbe\xe5\xfd\x7f\xb3\xdbkU\xed\x7f\xbe\xdb\xff\x7f\xb3\xfb\x7f\xe8\x11\xf8\xfa\x9f}[\xdd}0\xe4ߊ״\x8c\xbf\xfc\xa0^\xde\xef\xc09\x11\xf9\xb2˱\xc3?Jk\xc67c\xb4s?\x9cܾ)\x81ݖ\x14Ň9bHJ\xd3\xf3P\xfe&\x17\x16\x95b\x9f\x12\x19\x99\xe2-̸^c2+\xdel\xdb:\xdb\x17\xa5\x90YQ*\tp\x01T\xa9\x98\xb4\xeaI\x7f\xdeg%\x99\x912\xae\v\x1eƨ\xdfl\x9d\xc5\xc8O\x9c2\x7f\xbd\x88WOe\x1e\xaf\x9e\x1awλ\xbb\xa4\xe4\x8eoa\x01\xdb\xed\xc19\xe4\x8a{\x7fz\xef첂\xe9\x0e\xdae\xab\xe0u'\x19%*TO\xa2B\xa5\xf1\xd4\uf754_\"S\x05\x02\xee\xa6\xf8)\x05\xfdSa\xbb\xce\xfc\x94@\xf8\xd6Fb\xb4\xe6{\xe7_\x9c\x11뉕\\T&\x855h\xaa\xe4p\x0f\xeb\xeb\x1b\x92\xff\xe1\"ֹ\x87\x11xj9b\xf6\x1d\x85\x9ef\xe9\x03)\xca\xc6I\x05\xf3@\x8f\xf5\xdem\xe3\xd5c\x92\x92\n\x1a\x1c\x94l%\xba\x9a\xdc\xdbo\xc3\xfd\x1d\xf9b\x9c\xbe\xa9rS\xa4G\x83\xd0Y\x1c\xd8\xe8d\xec\xa4G;\x18\xb0\xf1\x9c&%\xc9\xe3\r\xb7\xad\a\x1f\xd4ب\xfe=\xdf\x1a\x96\xc4\b\xf3+\x92\xe3Y\xa32KX[\x17O\rG:\x02\xebHj\n\xb3\x12\x05d\xb7\x89W\xe0\x80S0\xcdWj\xe2\"\xbbMͥ\xa1\x85\x01\x97\xc5\xff\x98\x0fc@\x0f\xf6*L\x8eG8T\x1csɱq\a\x8c\xe4\x00@LSvt\xc8\r\x01D\x06\xb0\xa5\x10\xd4[5\x18\x98\x18\xf5\xe3lK\xf9\x1b83\xca\xf4\xcdI\xb9\b\xff\a\x1c\xa4ش\xe4\xb4V:\x8fZ^\xbbx݈\x0f\xcf\x00\x10\xf0\xa8qw\x8c\x11PUzjM[\x01-\x1brx\xc9\xe5\xa58\xc0\x99\xa5\xc1k\xe2\xa14ld\xb14\x96E\xf9`v\xfb\xbb\xad1\x9e>\xffz\xb4\xe22UQ\xf7\\Þ\x8b)\x8a\xfaf\xd0\xf31\xc5Q\x9f\x8e\xd5ZOW\x11I}r:\xa8d\x1f\xe3\x9b\xf9\x83\x02A3\x8c\x1a}\"\xdd\x01\x91\f6\x1b]\x1aZv\a\xcag\xeb oNk\xb6\xb6\x96\xf0-\xb5\xac\xb4\xbc\xc6\xe1p}\xed\xbd\xe0\xeb\xeam\xef\x11Gy\xf0вx\x02,\xc9v\xb7\x89K\xa2͟\xe27\xcdC\x9f!kx\xf2Ʒr\x96\xba-\x9a\x99\xdf\u0099\x1c(\xadT\xe1\xeb\xa6\xfe\xb7d\xc0^i\xbd\xbd\xc32\xab\xe3\b\x8c\x01\xd1<V\xe7RY\xae\xd3y\xf1\x18o\"fBx\x8cz\x91n\x11==\t\x13\x19\xe1\aVB\x87~\xb32.\x12\xc0\xba\t抻<{pޱ\x1d\x188\xfb\x82\xd7o\xe9Z\xd7\x17O,\xa9\x8b\xc7l\xbfY\xc3\xf1R\xd98\xaa\xb5\xabd\xbb}\xf1\xd8ȉ#wgմ̕\x1a\xb6ͣ\x9b\x03tƵ\x8a\xd3!D\x88\x94\xea\x183\xc8X\t\x0f>\xa2\f\x9b\xf8\x9f\xafcjb\xf0(\xdfE\xaa\xbcE\xb2\x84M\x8a\xb8L\x82\x01\xb59)J\xb2S<\x1b9\xbd\xd3~\xef\xbc$kf/~\"\x02\xdbp\x8d\xf5\xcdM\xc1\xe9!\x9b\xa4lT\x85-\xf1\xf1f\x93\x81\x8d\x95h\xa6IZ\x94'λ\xf2\x11\xbc\xe9V\xc9ل\xa4\xf6T\xd6&\x91\xd4+z\f\xfb\x9b\xf3\xee\xb7=A\x17,\x8a\x80\xd6?ߧ\x82体\x92/\xa5\xfaR\xdb\v\U000a570b\x8f\x15\x17\x13\x00\x87Y\xbavމְUp\xbdn \x86\x96.\xb1\x12\xbb\x15е17\xb0\x85\xe7\x92@\"\xa1\xed!H\x8c\x93rY\x0e.(\xb8\xf4gru\xb7\xbb\xf2\xd5\xdfi\a\xb7\x8c\xe3\xf16\xa5Ĵ\x02\x8a\x16\xe1\xff\xa3\xcf\xff\xf8\x01\xce79\xffk\x9e\xb6\xfa]\xf3\xfd\xffi\xbf\xf9\xfd\xfc\xef[\x9e\xff\xf1\x1e\x81|\xc0\xf1o\xfe\x1ce\x13\x17\x857u`\x18\x16책v\xf4\x87'\xe9\xc6;\xbe*io\xb2GY\x89\xd2K\xa34:\x82/\xc8C\x92Na&\xe6\x03\x1a\x1bL+\nXX\x11EW\xa3pӵ\xc1\xa1m\xe2\x8d\xf4z\x19\xbc\xec&\xcb\xd7\x17\xe0\xe2\x13\x82\x02*\xb2N\x9b\xcf\x15\x02Dc\x94\n\xcf\x01\f&\\\xd1\xe5->\x89\xe6m\xfa\xe3D\x10hY\xd5d\xa4\x1e\xff\xb8y>\xe1o\xae\xd8\r\x14\xfd\xff\x90\x05\x1e\xd4\xcf`\xff\xdb\x11\xaf\xb3\x98\xbc\x7f\xd0x\xd0\xc7*\x10ג\xb6\x9f\xce&aXp[\x97ǫ\x92\xe4\xce\n\x88j\x18P۹71pr \xb5\xb0q\x8bU\xbc\xab\xe1A\x18\xae \xbf\xedI\xba\xb2\xa5\xa6\x91,\x87\xf1\xae\xdc\xe75<\xe0Lh\xed\xac\x18\x89\x85Ü\xe4\x1b\x7fgO\x9b\xe5p1\xb9߱\xab(\aH\x1dy\xc8m2\nȎ\xc4e\x1d\xab\x94\x14\xc0\"';R&e\x92\xa5N\xb6#\xd4\x1bA-+0C\xb53\xcb)\xdeYe\xfb\xb4\xb4$_D\xe33{\xc2E4\xfe\xeb\x19N1\x85\xebW\x9d\x16\x8e\xdf\t\r\xac\xeb\x94Y\xe6l\x80\x02'\x99A\xd4\xdd\xf4\xe1\x02NmI\xa9\xa7\xdd2\x1c\r\x19\x00\x7f\x7f\xb5$\x9c\xc79I\x0f';\xb1$c2\x19\xe4\x0f\xfb-Ik\xb2\x8d\x05\xb6̎I\xda#E\x99\xa4\x0f#\xb2+\x1fk\xeb\x0f\x8dVP)\xac\t\xd9m^1\x83(\x8f\x93\r\xc8!^=\x15\xe0\xc2A\xe7Rr\xb4s'\xf1qIU\xf2\xec\xdeQY`\x8e\x8bT\\\x9aZD\xb4\x97H*\x9d\xaa?aJ(\x93\x80\x87\xf3]\xd5{\xda!\x9fm*;}\xb7$ᖣd\x9a\x90\xcd\xde{j\xa4,\xdf\x00f\x9b5}\x84#\xa6v\xf1\xc2\vt^\x14\xfc\x18>\xfd\x1d5\b\xe7\xca\"\xf5\xfc.\x7f\xc3\xf1\x06\xb7\xe9Q\xb5`zs\x06\a\xc7,\xbd\x81aǴ\xfb\x94p%\xdfP\x99\r\xa2yVH:\xbc\xc7Fdl~\x86\x8dB\xe3\x8e\xdcg9\xe1\xf3y|\x0f\xf3[m\x16\x15q\x89ׂE9ؔ\xbc\xec8\xc6*\xc7\xd0<\x15\xba\x85\xd1b\xae\x93\xd86ƪ\x82*\x82\x0e&\x18\xc7\xc9F\xa1z\x18e\xe4\xda\xc58/\xdb)L\x1f5\xa5\xb1\xf05\x18\xec`\xd5i\x93Jf\xa7&\xaa\xa5pg&n\x90\xbe*\xec\xb9\x05\xebQuBI\xae)}\xe0\xc0\xea\n+\x96\xd1%\x84\x7f\x1bz\x8e+;\xab\n\x80\f2A\n\x8a\xb2\x8e\xf7\xb2\xf42'd\xfd*q*l\xb2\x9f\x12\xaat\xc8TBb\xa6\xb4\x0eH\xca\xdf\r6t\x05/i2\xf6\x92V\xa2\xd2W\xa8\x8b#\x9aÀ3)\xe8-\xec\xef\x94:\xa5\v\x9ec\xa8\x1a\xa4\v\xd6߉\xde\xc6J\xd0A\b\xa9=蝅*=\xab\x98sj\xa9\xa8\xc2;\xda5Y\x1f\xd3\xfb\xad\xbf\x13J\x9a\xde\xe8\x14\xaeJ\xa5\xde7\xf8;\xd1^z\xff\xf6w^\x862ha\xb8\xa6\\14*\xc0|\xb3\xe7\x15i!\x81\xfd\xbc'\x85\xc8\x1c\t\x99-D\x1c\x8e\xa4\b\xc1\x058\x14\x89Ò\xb1,\x18\xbd\xc0\x95\xbdD\xf4\xd5y\x9c\x17\x04\x1db\xddS<\xa5\xb2]\x87sV\xa0\x9a\xc8\xee\xdbjc\xb8ʢ\xdfUqB\x1f\xc4,Lg\xe4Ͽ\u009f\x0f,B\x82|\xb1c\xbc\x01d*\xfc\xc1\x98\x93pe\xf0\x94\xec\xbcl\xa7N\x93N\x1c:\x1f\x1ad_{\a'\xb2\x8esz\x0e\xd1@J\xb4\xe52\xdb\xdf9\xb0\xac\x8c\x95\xec>\xc0M!\x04\xfb`\x84\xf4\xb3\xe9|n)\x00Zk\xd8G\x13\xac\xb1\xd9\xe7,\xe1\xcf\"g\xf1\x17\xed}\xa4ի-:L\xa5\x96d\xaf\xfaY\xd5W_\xd1\xce\xe2/\x95W\n\x129\x8c\x8f_\x92\xae\x04\rh\xc3\xca\xf3X\xf5\xb82L\xb6\xbbMr\xaf]\b\U000f7640QsZ\x0f?\x14JVٚP\x9f\xac\x05\x9a*\xe5H\xbe\x89\x8bQ\xb6\xd9Ĺ\ua87d\xfews\xac\x7f\xa3\x7f\xf9>-\x93-\xf9S\xf38v\xfe\xd3iW\xfc?\xf5\xdb\xdd\xef\xe7?\xdf\xe8\xfc\x87\xf5\bd\x00&\x00\x15\xff/\x1c\xf3\xb7\u2d68\xf5\x03s\x01\x11\xac\xe7y\x06~\xf2\x1ap\x04\x8c\x01\x01Ye\xf9\xfa\xc4i\xf0'\xfe\xca\xe0\x9b\xce\xecUR\xb5\xe7٧%3\xc5\xff\xe0\f_W\x1bR\x88\xaf\xb0\x8cWO\x8cZ\xcc\xca\x179\x89\x9f\xe8\xfb;i\xcc2\x9c/D\xa1\x8cK%xs\x06\x0f\xa5\x9f\x12\xee\xbbB\xacg4F=r\xf7\xf7\xde\xd9$)1\v\x8ex\x14\x92\t=\x12\xff,\xde\xf7\xe0ٜS\x8es:I\xaf\xd8\x17&}\xc7P\xc2\xf9̆\xc4\xe9~w\xc0M\x06\xa78aQ\bN\xb0\xff\x98dC\xc0\xbf\xc0\x0f\x0f+}\x1f\xa9\xeeexX\xaa\x80\xb5+\xdf,\x8a\xb3qZ\x10\x91\xe1|\xa8^\xc9R/\x16\xef\xe0\xff\xec7\xddz\v\xb3\xe8\xb1\x12\xd8\ag\xca\x05\x06Q\xbd\xca\xfcU\xb1\xd0*\xa2r+\xde|\x15\xc3ȥ\x7f\x8f\xc6=-,\x85B\xf0\xe0\x9ch\x1d\x8b\x96\xf8\x80\xab\x11\xe6\a1\x8f\xa9\x85\xff\x12\x80\x06\n>\xc0\xe7aC\xfd4(\xecѝ\x19\x8eV\xbej\xdeϱ 1\x10\x14\xeawP![\xc7;1\x92\xc2\\VҴ\xb4\x8fu\xda\xc8\a\xcf8\xcb\xe7C\x8d'M$\x1c\x96\x0eU_\xb9\xf4\a\xc1\xf0\x8ag\x06\x1d&ޮ\xfb]1\xa0/\xfd\xd9\xe0v\x1e\xf8\xc3P\xbc\r@\xde0.}?D\t7I\xba\xff\xa2\x12\x06\xbe\x1f\x992\xb9\xccȗD\x8dK\x19>\vO\x19hH\xdb\xe6\n\x9e\xb0\x00\x1bK\xc9\xe8#!\xbb\xc1&y&\xba\xc7#\xe5\xf2\x7f\xf5\xe4\x87\x11\xbd\x1b\x92ifd\x8b\xb3U\x9f,oxS\xba/\b\xd8ًgO\x95\xb2\x80\x1bQ\x94..q\x94L\x93\xa1\xe8y\x03\xb8\x1e\xa4\x0f\x9d\xc4$6\xce\t\xd1\x00\x94Ŀ\xfb\aY\x95\x1a\x91\x01\xa2\x92j:\x9f;\xf21!\xf2\x9fc\xe4\x0eg(\x8b\x82\x88\xe7\xc1\xda\x15[\x1d1\xcf\xee\r\xe4\xb4$tn\x95\xbd\\\xc8\x00n\x92\v\xad\xee\xfc\x82\xef\x83\x13ee\xbc\xd1A\xe1k!\x7f3\xb7\x17\xea{F/V\xd57HD}]\x91x\xa7\xf3\x02\b\xe6\aߓ\xf5\x86\xe8\x00hd\r\x12\x90\r\x89\v\xb2րB\xf2{,z=-\x05\xe1\xfcf\xe1.Nu\x1a\n\xd2hh Y\x83\x88\xc20\xd5\xc5~\xf5t\x15\x17\x8f\x18v9\xc4_~\xf9Hr\f\x80\xa9\xf1r\xa8d\x19\x17\xf8s\x1e\xef\vB\xc5\xef\x15:\xd0+\x9c\xcf\xed^\xffW\r\xe8\xa6k\x1d\xea\xed\xb7\x97Cyz\a\xef0\xb3|E\xd6\bv9\x1c\xce\x17\xe3<f\xeb\x03\x7ff\a+\x01\xbcԸ\x1c\xf2\xcb\xfc\x11\xb9\xdb?ȯ\x8bWz\x16\xfe\xb9\xdf\xfaU\x04\x18\x86o\xc1\xf2P\xfb\xcb\xe9|\xb6/ɗ\xaf\xd7@hZ\xd8\n\xcf\x17\xda\xfe\v@\x0f\x19}\xc0\xae\x8f\x02\x90\x80\x98\xbdD\n\xb6\xe7\xa6ol\x12=P\xb2m\xd9\xd1\bk#\xc0\x19T\xfab-\xb2L\xd2T\xf9\xdey\xb7\xa3\x9f(#\xfa}\x02d\r\xee\x97\xc0\xfaTY\x12.\xd2\x1d\x84\x8a\x94Θ\xe3\xb5\x18\xc7`\xe9+~c|\x94\xf3G\xd0w\xfb{\xe3\xf1&\xbc\xea\xc4M\x10\x97\xa4\x91\xf3\x99\x12\xd1(\x15\x8dR<\xfe\xd3\xc4?d4\x138go<\x93\x9c\x1e\xdc\xd3F,\x05\xb8\xe2\x18I\xbcI3\xe1\x05u%\x9a\xfc\x93\xe45\xaf,C\"\\" +
+	"C\xa1\xf5\x0f!\xc7IJ\xdda\xe7\x8d\xec\xee\x1f\xba\x8b\xbd{\x81\xb2\x89\x18\x9cL\xa0\xfe)\x86\x87\x92\a\xeaylJU\xf2|\x0fv%|%R\xbd\r-\x962(\xf7\x81UA[[-\x93\xb6\nh.ZT\xf3\x8e]f;\x0e\xe7\x10\xb6\xa4\x0es\x12\x97_\xb3\x86K\x87C\x83\xa2 9\x88\xe0\xe8h\xa9RW\a\xc3\x01R\xeb\xe8Z\xa4\x1b\x9bbp\xcdz\x97y\xffA5CQ\xb2\xe2\xb5\xf8\xd1\xcb\xcaI\n\x8b\x83\xd5'\n\xd3$\x0f+\x8f\x9c$)&)(y\xeai?\xcdP\x90ˁMɭ\x99\t\xd2\x13\xe7\xd9\xe2\"@a\x97c\x16\x91\x16+\x83\bM쪪\"(\xf2\x15\xe7\xc0\x7f\xf1\xa2\xee\xb4\x19\xe8\xa8\xfe\xbe\xe3\x13\xcd^L4\x94\tgy\xa0\x17p\x8a\x13z۫\xda\xe7\xfb\xc1\xceם\xff\xfcm\x95=\x93<~ \xdf\xe0\xfc\xa7\xd9\xe9\x9b\xf1?\xda\xdd\xd6\xf7\xf7\x7f\xdf\xf6\xfcG\xf6\bu\x10\xa4 \xda[@\x19\xb9#\xa7\xa72p\xeea\xc4\x1d\xa0ч\x05N\x8b\xe7\\K\x06^\xe1T\x98\x05\v!}\xdev\x98\x17\x90\xd8\xf9|\x1f\xf7\xe6\xf8_\x83\xda\xfd'\xe5q,\xfeO\xe7\xb4\x12\xff\xe7\xb4\xd5\xfa>\xfe\xbf\xed\xf8\xa7=B\r~\xfei\x8d\xf3\x8f\xa3\xfa_\xec\x93\xcd\x1a\xeb(\x97\x19מĹ\xd0\x0f\xff(\xb2\xf4\xbf\xff\xef\x0f\xef\xb3m\x02\xaf\x00\xca\xd7\xff\xfb\xc3\x0f\x1f\x1c\xe4ȳ\x8ed\x16'\xa93\xcb\xd6\xfb\r\x91$\x141\";\x1a\xce\xdf\xc0\x19\xc9CR\x96IJ\xdd$\xd3R\xf2o;\xb9RMd\x8d\xea\xcc`4f\xbc\xd6\x1f\xa5gκ\xd2\\#w\x9d\xb5E`\x87\xbey\\<\xfa;\xd0]\xe5\xb1\xc9\xef2\x18\x11!~8#\xdb\f\xd44\x11\x87s\xa8\x1d\xb1\U0001df8cC/6땝?#\x19\xed\xf3\x18\xf2\xe2\x18\xe7\xf3\xaf60=\x00\xf8\x15\xf1\xa4П\xf7qZ&\x1bR\b\x9cH%\xea\xc2[H\x9c\"\x1fo\xf3\xb7u\x9ep\xbf=B\xc1_\xde9\a\xfb\xc8\xef\xf8&^\xb6{c\x1d\x97\xb1z\xb9v\x978\xaaOX\x9es\xcf\xf3$-\xf9N\nm\x88\x15;\xf0\f\x06\x03\x04s1\xce\x1d \x01o\xc5F\x01\xffw\xde\xf1O\xbc\x01\xa6\xfdb_\xc2{\xdb{\ab\xbd\xc3\xd1\xf1{'ە\x85\xd6i\x8c=\x1b)/\x87s\x92\xafHZ6v\xecoe\xab\t\xbe\x0f\xbf\xf0\r\xa78\x87\xac\x12\xb0mR\xd1`6\xf6V\"\xda3\xa7\xc96)\x1b\x1b\xf8\xbfp\x8d\x85ON\xc0\xcb\x11\x1cj\xf8\xe9\x18v\xd8\rB\x8f\x84\xb8/)\xcd\xd9.)Ֆ\x7fC\x9e\xc9\xc6\f\xbd$7\xb0\xe8F\x87*\x03\xb0+\x1b\xed\xb7\xbb\xc6\xfdZ\x9e\x80\x7f\xd7\x03\xe4\xfa\xbf%e\x9e\xac\x8ao\xb1\xfe\xb7Z\xa7mS\xffo\x7f\x8f\xff\xf7\xad\xd7\x7f\xde#\x94\x06 \x01,\xec\x06=\xff\xfc\xfc\xeb\x88\x14\xab<\xa1\xf3\f\x9f\xe2\x11\xc4\xea\xb4\xdb\xc0+\xe7ItEd^\x8b\x87\xfb\xed~\x13\x97\xc93\x11.\xb9\xb9\xc1)=4\xbeJ\x8a2{\xc8\xe3\xadv\x1d,\xaeM\xc5\xf18\xa1\x10~\xce,=\x8d\x03\xff\x8b\x18e\x86\xcd0\xe1\x9b\xe7\xa1\x11\xb4-\x04\xaa\x10\x98\xb2\x83)\xb9?^\x8co\xa1\t\x9e>d\x0ec\xb0\xae\x91N\xec\xe9\x97Ul\x94\x8b\xf4Y_u\xc5[\uf117;u\x94Űx#\xebw\xeb\xfc\x91\xf1\xda\xd6z\xda4\xa5A\x8f\x92\f\x18..\x95\x03,\x11\xdf\xe7\xde\x7f\xa7\xf9\x7f\xb7˳\xfb?)\x8fc\xf3\x7f\xbf\u05ee\xec\xff\xba\xdf\xe3?}\xe3\xf9\x9f\xf6\b\x14\x06\x8e}\x8a-\x1f\xbfH\xf9\xc1v\x164\xca\x1a\xab\xf2\x8b\xb8k\xf9\x91?\x86z\xefl\xe2;\xb2)\x9c)\xfc\tI\xf9\u07b9g\xfe.\f\xc2\x13\xa1̍\xb3\x9c\xd2\x16vv<\xb5\x8a\x81\x00фԞ\xeaDS\xa8)#;\x1f-\x88\x82\xe0\x84\xaf%D\x81\xad\xefB\x11\xfb\x82\x05\xe1A\xc1TEJAŢ6h\xcej\xde\xf1K\x12\x15\xb9\x85\x03\xeaȄ\x19\xaf0\by[|=F_g\x96\"\xd1t!5\xccM%r\xb0^\xf3 \x13\xda\xfd\x96\xb4\x94\xaa\xa6\b\xc86{&\xd5D\x16R\xaa\xa4G\x19>\xd2{\xcf\x0e\x1d(om\a\xc3\x131\xc3WC\x80\xb0\xbb\x11\xb7\xb0\xf5\xbd\xe7\x04_l![\xb0\xda\x03E\xb8\xe8Bt\"=\xc4H:\xd4G\x8d.\x7fbR\x98;\x94\x03\xe5\xf8s\xe7\x7f\xd8U\xfd\xe5\xdb\xcc\xff\xcdfό\xff\xd7굿\xcf\xff\xdfx\xfe\x87\x1e\x81\x8c@\xe1\xeb/\xdf\xff\xfd\xa7\xfd\x13\xad]\xfe\x89\x13\xc01\xfd\xef\xb4\u05ec\x9e\xff\x7f\xb7\xff\xfe\xc6\xe3\xbf\xd4'\x00\xfeyD\xff\xb3\xdd\b\x8c7\xc9\xc3c\xc9L?\xbe\xc2\"@O\xc6\x1f\x00\x197\x8b6\xaa\f92\xae\xa0\x99\xb1\x99i\rQ!\xb3(#\xc2h\xa5\xdfՏ|-U\x1cf\xe9}\"o\x02fI:\x00\xf9\xe9g\xe8\xb3\xf8\v\xb3\xef\xe4\xc6j\U000b98b5\x84\xd3\xec\xc1\xae_\xac\xe2\x92<d\xb9Ҁ\xb7\xa4(\xa0\xb9\xf4c\xd1i\xf6p\xffF\x06\xf7Y\xbe\x8d\x95\xd3n\xa1\xd1Z\x947\x8f\xbc\xe8\xf5n\xac\xee\x1f\xac\xa2\xa0G\x02\x18\xac8Dq\xf1\xd4\xd8Y\x8bV\xc6\xc5\x13\rA&\x95\xf3Z2\xe7\x1d\xf09Q\x0f\xc2\xd0ٓ\xcd\x10\x87Q@wP6>\xac\x7f\x1dP\x00\xe3\xbcd\xe9\xec\xe5\xc8)N+0\xcf\a\xa9\x90\xf2\x8a\x06\xca{и\t꣕\x0fTͯ\xca_\xc5\x06=\xa9?\xe9\xa6\xf1\xe0\x8a?w\x8e9\xae\xffU\xfd?7\xfb\xdf\xe7\xffo3\xff\xb3\x1e\x81\x1c\xbf\xb0\xef\xbf|\xff\xf7\x9f\xf8\xaf\xc8\xf2\xf2\xcf\xce\xe3\x98\xfdG\xbfb\xff\xd1lu\xbe\xdf\xff|\xab\xf1\x9f\xe5(\xf8;\xfbb\xc7qp\x8d \x02;ow<n(\xbf\xf1\x15Ѯ@\x81اk\xfd\xb5\x15\xbb\x0e`\xe1l卌X\xea0\x16\xa2\xd8\xc4\xf9\xea\x11\xc7ZBGQ:i%\"\x95\x89\x06'\xe0Z\xb0\x1b\xac\xf3\x19پ\xc4;3\x06\xaa\x95.\xcb\xd5[%\x8eb\xc1\x87\x8c\xb0A\x027\xc8IȂ]`\"l\xd3;IK!\x19T\x17\x01E\x121.\xd7\x11IE\x12\x18uP\n(\x9bZ\t \x1a^{Qn\x1e\x15]\xe9\x86(\xd8hM\xc6\x1f,\x19I\xe57-\x99,Q\xee\x00\xd3eh\xd6aRp$\xb5Ґ\xa5\xd2h\x02\x02\xef\x03H\x85D\xfe\x94\x87\x87Tּ\x93ߣ.\x8e\xac\xec\x11\xa5\xec\x01\xb1j\xdc\xf7\x8e\xb5\xf3\xb2\x04\xb4\x8a\xb1p\x84\xf8\xa5j0\x01D\xccʈ\xd1\tU\xee\x8bŻ9m\x153\xa0\xff\x86\x14\x85%\xa4\xaf\x1e\f\x18\x12J\xb9}\r\x03\xcd\x18\x03\xb8\x84%\x8d\xc3\xf2G\n\x01\x9d\xc9h\x10\xa5k\x03\xd3\n\x12\x05\x97\x16C\xa6\x12!Y\xe0\xd0\xc0\xa9JN'\xac\f\x1f\x03{p\x04\xe9Y\xd6\x0e\"\x9d\f\xcf\"\xa2\xb5\xbfT\x9c3p\x8c\xde\xfd5O\xf2\xff\x82\xf5\xbf\xccWY\xfa\xfc-\xd7\xffN\xaf]Y\xff\xfb\x9d\xef\xe7?\xdfj\xfdg=\x02\xa9\x00\x02\xc0\xec>\xa8\xe7d\x1a\xb3\x0f\xbbs\x16\x81\x005\xc3+F\xcb\x02\xeba\xe2{GΕ\xf7\xdb\xd2a\xc0]NVl䀳ax\xe5Wu\xf8\xcc\x18NR\x9d]\"\xcee\xee\xe2\xa2>\x15\r\x8a\xa2\xa5\xc3\xee\xd7\xed\xe4ԕ\x18N\"|H\x1dJ\x10e\x83p8\x99\xfc\x81t\x97y\xbc{LV_\x95Җ\xdb\xf1\x8a\xd9\xf3:\x98\x8e\x06\xad\xd3ž?\"\xf72K\xb0\xcb\x7fڸz\xf4\x9f8\x05/z\xbfѦ1\xa3d\xc0Sj7\xe7\x9e&\xf9Q\f\x87\x85\xd4\x15\xa3v>3\xa6\xe7V\xb4_\x8a\xbe\xa8\xad\r\f/bA\xaeP,\xc87tB\v'֫\xbf\xa6'[\x98@O\xb6\xf4^\v%\x95\xbeM⦛5\x96!\xf3\xf8\xc4\xf5#\xd1\xd4\x16\x87u\x93\x82Z\xabZQe\x167\x12=\x13\xba\x00{\xfb\xad\xf66O\xbc\xd0\x12\xb1\xe1\xe5oW\x1c\x93\xaac-\x91\xb6\xf6q\xab\"X\xa4/9u}\xa4\xdd\xfcR\xc3\\hd\x1c\xfd\xa8!\x03+!+\\\xa0\x14ͭ|ji-\xd2\xc0\x9d\xa0\x9a\x9a5q]Z\xd9\xf0Մ\x93TK\xc6\xdb\xcad\x90؎\x93\x15\x93E\xf2&.{\xc5D1\xf8\xd9\x18P\x9a\x90\xd5\xcc&Zݎ\x163\xcb1*\xb3{U\xe9\x04\xa7C\x05R|\x0eQ\xad\xb9\xf3\xb0\xa2j\xb1\xa1\t`\x91\x9as\xcaA:\xea\x1fPɚ\xc2\x1c\x1e\x14\x80\x19/0\xb7\x8f\xdb\xfd\xa6L\x00\xceCy\x95\xe0T\x111\x16\xcc\xff\xf2\xff\xcc?V\xf8\xe2[\xea\x7f\xa7\xdd~\xb3\xaa\xff}\xf7\xff\xfd\xed\xf4?\xe8\x11\x9a\xfe\xc7\x00֛\xbe=\xf3\x01\xa7=\xff\xf9\x8a[>N\x7f⬲\xdd+\x8d\t\xd18\xa9\"\xeb\xdc\xe4I\x82\xca\x16N\xa1\xaa\xfe\xf3$*\xa0\x014,\xf9YB\xe3*\xe4\xe5!$\xbd:\xa2\x0f\xde\xf9\xf4QUzLj\xb8\al\xac\x1c-4\x88\x95P\x9b\xb3\xdf\xc0\xb8\x12\xd3ɦ\x81m\xb2\xb4v\xa5`\xd1rI#V\xab\x90e3\r7Pq\x92\x16h\x02-\xf6wx}FZ\x85 \x1e\xa4\xaf\x88\x1e\\\x86\x17\x87\xc8Y\xb4,I\xcfOf\x94\xcebMD\xe5\xa5\x12Yt\x1cf\xeeV[nTǽFFvH\xf7`~\x81\x05\x8ey\x06\x96\x05Ň\xa1\x82\x13\xbc\xc7\xfa\xd7q3V\xc4\xf7\x8e\xe6U\xf3\xc4i\xbc\x81G\xb8\xbf\xd7y\x14\x14P[\xac*\x13\xea\xee\x12|?#&\xa5\xadEy \xe3\xda8[\f\x7f\xbc\xbd\x8ddW2>L\xad P!\xae\xe2\xe2X\x95\xb1&l\x84f\xaa\xed%\x94\xf0P\xcf6H\xe9\xc8G\xb4|\x0e0\xa8\x8e\xcb\xc2HP\xd3\xef\x15\xd5OY\x926Ȇl\vt\u0088\xbb\xa1&Z\xe8\xaeo\x16\x81$~\x9b\x18$\xf9QQHʯ\x11\aD\xb5\xdeƻ]\x92>`2\xa6\xcc\xd5Lz\x1ey\xe1\x81\x1e\v|\x91\x1fkf\v\xfc5a\xde\xc86딼`\x9b\xdfw\x02'\xad\x11b낈\xad\x11\xe2\x9aEL`@߯\xf8\xdc\x12H\xf8\x8b\xa3X\xf1\xd3\xebʮBO1P\x91\xaf\xde;\xd9\xfd\xbdx\x97\xf7\xc6䴽`b\xb0\x87\xc0b\x84\x8b4W\xa4\xfaʆy\xd1\xfe\n\xbb\xb1G\xd12|{s\xb0\x14\x8c9Okg\x1e\x12\xf2\xd4\xc8\xee\xef\vR\x8a\xbd\xd6\xcb#IWr\af\xd9:\xa9\xd4_a\x04T\xa9\x14\xe8\x15\x85a\x84\xc3\xdcG\xe3N.\x9cX\x1a=\x90w D\x99m\xd6\xf27t7#̙-5\x8doy\x84A%*0\xeb\xb8_\x11\x17\x98%8q\xee@\xf1\xf0S\xe4\a\xa8\x82m\xc8@\x9f\xb9\x85Ĭ\xb3\xc5#\xb2 ź\x8df-\xae\xd2\xd6vb3N\x1c\x9e\xf4\x8c\x15\x85\x92\x0e`\xed\xfcZzϚ\xa0.(\x1dM\xf7uI\xa2\xa4\xdcԊ*ʦ\xd9\vɏ\xa0\xc3\x1dY%\xf1\xa6\xb1r\xb8\x02\xff#\x87@\xec\x86ڹ1ʎdM\xd1\x7f\x94\xf7b\xb7;Tn\x8a\xfe\xa3\xbc\xafEx\x14\xbc0\xb2.E\xe3\x86ؓ\xe5\xc9\x16\x8f\xd7}Y\x90\x03\xa4\xc7W\xa6J\x92)\xb9/\xbf\"\a \xff\x03\xb9\x1cӌ*\t\x020\xd6\xfb\x8arQ\xfa?P\xb0pw`\xccS\x82#Z\x9a6\x7f\x89\xe9\x05\xddE\xd6M+\x1f\xfe\xd8,\xf2\x9f\x17\x06\x8e\xcd\xf0\xdf\xf4\xfc\a̽\xab\xe7?\xdf\xed\xff\xbe\xdd\xf9\x0f\xf4\b\xed\xfc\x87\x01\xe8 \xbbʊr\x1a\xbff\xfbҦ\xd2\xfe\xe5\xfb\xbf\xff\xb7\xfe\x15\xaf\xe9\xea\xcf\xce\xe3\xd8\xfd\x7f\xbfթ\xd8\xff5O\xbf\x8f\xffo4\xfe_ӕ\x16\xf6Qxt\xa4A`\x85K!\xea@\x9b\xe4v?\x97@y\xe2\xdc\xc4\t>\\e\xc00yH\xe3M\x05|\x91g\xf1z\x05\xe7b\xf2\xd1-\xe5\x8fm\xcb28\x1b\xfe\xc0]t*\xb7\xf4\xb3x\xf7\xe6\r\xcb,ޝ8\xd3,^\xd3W\xc3\xf8M\x83c{\xd9j8\xe5\xe1\xe9\xc32ˉ\xc9@\xbc=\xb6>r\xa5ɨ\x87\xbc\xc6I\xb5,~\xfef\x8eN#^\x95\xfbx\xa3\xd3m\xb2xMֶ\xa2\x02\xfbA\xba\x1e\x11\b\xab\xf3\xb6:\xd73\xab\xe1b\b\a,\xa0\xdeT\x93]N\x9e\x93l_\xbc5{~\x18=H\xd7\xf6<`\x8b\xab\x01`\x9f\xabgY\xbcĻ\xdd1\xee\xf6jV\xf8\x9f8\x8d5\xa5\xb4\xb2\xa3F\v\r\xf4B\xfd\x988\xb0\xbd,u\b|\xe8\xc0\x88\x12\x9c8lD\x98\xd0(\x7fe\b\xfd\x89\x13Ǌѣ\x8e\xb2hL\xa5\r\x1fo'\x0e\x1d\x90\xbc \xb0\xa1?T\x0e\xc0\x9f\xc0s\xff{\xf9\xceDG\xad\xb3p\x93\xbdTЀ\xa4\xbb\x05\x89\xe0\x7fy\xbe\xf3,ۈ\xec<\xf2\u0091X`\xf6y\aҝ8\xf3}i\xf7\xf9/\b.I\xa9s3\xf0\x0f\xa4\xa4\xc5\xde%ki\xa0YCJ\x9dՂ\xfb\xda,\xdbL\xb3U\xbcyo\xbc\x84\x17T\x94\xa1\x95\x92\x1d\xbd\xdc\x1cmtNr\xe2\x04F\xbbKD\x94\xbf\x06\xb6\xa6W)\x8d\xd6G\xa8\x9c\xa1h1s\x16;\xc2Bu \xe7\x83\x19\xd7\xe7\x1b\xb0~\xd78\xe1\x1d\x90\xcb\x03\xd6\x0e\x1aV\xea\x90D$\x11sM\xb0&\x9b2\xd6ŏ(Fp\xcfe\xc5\x18\xeb\x14\xc2\\j=\x9b\x16L6\x9f(\x8f\x04\x88\b\xbd\xb6`&\x15\xa2\x7f\x1b\xdd\x19\xd6\xf7\xbf\xc5e\xb6MV\xdfH\xffk5{\xd5\xf8\xdf\xe0\x12\xf8\xbb\xfe\xf7\xcd\xf4?\xde#\x94\x1a(\xbe\xed1\x9e\x06k\xb0\xc9\xec\xb4\x1b\xf1z\x9d;\xefx\xe8\x7f9\x1e!\x10`\x83\xaf\xc6jZa\x89\xfa]\x95\xa8\xdfE\x89\xfa]\x95H=\xab\x18\xac\xa9\x1d\xa2\xccj\xaf\xe5\xb5Ǚ\xed\xcdܸ\x9f+\x95P\xe5\xb7\xc7\x19\xeem9\xee\xca\x1c\xa5\xa4\xa1\xa0TR\x16\xa1G\xa4\x85/\x918\xb5\tf\vo?EQ\xb9Z\xd1i\xebiL\xb9\x884\xfd\xaeJ\x83\n\x99\xda\xc5BS\xedqV{3/\xabTd:\x99\xddޖ\x9fE(2%\x93\x89H\xcad\xc2,D\xb2\xcc:\xfdI\x1b\x06\xbadR%\xddXN\x18\x86)\xcb\xcf\xf1\xc6л8\x16tCh\t\x8ad\x05\xb0\xd1\x19ڤ cZ#OkS\x17\xf5t\x96ƕ\xed)\x15\xd0N\xfb\xad\xbc\xccF\x97\xed,y\xf5\xbbo\xe2ŃW\x88\xc61\x82]\xd0F1`\xb4\xf3j\xb07ed\xedu\xaa\x9fq\xbeo\x17\x82\xb57\xaa\xfe\xa7\xf8\xf5\xbbo\xe6g饨[*\x96\xac\xc7\x1a<\xc5S\xa7N\xfbP\x9f\x9d\xb0\n\xf2N\x8b#oI\x9c궆r%\xf0\xa2\xe3\xd6N\f\x92\xd4\xd2w\xdf\xd8\xe3$\vM_\xb2\xcfϊ6]7\x0eOY\x92\xd4\xcf\xeb)\x85$\xfb\xdd#\x92\xecw\xb1$\xfb]\x13\xa7IR\xcdI\n\x8f$i\x9f.%\xa9]\x92o\x19o\x92\x85&I\xfb\xa2\xa5h\x91$\x8f\x94\fI\xd2N\t\x12\xaaL?lK\xaf7\x0e'\xd4\xe7\x16E\xa8s<4q\xf04\xf6\xf88\x90\xd82\x19\x884\x95\x12Y\x06:\xa2\xedwM\xda\xca F\xd4h\xc1\xf5\xf3\xaf^oi\x92\xaf[n\xfd\xfc\x0f\xad\xb6,\xd9W/\xb6~n\x15\xc0\x91\xb5V\xb8\x9cȉE\"\x95N\"\bM9T:\t%<\xbc\xbc\xd4w\x12\x9a\xd8*\xbaj'\x91\xb4\x15yU;\x89\xa4\xb5\b\xc9\xd2IjV\ue0d3o\xcd\n}p\x9a9\xbe\x12[W\xdd\xea\xf2\x8c\x19Zŧ\xad\xb2ՎW\xbb\xb2j\xabi\xb5\xe7\x1dXA\xf5EӢ\xe6\xb1r\x1e\x9a\xea\x17\x89\xb6j\xee\xf5eS`\xd5l\xbf7\xd6\x1cI!\xe6\xfb\x03\x12PԖ)\xffͺ\x8a\xe2\xa2f\xfd\x03{\x0eD.&\xfe7\x15Q\xcc\xfdvb)\xdd\xc3\v\xe9\"\xd1VRݷ\xaf\xc4\xea\xd2\xc5됤\xc0ҭ\xe9'\x8a\xbaF\xbao\xd2\xdc\x14\x17]\xba5\x1b3D\x8e\xa5{\xb4\x88X\xbaUb)\xdd]\x99\x1f\x13/\xed\xfbH\xbe8\x94\xab\xc4\xeb\x12F\xb3\x10\xa6\xc12\xae\x9d\xccQ\x82\x1a" +
+	"1\x1fWgMF\xba\xa4k\xf7\xb18\x05\x16\xf6[ʊ\xe5];[\x1c\xf3d\xfd\x8e\x12Hi\v\xe5\xa5z\xc2\xcb\xe94\x1d\xb1\x9e\ni\x8a\xe8\\\xdf<\xe97\x93ٵƷ_<\xfc;\x9d\xff\x14\xaf\x05\x04\x9d\xfeS\xf38x\xfe\xd7n\x9eV\xcf\xff\x9a\xfd\xf6\xf7\xf7?\xdf\xec\xfc\x8f\xf6\b|\x05\xcc\x01\xe2\xf0\x0fN\bm\xf1\xde\xd9\x13\xd4\xc1x9\x98^:\x7fw:g\x184\x9fO\xddh0\xfd\xe8\xfc\xdd\xe9axx\xe5\xfc\xddii\xa4\xd1l~=t\xfe\xee\x18\xc0\x90\x02\xdbM\f\xbdm\xf7\xb0\xd3\xfd\xc1xy1]\xb8\x91\xefG\xc0\xb6\xd3\u0088`2\xbat\x9d\xbf;\xa7\b8\x1cL\xc6@\xa8\xc3<\xc8\xe7\x1c\x81F\xee0%%\x14\xb4\x8d\xa0\xee\xd0\xf7\xdc\b\xa0\x98v<\x99B.8\xeb\x89\xeb\xbag\xcdv\xabׅ\xbc\xfa\x18\xc3\x18\xb4\rP\x1f\x18\xe0\x8aN\xe6\xb7\xceߝ.\x86\x04\xa3\x01\xa4\xc4u\x9f\x84#(zG\xa3[\f\xaf\x01\x86\xb3\xf8\xe8~\x82\fp;L\xa7T\xb8\xb8lS\x7f8\x98\x1a5\x99\r\xa0\x1c\x1d\\_ύ.\xdc\xc5\x04\b;:x:\xf1\xa0\xb9[}\x1d\x1c\xf83\xe7\xef\x0e\x06\xce\aÏL\x90\xb8\x1d\xe6W\\\xbc\x1d\\\xd0\xf9|\xeeC\x19ڸ\x92\xc1(\x04\x10.i\xe0\x87\xb4\x19t\xd8\"r\xcd\x12\x05\xb7\xc1\x1c\xea\xde\xc1\xa5\x0f\xdd\xe1\"\x98DTL8\xa3УB\xc7\u008c&,9n\xae\x857\xb95$\xb7\xf0¹;\xc4\xc1&\x06\xe3\xe5\xcd\xc0\x9bO\xe6P\xa46\xae#\xeb\xd5J\xc8\xc1\xfc*\x18-\a\xa3\xc1\x9cv\x96~\xd7\xc4\xd0\xc15\xfd\xa8\x8d\x19\x8e\n\x86L\x88\xa7&\x82\x0e\xbcӳ\x96\t\x8ffz\x8f\xe6`s\xa41\xf0\x05\x1d>\xa7\xa7=\x03>\xbc\x1a\xf8\xa16\xd49|\x12\x0e}\x80\xb7LN\xc3p:\x99SA\x9c\xda0}\x8a2K5\x1a\rgs\xca\xceL4\x9a\x0e'z\x1fgp9fO\xcf\xda&ʍ\xae\xdc@\x1b\x8d\x1c\xc1ᦨ\xdcŤ\x0f#\xbamf>f\x03\xe7\xf4\xacWA\x8c\a\x17\xc1dH\x91\xd5Ts\x96\xaa_E\xcc)\xc2l\xf7\xf1h4\xa1\xe2\xaf \x82\xc1\x88\"\x9a\x06\xe2j4\x1dZ\xc5\x7f5\x99\xcf)\xaf\xb3J\x92\x1b\xd6\xf4\xedSS.0\xad\xb5:\xe7]}4*\xdcY\xb3\xad\rt\r\xd3jAom\xb6\xea\xb0\xcby0\tg\x94\xa6]K\x13\fF\x13?\x1a\xcc)Y\xa7\x86\x8cκg\xcdn-z9\xbf\xfaDIzv\x92e\x14P\xb4)\x99\x897\x9ex\x93\x8b\x817\xd2'X\x8e\x9d\x8fF\xb4\xd9NO+\x98ˀ.D\xa7\xe6h\xe5\x93\xfa\xe9\x99Y\x97\xe9`~A\xdb͔&\x9d\xa4\xd9\xd0?=\xad\xa4\xf2\xfd\xf9\xc5`Ȑf\xf9fn\x14L\x86\xfeL_D\x18N\xce\xd2f\x95=߃\x92\xf7{\xbd\x8e)\xcf\xf9d\x16\xb8\x974+s\x98\xce\xe7l\x90\x9aE\x98\a|0\x9a\xbc\x82\xc1\x8d쩦\x8c\xf8\xc4ޮ\xf4\xed \f\xaeGt~41ᄎ\xf8SSx\xe1\xc7\tk!\xb3\x17ʹ\xa8oA\xb0\xa9\xc8,V\xb4\xf0<\x97\x8e߾\x1dէ8S4\xd7\xfed\xc4\x05j\xf6>1\xecDȠ\xc1jEv%\xc4/c\xaf\x97R\xf6\xeb\xbdS\xc4N\x98\xad\x9e\xe0\x84\xc6\xf2\xe4\x85%\xeb6\x04\xf5\xfd&~(\xbe\x9eEQ4\xd0\xf3\xf0\xf7\xce6[\x13uBaKR\xe2\x04V\x9a\xf5?\xc0+\xf2\x17\x1a\xd2\xfc\x1dD\x11\xfcB\xfd4\xf0\xd0綂l6<\xe4\\Ȕ\xd2F\x99\xc7;\xb5\xf7\x8d[\xe8w\x1b\xfd\xee\xa0\xedg\x8e\x88rD\x04\xb9\xb9y\x9ef\xb5\xb9\xf5\xbf:\xbb\xf7N\xdcE\xbf{\xe8w\xff\x0f\x15\xa9,\xe3\xd5㴸\x97͙@\f\xa9l\xf54N6\xd8g1\xebI\x17M<\x86/Zlo\x02\x03\xae\xa5\xe6ËV\xab\x89\x97\xf6\v\b\xbf\xc0\xa8\x9a\xe7g\x06\x94'\xee)0#=W\x80N\x17\x8f\xe8\x8bV\xaf\x89\x15\x01\xf8V\x85\xe8+\xf0Y\xb3\xa9i\xbd\x17\xads\xc6Z)`\x17\f\xd0\xc7ߊש\x02w\x9a]Q\x01U\xb06\x83)\x8d𢍋\xa2jڡ\xa0S\xfc\xad\xc8\x14\xbf\x0eJ\x8d\x8a\xdd9\xe3\xf9\xa8*w\x11\x03\x94}\xb7\xdf<\x13lU\xfa.\x17\x84\x9c,/\xa8\x00[\xe8S\x95\x06AO\xfb\xa2ʧ:\x90\x93*~\x8cP\xd5\xf7\xb4\x87\x95\x9e\x8b\xf3v\xab/\x12\xa9Nq\xce`Jq\xb8\x98\x8f\x97\x03M\x99\xa6\x90\x8bP[\f)l4Һ!\x80\xa6\v\xad\x93\x00\x88.\xa3g\x1a\xd9E\x85\xffh\x02\xbb\x99\xee\x19\x86]i\xb5\x99\x8f\x97\x93\xd9\xcc\xccpB\xb9\xf7\xb5\x1c\x7f\x1a\x98T?\xb9?Wr\xfc\xe9ҭ\xe4\xf8\xd3eT\xa9\xe5OL\x13\xd5@\xa1\x1bUr\xfdhf:\x1dU!\xda\ue042\\\x8fv\n\xad\x18S\xaaßkŝ\r~\xf2\x83\xe5\xb5\x1b\x84\x13\xdf3\xb9\xcc\x06\xb7\x13/\xf4B\xd6O\xf4t\xee\xac\xca˝\xdd\xf8lo\xa5\xcbd6\xf1\x0ed2\t\x87\xda\xf8\x01\x18\xdb\xe6\xf7\xb5j\xce\x16ӊ\x14=\xf7\xb2ZO?\xa8H1`\x92\xd5@,\x8f\x96\xc6/Զ\xd7\fr\xab\xcdv\x00ZT;ZDw\xbaZy\xa3\xdbA\xb5l7&խ\xd6\x1b\x83\x8f\x13\x8f\x17\x81N\xe0\x17\xe0Dײ\xea\xda\xd6G\xa0\x8d\xb2\x11y\x067\x9f\"͚~\x1eXU\xe1\xb9\xf5\xbc\xcc\xc7y\xb6\xad:\x00ygy\xaf\r\t\xa8cJ{\x92Ͽ\xeaiXņ\xe2t\xa0\xddTCc8\xf5=w9\xbc\x9aLG\xcb\xe1\xd4\x1d\x04\x11Un\xda\xcd\xf3\xd3V\xafm\xa3\n݈Ѵ\xfa\xa7\xa7\xa7\xedV\xdf \x02&\xcbpry\xc5f\x87n\xfb\xbc{\xde?m\x9f\x1bt#7\x1a\f\xaf\\J\xd2:\xefv\x9a]\x1d\x0fg2\xb4\x177\xdb&&\xd45S\x06\x9dx\x91\xbf\x1c^\x06\xfe\x82n-zg\xe7\xe7\x9dn\xefܤ\xf2\xe9\xa1C\xf7\xb4{\xd6\xe9\x9bR\xf0\xdc\x1bɠ\xd3\xe9\xf5\xba\xddN\xbbB\xc1\x8e\x0eZ\x9dn\xbbuzڮr\xe0\xc7K\xcd\xd3\xcei\xb7u\xd6\xeeV\th\xad:\xad\xe6i\x95\xf9\x9c\n\xb6\xd7韝6\xcf[U|4\x99\xb9z\x7f\x96\xa8E\xc86\xc2\xfd\xb3n\xa7\xd7\xed\xf5\xab\x04\x11d\xdc?m5\xcf\xce\xfaF\xb1\xe6\x83\xc0\xf5\xd8\xfc\x88ta\x8cC\xcd\xde\xec\x9e\xf5N\r\xfe\xf3\xc9h<2\xa6(\x8e\x89\x82\xc1\x10\n}\xd62\x1b\x03XNic\xb6\xbb\xed3#[Ճ\xaa\xdd5\xfc\x14^\x87t\xf2k\xf7ۭ\xaeQ\x99\xe8*p\aBC7J\xb3\xf0hq\xe8\xb2\xd59;\xeb7\r\xce\xd7c?`\a`\x9d3\x83\xed\xf5L\xdf^\fy.\xb81\xc2\x1e\x9eZ\x86a_\x9b\xa3\x86\xe1\xa96y\x0e\xc33m\x99\x1a\x86\x93_\\\x03\x12\xf9t\x17)\xef\x01\x87\x8f\xeb$?\xa6\xa5\x0f\x1f\xb7\xd9\xfa\xabt\xff\xe1c\xf6\x92\xea)\xf6\t\x9f\xbe\x1e\x12\xb1}\xb1$˳\xec要>\xa3!\xe9sC\x01\xb2\x82\xa8]\x91%AV\x10?u\xbf\x90\x95\xa0\x12\x98m\xf1\x00>7\xc0\xcf\xf0\x86\xa4\xa63f@\xb3\a\xcaU\x02\xf6 j[<<\xae\xe55ᔤ\x8e\x88?;\xa5\xb1\xa9\xa9\x84>84&\a\xfd\x8d\x9eH\xb1\xb4'\x0e\xf0\x16>*\xe4\x86@\xbf\xfe\xaf&\nI\t\x05ߐ\xf4\xa1|\xc4\xef\b\x86Y\x9a\xa2\xbb'\xbeo\x01(ܚ\x051\xbc\xf6H\xdf\xcb\xd7\xcc\xd2_RJV\xe5[\x97\xa5aNb\xad\x99\xcc\x0e!\xf8T\xc2\xe4\fs\xb2&i\x99(\xfb\xf7E\"n\xb6?8\x97\xf87\x18\xe0\x8b\x80\xbe\x00\xf02\b\xecǠ<\"0\xebգhyA\x8f?\xfa\b2\xbc\xd2\xce\xf1F\xd1r4\t\xb0\xc29\x8a\x96\xe3\xc9\xd8Ǫ\xcb(ZN\xd9yu\x13\x81ؑ\xc6\x19\x82\x84>=Pia\xee\v\xef\xa3\xe7\xdfxx\xbc\x8e\xa2\xe5\xcdUķ/,,&\xd1\xf7nr\xafƢ&'9\xf7C\x00r\x99\xa4\x99\xecK\xbep\xce\x02A\xecW\x1b\xde\xcbZ}ޱ\xe0\xe3\xec\x03\r\xec\xe8|n\xf7\xfa\xbf\xb2\xefy\xbc^\xae\x1e\xb2e\xd3\xf9ܣ+\xb8h\xedў^Y\xca\x01ck-A\xd8V\x94\xf4Z\xb3~\x94\x8d\xf6\xbb\x8e\x95X?p\xc0阜\xdc\xf6\xc5\xe4\x92mr\xb1\xd2\xe8\x0e\x86C7\x94p\xb5\xedp\a\xa3Q0\xf1\x16\xa1+\x91\xe7g\x1a\xd2\xf3\xa3\xc1\xf5`2U\xf8s\x84\xbf\x96\xe0>J6\xf6\xfcp1\x9f\xfbA\xa4R\xa1\xb2\\\x0e&\x9e*KK!\xa60o\x7fB(\xd9\xc3܋\xc1H\x15\xb1\xd7\xc6\xf0\xb1\xcaD\x03\x8f\x94\x1cN1b\x16\"\ti9\x04*\x87\x8e\x06\xffy\xa80}\x8c\t\xa7\xaa\x8a=\x94\xcb\xd8\xf7\x10\x02\x95k\x11\xa2\xfa)VÁ7t\xa7\xae*rK]a\xb8T\xb9S\x98&B\xf8x8\xc1\xf70\x9a\xea*\x18\x00]#\xb3+\xff\xa3\xb6\xdaQ\x88k\xac\xe7\x00\xf4\xa6\xdaV\x01@\xf3 2T?wx\x15xJ\xa4]\x84\xf0g3%jTn\xdf\xf3\x06\x17~\x10\xe1\n7;\x1a>pǋ\x10\xe3[-\x03\x0f\xdbA\x95Z\xe5:r\a\xa3\xe9G\x89\xea\xf4t\x8c?\xac\xc1\x85\x11\xf4\xf7\xc0\xfdY\xa2\xcfTÍ|U\x13u\xb7\xe5\x8e\xfch\xe4\xabb\x9c\"\xcc\xcf\v\x84h\xa9K.\u05fd\x9d\x84\b\xa3z\xcdx\xb0@\xdd\tu\xfe1\x1e\xd4\xea~Ľ\xf2\xc3h\x043\xa5\x12R[C.\xbc\xc0\x1d\f\xaf\x10^\x15p2\nT\x95\xba\b>\r\xb1\bT)&\xde<\xf0/\x037D\x13\x89:\x96q'^\xa4\xc6\x0fNu=P\x93\a\x12\xc3\xc4W\x03D\x01Ch[Ԭ}\x84\x82UG2j!\x847\x98!I\xab~\xf6\xd1\xfd\xe4\xde\xce'\x816\xaeN1:p\x7fr\x87Z7l\x9f\xeb\xf8k\xff\xa3\x86V\xe3cھ£\xbf\x85\x10^\xf8\xc9SSFW\xd5o\xda\xc1i\xba}\x84\bP\xa7\xe8\xaaBN'\x17\x83\xa1\xe2uz\x8e1\x17\x03U\xb4\xb3&Ƹ\xb7\xaeJt\xd6\xc1(\xb8u\x96\x986ƄC%\xfa3T\x1fO\x1b\xdfH\x02\xbe?Wp\x95\xff\xcc\x1dM\x16\xb3\xe8\xd3\xdcE\x82S]bF/\xf5eK\"\x04\xbd\xe3\x96\xc3L\x95`\x16^R\x05\\N\xf4(\xaf\xc54\x9a\\\xa1r\xa8\xbd\x9b\xeb\rf`\xbd0\xf5Q\xf1ն\xc3\xf5\U00105b65\xaei]\xcf5\x06V\xb3\x89q\xe6\xe4\xd3\xc6\xc8ʠS犮\xa7W]\xb5\x8b\xe7\x0f<4 z\bq\xb1\x18\xa3\x11\xd7Ĩa8QiP\t\xfd\xd1 \x1a\xa8\xa5\xb9\x851\xaeZ\xb3q\x85}\x17\xadXm\f\xd7z\x12B\x80݃j^$V\x7f\x8a\xa7\xd8S\x8c\xc0\xed\xdb\xc7\x18\xd6e\x10\xbf\x8e\x86\xc4\x18\x8c@Ky\x17#<\xd4<h\xfd\xf2\xfc\xf9G\x95\xa2\x8fE9\x0f\xfc\xc8\xf7\xe7Hc\xc1\xfc¹\x92A\x1b\v!TSR\x1f\x179D\x93a\x1f\xb7L\xf8I5f\as\x8a.Т\xd5\xc2E\x8b\x8c9\x11\xcb-\xd2&E\x9cQ\xe4\xce\xe6\x91j\xa1\x0en\xebH\x9b1[Z1\x02w\xe8_\xbb\xc1\xe0\x02u\xd4V\aw\xa1(\xc4k虖:\\\xa8\x91x\xaeU\x02\x95\xa5\xad!\x16\xdeD\xad7\xa7\xa8\x1fݢ%B\x81\xfd9\xcbƚ\x0f\x94|<\xf5o\x14?\x84\xbb\xf1\xdc\x00T\x00T-%\xb0\xb9\x8b\x96CU۹M\x97U\x85\xa1\xf6&RĪ\xc7\xcc\xfd\xe9\xd4\r\x02m\xc4P\x18(O\x7f\xad\x9erQ\xe4\xd5b\xae+j\x00\x9cxzy\xfc\xe9\x14z\xbd\xa1\x82\x01\xd8\xf7\xdc\xf0ʷ\x1fq1\x82\x85v;La\xf3`\xa2\x8dw\x80\x05#~\v\x8fW;\x86`E\xc4\xe7E\x1c\xe1\xf9\xc1L\x1fk\x00\xbf\t8'\xac\x902\x04O\x80Nm(b9\x9c\xb2\x19\xc7<z\xe2\xd8h\xca/@ZUĈ\xde\x18\xb7\xab\x88\x99?\u0087\xd4\f\xe1\xf9\xdeŔ\xed>5\xb5\x97N\x04\xaa\xff\xb4t\x84\xa57tt\nm\xd9;WɃ\x81w\x89\xfa\x8a\x12T\xe0ΆWhKr\x861~\xa4\xd2\xf4\xfb\x06\x06\x8d\x8f\x16R\x88\x02PeQ\t\x95\xe1\x8c\x1b\x8c?N\xa6h\xc9C]6\xf0\xd12\x83\x06Fx\xb50\xd7BUB\x98\t\"\x8bLT\xedBm\x88 \xed*\f\xd0\x1a\xd9A\xe0\x19Z\x88\xfa\x88>\x1a\xe0\x19\t\r\x930\n\xb4L\x94ɏ\x1b]-\xe7\xcbV\xef\xec\x8c*\xfb\xcd~Gǀ\xc5\n\xdcQu:x0\tԲ\xad\r\x17\t\xeehݏ\x82\a\x83\x80\x1dM7[&f:\xd5\xeaǀtK\xd6鞟\x9e\x19\ba`\xd6\ue6c89\xed\xa8=\xa3<\x03n\x05\xdai\x9f\xb7\xcdL\xa2\xd9x\x10\xf1\x8c\xbag\x15\xe4l\xee\x0f(\xf2\xec\xbcm \xb9\xb1\x84\x0e\xbd\x98\x83\xa8ڝ\xa6\x91\x0f\xb7\xfalwOM\xb8\xa7\xaf\xd7\f\xea{Q\xe0O\xf5\xbd\x00\xc3,\xd8\xf5R\xb7wf`\x84a\x9a!\x93\x11\x9d&\xda\xddީ\x89\x98\f.9'\xa35F\xde`9\x9a\xf2T\xa7U\\ 8\x9eYp\xbct\xa7\xe7\x06.\x1c\xe8\xdb2\n\x95\xa6qf\xc7r\x19}\xaf\xdf4\xdbx<dݢ\xd74\xb3\x1fS\x03\x9aN\xafi\xb6\x14\xb7\xebi\xf7t\xb0f\xac\xd5\xee\xf6\xab\xd8\xf9\x82\x9b\xe44\xad\xb8Aİ\x86\xf8&ss\xb6\xe4\xe0\xeb>\xedI\xbdJA\xa8\xa5m\xa7\xd34\x85V1\xb8e\xe0\xe9@t\x01\xa3\\\xa09.\xd9\xe1F\xa7{nʔ\xd9m\xb1\x91pn\xa2\xfc\xb9\xbefS\xf0̿\x98D\ueb7e\x93d\x98\xf94\\Άld\x9c\x9dY\x90\v\x814\x9a|>\xe0\x83\xadwf\"\x16!C\x9d\x99%\x97f\xb9\xed\xae!\xba\xf9|\xcek\xd4\xeaW0\xcb\x11\xbb\x16\xeet\xcf[\xd5tK:ZΪ\xf0\xd0\ry\"\x93\xe5°)\x93\xe0A\xa4[82D\xc0\xe7\xbb\xf6\x99)\xbep8\xe0-h$\tA-c20J\x1cz\x03\xed\xb2\x9fA#\xf7\x82Y\xa9v\r\x91\t\x13\xe1^\xb3eT1\n\x06\x93)\xbbk\xab`\xe4\x84\xde2Z\xedf\xe0q\x93:\x131\x1c\xceY\x81\xcd\xe1ȧ\xc7&:%\xa4\xd7\x7fr\xd5jk\xf0\x11(^j\xe1B=\xdb\xf7g\x03\xefS\xe0j\x1b<ԁo\xa3\vt^\x88\xe6\x8b\x05\xdc\xe5\xe2s\x1fU\xf6\x857\x88\xd0\xca\xdaU\xec\xe0\x1eRe\x84z\uf37f\x98\x8e.\xb4\x931\xb4\x8c\xddj[F%\x8a\xdb\xf1\x02\xa9\x12=q0\xef\xa6\xcfI\x9e\xa5\x8d\x8a\x9few\x97m6\xf4\xba\x834\n\x14\xf9\xa9\xee\xc4\x1cѷ\x1ap\xec\xfd\xb6\x04\xe5\xa6Av\x82&۱\xbf2\xcd3IK\xe7\x1d\xa5t\x9fI\xe5\x14\x9d^\x1e(\xac\xb8@\xa0\x1f\x85\xbcO\x19\xaf\xc5e\xd4<^\xebwQ4-\xf5\x81DvZ\xa6p\xfd\xa2\x18\xbfw\xb6\x05Y\t\xbb\xc1\x9a\v\x1e&Z\xe3-\x1c\x05\xd6\x05\xfc\xe2\xc8I\xd1(\xe3\xfc\x81\x94\x9c\x9f\xe6\xf8\x83\xd3D\x04\x1e\x9c\xc4\xf9kÎN\xb6$ۗ:\x92\xde\xfa\xc5\xf9\xc3sS\x85\xd4\xc8\x1f\x9eQ\xd8\x03\x92>\xabO\xdb͆\xfb%)\x1b\xe0i\x9a߳\xb1\xbe4\x1e!\xbd\xbf\x85\xa0\xa1\x1b\xf1\x9bW\xbc\xdd\x19O\x17\xe1\x95o\xdci\x8f\x97\xa3\xc5|\xacY\xffp\x10\xe6\xdd\xec \xac{;\xa5\xfa\xbfb\xbc\xbct\xa3\xb1\xb6\xbd` M\x87\xa3\xa0\xa9;\by\xb9z:B{\x94\xc3A\xfdn\x05\xc8n\xb8\xceMؒ.J\xad\xbe\x06\a\xf5v\x19\xfe\xc2*\xd0\xd6P\xe1\xe4R\x1b\xae\xf4\xa5\xc9G\xbd\x06\x9e\x1fMƟXa\x11c\xff\xa3.\xac`4\x1d\x1a\xa0\x90\x8b\xa3\xad\x83\xa6\xba\xd0BM\x1c\x06B\xbbM\xe4\xa0~\xd7\x02\xbc\xc1S\xb0\x80\xf5\xbb\x15(\x13ܙ\t\xe3\x82\xebipMp-\r\xc5\x05\x87+" +
+	"{\xc5\xea\x8fXGn\x18\xe9M\x1f\x89=\xa3\x02-8\b\xb1ZxS\x93\xea&\x98\x8ava\xa1Ybj\a\f\xc1<\x93\\L\x15u\xf7\xc0\xf5\xf7~\x9c\xd7f\x93\xad`b\x15\x8c\xb4\xb42 \x05\xc4\x12N\xe5\x13\xfb*\x9b\x155a\xa8\xbf\x97\x1c\xaf\xa8\xf9\x82%\x93z\xea?PA-\x12\xe5\x8a\xda?\x88\xf4o1}`I\x0e\xe4k29&\xddUZn\xc6\xd4\xe7\xde=z\x1a\xbc\xda\xf2ě'\xe7\x1dE/\x8d[\xe8\xf1:$r\r\xb9H`\rh\xd1\x1b\xe5~W\xac\x17c\xb0X\x80'\x80\x87\xa4.\xb2\xa6\xb9=f/\x16B\x11\x1a\x1e\n!r\x14\x96\x12p\xb9}##u\xb4\xfa\xdauv\x97^g\x7fp\xc22\xceKG\x1a\\\xa4\xe2\xe7<\xc1\x8b\x1dM\xd5\x12\xa9~WA5\x9f\x8e\xaf\rqY\xe6λy\x9e\xad\x06%\xb5\xd3\xde%\x96\x95\x1cb\x92\x02?p`H\xbd\xa7\xfdȽ\x1b\x8a:\x16\x89\xf4\x9b{\xbb\\£\xe4\xcf\xed_\xb55x\\\x941\xb2\xbf(\xe3\xd2y\x17\x96q\xb9\xb4K\x17\b\xee\vIO\xcd\xe7C\n\xabKp\xa4\xb9\xca|\x9fj\x83QY\x96Ԍ\xbb=X\xee\xab\"\x94 6X\x81\x9f\xe3\xcd\x01\xfa\x03=\xfc\x18\x87KR\xae^\xd6\xf4\xa9\xc0\xd1H7\x97\xa4\\\x83\x02\xa3I\xe8-\xa9\xc8CB\x9f\x97\x131V\x11*}\xa6\x8e\\\xa5\xa2\xf0,\x9e\xac\xd7DĂ4{\xcen_a\xc73\xaa\xe6\xf3@m\\8\xae\x10\x91\xe2m\x85\xdd\xc5\x0f\xa4\x90\x81\x81\x10\x9c\x90<\x8d\xb7\xc8&\xea\xf0\xeb\x0eH\x02ő\xeeN\x1b\xbb\x87d]/\xa4\xddC\x0e\x11\xf5$=\xc2$\xeb\x1a\x84\xc0XPy\x92\xe5I\xf9\xdaxyLV\x8f,ח\xc7L\x94$O\xb2\xfa\x92\xe4\x9bd\x9b\x94\x8d\x9c\x14\xd9>_\xf1G#\x00t\xde\x05\x14Uӑ\xf2}\x11?\x90\x06\xcf\xe6\xbdþ\x9dw\x01\xfd[\x93\xa8\xc8VO_)VH\x92\xed\xca\xc9p6\x7f\xee\xb3\a\x1ah\x84q\v1P\xf9y\x14\xa0\xc6;L\xfa\xbe\x96\xdf|\x96\x93ߎq\xa2DGx\xa4ob\x92\x1e\xe0\xf2ܟE\x8bIz\x9f\x1d\xe5$)\x0fs{K\xcd\x18Y=\x9f\x94\x94\xdd\xc1z}D\xd8l\x00\xf3\x85\xe1p\x03\xa6\xe5[X\xd5\xf6S\xcef\xb1\xca\xc9\xfaH\xe5(M\xb5f%\x1b?ee\xf8\xc0\xb4\x9aݯ\xe3\xd7F\xf9\xec\xbc;<\x7f\xf2٨:\x19\xbdP\xf8\xcb\xda\x16\xcaV\x12}\x81\xd5P7\xfb\x03\x88\xfcX\x13\x19\x1b\x1c\xc6\xc6?\xab\xe2`z\xe5\xd5b>\x9c\x1az\xf7d8\xf0|\x0fk\x9f0\x10\xae\xfb`\xb8\x1d\x89\xb7\xb7́\x1c\x1a bQ\x1d\xc5e\xec|>㶂\xbf+\x16\x817\xd5/l&\xeem\xe4z\xa6i\xf2d<\x80+\x1aj'\x82v!\x14\xec}\x1a\x0e\xa8B\xdd\xc3\xe0\x8b\xc0\x1f\x8c8\xa2\x8b\x11C0C\x9fxԲ\xb0\x8f\x11\xe3\xe5h0\x1a\xc3a\xcf\b\xeb\xeb\x1c\xe5\xce\x03w8\x88\\\xfd!)C^\xf93\x17\x95\xce\xe0\n\x17\xe7\xdav\x87\x81\xfdy4\x99M\xc2h24K8^½\xe1\xc0c&\xda辌!C8\x05\x1e\r\x82O\xa6$`g1\x9b\xfbA\rʋ\x06\xd1\xe4\xda\xd5.\xd4\x007\x1d\\\xb8\xdaV\x94\x02\xc5\xdb\x01\fd\xae\x05N5\x10XJp1k\x88\xeac\xfa\xc9\x18\x1e\x15Mi\n\xfd\\\x90b\x16\x11\xbd\xaf\x1f\x98\xd6\u0600č\xa9\xa5\x1a\xb9\x17\x8bK]~\xe3\xe5\xe8\x937\x98M\x86\xd5>4\xc6\xcfm5\xf8l\x10\xf2N\x8c\xbb\xfcx\xac\xd5\x0f\x1f\f\x00\xce\xf3ف\xa5\xd6B\x00\xe6G\x86\xa1\xd1U\x00\xb5\x9cO,\x9c\xc0\xde\xfc煻0\xed\xe6\x017\xf7\xe1\xa9\x03\xfd\xbfѹ\x00\x17D\xa1;\xb5\xa5\n|\xfe\xc8\a\x8f\xad\xf1x\x19,<o\xe2]\x1a\xbd`\xbc\f\xa7\x83k\xd3Z\x0f\xe0\xec\xe9\xb6\xc6;Zxz\xff\xa2\xa0\xa5{;\x9c\xdad\xce.\xa01\xe4\xdas\xa3\xe5\xd5(\xa86\xf5\xb4f\x98O\au}`:X\x0e}\n<Ӏ\x93\xf1`:\x19\x84\x9a\x01\x03G\x80\x05\x8f\xdeߧ\x83%\xf7\x84ѫ\x00\xf9T\xd1:\xab`f\xfe\xc8\xd5\xce~)f6\x98k\x86v\x1c(\xfb\x97\x01\xbf\xd5.0\x190Z\xe8]z:\x80\xe7\xdeK/\\\xb2\a#-\x9dޟ\xbbA\x18\r\f\xbf\x19\x145\x0f&>w\x92qn \xfcH\xd4L\x97\xe6\xcf\xfc\x16@g\x04\xfcC}\x8cO\a\xcb\xe8\xf6g\xfe\xeaM\x17\xa6m\xf0O\a\xcb\x1bwr\xc9\f\xaeu1\xdfL\x02w\xca\xdb\x1c5\xba7\x98\x85\x93_\xf4J]z\x17\x81v\x105\xb9\xf4\x86\x811\b/\xbd\xf9@\xb3%\x9f\xcc\x06\xb7\x17\xd5q\xe2M\x87\xfa\xb3\xb5\x897\x1f\xea\x97#\x13oI͜\xf5\xfe\xe8\xc1D\xb6t\xaf]/\xe2\x0f\xf5z\x18\x17E\xc1\xe4B\xcb\xdf[\x0e\xa7\x830\x1c,\xafX_\xe5o\xa9zU\x02\xd6#pm$\x8a?\xc4o\x9f\x9e\xb5Λͳ\xa6\x85\"\xbc\x9a\x8c\xf5\xfbA\x81\xbc\x10Yk\xef\xd8\x15\x96\xe5\xab=\xa1QH\x91\xf3y\xf7\xbc\xd9:\xed7-\x14\"\xe7V%\xf9P\xe4\xdc\xee\xf5\xaa8ĺ\x7f\xda\xec6-\x14\x82\xb5.\x12\xbbE\aC\x85nU\x06~\xe8.=\xff&\x98\x98\xe3D \x05J\xe3\x15\xb8l\\\xe1Y\xd4\x03\xa3\x107r\x8dEL\x80\x97\xa1;\x1d\x9bK\x89\xb7\x1c\xf9^\xb4\x1c\x83\xc5̍\xe5\xbdل͟p\xc6\xc7&\"\xf3\xe1\xd6\xc4[N.=?pG\x959\xd6[23V\x9b\x8d\xceēk\x1e\x7f\xa9\xa6\xee\x94'\x1e\xccK\x1f\xb9\xedK\xe5\x15\x1a\xa0\xfd\x11;\xe3Ձt\xa1h\x9d\x9b@pj\xe2ό\x01\xc51\x91_\xe9Հ\x10\xb2\xd2V\x1d\xafΌf\xe2-\x95=R\xd5މ\xa2\xa7\x9f\xb8(\x8c\u05ca\x80\x9cs\xc5\x12\xc1~^J\xeb.s1\xf2\x96\vo\xe6/\xbcHN\x1bL\xbd\xa5\x1b.\xa1\xd8\xce\xf6\x9b2\x81\xed\xa5:\xf4\x9a\x88\xb7=\xe8D\v\xa5L\x0f$\x85\x1d\x11)\n\xc4\xeb>\x81\x80ʎ\xa16ϩQ\xd0rp\xa5\xd9\b\v\xf0П\xcdu\x93\x1a\x81\x19\xf1\xdb\xc7N\x05\x01\x0f\xcf\u0603\xc1\xa6\x89s/\xb5\x8b_\t\xf6\x86\x83\xb9\xf6\xb4Cb¹f:*\xe0\xe3`p9cJm\xb7k\"\x99\xbf\x95\xee\xa9\t\xbf\xf2\xe7\xbcd\x15~\x13f\xc0Ѳ\xc1\xa9\xed@\xafR\xb4\xc9h\xae\x19\x89H\xf8\xe5LWs\x04|n\xcdxN\xe1\xdd*\x9cfۭ\x94h\x16\xcd5\xe3O\x01\xe7\xbeZz\xe7&b>\x99\xe9\xcf\x17$\x82iS\x15N\xc1\xe0Ƙa\x05\xc2_DL\xdf\xebV\x98\x05\xe1\xf5\\\xb3\x18\x17\x88p\x18\xcduK.\x81\x89\x86\x9a͌\x04\xcfu5\x86\x83\x17\xa3\xb9\xae )\xf8\x94\xcf\xc3h\xb9\x81\xddd\xbb}\xdeV\x9d\xb1[\xc1]\xf9\xf3\xe9d6ї\x03\x84\xe4\t;\x15\xdc\xfc\xa3\xd0w\xda6\x1c\xec\xc6|\xfaT\xb7Z\x9e b\xbajOÀ\x9e:f\xf6\x86-\x13\x01\xcf\xf2/\xdc \xbc\xe2\xb6-:~\x11]q\xe5WG\f\xaf\xdc\xe1\xc7p1\xd3\xd4,@\x8c\x02\x7fn\xb0ԳT\x12\xc3]麿D\xe2\xea\xb5M\x8cH\xa2Ky2\x0f\xdd\xe1r\xeeO'\xc3O\x9aM!\xc5\xfe\xe4O<\xb4\xe5n\x9fV\xb1\xe2I\xb5Q\xed\xa9;\xb8vq\xd23\vZ\xa6\xd5\xeb\xc74\xe2v\xd7\x04R\x8b\x15\x98\xc05\xab\x1f\x86\x15\x1b7\xa8i\xa8+\xf0:~26\xfa\xa8\x86\xe5\xa6>-]\xae\x9e{\x1b]Q\x84\x0eW\xbd\xac\xa7\xd7~>\x8b\x16P\xd8\xe5\xa8\xda\x05\x11\x8eN\x8e5)\xe7\x81\x7f\xe1V\xfa\xb6D\xdf\fhb]p\x81;\xbcF\x9d㬂d\xf6\xc4\xed^\x05\x81{Nˆ\x15<;\x15\xa4\x12B\xf7\xbc\x82\x94\x83\xa9_AET\xe1\xd3lR\x19\x0e|\x02\x06\xcb\xc1\xd4e\xd6r\xba\xf4$\xc3\xd3*\x18U\xbdW\xc5BۆnE\xe0\f\x17\x82\xeb-\x8b@)\x12\xccq\x97\xcdj\xca\xdbځ\x18\xdc\xd6\x0e8Um\xbd\x06\vO\ufffaP\xae\xfb\xa0\xebh\x06<\x14~;\x0efh\xf4\xa2jW'\xa6\n6\xf4\x17\xc1\xd05\x88PE\x96T+\xe3d\xda[\x83\tXNҷf\x96\xb1s\x90(\x8a\xa6&\u0378\xa2\x8b\xcd-s`\xa7o\xa2m\xc5G;\x8a\xf9r\x1c\xb8\xee\x05\xf33\xd3\xc2u\xbf\x82\xf7\xaaC\xfd\x10ln΄\xb8\x01`\xbb$]b\xea;*\x8aCe0\x8e \xe6\xcb\xd9\xd8܇\xce\xc1[\v\x93\x03\x9e\xf8\x96\xb3P\x1e\xaevu8\xedF\xa7Z\x81\xa2\x85~\xec07'ȖV\b}\xfa봭8ހ\x9d\xae\x15\xcbJ\x8c\x95ɥ?\x1eÖ\x82U\x10\x17Y\xad\xaf\x98\x97\x1fL.G쉤)\xa5\xf9 \fCf^\x83;\x99\x9a[\f\xa8\xca\x00\xf7W1;\x9aկ\x9d\x8e\x0fM\xc6\a\xa7\xe2C\x131\x9ai[&X\xa8\xdd&\xb8^2\x80\x0e\\1Ϝ\x1a\x98\xc8\x0f\xf5\xf3\x18\x0ef\xa3\xac\xad\x81\xad<ƕ\xfd\xe5ܜ\x81qg\xe7\xf9aH0\xf0B\xe9a\x04/\x9c\xbc\xc7\xe0B,<sJ\xc1\x85\xd1'\xb3֩\xdcHɛ*\xb1\x99\x82\x9d\x93\x13\xc4/\xe2\xb6\x0f.\x98\xfa\x1f\x9cY\xb9w䝃Jk\xdf\xc0\xb5\xfa\x95\x1d\x9cy_\xc1\xed{\xd4w\x14\xb0\xb9H\xd5i1\xe4^\x11\x11(\x1a\x9fU\xa6\xb4ہ\xf7\xc9\xdc\xdf\xde\xfa\xe3\xb1y<|\xeb{\xf20\x81\xd5\xe0\x1e*\xbb-\x1eD\x15\xc6\xf16ټ\n/\x03\xf3\x9c\xdc'_\x84\x0f\x82\xb3\x0fΘ\x9a\xa1\xf0\x8fp\x95)\x87\x04\x13\xba\xb74\xe4s\x0fb\xad\xe5~\xbb\\&\xf7\xc9r\x17\xaf\x05Dz8h\xf5\x05Ga\xd1(3\x86\xaf\xe1#\x04\\\xadHt\xbbې-I˂^r9\x7fw\xca|ODY\xe0\x96p\xfeT&\xa8\xa1\xb5\x1d\xf1\a'ܑ\xd5r]\x94j\xcb<@;\xea\xdf\x11\xa3\xbe\xc1h`\xb49\xe7\xbb\u05ec>&iV&\xf7\xaf\x83\xf5\xfa&.W\x8f\rl\x17\x017\xcd\xca\xeaH\x8b\x06\xf1\x02\xc4kR\xacj\x8c09[\xcd\x1c\xf4F\x9a\xc4\xcc\x14\xaf\x0f\xce0˞\x12\"?\x85\a\x93N[\xf8\x93h\xd2K\xb53\xa3ē\x14\"f\x1e\xb0kEt\xad\x06\xb6T:\x96\"\xd8\xea\xa2Pu\x95\xd5/\xf6\xd4\n\xad\x8eO\xb6#\xf9\xb6q\x9fg[F\x91\xee\xf9\x8f,\xad3\x7f\x99d\xbbMC^\xc7\xda̔&\xd93YI\xb3\xa8\xb8 \xce;ְ\xca\xe7\v\na\x05\xc4v\x1f-\x94\xe2c\xb2\xd9(K\xa2\"y\xe0A\xb1mE\xfb\xb8\xc9\x1eV\xe5\xa6Q\xbe\xee\xdef\xc9\xc2:\xfet\xe2-n\x97\x81{\xe1\xfb\xd1r8\x1b-\x87\x83ђ\r\xff\xe6a\x1a\x8f=\x989?휞\xf7Oki\xaf\x06ӈ\xbd\x9f螟\xb7\xfb\xa7\xbdZʏ\u008e\xb5\xd5\xeft[m䦷B:\xf7o܀\x17\xb3\xd5j\xf7;\xcdV\xb7٭%\x17\x8f\xc6`\x19h\x9e\x9d\x9dv;\xc7H\xdb̟j\xfb\xac{\xdai\xf5k\xa9Ûe\xb8\b\xe7.\xf3\xeb\xdb=;?m\xf7\xfa};\xfdlp9\x19\xb6\xd8\xc9y\x1f\x9e\xaf\xf4\xcf\xeb\xe9\xdaT\x0fo\xb7O\xbb\xa7\xea\r\x1e]\xa1ػ\x0e\r\xe4i\x87\xfc\x14\xc4\x1c\xdci\xa0\x05\xb7,e\xc1\xb2V_\xedy\x89\xc5ZO\xd2\au\x9d\xee\xa7\xdc\xfa\x92N\t\f\xa5\xcd[\xd3$}\x02_/Z>)y9\xe2\xb9i\x9a\x14%I\x1b|\xe4֎F \xb3\xd8\x1a\x1c7/`\xa9\x8b\xfb\x9f\xf6\u06dd4\xd8~\xef<\xb1?\xff(\xf9\xdf{\x96\xf1;\xe54U%\x05\x18)\x1b\x89Z\x06\xde;\xbb<+\x859\x92\xc8S\xcb/,\xb7\xa5\x99\x9f\x9d\x7fi\xfaM:`\xe9\xc7\xdax6\x18]/G\xbep$\xd62\xe0^$0\xcd\n\xc6s\x99?<\rq\xb5\xb8t\xe7\x83KW\xd3\xe6\x19\xe6f\xeeOB\xae\x0e\xe8\xdcfnp\xe9\xd2\xd7\xd5Xɣ(\xcf\xc7\x1c{\x06.\x98\xd1k~\x9d[0\xf0F\xbev\xb0\xc5\xc0.?\xfa?\xd7\xc0\xa1\xfb\xf3\xc2\xf5\xa2\x89n/@Q\vO+XGC\xdeL\xa6S.\x01\x84\x98/;틉\xee\x93\x13\xa0\xdc\xfa\xa4\xd36\x81\x9ff\xfe\"\xac`F\xae\xf7I\\\xe8`\x15\vp0\xdd-\"^(\xack\x01\x92ǆh\xea\xb0[w\xa4\xed\x00\x01z\x19\xf87\xe1\x88\x19\x8e\xb7{\x1a\n$\x1eM/*\x0e\xeb\x00\as\x82;2\xb6\x80\x80@\x97\x1e\xda5\x1c\xc3\x05n\xe8\x06\u05ee\xa9F\x02r\xee\xcf\x17S\xfe(\x13+\xee\x14\x17L\xae\x19J\xcb+\xbc\x1a\xb0\x9b\xa4\x96\x06\x8d\x98i\x84\xee\xad\x100\xf4\xfd\xb1\xd6}\x86\xd3\xe5p\x11\b%\x1f\x83ǋh\x11\xe89z\x11\xf7\xffhB\x99'\x15m\f\xd0[2\xb6\x17Pl\xc3\xcb\xe5\x90\xfe\x0f=\xba0\xaf\xba(\xde\xf7Ɠ`f6:`\xa2`\xe1i!K\x00:\xa2\x0fGYȉ\xae\x89\xb8\x19\x98\xfd0\xbc\\\xba\xbeq\xdbL\x81A`3\xdf\x00\xd4x\x10F\xfc\xe2\xa9r\xc3F\xf1\x13O\xdf-\x00p\xe6\a\xd5\xd6\f/\x97\x9e\x1fN.\xbd\xc1\xb4\xd2\v\xc2˥\xef_\x98\"\x9b\xbb\xae\xf6^\x80\xc2\x02\xffV?\xcd\x00hP1p\x01h\xf8\xc93\xee1\x01*\x04\xd9i\xeb\xd0OW\x83`d\xca\x11d\xc8^+\xe3!\xc2\x11c?P\xce\xea\x11v9\x18rK%[+/\a\xd4\x11\x8eV\xd7%?\xd5\xd1k\x00.\xe5\x04-n\xb0%\xf5\x1f4\x19\x99\x03#\\N\x90\xc7\\\xc3a\xe4,\\~t\x03y#h81\x9d\x85\xcb\xd9\xc0\x1b\x89\xf1\xab\xc1/\x87\xcbY\xf8Q\xdcrk\x17\xe8\x1c}=`\x963\x9d\xfeiﴧs\xf5\xaf\xab\xdd\nL\x8f\x84oP\xadu\x96\xcc\x17\xcb\xdf\x1d\x036\t\x04\xbd>.\x96\x9e\x18O:0\\LF\x86h<\x9f\xbb\x1c\xb5x\x9a\x98\x85˹\x1fNn\aé\xa55\xd1\x1c\xa4φ\xe12\x18\xf1SLܖ\x81;\xacv\xf0e\xe0NU\x1d4o\xb5\x14)ڥ\xa3\x81g\xd1R\x9cGAk*\xdf[\xb3\x10́\xba\x87S@M\xa6\x16\xff\xa8\xb3PZI\xe9F\a\xb3\x90\x1f\x17\xcbF1.\x9f\x81\x80\xf5î\x01\xba\n|\x8f\xad_\x1a\xf5\u0083\x0e-\xd7M6\x1dS\x15e\x16\xaf\x9f\x93\x824\xee\x1c\xe1\xf5\x17\x00+R\xa7\xab͞\f\a\xa2G_\xd8\xd0\x14o{`cO~\x9f\xdcg_\x99cz\xc0\x7f)u\xa8\\_\xbd\xf4\xed\xaf\x81\x0e\xf1\xa1\xcfa\xee\x94\xfaj\xa7\x80x\x06\a\x9f\xf5̶\xf1Nn\x8d\xb9\xcfP\xf5J\x8ao3\x99\xc6Z}$\xb4\xa6V\xb2u\xc6γl\x9f\x96\rnG/\xeaǟd\x8a\xcf\xfb\x82\xee\x16\xe4\xa7<\x81a\xe1\xf0\x81\x7f\xbd\xf6?\x83R\x81\x97QշD9m[\x92\x99\xe6Z\x95\x1eF\xf0-7\xfcޠ\x83\n\xcbˠI\xf6\xec\xb0m8\xfd\xbdA\x8eY\x87YZ\xe6\xd9F0㟘b,dv\xf0\x01Q\xe3\xddL\xf9bU\\,\xdb\xfd\xd9>=\xde\xfc\xfbTt\x80\x1a4\xb4|\r\a6\xae\xc1\xeePX\xfc\xa9\xfb(\x1e\x85\xabz;ӪP,FT!97\x11\xd24\x12\x14\x11_\xb3|\x13$\xe0\xed\xc9\x1dF\xbe~\x06-\xb0#/\x88\xb8\x03\xa0JJ\xcb\xe5\xb3I\xe2\x0e\x83O\xf3h\x1cjǽ\x029\x9e\\\xc0=\xc2Gf\xb8Ь\xa2\x03\xf7\xc6\xf0g\"p\x97\xae\xe7\xb2PL\xad\xbe\x89\x83\x18l\xc2'G\xa5̓y\x7f9\xbeѶ\x1c\x12\x15\x0e\xc3\t^\xef\x04\xe2\xa3\x7f\x01n\xfb\x96\vj֧)\xbb\x82\xc4s#e\xed^\x91\x827\x9e\xfa\x97\xf8t\\\"\xfc\xa5\xf0\xb8f\xc1\xaa\x1b\x8dJY\x85fZ\x11\x1aԁ\x1d\xb3\xfb\xect\xa5R\x9bХg\x1b\xf8p_\xa0\x16\x1e\xf5" +
+	"\x81i\xe9`\xf4-\xbf\xf1\x12U\xe0\xe0(\x1e\xdfSx`\xaa;\x9d\\z\x91\xaf\xb5\xc0\x94\x9a\xbc\xbbaT5T\x13\xb8hy\xf1)r\xfd`\xe4V,\x80\x81\xe4j\x140\xa3R\x04\x05\x8dњ\x1b\xd7յ\xcb\x18\x06\x15C\xa1\xad\x83%\xf7V_G\xcc&\x9e\xdc\xe6\x18(ϸ\xc7dP\xb8V\v\x16fA\x97\xe3\xe5`\xf8\xd1\x02\x9c\xf3\xd3*\xac\x80qT\xe43su\xac\xcb1\x94\xb4?\xac\xa2F\vj\xb2\xa4\xc9\x17\xe0\xa6\x03W\x0ee\xc6\xd9h\x9f\xc1\xe03\xea\xd7\xc1\x86\xe0f\xfa\x068p\xe7S\xe6\xdf\x1d\xe9\xf3\x02\xf5\xf3\x82\xbdln\x19\bߏ\x8c\x04\xfe\x98\x05Ơj9\x9d@\xbd8͊\r!\xbb\x06\xbc\x97a\xefd\x8a\x1dY\xc1\xbay_f\xcf$W0\xdbj\xe4\x91r\x93\xa4O3x\xfd\xfc \xe3\xd4^\x91xMr\xc7۰\xa5\xe0\x833RK\xacX'x\xca`rѐ\aI\xef\x9d{v\xed\xc1\xd6>3\xc0\x02\xce1\xc8\xf6%\x19\x94*\x161\xfd\x1d\x94\xf0\xe7\x03\x8f\x9a\xfbY\xbb\x8d\xc0\t\x03\xf2۞\x14\xe5\xe1\xf2\x06\xe5%I\xb7ŃZ\xd9,,N\x9c2\xbbIr2\xce\xf2m\\6Nx\xa6\"\xcf\r.\xe2\xd4桺\u0557\x05\x84\xecI.ɩ_\by;Q<\xc8\xf2i\t,~֥ku\x91\x99\xba\x12\x82\xaf\x90\xfc&\xc9\xe6ҵ\xb8l\x97\x82\xac\xa2L\xb4y#\xe5\xbe-൫\x00V(\x9f\xe3M\x85\xf09ވ~\xe7\xf3\xf7Frp\xf8c\xe6\xdf\rm\x0f\xfd\xf1d\xaa{(\xf6\xa7\xc3\xc5\x10\x0f\x05\x9f\x1b\x8ew\x11 p\xf5݇\xef\xf9\xc3@\x9bK\xfc\xb9\xaf\x8f\x0e\x1fL\xcb\xf9#\x82\x0e\x02\x8a\x99\x02\x8fy_\xeez\xf1NЯ\xb5\x83\xf6\xd9ԡ\xd7\x03\xf6\xc3\xee02']\x01\xf7\x83O\xe6\xee\r,C\xf9\xc6\x05\xed\xac}1\x9d`\xb1-ǜ\xb2\xd5\xec\xb5\xfb\xa7\xa8\x8c\xd3Ap\xe9\x9a\xe7j\xfe\xd2\x1b\xb9Ӂy\x05꣭\xad\xbe[\x04\xcc0\x8a>\xe93\t@\xa5Q\xb5~n\xe5י\x11\xfbj\xdb\xd9İ\x1bm\xb9\x00\xf3Ț\n\xd5\xc1\xc5\xc9\b\x9aL\xfd\xe5M\xa0\xb6\xb8\xb4\xb7\xfa;\x92Z6;l_@\xf2\xeda/\xfd\x92ŁM\x8f\xdcS\xbc\xc5\xed?+'3f9\xa0\x84r\x02\xfc\n\xc7\xc4\x1d\xd0\x149\x05\x1c\x86\t\xa5\xa6o\xe0\xf8#\x81\xa6\x01F/\xb6z\x06j\x16\xe0\xf7d\xed*\x16?\xdejV\xd1ꍔY\x13\x9c\xd0\xe4\xebC\xa8Q^؎\x89[D\x97>\xb7\xb65P`\x84\x01\xf8\xf9\u00960\xb8]\x06,\x9dYIxxC_\t\x86\xba\xcc\x02\u05fbЇ\xc2|\x10\xcc\x02\xede\xdb|\x10\xf8#\xc3S&\xcc-\x13Ϝ\x02\xe0\x9d\xd0r~\x19\xe8MN\x81\x81ϟ\xbf45\xb8\x88?\xa3\x80~\xb4\xe4SQW\x03\xe2sr\xf3`D\x11X\xa3\xf0P47\x92nj@\x11\x8cE\x03\xca\x03\x7f\x05]\x0e\a\xf3\x8b\x90wN͆\x14\xe183eA:\x0f\x96 \xa6\x81\xb7\xbc\x98\\\xeay\v\xc4t\x12Ez\x14i\x85\x9bχ\n\x8f\v3\x9e\xbb\xb3\xc5\x12\xfc\x02O\xf4ss\x89\v'\x97\xe3y5\x19L\xb8r\x05\xb0\xa0F\x93\x10\x0e\x8bFFY%\xf6ڜ\xd8\x15r\xe2]W\xcf\xc0\x14\xda\xf3=꼷RZ\x8a\xf5\xafǕyWa\xe1\xc9\xec$Ԗ7\x85\f\xdcj( \x85\ro\x96\xae'/\xb4,\x04\voTY\"\xe6\x018/\xa2\x9a1O\xda1P\xac\x81\xb4}*\xc7P\xf1\xe3͵\x82\xdf\x0e\xb5M3G|t\xdd\xf9p0\xd7\xec\xa78\x8a?0l\xf5\r\xf8|\xe4\x0e\xa2+fC\xd46p\xa1;\xe4\xef6ڭ*j\x11\xb8\x17\x93(\xd4,\xae9\x16\x16\xcb \x9c\xb2)\xb2Ӭbټ\xd22E\x11\x85\xba\x93G\x0e^xt\x9f\xa5\xcdD\xc1r6t\x97\x1f'S\xdd\xde\x0f\xc1Y\x180\xa3\xf7I$7\xfa4j-\xd1\xee \xd0π1\xf2\x92\xe9U]\x1b\x8e_zY\xf3dq\xfd0\xcbP\xef\x1a]\x03%\xbbF\xbbi`D\xd7hY\x10\xaco\xb4\r\x04\xea\x1bg\x06J\xf4\x8d\x9e\x01\xc7}\xc3,5\x8b\xa9E\xb5\xc9\xee\xf9y\xafw\xdan\x9d\x1a$\xa8\xfb\xb4\xab(\xd4}\xcc\xf2hݧ}^\xc5\xf2\xeec\x8a\x8bw\x9f\xbe\x01V\xdd\ac\"xf6w\x831\x7f3)\xe6+\xcd!\xbf\x8dN\xce\x01xM\xe0\xa5RK\xa3~\x85\xad\b\xa0na4\x98\x19\xab\x1a\x94\x1d\xcd.\x06\"\x9c\\\x86\xee\xe5\xb5\xd1Yy\xbdj\xe6n\x81\r'\x97\x17\x8bPKJ\x9b\x0e\\\xf0^-\xe7\x01\xf75\xda4\xb1\x11\xbf\x1bm\xf5\r̐\x19\xa1\x9e\x1a`y\x99\xda21TnK\x1a\xc1L\x9b\xfe0\xb6\xb2J\xebȉ\x16\xb6QC\nS\x06+R\xc4Rk\xd7c\xc5QM\xcf \xb9t#J\xc5\xcf \xfbg\xe7\xa7U\x8a\xf1<p/C\xbd/\"\xe4\xad\xc0\x9eU\xb1\x02ն\xa3\xd8T\xd1?o6\xab\xf8pr)\xdeI\xf7\xcf\xce-\xbcy\xfc\xb9\xe5 p\a\xfa\x84\xcaH\xf8\xb4i\xa6\xf4\xa7\xa3\xd0EV\xcbmS\xa8\xbe\xb8\xb9B/6%\x8a\xfe\x15\xad|fG\x8b\x8b\xf2~\x1dڸ\xdd\xd6\xd15\x8dɱ\xe1\xa7\xf0\xd2\xf7G\x96\xbe\xc0\tDg\xe8\x1e@\x8bs;3\x0f\xb8\xb6\xa6\xb1&\xfe\xee\xd8P\x91{\x1bY2\x06\xd4\"\f,\xbd~\x0e\xd1V\x18\xbf\x9e\x05\xc5\xf9u-(\xc6\xcf\x14`\x88\xfbbφ\x94}\xf1\xbc\x8aUM\x0e\x1d\xca\xc2[\xa4\xed\xd8Q\xb2\xb3\xb6\xaax\xbd\xb3Z27;k%\xfb\x89w9uŦ\xb9ӱ\xa2\xc3\xc8\xd5^>\t\xe4\xa7pȯ\xfa\xbbU\x14[B;-+Fg\\\xe9\x10\xf4\xff3\xbe\xd4\xd3\r\xf0<\xce\v\xc2\xe2\xb1!\x7fc`\x86\xcbM\xc2\xc08\xb7\xc0NC\x81\xe1~K\xf8\x06x\x05wi2\f\x9aA\x8c\xf3Џ\x15\xf1\xfd\xce\xe7_u\x9cau\x86S\xcb#\xc2\xc6\xd6y\xa7\xa7\u008c$\x99\x8d\x15\xb3{\xe3\xd7X\xd6\xe2\xd8(l\x9c\x16i\xf2E\x85\xf9+\xa0L\xb6\xa4u\xae\x8e$\x8f yx,\x0f%W\x8e\xd1\xf0AC\\>\xce\xe2/\xf2D\x89\xf3\xdc\x17\xc4z\xaf6Ov\xa4\xb1c>\xd6t\x1f\x8e\x80i\vT\xad\xa7\xc7y\xf2\x9c\x95\x01\x04\xadL\xc9K\x9ee\xea\xaat\xb7/\xb3\xcd\xfa\xc0u\xe8\x1c\xe2\xca+\x8bo\xd9\xc7\xf0\xad\xee\x01G\xbb\xc2'\xa1t\x81\x94\bGL\x1f\xc0\x9d\xb1\xecn\x1f\x9cq\xb2!\"\x90\xe2\x0e\x0e\x8d\xc3\xd7\xc2y\x17\xbe\x16\x82\x858\x11\x9d\x97y\xbc\",\xc0<\xf2\x05W-9\xa5\x83\xf6Ќ\x98\xd3xs8\x05\v\x7fx\x9c\xf3%)\xa9\xe9\xfa\xacx@\xc4\xf0f`o?\xaf\x92\xc9\x02\xf2P\xa82\xe5\xe4\xa1\xc8\xf6\xa5\xf3\x8e\xe1\x01Y\x9f霐'8\rW\xc9\xe9\xab\ry\xb9\r\x8c\xe4h@\xe3\xfb\x00\xbb\x88|)\xffU\xec\xb2'r\xa8t\xe8j\xff\xcd\xfc\x0e\x15\xefM\xfcX?\x94\xb2\x15=1h\xf5\xe4Uz\xd0\xea\xa2\xdf\x1d\xf4\xbb\xad~\xdf\xed\xd0\xef/\x88\xa6\x85~7\xd5\xefs\xf5\xf3L\xfd\x8cQ\xca\x15\xfa\xbdF\xbf\x8b\x04\xc1\xd5o?O\x1e\x969f\x90\xa8\"\r\v\xf9\xd3U\x06\x0f\x8c\x9d\xa2\n\x15x\\,\xef\xe0\x81\x80\xf8\xbe4\xbeG\x88!N\x85\x12T^\x14\xe0\x1e<\x1f6N8\x81\x15\x1f\x92r>l\xecV\x9cFo\xf8\x90\x94\xfe\xaeL\xb2\x14\x8d\x93\x8c\x01\x0e\x0f\xca\xd06\xba\xde6\xb4\xc2$}ؐ\xb0$\xbb\xe3c\x9fǫ}\xf3\xc4\xf2\x92'%\xf9C\xb3([/\x02\xfa\ny9\xd0^\xf6q\xe0\xd0\xd7mG\x05x\xbe\xc0\xfbA\x0e55J\x0e\x1e\vW\xe0\x06\xdc\xf3\xf9\x8dũ\x81\x10F\xb2\x1d\f_N\xbc\xf1\xc4c\x8e\x96\xfe\xaaXE\x83\xdb\xe5`t\xcd\xdek\x9ei\xe0\xe1\x8d7Ҹ\x03p\xec\x0e\xc0vV߫`\f\x1cx\x83\x1b\x8d\n;\x81w\x87\x9ao.\r\x17\x0e\xf4\xab}\r\x89\xf7\xca]\x8d\x02\xbd\x04o55\fԘףժ`\x02\x8eѹ\x99\x8e\xc4)\x90;=\xd2)\xf9\xa3\x7f\r\x160\xb3\x01v&q\xae\xa3\xc0\xcf\xc6DwF\xc5\x11Q\xa5NA\x14]\x0f4\x9f\x0e\x14\x1c\x86\xa0\x1fӛ龆\xa8:\xb4\xa2\xe0\x9b\x897b\xee|\x10\xd8f\xa8\x10D\xba\xe3A\xadu\xc1}\x83!\x92\xa5\xf0H\xa3\x01/\a\x91{3\xf8d\x14{9aOx1\x88\v\xb4\xaf\xc1\\\xb0Q4{\"\xbb\xeb\x98\x0f\xa2+C\xa2K\x7f26\xab0\x87\xa8\x12\xc1\xd0\xe8\xb7\xd8˘\x96##ժ*\xcc\xee[Z\rl\xe2\x1d\x8e\xf9\xb5$\xe3b\xfaCb\x04\xe0\x0fI\xddi\xeaw\v\x94\x82\x99\xe4\x98\xf7\n\x145\x1b\x84?W\ry)\xca\x1bD\xc2d\xb4y\xa6a\xae\a\xd3k\x8b\tj\x10\x8d\xa9C\x10\xfa\x94\x9f\xefۻ\xad~\xf3\xb4{\xde\xeb\x9fU\xa8|o\\9\xbc\xa6H\b\xb8\xc6\x1d@\xe9\x87\xea\x80\xd5<\xe0\x99q\xff\x81\x80\xf60\xab$\xc6\xe8\fV\xbb\a\b\"\xec/QO2\xb6\xbb\xaa\x02\x94\xea\x89\x1a\x98_\x8biu\x9ax\x91\x1b\x8c\aC\xbb97\xa5`#\xb4\xadW\x06\xc4\x10Dի\t\x8a\x13\xde)+\x96Ȁ\xa5^\xab&\x86\xa7N\x8a`n\x16\xb4\xcc\xd9,c\xc0\xd0\xf5_\xc5h?\x88D\xffhu\xba\xed\xd6\xe9i[\xcb\x1c\xae\xa3\x83\x1bn\t\x8f\xee\xcc\x19N\xf9\r\xb1t \xcf\xc7\xcf\xd5\xf1\xf5\x1c`\xe53\xe8\xeaH\x00ۛ\x89'\\\x00\x9e鈟\xd8\xe8\xe8\xe95\x00\xe2\x8a\xcd\x11 \xa2\xab\xc0\xbf1\xcc\f\x00\xae{q\x04\x88\x9c\xfcZ\xba\x04n\x037\xf4\xa7\x15g\x92A4[^\xf0x\x0e}\f\x043\x80a\xc4l\xee\xbb\xe7&\x86?\x80oY\xe0\xc2q\xe9i\xc7@\x8a\x11tj\xc0=\xf0?\xa8\x1dss\x84\xf0y\xd81S\x88\xcb\xeav\xcfD,ر\xb4\x99\xb5t\xe6\xd15K\xacL\a\xbb\x1a\xb31;\xad\x1d\x99m\x04\x18\xf7\xe7\xc5`Z\rU\u00902\x00\x876p\x00\x03\xd3\xf4\xe4\xd6\"\xffK7\x92\xa2\xee5M\f\x17u\xdb\x02\x97\xa2\xee\x9aH\xe9ϧo\xa6\x1b\r/\xb4ȃ\x1c,\x1aǄk\x83\xceD\x8a\x96\xeb4m\x88\xe8b\xaay\x8d\xe18٪&7٪f\nѪf-U\xab\x9a\x95D\xad\xaa1\xe3\xaer\xb5\x8e\xe6\xb97\xaa\xa3\x9f\x99\x18\xd3\xd3\x03\x82K\xe9\xb7\r\xa4\x90e߀{#\xb8\x9e\xf7\xe7\x91\x16C_ \xc5(\xb0\"\xb8,\xbb\x06Nv\xa9\x9eY\x06)e\xb3\x10R\xca&/!e\x93\x93\x92\xb2)\x04$e\x9dY\xb0\x1c\x0ff\x93\xe9\xc4\r\xabb\b\x96\xb3\xf0\x12,:\xc3J\x85B\xd9;\xcf\r\xb0\x90\xa8\tǲA\x93\x84we\xd7\xf6\xbc+\xea3Z\xb7Q\xe0`\xb9\u009b\xf4s7\xb8\x1e\x84\xec\xe1\x13\x92\x8d7\xbd\fh8\xbc.8\xb5\x1d\x8d\f\xb5\x15\xa1g\x15\xdb\x1a\r-\xb0\xa7v,k\x953\v\xb2\xaf2>\xafA[\xf4Z\x84\x97\x05k5\xadxٻZ\xf6\xece\xf2\x96\x1d͊ު\x14N\xb4\xa6\t\xf7FK5@ڕ2\xc9٦\x820LQ\x14<2\xfcd\nL44\x1a\x1ay@\xd3\xc0\x17p\x05{\xe5O\xcd\xe6Ӵ>\\\xa0\xaa\x8bn\x00rſ\xa5\xc1\xf0̊\x11\\\x8d\xc1\xd5\x01{\x9f\xabɅ\xee`\x0f\xe0B/\xc02\x16.\xaa\f\x11/y\xb0r\xae\xea\x9fꨪ\xa6\xefa\x95\x01\x17\x9d\x1a\xf5\\L\x82\x91ѳ\x18\xdc\xf7\r\x91P\xf0\xe8j87\xe6\x02\x06\xf7h'\x1a\x19\xfbC\x8a\xbb\xe4~\xdd\xcf\f8\xbc\xf7s\r\x11S\xc4,0\x05G\xc1^\xf4\xd1\xd8\xdaPp0\xd0\xe5ƀh\xe3b\xa0\xa4n\xd65\x106\xe1Qĭ\x1f̍\r4\x85\xff\xe2^\x04\x03C\xa8̭.\xb6\xc9\xe8u*Xٷz\xbd\nn6\x98\xb0'\xcf]\v\xea\xb6&\x91\xad\xe8\xcbp\xe8\xcf]\xe5\x1d\xb8[A\xf1\U0006b5d0\xa1<\xff\xe6\xca\r\xdcJv\f\x1b\x8a\x97\xdf\xd5\xec\x16\xde\x04\xdex\x1a\xe3\x98mL\xa9\xebT\x1e\xa6\xb4]A։\x8ca\xed\"c8\xab\xc8\x04\xeaV\xf9?n\x9fW\xd3Z$\xb7\b\a\x97\xeerx5\x99\x8e\x02\xd7ӏ\x9c\x18\x8e\xbb\xd55\x93\xb0\xdb?\x14\xab!\x88_\x86YJ\x0fߘǣ\xff?\xbf\xc5i\xdc;p~\x87bS\x9d\xf0\x8b\x95\x0fN@\xefE*x\xa7\xb1\xceR\xc2\x02\xecH\xda\x1bv\xfc\xf7\x16\xe2\xdfU\x99\x84\x17'\x8b\x0f\"\xb0\x1bgF\xfc\xad\xee\xaf\xdc\xf1\x8d\x99l\x90\xbe\xd6\xf9\x85b\xd1\x15?\x9f\xf7\xeb\xd2R\x9fC5\xf9γ\xbc\x94\x1f\x03\xcds\xef/$\xcfD\x80\x8b:\xb6\xfd\xb7\xb0\x1do\xb2\x17\xea\xa6H\x18\xc6\x1b~\x8a\xa8\x03\xa7%6\x967\xb3\x02\xdf\x1f\x9b\xf8\x95\xd4Io\x0e\x8f\x1cV\xd9F\x02\f\x97JWq\x89M\xf6\xe7O\xa5\xfc>\x03,r-\xc5\xcaV_k~\xb1YW\x90x\xad~\xcb\x1a}p.i|\xa5\xfa\n\xc2\xe5c-\xcb\xf2\xd1\xf9\xdcj\x9e\xfd\x8a}\"AZ~l]\xe6\xf1Nݢ\xc4-\xf4\xbb\x8d~wPG\xcd\x11Q\x8e\x88ଚ\x05\xb6\xacd\xd3\xff\xea|\xde;q\x17\xfd\xee\xa1\xdf\xfd?T\x16\xdb\xdd\xe5\x81\xc8Z@\xcf\xefӿ*\"\x17\xa4\x83&\xd6\r\xdaߘ\xf4\x0e\xeeey\xc4;\xdb\xe5A@V\xcf\xe0&\xcar}\x80\x9f\xcd\xf2\f\x80\xf2P\xb4'\xe0\xb6-\x1e,̲\xec\xee\x10\xe3,\xbbKŕ\xca\xea\x19\x19\xd7\x1f\xcfq\x9b=\x93\xc3Qy\xec\xf5\xa6Q\xac\xbe\xde\x7f\x0fK\x18\x97\x90\x14=\x0e\xb00B\u0603l\xd9أ\x91\xbaĐ\x1b\xeesy\x136\x93wrr\xacm\x8d\xf7\xe6z\xf4B\xf6.\xea\x8d\xef\x90Ļ'\x9b+:E3\xab\xf3U7*\xca%\x9a\xac\xc2|\x85?\xa3L:ŋ\xe2\xbb\rQ\xfe\xf3\xd0\xf4hz̋\xd0L\x88\xdd۩\xd2x\xe4K\xf9\x98\xedl5\xd4\x1c\xf1]e;\xf9\xdb\xf4\xf2\xceX\xed\xf1C\xb6\x05}\x15ǟ3}pB\xfds\x16\x7fə\xbf5h\x94\x89\xf6\xb1\xc6\x1f\x05\xfa\x98%\xe9\xfdFFg\x9c\xc5\xff@_^\xf1\x12\xefd\xaa\xf4\x0e\x9e>\x8bO\x7f\xaf}Ί\x87\"]\xa3\xaf|\xf5\xac\xd8\xd0\xcbA\x99\xa5\xf7\xbc*^\xe4G\xa2\xbe\xa4?\xc0p8\x83\x97K#\xe6PH3\xa5\x04T\x00\xbbp\xcd\xe1$@\xf1\xddU\xfb܊a7F\x9dS+\x92Zc)\x17\xae\xe1\xd5\"Z\x06\x9a-=\a\xe9/\x84(\xf0&\xd0J3\xb9\x1c\\\x04\x11w\x17\x87\xb7r\x80\x99\x063\x85Q\xca:\xb7\x1a\x95\x98S\x84\x18^MG(\x8d\x86\xaaǀըB\x9d!\x14<.\x90\x18\x8c\xb8Z\xccQ\x12\x84\x00\xf3E\x89\xc0e\x9e\xe0L\xda\x18\xe1#\xf8\xb9\x86\xa8\x91\xccG-\x13\x9c\x04b\xed\xa2ru0ʟNk2\x9a\a\xfe\x18\xa1\xb0l\xe67\x81¨\xe3\xc4pr\xf9\xf3b\x82\x8a\x87s\xa2\xf6\xc0\xaa\x10X:a\xf4q<\xc5\xc2\xeekH\x1f\v\x15\x970\xfc\x84\x1a\xbc\x839F\xae\xd6Kz\x18\x15\f\x10?\r\x13F\b\xd3\xc6Պ\xa2\x89\x87PZV\x91\xbf@(܆\xfc\x91\xb8\xbd\x88\x8b\xe0\x12%ÒZ\x84A\v\x15\xbe\xa9\xa3\xda\b\x853\xbb\x8e\xf4\xa1\xd1\xc6B\xbc\x99x\xc3+\x84Ý\xf6\x16\xae\xfa\x15\n\xf7\xce\xdbq\xf8\vB!a\xf9\xc3\xc1h4\x9a\x0e'tУ-\"G\x89\ae\x9d^\xab\xdd2pt\xdf\xdf\xe95\xcf:\x1a\"\x9a\r\xa8\xb5k\xa7\xd7<=Ř\x11\x8b\x10\xd6\xe9\xb5zZ\tF\xee\x14\x95\xa0e\xa0p\t\xda\x06N\x96\xa0\xab#\xae\x95\x97\x9dN\xaf\xd3ғ\xc9\xe3;\xe0\xd8\xd7P\x81,a\xff\f#.Uɵ\x04\x97\x88W\xf3\xbcc\xa0.x&\x9d~" +
+	"\x051B\xc9N\r,\xbf\x10\x05T\xb3\x82\xe2~}z\xad\xf6\x99\x81S\x0e\x92!e\xcf\xc0\x8a\x00%\x9d^\xabyn\xe0\xc6\xd3\xc1e\xc8ӵ\f\xdcՍ\x12V\xcbDN\xbc\x91{ˋcV~&\xb2;\xeb\x9a\x18w\xc6\vRIC/\xe59\xd2̌\xddM\x02Ƭ\x9b\ba\xd6k\x9e\x99B\xf1܈_@C\xe5̊\xcfU\xcd[m\x93\xab\xf0n\x04\x157\v*C}A\xeb\xeay\xf2w\x83\xd0\xf9\xf5fG}K/\x87X\xac!\xc9Y\x15\xc3\xd7\xe3橖\x8a\x86\x0e\xc1\xbd\xbc}\xaee'\\\x98\x80P\xb4\x9a\x85\xaa+k\x1d/Ժr\xd7@ɮ|ZA\xe0\xae|f`\xf5>\xd97\xb0\xa8O\xb6\x9a\x06\x0e\xf7ɶ\x81\xc3}\xb2yVAjgĽV\xdb,2?G\x03ɜ\x1b(\xd5i{&Fv\xdan\x05\x83:\xadYT\xd5i\xcd\xca\xcbN\xdbj\x9a\x05ĝ\xb6\xd54%\xa3uZ\xb30\xa8Ӷ̈́Z\xa7\xd5k\x8e:\xad\xceQu\xdaS\xc5\x0e\xfc\xb8\xd6=\xb5\xa7H\x1eY\xa9\xaf\x03/\x83\xc1LS\x8e\x00Z\xf3*\x9d\xe2dL\x81\x96\x9e7\v\xef\xd3\xd1a\xa3\x99\xa6\x91\x01,t\x7f\x96,z:&\n܁\xe6\xd33\xf4\xa7\xcb\x01;\xb2\xec\xf74`D\v\xdd\xefb\xe0\xc8\x1d\xb2\x00b\xed\xbe\xc6\xc1\x12l\x89\x82\xb5\xc0I\fb\x04G\xa2\xc0`\xc4\xde!\xf41TV\xa1\xdd\xef`x%\xc8\x11\x00C\x9f\x13k\x8c#\xb39\xa6\xcb\xdbv\x8f\xb2D\xad1\x1b܂c$݆!\xa4~\x19\xdcy\xc4QHI\xf4\xf9S-\x15\xf4\x16\xad\xa6>uB\x18\x81\x03\xbe\xc9P7\x1a\x00\x1c\x1a\xa2Z\x9ap$\x0f\x9dі\xc0\x97\xa1M[\x1a\xcc\xc8\xfd\x14#}~\xcc\xdc9נ\xca\xc3%.O\xc5ST\xe8\xd3g\x8b\x83\xe9D\xf7\xf1\x1a\xfap\f\x7fI\xf3C\xab\x82\xbf\x14:\x0f\xae\x8d\xe7\xb3(F\xba\x9a\xec\x83s\xca\t\\{\xbaFצa\x1e\x86\x81\xe1]5\xf4Q\xf8\x87\x0e.\xe1\xdcu\x03A~j\xc0\xf9\xf4\xd2>3\xe0\x9c\r.\x0e2Xk\xb55\xb8\x1f\xf9C\x7f\xaa\x19\r\x84\xfe2\x18^_,\xc6ڎI\x00\x85\xaf\xd2NGGM\xfd\x9b\x81\ue689\xc1a\xd3\xe9\xeb:\xba\xbf\f\xdcE\xe8\n\v\x00\f\xbf\x85\xf8t\xe3\xa9v'\x1e\xfa\xecI\xe7$\xfaDcI\xc1Vy8\xe0v\x05\xed\xb6\x8d\xcc\xf5\xa8\x87\xae\x89\xef\x81\xfb\xa5\x1b\xfe\xb4\xab{\x84\x14{\x98j\xe3\xea\x85ވ\x89\xe3\xb4\x02\x94\xe2h\xeb()\x8es\x1d.Ł\x9b\xa7f?\xef\x1f\xd8\xce\xfb\xf5\xbby_\xb8uRU\xf8\x14\xf2!\xae\x85ES\xe0.\xedGg&\"\xd4\x1f\xeaq0\xf7\xa7\xa2AG\xe0U\x9d^\bwu.\xa3\x9f\xa0\x9c\xf4\x8e\xb6w\xaea\xc6!zA\xd5:\xd3\xf9M\a\xc1L\xaf\xf0\xa7P\x7fT\xda\xeai\x19\t\x7f\xa8Z\x1e<tj\x1bÆ\x83\xf9%\x0f\f\xd93\xe0\xfc\xa9Y\xbb\xaf\xc1\xafX|ų\xa6\x0e\x9d\xd1׀\xe7\x06\x94y}8\xd7s\xbc\xe2\x0e\xa1Z}M\x94C\xea-\x9d>ɤrn\x9f[\xb1\xc29L\xfb\xac\x8a\xf6\x06\x9e\x1fN]\xfa\x8a\xac\xddiV\tB\x94\xfe\xd4@\xb3\x97\xa9}\x03\x1aVz\x0ew\xa5\xa7Y\x03Q8w\xb8s֫@]\xb0I\xe4F\nH\xdd\xf8\x14\x8a\x90\xa1\b\xad\x15`\xb4\xd0\x1f\xc4qX[\x9fp\x18\xb0CG\x8bF\xea\xc2\x19\t\nb\xda\xea\xd4a[4q˂f![:\x1d+\n\x9e\xb1R\xc6\xdd*z\xce\xfd'\xb7\xcf,l\x05\xaeӶ\xe3$cM\x98\xf4\x91\xf0\x98\"κ\x16\x04\x8d\x1e\xa0\xf7APٮ\xf5 \x8b\f\xcc^\xbf6M\xa0\nA\xd7ъ=f\x13\x00\xbb\x1c\xee\x9f\xeb\xa8\xd1\xf5$t\xfbt\xd6h\x1b\xa9\xa6p/̤\xafw\x8c\xf1\x80Y\x8dP[y\xaaf4\xadh\xb1\xcc6u\xc6r\x1c\x9a`>\x10-`^\xf63\x03\xc3\xc7h\xa7\n\xe6\t\xf4r\r\xbdH\xb7E\xa3Px]\xc1ݓ\x9c\xeaռt\xa3[\x88\x88L'\x7f=\x93\xe9$D\xb8\xbe\x8ec\x1a\U000a9782\xad\\=m莙_z\xc5Ho\x9c\x10\xe7\xafW\x05\f-4\xb5H\x00\x99+ˎ.)Q=\xad㍩\xcf'\xd6\xc0\xa7z\xb1\x16\x11\v\x19\xd1l\x1b`X\xa8\x06\xa6*\xfd)\x84\xc9mx3Ҭ\xd28x\xc4\x03M\x9f\x9e\xd9\x10\xac\xe3\xb5N\r\x9c{ɂ\x857\xcdD\xee\x82#\xcc\x14\"AׄÐ`ѹ{\x06j\x02\xb5\tt5\x90a\x90J\xd6k\x9b8\x9eS\xbbUA0C\x96V\x051\x19\xe9\x9a-\as\x8f\x01g\x15z\x96\x00\xef\xb29\x02\xa9~]\x13\x19\xb8\xa1(\x99\x05\xc5\xc5\xd62\xe5\x19\x88\xe71\xe7\xa6@\x99م\x16$\x97cB\x9e\x8d)j\xd8\xcd\b\xa9\xb5,8f\xb2\xd63\x9b!b\xec\xce\xfa\x15\xf8\xcc\xf5\xc7#j\xa9\x7fn\"E70[G\r\x17\xb3\x04\xdc\"j\x19~\x9a\xd1\xeepjV\x18\xdc\x7fIC\xf5v\xa5\xb9\x96\x81\x7f\xb1\x80\xb8r\x13\x1e=Ԭ\xbe\xf9>]\xef\a0S⥲\xa7#\xd9|\t\xba\xd7\r\xf7j\x89\fn0\t\x9fq\x91\x15\x8f\x89eˡ5q0C\xec\xf5\x12\xf8\\\x15\xeb\xebйˢ\xc4\xeas\xd9ğSb}\"\x9d\xf8Ի֥k\x18!!\\\xc8q\xbah\xfc\xe5p\xe0\r\x99\xb5\x9a\xde\xe9'\xb0o\x04\xbfk\xb4M\xf4Aϲ\x92\x81\xec\xdb\xfa\\\xc12\xe3\xc1\\\xfb&fq\xc1z}\xbb\xa953\x8d&\xb4\x9c\xfḁV\xb7\xaf\xe3>q}\xa2\xa7\x15\x91\xfb\xc8\xe8k՝ʥIk\x87\xa9\xd6C\xf5\x14\xec\x98\xeb\xaco\x02\xf9l\xdb\xd3\xe1a\xe4\xea\x86\xf0\x02\xac\xd8\xeb9\xeb8\x9d\x1b\xf5p\xbc\x1c\r}\xff\xe3\x84\xe9Zz\xd1\xccEJ\x13\xf44d\x91\x1aL\xa0\xca\xed\xec\\G\xb1\x95K\xab茩!\xfa\x16\x18\xe0|/\xd0\xd6w\x0f\xb3\xc9e\x00\x9a)D\x86\t\xf5W\x04\f\xed\xf1畺\xa2<\xfb\xc8\xf5\x8eN\x05\xca\r\xe7\xf4\xcc?z\xccMH\xa7S\x01sz\xadf3\xf1>\xb0[\x05\xf3\xad\x91\xde\xf1g\xec\x00S\xa7\xa6a\xeb\x97\xd3\x11s\xe3\xdc\xd5q\xec>\xa1\xa5\xf7\x06h\x1c%\n}\x01\x9e\xc1\xb1\x007\xd5\xd4\xfa\xca\xecg:-\xbb\x11o\xa5v\xb7g\xa0\x95ap\xb7k\xa0xP\x90v\xd7d\ts\xf6(p\x87.\xb7\x04\xefvl\x04!\xf7&\xac\xefAf?/\x17¼\xbc\xdd\xd5\x05\x15\xb8LT\xfa>o\x16^\xb2!y\xda2\xc0l\x0e\xd2\xd5\xc5Yx\x19\f\xc17\xd2i\xd3\x00\x87\xb48\xbab<\x13\x0e\xeb\xf4\x9e\xb5\xf0D#\xf7\x9a\x16\x84h涁c\xa5\xd7W\x04\xbc\xe1\xebh\xf5\xf2\xdc\x1b\xaa\xca\xf1\xa1\xaf1\xf3\xc6!\x04\xd3ᳵ\xbe\x93\x15\xcdb\xc2xg\xd5\xc6\xc2|\xb0\b\xf5\xf7\"\x14*\xfdWIf\xfah\x9f\x83\x97m\xf0\x18Ŵ\x10\xbd\xdc\xf4\xc2[;\xc7\xe1@\xb6\xb7\xe9\xe8\xe0k\xb0$\xe6\xbbi}1\xa2\xb7\xe3\xf8\x84\x06`\x1c\xd8\xd6\xdbZ\x1d!\xe8\u0530\x12SݲU\x85S\xffX\xfa\x148gz\x10M\xd1ѕ\x8ay\xe8N\xddaԧyk\xe2f\x1e^\xe8\xc8\xd2˴P\xba\x9dΊ:yd\xc5:\xab\"X\xb9\xb4\xfe\xf6\xf3\xc2\r>a\xb5\xe1L\xc7\xfaр\xd7_\x1f\xf6\xdcT\xb6i\xc2\x06W\f\xd1:;5Qb\x01:\xb7!xO\xac$\xba֏\xc5(\xf4B\x9c\x90\x18\xf0\xe1\xf58\xa0\x91\xb9\xba=\x131c\xe2j\x9fW\x920D\xd7\xc8X\x04\xbbR\xb3\x9e\xae\xb7\x98\v\x96\x91\x9c+\xa9g\xed*\x98W\xb4\xabc\xa8spy*f\x14\x92\xc6\xfe\xc3\x1e\x84\f\x81\xcc\xf8\xa2\xa3\xf3\x8c\xc0\xe1\x9a|R\xd5\xeaT\x91\xcc\xdb\xe9\xa5\xeeE\v\xa1\x03\x7f(\xbclYXӘN\xf2e\xcby\x95 p\xa3E\xe0\xe9f\x1a\x12\xa9\x02\x14\xb6:\xcd*\x9a\xce\xe2\xfc\x18\xa4\xa5\xaf\xd8`\xfb}i\xe4\xdf\xd6\x1b \x1c^\xb9#\xfa\x00o,\x1d\x0f\xb4\x9b]+\xc9|\xc0\xae\xa1Zݎ\x15O\x7f,\xa6\xdc\xc1`\xcfJ#\x8f\xcc\xe5[\xfd\xfe1:\xf6\x16\xbfk)v\xc0\\Mҗ\xc1,\xf4Q\xab{V%\v\x8d\xdau\xac$\xaavm+^\xaf\x9dEB\x9f&.;k\xd2q\xfc@\x9c\x0et]&t.\xd3\xcf\xc4)xƦ\x91~\xdf\x00\xf3\x85\xd4`?\xa3π\xfb\x06\xeb\x19\xed\x16>s\xe4\xd8\xd4qވ\xbb\xa4\xe8V\x10|\x88\xf7M8{\x97f\xe4\x1c\xb1\x9b\"\xe1\x89R_\f\xc0\x7f\x99\xdc\x04w*\x18\xbegԗ\xa7P\x1d\x1d\xf4M\xb8::0Q\xf0\xeeC\x96\xc1\xa8\x11:W830\xe2\xec\xa0yn\"\xf0\xceެS NCZ\xdd\nFT\xb7u^E\x89=\xffi\x05%0\xa6\x8c\xe4i@\xaboV\x8ao\xfa[\xa6\xf0\xd0\xc6\xde,\x1d\xde\xc0\xb7\xcc.$w\xf0F'B\xdb\x06Sz\xc6\x06\xbd\x8267\xe8f\xed\xcc\rz%\xebe4\x19Q'\a\xe2\xb6D\xcf\xe2j6\x88\xf4;U\x06\xe5\x9e1[\x06\x98j\xf0\xfa\x92\x19^\xf1\x01\xa5O\xc8`\x8cɝL\x1bs\t\x18^F(\xb4\xa1\x81\xf4\x06S~\xa2ܶa\xd8U\x90\x9e\x95\xb8q\uedaa\xe0\xf9\x80\xaeT\xfa\xd1B8\x9f\xf2+a\xfd̂o\xe3\xba&\x8c\x9f?\xea\xf5\xbe\x19\xccy\xe0\xdd\xfeY\x05\xc1\x9dkk\xf0O3\xa1\x8f\x9cY\xe0|\x95\xd6G%wl\xdfo\x9bP\xa6*\x04\x03\xef\x92U\xc3\xc8)\xe4%>7\xc0|\xf1:7\xe1\xcc-GK\x9f\xd2#\x97\xf1֊\x14]\xf2Á\xb6\xaee\xcbPp-\x13\x1a\x8cG\xe8\xca\xe3\xaccã{$]\x8b\x13\x04\xe8\xa2H?N\xa0\x04\x88\x7f\xbb]ŲK\x1d\x8a\xb5\xa4\xbdt#\x15:\xa6\xdd\xeeY)\xe4-U\xb7\x8aƗX\x95څztX\n\x16\x124\xc0\xe8\xe4Z/\xe6\xe2V^Cj\xd9/\xb8\xae\xa4\xeb\xfd\v\xba\xa5n\xd3~\xa3\xf1Y\xf0\xd9]\xbf\x1b\x95\xfbӳ\xd3*x`Z}P\f\x8d\xd4G\xbb\x86&\xec\x05\x049\xba`\x8e8tx\xc4\xfds\xe8\xc5\x11\xc1\xf9:\xed\n\xd8\xe3\a\xf2g\xcd\n\x8a\xceb\xfa\x1eM87Տ:\xae\xaf\x06\xde%s\x93\xa1\x8f\xfe\xeb\x19\x1a\xffz\x12\x1aӕ\x1e\x1d\xe8e\x05\xbd\x10f\x9e~˄\xb2\xb8\x8c\xbaV%\xfc\u07b7*\xc0k\xdd\xd2\xe0S\xb8\xa4J6\xdf\xee\xa9,\x97\x17ӏ\xdc\x11\x16rB\x11.'\xdc\xe2\t\xad<\xcb\xc9\xf8b\xca\xce\x17\x90W\x16\x00\x0f\xaf\x02\xc3q\b@\x99殹3\x01\xf0d\xec\x1b\x1eR\x00:e\x0f\xe3\x9b\xe7h\xed\\\x82M\x19\x95E\xb7\xabA\x03\xf7\xd2\f1\x05`\x1e\xc1\xaa{\x8e\x8f\x10\x96\x13\xe1\xe4\x1f\xd7z\x12p+0\x8dЧ\xbe\x90pi\x03\xe6\b\xd6H{s{\xa9\xdf\x18S\x98\xaf\xed\xba)hA\x950\\H\xa1r \xa7\x1e\x00\xe5Q7\x9b:\xedut[i\x97\x1b~Q\x823\xbfa\x05\xd7\xc8D\xcfhc\x8e7\xac::\xf0\x96q\xd4@\x8ca\v\x83XR\xe9\xd9.$\xe4\xa9&\xde\xe1\xcb#Ie\\J\x1e\xaf\x1c\xe0\x95WK!ِU\xd9H\x05\x9b\xdcy7^\x87\xa4|\xef\xbc\xc8_D\xfe\x82\x97/\xd4i$\x7f\xfdr\xe0\x85YH\xd2\xf5}\xb2!\x8dl_\n\xe6Ij\x94\xf6\x1d/\x96\xf4\xa1x\xe24\xc0i]I\x0e\xb1=\xfe\x90\xac\xcc\xd0Ӱ\x831#9?\xef\x8f2<T\xce2;\xf4xNcj/Z9-\xee\xe7y\xb6M\x8aU\x03\x1e\x97\xa9\xa05\xec\xf1\xae\xe6m5$\xa5\x97\xb1\xd7B2۔\x03\x92\U001019f0f\xb3ζq\x92B\x0e\x8d݁0\x8c!)\xc9C\xb2n\x90\x87zG\x85@\x93>7\x9eȫ,\xec3\r\xc3e<H\x93\xc4{`\xb8?\xc8\xf0\xbe\x80\\\x1f\x8e\xd0\x00\xa3\xc3|\x8esy\xa0\xaf_\x81\xa8\x10no\xadt\x8fYQ\xbeE\\;\xc8Rzk\xdc\x1d\xce|\x97'Y\x9e\x94\xaf\x8d\x97\xc7d\xc5c\x95\xbe<\xf2\x90h\x80<\x906\xa7\r\x93?\x88\xac\x8e4QN\n;\xfd{\xa78\x9a\x12\x04\x9d\xefeJ\xf9\xab\xd8\x1fIiOXGN\xdf=6r\u0083\xae\xd24\x00tޱ'\x91\x95\xaeT$kpc,\xc5m\xe3Zd\xab\xa7lW^\xbc\"\xbf\x98\x1b\xf2L6\xd2\xd9'\xfb\xc1\xfa쁖\xe5\x8c\xc0\x02\xf7\xb9?N6%\xc9\x0f2\xbc\xa7$\xce;\x9c\xa0Z\x03\xcet>\xcb\xc9o\a\xd9ms\xf2\x9b\xf3\x8e\x11\x1e)!\xa5I\xdf\xcc-=\xc6\xee\xb9\xff\xe6\xd21\xd2#\f\xe1\xcd?\xbce\x7fC{\xf0\x17\xff\xc7\x18\x96o`U\xdf\xf18\x9bi\x92>\x1ciӍ\xf3\x8eQ\x1d\xe6\x14ҙ\xef \xa7\xe2\xc0\xbbb\xc5H\x04\xd9;ĩ|Ƌ\xb3\x8d\x19]\xc3\xef\xd7\xf1k\xe38\xed\xf19\xf5\xf0\x03k\xcdW\xf2\xb1\x95\xf8q_\xae\xb3\x17\xd5S\x1f\xb3\x97\xba\xf0ǡ\xf4{\xcb\x137\x18\xe4\x84c\x1a'\x158k\x84\x13^0\xa9U%\xff$\xd9\xfdp\xcb\"(j:\x1d\xc5\xe0\xe1\xaa\xeb\xaa\fM\x87\x8c\xa6\xb9!x\xaa\x1b|r\xccs\x7f\x16-&\xe9}f\xe5ǆ\x8c\xbes`\xa8{\x18$\xf0 \xbb\x9a\xdb=\xb0c\xa8J\x05`x͟ʄeX)NJʾBW2M\xb32\xb9\x7f\xa5\x0e\xc7-\xccY\xef\xaf\x16h&\xc4\xd93\x12\xf0H\x98X\xc1\xe7p\x1e\xf4\xb2Z\x04\x19\u07b2\x9a}Pڹɧ\xebX}\xe6\x98Y\xf1`\x11\x83z:^\xa9\vhh\xb2\xfdm\xb8]\x9e\xd9\x04\x8f\xfd\xa1\xe8\xe7\x8e\x1a\x9a\xb6ρ䴁t\xb3\x02\r\xaf\x1c\x8eTEgz\x02\xa9\xd6[\xf3\xea\xa1[lQ|4\x9c\xf3\x9e\x8a\r\xd3(\x8a\x86'\xe0,\xd9\xf8\xdd$+2/\xf3q\x9em\xd9h+\x1a\x85\x16\x06\xe2\xf3\xaf\xef,\xa1\\\x91\x80\x85\x93\x03\x117\x11\x1e\xee\xffT\x8a\x97\xfa?\u074b_\x1f\x8d\x97\xff\xaa\x1d,/\xffQ\x00t\xe1ǅg\xf7Ne\x8d9\x81@\x90S\x9e\x82\x83\xa8nQ\xe6\xce>-\xe2{\xf2\xe3<\xa3$0\v\xa7\xce\x12\xd2mH\x8a\x95\x0e\x93\xa5\xe6܆\xba\x9dI\xd2\xd2te\xb3\x14\x04\xbf+\xf7\xe6\x1a\x83\x13\a\x97\xc7,\x8bV\x0e]\xc4\x15G8\xaa\b\xbfd)\x99\xac\xeb\x9c\xde\x1c+R\xff\x7fT\xa4\x8aÜC\x1er\xfe\x90\x7f\x9c㵐e\xf8\x1f\xd5\xe4_\xe0o\xe7p1y\x06\xff\xa3Bb\xf7=\x9e\xdaQ\x1e\xce\x18\x12}m\xaelJ\xa0\xb1I\x1al{\xc9\xf5\x93ם\xd8\xd0\xf0p\xcf5\xa1Qe\xb1\xcd\xe0&F\xb8f\xbev\x9bѥ\x9f㜧\x1e%\x05\xb8\x18\x81\xa5\x95\ue075\xb2\xed\xe2$\x7fS\xf9>\xb7\x7f\xad\xd9T\xec`\xdekH\x8f.9\x9c\xb6\x88s\x8d\x17\x01}\xc1Ѝ8ݨ\x1c$؏h\xca8/!\x1c\t)\x8aF\x9c?<7\x95\xaa\x95?\xa8`&\\\xf3z'\"\x97\xe0\xbd\xd0c\x9c\xae7D\xf7YdfR\xeaj\\Qƥ\xf3\x0e\xe0K\xbb\x0eF12\xc2\ny\x96\x8ei&i&\x7f{tL\x88\xaf\x99\x8a\x88\xfb\xc1Y\xe0a\x80~\xdf.\x97\xbbx\xdd\x14.\xb1\x825b\rk\x8f\xf0ar\xb1y*\xb4\xcfl\xf5$\xbd\x9d\f\xcad+CU\x7fpf\xfa\xe7P\xff\xbc].\xf7\xe9\xbe k\xe7s\xe7Wͣ\x0eT\xf2\xbe\xa8:Xz\xc7\x10\xf5\x92\x01\x9c\x90\ru\\#Ji)\xb2\xa8\xdc\xc5}NT$\x8c\x8b\xf89N6\xf2\x93\x05\xaa\x91_\x1a\xe9\xb8H\xd6\xf4\x7f\x1f\x1c\x18Լ\x7fQL\x8e\xf3\x1b\x8b\xfe\x06\x1f\xe1.\xce\xe9\x96JT\x98\x0e\x99r\rա\x8a5\xffNR\xed3ۗH\xeff\v=l\xa7\xe7e\xdePۘw,\xfc" +
+	"\xb9ACU\x04D\xf5\xf9\xd7\n\x99\xd0\"tՁk\x0e\x82\xf2uK\xdd^}\xbd\x8f\xa6\xf05]5\xf0\aH5\x88\xd3\a\x82\xcfQ\xc5H\x94C\xb2n\xdf\xc2Z[\xc5\n\x92\n\xcc#\x8av\xf4\xc1Q\x01\x9f\x9cw\xea\xf7\a\x1eԃNJ\x1f\xf8\x01\x86\xfa\xd8=\xe0\xafUY\xbe\xf2//C\x1fC\xf8IW\xc6q\x96\x138\xbeJE\xaa9?\xc8\xf9\xe0\xcc\xd7$.\x1f\x8b\xe4\x81\xef\x8e>8\xc3M\x96\x12\x15\xaf\x85\x06>Z\xa4\xc5c\x9cW\xa0\xc9z\x16\xefv\xa0\xcd9\x9f\x7f\xe5u\x9d\x8cf\xf1\x8e\x0eڷ\xe0\xdc\x14\xe6_y\xbaƋ7\xd8\xde%$-\x87\xf1N\v\xbf\xb4(ȐҍG\xa2\x8a\xe2\x93\xd5%Y\x8fGt&\xfd]\x97?\xcdWi\x90i\x19')\xc9'<\xd9UV\x94ⷘC\xe4\x18\xff_pO\xf7o\xe3\x9b.|-`\xaf׀\xff\xd18W\xf0cYױ\x19R\xfa\xc1\xdaQ\xcfW|\xfe\x98f1\x9c\x92v~\x15\xb3P\x94\x95\xf1&\x8f\xb7jZ\xca\t\xc1\xdf!\xf4\xaf5\x86\\\xec\xef\xefI\x8e!\x94\tu\x80\x85\xb9h\x00h\xed®X)U_(Ԕ\xdfc\xf2\xf0\xa8\xf1\xd3\x00\x8b4)\xd1\xe2s\xef|n\xf2Ă_K𓮲\xa2!\xd8n\xb1Gr\xca\f#\x1aN\xc6\xd3\x05\ra\xd2D0_\x00\xdb\n(a\xc2\x1b\xa8\xd8jqY\xc3\x1a\"\xf5\xdaa\xbc,\xf0w@\xca<N\x8bmR\x16\xc8s\xda\x1d\x91_\x17\xf1\xea\t&2\xfeɃ\x1aIZ%\xa66\xafiP\"\xa7\x97\xe8w\x98\xae\x97\xdbB\xe9\xa7\xc1\xeaY\xfb^\xa4\xf1ꉨ\xb5;\xd4?\xa7Y\xa1D\xcbK-\xbf\xc7@[\xaa\xefi\\\x94K8/Z\x16$-up\xbcz\xb2@)1\xf8\x05\xac\x12k\xd0\xf9\xb6\xdckU(\x8a\xf21'ţ\x02\x96%\xfe\rk\x1d\x16A\x85\x1e\x80\xab\x97\x14o\x9a\x9e59\x91,_\x13\x98\xfe\xb5|\xf3\xb2\xd4˱\x83\x05@@h_]暘P\x8f\x9b\xc3\xeb\xd7K7\xac\x98\\2T\xa0\x05F\x02\xd8\xc8\x1d\xbb\x81zl}\x8eq²\xb2\x85\x814t\xb2\xa7=n\x10\xe0\xc9H\x0f\xdc,\xe1^t=\xc5\xef\xf8\x00\xc1|\x16\xb4\xf1\x81\t\x80g\x83\xdbн\xd4\xc7\x01\x85\xdeL<\x1e\x1b\xa5g` \xd0Ex5\x19\xeb\x1e\x1a(r\xd4\xe3\x01\x9b-`H\xfa\xd1\xfd\xc4\xfc\x8f\x9c55\x820\xd4/t\x01\xe8\xf9\x10\xd6B\x8b\x83\r\xe0\x9f\x17\x93\xe1Gn\xbb\xa4уU\x8e\x1e\x1c\x18\xa0,(\a8]fQ\x8fQ\xbe\xa1\x9a-\x94h'\xfepȞ\x85\xb7[\xbdnW\x83\x8b\xc0\xb4\xbdN\a\xc3\xdd\xdb!3\xd1\xed\xb54>\x97#fn\xd0Ꞟ\xf6O;]\x1d\xe9F\xfc=o\xb7\xa9\xc1'\xc2\xc1S\xbb\xd5;?\xd5P\xd3\xe1\xc7(\x98M|^\x8as=!\x7f*\b\xc58\xd7\x11\x91\x87\x8b\xa1\xd5\xe92\b\xbbg=\x96\xac\xa7\xf3\v\xdd`\u0083\xca\xf4:z\xa2p\"\xca\xde\xd3\xe1\xfe8\x1a\x0eX|\x92^[/\x05t\x9a_8F\x13\xdf\xc4\xfb\x99g\xd2\xc2\xe0\xe9\xc4[\xdcrD\x1b#f\x17\x93!\xe7sj\xc0\xb9`ښ\xa4\x85u\\\xab\xd7\xd6J;\x9b\x887\u05ed\u07b9\x9e\"\x94)\xce4\xf8r8\x10\x97\xfd\x1atd\x03\xd2\xcee\x14}92\xed&88\xd2<]0\xa0\x1e\x8d\x9b\xc1\x82\x89n\xa7\xc0\xa1ޥ\x15L\x8b`\x94\x8bY:\x18\xf9\x87\x9a{{\x80y~Ĭ\x8c[\xbd\x8e\x8eP\xbd]\x13\xbf\xbf\x88x;\xb6\xb52\xcf?rYv\xfa\x06XD\xa0kV\xe0>u\x14hJ\x0fPt\x85\x0e\x8c8\x12\x1aRXxT\x92\x8a\u05c8\xfd\xae\x89\xf1D~\xadJ\x11\xa9\x1d\xbe)\x1b\x86\xf0\xe7\xa6pC5qh=<\x1cJY\xb64F\xa1KC_\xd1)\xb3\xddꝝ\x1aH\x88\x0e\x13\xf2\xe1tީ\"\x85_<@wMt\x18\x05\x8b\xa1\xe8\xdfm\x13{3\x11\xfe\x04\xce\xcc\"\x85:㞉FI\xcf\r\xdc2rg\x91\xd94\xa1\x9c\xea::=[*\x9a\xa7\xddf\xbbg\f\xdaМ\xebt\x8ej\xaek7uD4\x1d~\xc4\\\xf5tx\xb6\xd31\xdal\xa7WY\x9b\xd5:z~BJm]\xc0x\xb2S\r#\xba\x19\x1e\xfe\v\x8fyP\x92.\x8c\xa0\xe4g\xad6\xb2\xb0\x8e\x16\xdeȵӜ!\x1a\b\xfa\xae\xc2%\xf2\xe9\xbe\xd7\xec\xe8$\x93\xf1\x18c\xfb:V:\xb2\x91\x04\xa7:\xc1\xb5\xe7FW\xa3 \xfc\x05\xd1 }e\xe1Ac\vGM\xac\r\xfa\xa7\x1d\x1d/\x1cJH\xfc\x99\x8egE\x94خ\x8e\x15!L\x04\xfaTG{\xfe0\\\xcc0A['\xf0\xc7c\xfe\x16WP\x9c5\r\x8a\x1b\xcf\r\x10\xfe\xb4\xaf\xe3\xe1\xb9\x1a3\a\x97\x14=\x9dB\x8aQfaT\"\xba\xd5ڒ\x92\x18RĒ\x964g|\xc7\x18\x11t\xba\xf9\xa2\xee\x9e\xdfz\x86\xc9v7$\xdf&\x99\x8c\x82;\x81TR\xef\xf5\xb5\xaf\xa1\xf65տ\x92Tm\x8aV\xce\xe7N\xfb\xd7Ꞧ\xc3\xf74\x93bG\xd0^\xc4ǟb\xef\x1f=<%\xb0\xf5\x97\xe6/%\n\xad\xea\x88\xcb\xe3\xeaA\x12\x9c\x1d6\xb8\x1d\x1c\xdd=\x97\xa5\xc3~\xdb*O\x11\x0e\x0eJ\v\xa0\xa2Qn\v\xe7]\xb4- }\"N\x02+糒\a\x1cV\xca\xfd!Y)G\xcf\xe2\xb7:\xba\x17\xe4'\xb0\xbb\xfd\x02G\xf6\x92轓\xca\xdf'\x96\x04^\x9cf\x8d\x93jiwd\x15e\x90W\xa3,\x1cE\xce\xe9d!\x9f㍥\x8c\x8b\x9a2RӃ\xaf(\"\xa5\xaf)\xe1s\xbc\x11\x05|v$\xb1Y>y\xfd\x01g\xd1j\xc3f9:\xf0\x91E%=6\xf8M\xf9\xeb\xfeB\x1bǑ\x91\x8aK\xed\x1b6\xef\xfb\xc21X\xb7\x14\xeb!\f\xbe8\x95\xcc\xe79Y%E\x92\xc9s\xdb(ې<\xe6&\x9c\x14\xa0\xf9\x1c\x8f\x12\xe5\x03|\xbe+\xeeQ\xd9~Jʒ\xa8\x92<&\xf7\xa5Y\x90\xb6*HX\xc6w\xc9\x06\xa5]\xa9B\r\xe3\r\xfar\xf3\x1c}\x85\xe5\x1d\xfa\x8a\xe2\xc4̣\xe3|\xee\xca3\x13&\xfcm\xa1;T\x97\xac\xd0\xc7p\xaf}\x15\xeaK\x8e\xd8|\x9f\xae\xe2\x92\xe8g\xf2\x1b\x92>\x94\x8f2\xa2qe\xf8\xb0\xdbjqŘ\xacEq\xedw\x10\"\xaf\xc56.\x9e\x1a\xf0?aI\xbbY\xcb/AC\xed\x00\xe9\x9d\xc0\xa2,\xe0\xc36_\x98q\xff\xf7\xb4<,ƿq\x0e\x8e\xc2\xfb߯\v\xe7\xc7\x1f\x7f\xa4y\x1b4f\xdc\aݖ\x8c\xe1㲁B\r\x1c \xdef\xfb\xb4l\x94q\xfe@T\x8c\xfe\x83\xc6:\x8b\xb40\r=M\xa6\xf44\xb9q\x98\v\x9cj5\xe0~\x87\x96pϤX\xd4^9q\x94\xbcW\xa1\x97 \xf5\x038JRq\xdfDG04\x0e\x18\x01\xf0\x10\x02\xbbx\xf5\xa4>mg},\xd32\xd9B\xc1x\xa6\x83\x15\ue873l]\xed\xa2\xb4{[\xee\x8c8'\xab$J\xba hiJ\xb8\xda;`\x9f\xc5\xd2\xd0\xc9POW\xf0tl\x82\xb6\x88\x91\xf5S9M\xbf\x16\\2=.\v/[\x13\x03\x14\x90\r\x89\v\f\xb9&9\x9d\xb1\x14d\x16\xaf\x1ea\x81V\x90\x914,\x96@y\xa0u\r\xb1\vY\xacVu\x94u\xed\xfaZ\xe0\xe3k\x97:\x84l\xb50\xa0\xadm\x9f\xae݀E6\x95\xca\xd7\xf5ģ{\\\xa9k]\xf3G8*\x9b)\x04\x83\xd5\xde\x0e_\xb37\xb3\x8a-uI\x8f\xb6\x17ׁ;\x0f&\xcc\x7f\x85ʪ\xb2i\xbb\xe6\x8a\xf7\xb9\x02,B\xfd8\xe8:\xbc\x89\xb48\xce\xd7\xfcu\x8c*̍\xa8\x94:ݺ\xe1ς*a|o\x84\xff\xbcjD\xde\x1b\x88F0\xf1\x16z\x84\xb5\x1b\xf0\xb5FA\x8a\x87\xe7\xc3\v\x1a\\\xdf\x1bϗ!\xab*\x81xo<_<\x9f6\xe2\x1d\xdf\xf8\xc1\x88\xbffQ\xbb\xdf\x1b\x10ʜf\xa9X,<\xea\x7f\x80\x03i\x97\xbe\x89\x93\xb2\xabn\xad_\n\xb6\x90\xbe\x038[T\xb5\x80\xfc\uf75c\x05\xeax\xc7\x02v\xc0\xeb\x01\xab\xf9/\xed\xf6\x8a\t\x9f\xe6\x85f\xa1\x10'\x8e\xfb%)\t\xd8\x11#S\x01\x8d\x80鄇I\xcal\xb7;H\x01\xf7SI\xba?B\x93\x93\xd1~\xbb;@\xe2~\x11\xbf\x99:T_ކ\xf8QW\xde7\x90Ey\xbc\x1b\xc6\xfb\x82h\x99\xf1 co\x8av\xc4\x1a\xfevȺ\xb6\xd0ʄ\xf1\x88h\x95\xbf\xfc\x1b\xfd+IQ&\xe9ß\x9aG\xb3\xd9l\xf6\xbb]\xfa\xb7\xd9l\x1a\x7f[\xdd\xd3VO\xc0\x18\xbc\xd5\xec\xb7z\x7fq\x9a\xff\x1b\x02\xd8\x17e\x9c\xff\xa5\xd9\xfcWT\x12W\xee\xff\x91\x7f\xf7\x99C\xbe첼t\x9e[\xffEWY\xde#\xfe\xeb\xbf@}\x80\xb9G\x02\x92-%\xfca\x95\xa5%\xf9R\xfe\xa0 I\x86>\xe0\x8a\x9d\xac0:ߧ\xb0\x94#\b\xff\xa4\x03l\xb0\xd9d\xabbN\xf2`\x9f6\xf2\xbd\x98\xf9xX\xbf\x93\x13\xa7\x11??8\xf7\x9b,f\xbb%:\xa6.\xc4\xe2\xbeʶ\xdb,\xfd\xe0x\xec\xee[\x99y\xc1\xef\xcf\xcd_\x19\x13\xb5)\xbb8a\xf6F\xa0?\xe4\x8d\x13\x1d\x9e\xed,\xe0\x80\x14\xc4JN\xe83\x8bB\x9c\n\x18\x89\xa0\xa2\xacjz\xb2|\x9fz,\x89\tn\x193!\a\xeb\xc9\xd7\xd9\x05IW\x8f\x8d\x13\x87\xfe\xdd\xc6\xf9S@\x8a\xfd\xa6\xc4D\x9bxOi0\xcc\xddĻ\x82N\xc8 \xfc\x1fG\xfb<\x86u\xa6Z\xe8\x19)\xf3d\xd5H\x85\xc8\xdf;\xfb4\x11j\xb3Ʋ(\xb3\x9d\x9f\x87\xabxC.\xa6Y\xb6\xb3\x94\x7f\x93e\xbbp\x93\xbd@\xec<\v\xba&\x15\xf4\x04\xed=\x18\xef\fwλ\x8b\x93*y\xbc^7\xb2\xf2\x91\xe4\xa6L\xb4Җy\xb2\xf5\xf7\xe5n_\x1a\xed\xbbO\xe7q\x1eo6dӸ\xcb֯,\xabw\xf3\x8b\x13\xb3\xb9\x05YRl\x1b;܂2ۆ^\xd0J\x13\xb1\xbe\xabC\xa5\xb9\"\xeb\xc1\x91\xde>\x1f\x1c\xdaɤ>N\xb6\xacS)\x933\xb2e\x14\x02\xe0~)\xf3\xd8\xd9ƻ\xcfLz\xbf\xf2v\x94c\xc0\x14\x92\xe3\xc1\xe8\xf3wƑC\x95n{7'yHV\x8d\x13\xd17jI\xe5\xa0~\x03[JKִ\x16oKQy\xecPC7#\xdb\n)m\x82a\xf6Lr|P\xa2l\x99`\xabH\xf2\x02\v\x90\x99\xeft\xdaҠM\xc3Q^\x14\xf1\x81\xf1%\xeb9\x9b:\x85!\xd8\xef8WJ)\xed\xb8\x93\x944\xd5\xc9`\xb6iJ[\x0f@\xb50\xaa%Qa\xb9-\v\x15ΎV\x9e\U00086698R\xa1\x88\xf8\x81\xe0F\xa3\xa5\x19\x9bS\xe8ѹs|\xe2\\\x91\xcdN\x9b\b\xc7'\xce8N6:$|J,\n\"`\x06\xeb5\x98x\x8am?7A\xff]\xe7\xb7\xff\xe7?\x1b\xccJ͊\xcf\xe94%s\x9c\xa4\t\xfd\xa0\x95\x9a@\x9a4\xdeȮ`5\x06\x1e\xa3Q\xfa\xbb\x91\xd2\xfd\x12ow\x1br(\xdd\xc9\a\x87M$\x12\xb1H\xa99\x04a\xb6iJb\x06\xd3\x13g\xc7\xcc\\\x83}\xca\xfah\xa3`\x16\x87ro\x9blI\xb8#i\xa9\xcf\x02\xf0\xbe.M\x8aG\x9e\x01\r\x16ɺ\x9a&%\xa7\xb1\x8b\x8b\x82\x13\x99\"\x01\xb1F\xf2\b\xa4Z\xb7\x94U\xee\xdey7\xae\b%\"EyH\"\xef\"\x99d&\xe8\x8ev\xa7\x19\x9b\xe8O\x9c\xc6\nF\xa0\xb6\"\xceN\x9c;r\x9f夡\xc1\xe2\xfbR\xeb}\xb3\x13\a\x9eS\x93y\x9e\xc1[\xecBG\x81jW\x0e6q\xbe\x15\xab\x1e,\xe3:E\xb6\xe3\x04\x1c<\x8b\x93\xb4\xb1\x8d\xcb\xd5#\x9b9X\xfdvq\x89\xec\x86QxM\xd6\x1aL\xff\x7fOU%8\x9c\xc0b{\xef܉\xbe\x88Q\xb2\x83\xbew\b\xeb\x1c\x18+\xfa\v*\x14\xd5Z\x1ak\xb2+h6#\xb2+\xfeh\x86\xf7\xb2'`\x02\xd5?\x0e\x16\xc9y7\xe3-=\xbf\xa86\xb5j\xdd\xf9ŉ\x03\x8f\x8e\xf4I  \x0f\t\x1c&\xd3Y\xa9\xc1g'Q\xcd`\x9f\xcaR\x16\x7f\xb8\x11\x0e\xd7\x1e\xe5\xc5k\xf4\xc7s:$\xa5F\xf6$F\xa1\xc8\x0f\x1a\xa7\xf8\x97\xf6\xadj6\xe1#\x9d\x1a\xb9\xc8ٹt\xfd<\x8f\xee*N\x1c\xa9\x04\x9d`\xe8ꑬ\x9e\xec\xa8cO\xe11\xed\xf0\x11\"\xb7\xae\x93܆\xacS\xf7\xe0\xfe\xc9T\xf7\xa2\x13g\xc4\xc2\x02Ê\xd6X\xf3\xdfjx\xbfw\f\x91\xd04j\xcd`2\xb9@\xf3\xe6 /\x93\xfbxU\x8e\x92\\.\x9e`%X\xe6\xf5u\xfbP\xad\x11X\x1d\x938\xdd\xef\x1a|\xe3B\xafAJ6\x04\xf8\x9e\xe9G\x0e\xa1\xd7\rYn]\n9\xee\xbeq\x9f\xe5[\xd47\xec\xb4l\xf1e\x7f\xbd\xecE\xfc\x94\x8b/|\x96\xf1\xa6Q\x97\xba\x8c7o\xccI,\xfd`\xf5\xf8P\xc3o\x9a=\xbc\x91\x1b\xac\"H\xdaB1w\x92\xecGz\xe2\x92\x7f8ڿ>P=\xa3\xa6$\x80\xe2\xf2\x80\x9fo,\x95\xae\xb8|p\"\xb2\xdd\xe9\xddb\x97'\xcfp;#ױ\x88m\x8f\xf5i\xee\x9a\xe4wYA$\x90v:6\xfe\u07bc<2r>\x02ar\x1ek\xa3\xe4\xa4Bx\x9f\xc7[BER<%l\x7f\xe2\xf0\xad\xf7\x8fc\xc0USl\xf6\xc5c\x94\xcdc\x1a\x9b\x1bf\x18O\x1b\x86\xc7EVa)\xe5S\xc1h-^\xc1\x16\xa4\f\xe2Ԓ\xcc\xd0.1\xb8\xaaab,m\xfd\nf\x93=\xc8\a\x1a\uf764p\xf3ܘ-\x04\xe5\x9a6,U\xbf\x1a'\x0e\aW['\xdelB8!T2\xaf\xa9!\x15\xf6<\xce\xe1\x82\xccR\xb0\xea\b\xb0ɈQ1\xbc\x85I\xddȴ\x11\xbeiHT\x12\xd6\xcf[v\xd2?\x96K\xfd\x9ce'\xfdc\xb9\xd4N\x1fV\xca?\x9e\x87\xbd'\xd6l\x92\x04\xba\xb2\xd3\x12\b\xb9ʈ\x03\xb2\n\x85mA\xab\x10m\xe3'\xa2\x136DŴ[8\x95\"'\x9b\xb8L\x9ee\xaa\x8b\xb880\xa0ͩ\xd3Z\x00Et,\xf37+\x1bRL\xb5\x1a\x87\xa4\xa8[\x9d\xab\x02=\xa8\vT\x05\xb5OE#\xed\x1e\x9d]\x9c&\xab+x\x9f\xc8\xd4:\xfa}ͼ;\xd4\xf7\x9b\x9c\xd0\x19q\xa5\xedi\xb4%\x81#\x9d\x06<\xb2\xa2c\xad\x90\a\x91t\xbd\x11\xdb\x04\x94\xd1\x10\x12\x0e\xb3|\xb7/\x1a\x9f\x7fU\xf0\xdf\xdf;\x9f\x7f\xe5\a\xb7?\xc2s>~\xd9\r*L\x96\xafa\x1a$\xb0\x04\xc1BglL\xb9A\x8b\x1dJOr?\xff\xcaW:\xb6\xceȥt\xae.u\xb5\u05ed\x1f\x9ck\x10o\xe1h%\xfc\xe0\\\x92\x940\xfe\xec\xa4lR\x84D\xec\xb8\xcd\xf2\xbf\xc7*\xb5\xba\xbc\xff\xe0L\xe8\t4?\x8e\x14\x99\xc3\x11B\xc0VJt\\\xd2ت\x83!п\xe3|\x94\xbd\xf0]2\xdd}\xefخS\x92<d\x14\x8c\xfa\x9c٭\xdf;E\x1a\xefV\xd93\x1f\xbb\xf2X\x1b.\x97\xe5\xbe\xe0m[\x02z9\xbf\xdf\x10\xa3.\x01\x89\u05fc\x85\x05\v\xb3i\x1b\xff;-\"\x8aI\x0fЕ\\?\x80\xbe\x0f\x9d\xe9&˟\xc0\x03\rH\xe2\xcf/\x0f\xef\x01\xb2#\xd0\xf3\xdc4Y\xf9)\xdc26\xa9hA\x03L\xe3]\xf1\x98i\xe5\xa5[\xef\xe1|\xc1O\x19\x1ar\x85V̀\x024AX\x80\x15\xfa\x03\xbdS@)9DP\xca\xf47\xe8\x10#\xcad\xc3IN\xef\x99fA\xa9\xc5A\x8b6\xaf\x00\xfe\xbf\xfe\xf8\xfd\xdf\xdfV\xf9\xeb\xae\xcc\xe0\xeb\x7f\xfb\xfe\xaf\xd9l\x9dv\x8d\xfb\xbfN\xb3}\xfa\xfd\xfe\xef\x9b\xde\xff\xa1\x1e\xa1\xae\x021L^\xe51\xfa\x1f\x94ˤ\xcbMv\x17o\x828]g[\xd8\xc3s\x8a\x1f\xa3\xf7N!,Ta\x95\xfa\xcb\xf7\x7f\xdf\xfc\x9fh\xed\xfb\xe2\xcf\x19\xfbo\x18\xff\xedv\xbbc\x8c\xffv\xab\xd5\xfd>\xfe\xbf\xed\xf8\xbf/\xf4\xb1\x7f_\xe8\xe3>\xc9\xfev_T\xaf\xf4\xd9\x1d@\xbc\x1b\x87\xf8\x96\xec\x1d@\x92\x8d<\x81\xa0\x04'\x8e\xbf#\xfa\x99\x86Ӹ/~\x04Bs\x1f\xc0\x13\xe4\xa4\xc86τ;" +
+	"H(\x8c\xb4K\xa94\x99G\x80<9\xe8F\xe0\f\xc7Hg\xdfz\xf04Sj\xc3i-$\xbcg\xaeI\xb5\xb1\xa4z\xb7\x8dwG\x92A\x01\x81\xc4H)\xfc\xcbYӄ\x7f\xa4|\x90\x11l\xbc\xcc|\xee\x8b\x1fGI\xee\xa6e\xfeZ\x93\x12fwPPK\x92\xa78\xe5A!\x86\xfb\xbb\x86\xa6\x16C\tCÇ\x0f\xef#\xd2\xe3\n\xd6\xf7\xe8\x1d-\xaf\x16\xfc\xa6\x10j3.\x0f~?\xc0\x83~\xbc\xa9\xc2't\xe3\xb0q_\xbc\x16\x8e\xc8\xf7ˎ\xacJ\xb2\x86\xed\xbba\xddK\x8b\x82Z\xea\xd0\x1d\a\"\xab;\xdf\xd2H\xc03\x83y\xb9\xadS\xf0\x1b\\TS;\x1d(\xf2o\xa1\xe3R\xb2\xde\x7fi\x84\x93\x82\xedõ\xb3\b\xbd\xf0\xaf\xdc\nOȷ\x86\x11x_8\xdc\a\r\xbe\xc6\r\xfd\xf7E\xf9\x1b\xac\xffI\xf6\xcd\xd6\xff\x16\xfa-\xd7\xfff\xe7\xfb\xfa\xffm\xd7\xff\xc4\xd0\xfd\x93\xcc\\\xff\x85\xca\x0f\x13\xb5\x9b\xe7\x01\xf5W\xd6\xc8a\xd7\xca~\x9f\xa8\x9f\x9cT\x91)\xb3YL\x04~)\xdc<\x8f\xb8\x1bv\xfc\xe0\xe2*\xdeܿ%\v\x8f\xbc\xd0e>{\x00\xf7\xb6\xbb\x9c\xdc3\x8fptq:\x96\x92\xee\xb6\xedI_\x1c\xb4\xed7\xcf\xe8\xfd\x94\x80\xf9\xd2[\xca\a\xabQ\x95\xee=;\x83LKi_\x8c\xeb\xce\xe5\xf1&\xf6\xfc\xf9\x10\xbf\"x\xc1g\b\xc2PQ\xc1\xfeK\xb4\xf6o\xfbd\xf5\xf4\x97o3\xfe\xbb}\xd3\xfe\xb7u\xfa}\xff\xff\xad\xc7?\xed\x11j\xf8\xf3O1\xfa\xb7q\xf9\xf8\xb7<N\xd7V\xc3_fx\x06g\xcd\r͌\x8b\xf6\xf2\xfb\xe4\xc1y7\xa4\x7f\xf5^N\x13\xb8\xbf\xed㍙\xea\xe1ML\x98\x8d\x9ddB\x8fĕ\xc72\x00\xb3\xc36\x7f_\xb6̓C\x7f_\xb6u\x98Ro\f\x96\xe2\x0e\xcc4)\x949\xc8<E\xd0\a8`>\xcc\xfc\x10_VYa\xab\x18\x7fAl\xc5\x175\xc1\x15g\xc9\x1f\x1c8zq\xdeA\xeb\xfc\b?\xe5i)d\xd9P\x87\xc1\x14\xfa\x1e\x11\xa2\xcbg!\xda\az-\v\x97T\x8a\xccF$JB\x8f\xcdQ\x19\x85n\xcfOf3\xecx\x96\xc3H#\xd7\xcb\vo\x93\xff\xc9=\x98ke\x95\x1e\xd1H\xb9\xdfIQ#m[!\xaa\xc2d\xf7\xf2\xc0\xa6Q:\xfaU\x81\x91\xff\x89\xd3`\xf7;\x86\xa0\xe4\xa6\xf2?P\xff+6\xd9ß\xa5\x01\x1e;\xff\xedv\xcc\xf9\xbf\xdd\xed}\xd7\xff\xbe\xf1\xfc/z\x84Z\x02\x14D\xcc\xf8\x9b\x8c\xd1\xfdP{\x1c\f\xa6]\xfa\x11pJ^\xe8\xa5\x05ɹ\xe9\xa8ĝ\xd0\x1c~\xe4X\xb0q\xa5\xe6\xf1\x15*t\xaed\xb9B\x05\xfd\x8a\xb3h<Z9\x16\xe2\xf2\xed\xf3\xaf5\xac\xf8\x8a\xf2\x97\xff\xfc\x7f\xb2\xb5_\xd3շ\x1a\xff\xed^u\xfc\xf7\xbf\x8f\xffo=\xfey\x8f@\xe3_B\xaa\x83\xdd\xf6\xb0+\xdc\x10\xb2k\xacu\xd3\x00<L\x8d\x99\xe1\xbe2\xd4Oг\xd9\xc6\xf7\v\xa3?\xe1\x1f\x98\xbb\xfc\xadX\xc5iJ\xf2?+\x8f#\xe3\xbf\xd3\xea\xb4\xcd\xfd_\xaf\xfb\xfd\xfe盍\x7f\xd5#\xd0\xd8\x17\x00\xed\xf8\x87\xbdw\x1e>\xc6\x10\x9d\xe3\xaf\xf2u\xff0\xdbnY@\x95\xbf\xca7\xf9\xcc\xef\xc1_\xe5\xeb\xfb1lV\x00\"\xdf\xd0_fQ\xf6D҂\xc5\xebo+\xf0\xcdcR\x12\xe6I\xf4\xefN\xb7}\xde=?=E\xb1\r'k\x9e\x97L2a\xdf\x1d\xf1N!+\x12j\x13\"\"\x17$\x1b\x92\xe2G,\xca\x11\x0e\xf7\xc0D\x7f\r\xb3\xcd~\x9bbGʍw\x82\x15\x9c\x97_ǛĴ\xdeS\xf8\x9ax<A\xfc\xc20P@\x15\xc9q\x15\xa7 \xc5B\x0f\x99\x03P&\xca\xc2\b\x10\xb9\x8aS*\xc0B\x8f\xac\xb2\x8aS*\x8dB\x8b\x19\x03P\x06;\xc30Y\x94\xc2\b\u05f8\x8aS\x85\x90\xcf\xc5B\xd6\x03\x84\x10\xd9\xf6\x8b\xd9\xee8\xef8\xf2\xbd\x03Qi\xa4y6\xa5\xc1\xdbU\xe1\xad䃃\xdaTz\xd5/hك}J\x18\xdfգ\x93\xefS\xf2\xdeI\xd8^\x90\xbb\xfd\xe6\"~\xc3{5^\xaa\x13\xf6D\xac\xc8W\xf8\xe0L`\xab\xd4)3\n\x84\xbc\xabH\xef\x10r\x0e\x01+\xeb\x90T\xa7l \x01Ր|\x9d\x9d\xa9L\x9b(\xf1Y%WMQ\x88\xee\x92\xdc'$\xaf-\xf7:yHʢ\xb1zlr\xa6wqAD\xbc\xcbg\x18\x04\xce;@\x9c8*\xdfu\xf2P\x90\x9d\xfe\xa4K\xcb\xd7\xdbo\xefH\xae\x12\x14\x84\xa4\xa3\xac\x14A\x1c\x19\x90r\xb5\xa7\x1f\x89BUʔJ\xc3wbO\xea\x16\xabxG\x1a\xbf\xed\xb3\x92\xb0,\x0e\x10\xf3a\x8c\x89\xcd\xc7\xdb\x1a\xbd\x1cT\x8d\x1a\x02\x18\xe6\xb586\xd8E\xad\xea\xca\x05?\xea\xbb`FmBwY!G\x8a%7:\xd3F\xac+\x1b\x01Þ\x92\x1d\x9atp`X9o\xf5\x85\x0e\a\\xu\xcb\xec\x89\x17\xfa\xfb5\xde\xffk\xfa_\x19\xdf\xd1W\x94\xf97\xd1\xffڝ~\xabr\xff\xd7n}\xd7\xff\xbe\xa1\xfe'{\x04r\x03\xa2@\x16\x1dp\xb0I\x1eR\xeaՍ\xae\xe7X\xff\x18\x91\xbb\xfd\x83\x80*\xedf\x94\x14\xab8_\xbb\xdb]\xf9\xcaT\xadB\x10)\xa5\x91N\xd5\xceߝ\xff\xf3\x7f\xf7\xcd\xe6\xfd\xfd\xff\x91\xda#\rjv\x15ͦ\"M˸\xc4\xcb\x1b\x19{\xa2\x8d\ueff6I\xfa\x92\xacK\x1ez\x16*\xa4\xbev\xf1z\xcd\xedh\xe9\xc7\n\x94Z\x1c\xd5qO\x97\x95w\xe2\xdaO͉;^J^\x10Y\xc1(\xbe\x9b\xa4k\xe1ӟ+j\xccQ\x13\xe5\xf1\xe6\x87`\xe2\xd21^\x83\xf1\x12\xb8\x9c\xdb˗\xe0'\x15*\xfap\xa0Q\x85S\x1d\xe8ϕ\x89\x91\xe3\x9a:w\xaa\x80i/jR\x8f\x82\xfc\xae\xd3N\xe2Q\x8dM\x98>\x19\x8b\xaeF9g\xe5l@\xd7}a\xa5]\x91͆\xff\xdc\x17$\x8a\xef\x8a\x1aqQ\x06 \xd6\x02\xd6\xcc&K\xb2\xa1n\x19\xe4\xcf\x16\xf7\xef\a\x8b\xaa\xb5\x14La\xfb\x1f0\x88w;\x92\xaei\x05j\x85\xb2߭\xe1^\x17\x9a\xc7\"U\xfa֝\xab6\xabG\xc7\u0382\xa4kNRŕ\xe0\xc16\x8dK2$\x9bM㱌\xef\xb82\x86\xfcnIb\x16n\x8b\xda\xcfӛ\xfcwTs\x05\xdfe\x15\xe5V$\x19C\xafm\xe87~H\x82\x1c[\xf5\x02h\xd0xوܓ\xbc\xb0Ԁ\xfe\xc5\xfd\xca\xe6\xa3\xeb\xfb\x92oY\xff\xc9v\xb7\x89K\xf2\x97o\xb2\xfew[\xcd~\xe5\xfe\xbf\xd3\xfc\xbe\xfe\x7f\xcb\xf5\x9f\xf7\b\xb4\xfcK\x88\xd5\xe9W\xc5\x1a\x18s\xf9\xdb.\xce\vi\x1c\xec~!+\xed\x9e\\s(⊡*\xd7?I_s\xa7\x8b\t\x16\xe9K\x1e\xef\x1a\xbaI\xc0x\x9f\xae v\x96\xfd\xb2G\x98\x17E\xb3)\x9f\x1b5\x9b\x99;c>Vt|㣢\xbdi\xa5Rt\xf6ײ:\xf5\xa4\x88\xf2=\x81kgßK\x99\xef\xcbG\xee\xf3\xc50j\xfe)|Ky\x7f\n\xdfRڟ·\x97u\xb6g'\xf7\x11o]<\xbd*\xa8R\xc7t\x1bc\x93`\x0e]ð\xce\xe5\xd6\xc5\x05\xb6\xcem\xe8\xf9\xa9\xf5\x811\xa0\xce_\xee\xf9\xc1\xdeW$\xb4[3[\x92p/\xef\f,z\xee;ڱ\x7f\x8crB>\x88\x17\xe25\xfe5x\xca\x13\xda\xfd\xf7%\x11\x80\xc6K\x8e\x9bOs\x83Ac\x8b[\xee%k\x99\x1a\xccޞ\x9e\xbc1\xbdmyV\\F\xe4>I\xc9Z\x00\x8a\xaa5\xb4\xa2\xfd\xc3\xcdVa\xf2\xe6&\xac)\xc3\xff\xa0\xefaf,\x06Y#ە8\xa9\xd9\xddq\nxί\x12U\xfc\xa2H:\xbba9\xc2\x1f\x1bc\x988\xe1\u07b2*\b\x1a2\xb1\xf1\xc6ʮ\xb2\xddkc\xe5\xc8\aɇr\x1c\xac\xd7T\xd00Jt7/eN\x88\x83\xc6\xd0\x1b3\xc7\xfd\xeb\U000ef1f2\x1e\x91M\xb2-\x1a\x1br\xafNSs\xbaI}\x8b\xa8`\xdd(\x1a\xf7|\xf5\xe0\xab\xc8\xe1$\xd3,{\xda\xef\xde\xde\x1aT0L\xed\xff\xaa\xce\x16\x17E\xb6J`\xfaH\xc9\v\x9e\x8a\xab\"Eg\xbf\x8b`\xfa\xf3\x9e\xe4\xafo\x9a\xeb\xbf\xc6e\xc9w=\xfa?B\xffgz\xda\xff\xb2\xfe\xdf\ued7a\xbd\xca\xfb\xdf\xdew\xfd\xff\xdfA\xffg=B\xed\x02\xf8\xa7\xd0\xf2\xd9\\Q\b\xcd\x1eB\ad\xa9ǽ&P\xd5>[\x13\xb0\xb1\xa4\xd7v\xf8ru\x9e\xec\x88\xf3\x0e\xfe\xef\xd1\xd7\\6\x9dI\xb1\xab\xf5\xf8\xa9\xd1У\x1cx=\x7f\xe7\xbc\xe3%\xfb\xf1b\x9fl\xd6$?\xb1&\x80\xc9\x12,[a\xa2\xb4\x12\fa\x99;\xa1\xe1\x02\x84\xef\xd6,\xdb\x1c\xa8 h\xf1\xfc\x9e\xd2V#\x91\xba\xbe>\x8a\xe2M\xb5Q\xe4ֺ(\xb4\xa5&y\x9c\xae\x1e\xffPcM\x93\xa2t\xde\xc1\xffٷ\xbb)\x88\t\xb3V_fy@\x00\x88\xe6m\"@\t\xecB@\x0461\x90\xf8\xe9MR\xb0\u05c8\xa76XW\xd1\xf5\xf5\x95$5\xa5\x97\xf8\xc3\xe2\xe0F\xe8qrh\x00R\x14k\xa0qB6k\x19\x82\xdc^=Ɏ9n\xbd\xa7ILE\x15\x11\xd5U\x12\x91\xbc\xa9M\x11\xbdU(\b_mQ\xb8\xbd\x8c\xd3\xf5\x01)\fr\x1aл\xbe\xa3\"\x16\xf2l6\xce\x1fh.'v\xaaڪc\xa2\xb7U\x1e\xa7\xb0W\x1fS\xd8\x05@\xd2\xf2\xd04\xa5\x14\xcez\x01p\x16\x87\xab&\x89\xde\\5\x99\xa2\xb6j\x92\xc2V5\x16W\xe2\x0f\x0fX\xcc\xc0>fu\x8a\xfa\xbac\xaa\x9a\xaa`\x927\f\xdeQv\xa8\xcdl\xb5\xe1)\xe4s`\x91\xa4JPW\x0fI\xf0\xa6\xf6\x93\xd4\xd6\nKl\xb5\xdd\xe8ds\xa0r̆\xeb\xf0d$y\xd4\xd7\x06\x91\xbc\xa9>\x88\xdeZ#\x84\xaf\xd6I\x99\xce\x1c\xadءj\xe9l\x98\x9f\xf9\xac\x10\x06\x1c'\x8eAp(\x1d\xdda\x97\xac\x0e_\x91\xb0F\x98&ݛ$j&\xb2\x8a\xd5$\xb2\xc8\xf6\x1e\xcbT\xad\xe0Hl\xf7uCxR\xd0;e*\x8c\xd4aT\xc8\v\xa7PQ\x0e\xac\x90\x87\x17\a\xc1@\xae\f\xa9\xb1.(\x02k\xed\x15\xbaN\xf2\x8a\xe2M2W\xe4 \f\xf8\x82<\x05\xb4\x86L\x97\xb7\xb4̳\x1f۾\xa7\x06y\xf4\xac\xa0\xee\xc5\x04\xae\xa3G^T\x137\x124\x04,\xbd\x92\xe6\xef%\x9b\xaf\x9c\xfax\x8a\xfa\xa9O\x12\xd4IY\x12\xbcIȒ\xdaڦ\x12[\x15\xad\xc7}\x9b\v\x0f|\x8a@\xd926NXE\x8d\xa2~0+\xf7A\xcb\xfd\x83,y\xa5\xd82\b\x19\xa4d;\x1a\xc9\x04\xc7\xc0\x02\x18l\x120\xb2\xad!A\xfd\u0530ZZ\xaa\x88atG\xc32=\x05\xe3\xbb&\x9e\xa4\xec\xb7`\xdf\xd4\t\xd8\x12\xaaQh\xe5\x1be\xec/G\xf60\x8ei\xb8\b{\x86\xb1\xaa\x1fb\x92s\x8d\xe4^\x13\x9aV\xb4i\xc2\x7f\b\xac&\x17/\xd1D\xda\xd2\xca\xccl\x1f5\xbc&6\xba\xdd\xc2XMhA\x9c>\xe8h\xadҬ\vi\xf8>\xc6\xcb+\fLq\xaaS|\xd1j\xd6D\x1d\x99\x82\x90A\x80\x80Y\x86\xa1bx\x1d\xe7I|\xb7ѳ\xd4\xda\xe2&)\x1f5\xec\xb9ȓ\x8a\xea\xd0\xfaZ\x80\xb17\xdbqO\x8aE\x82>\x98\x85\xb9\xf8\x1af\xe0\x97\xfd\x8b\xf8\x06\x93c\x19\xbb\x95\xfeUa\x14\xd93Z\xf5\x9c\x96'm\xb5Ϝ\x95\xfc\xf9\x06%Z\x15\xfe\xc4)\x92\xedn\x93ܿr^\x8d\x13+U\xedT\x85h\xde6[\xa1\x04\xf6\t\v\x11T\x97Pv,\xad\x9fի\xdaB4\xd2\xfb\x92\x9e\xad\xeb\xa7\xea:\xe8\xe8\x92\xd1@\bZ\xba\xf7F 5Z\fi\x8cJ\xd7(:w1\xab~~\x1cqt\x1b0)\x06E\x91<\xa4\xbc\xe9Gd\xb5\x81k\x03\xd1+\xd9L<ܮa\xd5\xc7[+{\xa3\x8al\xe5\xea\xbfb)\x1c\x9c\xf4\xc4B^\u05f6\x8a\xe2M-\xabȭ\xed\xaa\xd0Ъ\xf0\x05$\x02ZC\xa6/Xsf\xa4\x84\x9e4\x9c\x18\v\x15'\xa43\xd11=M\x12\x19Y)kc\xb8X\xa1\xaf\xe0E\v\xb7ŋ\x03*\x83\x03m\xfc3Xe\xaf\xd5byl@*\x86\xf5́i\xde\xd4 8\x81\xb5I0AU\xdab>~SW\xd6,%\xder\x84\x8a\xb9\xd7\xd7Y\xa7zS\xad\xf5$\xd6z\xeb$\xb6\x9a\x7f9zF!\xdc\x7f\xd9\xeb\xf6\xa5<V\xaf/\xe5W\xd5\xe9Ky\xb0>_j\xf5g \xb4\x9a\xb3\xd0)L\x83\x04Y\xa6\x1d\x94\xd2N?3Z\xb0\xc6\x12\x94m\xecR\xf2\x02\xc9\xd1\x16\xb1\xa2\xebKB(\xb4 \xd4&\xf1\x13GV\xa8\x9aJ<D\xa8I\x88\x0ei\xaai\xa1C\xd2\xe8\x1c2\xf1\x86\xf7_p\x9b\x9d\x17\xe6\xdck\x9b\x9e$3\xa6\xba\xdaX\xed\xb4\xde\x7f\xe2\xa0s{{}\xe2t\x8d$\x86&\xeb*\xb9(\x9e\xca\xd7\xd8\xc3\xe0\xf2W\x93\x8f2\xdc6\xfcT\xa4J\xe6%\x1bD\xc6w\x10U2\xaa\xc5֖D\x9ePX*\r\xba\xb9J\x98\x8a\xa3_\xa8\xbd8?\xad\xa6\x82\xdd\x00jwq\x9fq\xe2\xc8\xcb\x04KM\xd8c\x1e[oy\uf52f;')ɖ\xbb\x9eF\x9aG\xf5~[0\x14n\xb0\x05\xc3,O\x1el\x9a\b\xbc⒳k\x95\x8d\xab59\xa9kn\xb8\xbe\xa8v1H\xb1)j\x9axr\x7f\xbcO\x02\x06߉\xbcwH\xe5\x9e\xe4\xc4\xe1\a\x19\x96\x86\x80\x9d\x97\xb5X\xf2J\xc0\xd6\xd1نɚ\x0e\x1fHV\x93҅\xfa_V+\xb9\xecW3\x02e\xff_\x96\x0f0\xab\x9b\xf9\xb8\x81\x95%+M\xb3\xad\xcc$x\xe12\xf8\xf2\x99\xdfX\x18x\x96\xf45\x15tu\x03s\x17\xaf\xc0(\xe4\xc4\nn7\xca\x16MdGw\x1ae\x9b\xa2\xdf;5t;\xf6\xe6ВoJ\xe7\xf74\x84W\x96\x8d\x13\a\x9eh\x91\xb4\x96\a\xa2\xb4\xf0\xe2O9Y\xc0\x04q\xaa\xd6\xd8d\xabX\xbc\xac\xa5\xb2\xe4\x81\x14\xaa\xc6L\x94\xc9\x1fxވ\x12Zm\xcf\x18\x9az\xf3lH\xa7\x9eb\xba\xa9\x94\xc7V3\x96\xcaO\x89\x7f/9\xb4\x10\v\x01k\x7f\x1d\xdb}*\x12\"\xb9\x1f\x13\x10\x8f\xea\a5\xddq\x9bz\x93\x84\xda\xf9\xb3\xfd\x19\xdb`\xd591\x81]\xda\x17\xe7݆|\x01S>\xd0hBR:斫\xca>\xdb1\xee&\xa6b\xaa\xf4\xc6m`]\xceo\xd9 2k&\xb95\xb4\xb7>D\x7f\xadthk\x15(\x94\x1a)\xf2͌\x81\x87&\xe2ǧ\rs\xf6Iũ\x88\x99\b$\xe2\xe7\\Q1\xee\x92\x18\xc5jCbN@\xdf\xefT* \xd2VN\x92\xf9L\x00\xf3=\x8c\xbd<\xdbTg3{\x96|\xaa\xff\xbaT;\xa1\xba\xe9\xbd\xf4\xbdC\xd25^Í\x19\xd3̛F\x8c\x93Z\xa01\xab\x1f\x1e\x00;\xb6\xe3g\x856H\x9d\x7fѢa6\xfa\xbd\xc8\xcf*\x93\x1c\x16\xb2\x83\x14/I\xf9x\x90\x80\xa4\xeb\xc3\xf8Mq8\x87;\b\x19{\x90B\xd8\xeb\x1c$\xa2\u0095\x8b\x1b\x18\x95\x1e\x98\x99\x1c{\xac1ѽ\x98>}P\x91\xcev$gD\xd6\x02\xe7[;\xe61.\xe0D\x80\x0e\t\\\x04#\f \xabQ\xb6\xbb\x8e\xf3\xc2|\x91͐\xfb\x02N\x1e\x91\x02\x8c\x99\xa1\xbd\x1bV\xe6\xff\aד\xfa\x9e\xa6nK\xaaS\xbdi[\xaa'\xb1nMu\x92\xea\xf6ThI\x87\x0ej\x04\x8d-\xbd\x18\xfb\xec\\\x88\x82\xc8A\v\v\x9b\x80\xc81S\t\xf2Uf\x12䠉\x04\xa97\x8f\x10Z\xfd\x1f\xb2\x1f\x10\x89\xeb\xef\x9d\x14Em=%\xc5\xdb**\xc9\xed5\x95h{\xbb\x1d\xf0_\x0ehK1iR\xaa7\x1cH\xcb\x14\v\xbb\xb3\f\x8e\xdaY\xbcapTE%\xe6\xf0\xf21)&%\x81\xb0!j\xbdѴ+NH\xb6I\xa9\x11\x15%\x84\xa8Jmt\x94a\xe2\xb0\xda\xd6\xd0%\x0f\xa9\x1el\x98\xc3\xe3Պ\xec\xca\x06ssa\x9d\x894Bpw\xa7\xd1V\xca\xf3f\x15\xb8\xae\xa4 pZ#\xbb`\xe22\x90:\x18\v\x90\n\xca\x18{@\x04\x11\xf0\xa9\x8eo>\x86\x95i#\xfe\b4\xab\xb8\x82\xe7$\xc8w\x87v\xab\xce\v\xcb=x\tb^\x83\xeff\xd6\xff\xb6\xff\xc07۟\x9d\xc7a\xfb\xefV\xaf]\xf1\xff\x0e\xf4\xdf\xed" +
+	"\xbf\xbf\x91\xfdw\xb2E\x06\xdf\xec\x8b\xfbx\xf0\xc2\xc9\xd0\xf9\xbb\xf3\xc3,K\x9d\x9f\xe2\xd4Y\xb6\x9dVￛ\xdd\xffn\xf6\x9cv\xb3\xd9\x17\x8e\xff\x064J\xfbڑ>\xff\x9c\xff\xdf_W\x8fq\xea\xa0\xf0\x13\x94\x06\x14=D']\x00\x9e\xc0\"\x97l\x95\x9f\x85\xc1.O6\xce,K\xcbGlD0\xd8?\xec\x8bR\xc2ϔCz\xd2x%q\xce\xf6\b[\x8a\xa6D\xf0\xa6\xed\x95A\x1f\xb3\xbd\xc0'\xfc\x99xAV\xecG*\x7fm\xb2\x95\xf3nʏ9Nx\x05\xb8\x03\x8b\xb8$~\xbay\x05\x89@\xed\xff\xdal\xfd\xb5\xd9\xfe\x01\xa3\x81\\GK\x81):\xb2\"\xd4\x1e@Ԣ%\uefc4X\xf4x!J\xa85\xfa\x85\"\xe0n\t\xe0E\xfc\xbbϝ6\x7f\x14\x8f\xee\xf6\x14\xa9\x17\xa7YAVY\xba.\xcc\x00%\x8ah\x96\xac\xf2\xb7Pm6\xc9Q\xaaP\x12\xf0{v\x1b\xa3t_\x92\x83$W\xd9>?H \xdc\xe17\xb6\xa87\x8a_U\xf2 ۧ\xeb7\xd2\x0e\xee g\x85\xe7\x9eI\xc8]\xbe\x8f\xf3Wٜm\x9er\x9c|!\xeb_\xb2ԸWϤ\xef\xb9\x13\xd5\xd1$\xb3<\x81\xfezC\xc8\x13\xfcE\xf6-Pq\xd5A\xfe\xeet\xfah\x06\x15D?ũV\x14i\xa2\xf2\xd3~\xa3\xa0\xa7\n\x9a\x12\t\x95F#\x1f\x93r\xf5H \x8f\x1f:\xff\xdd\xec\xceg\xb2\xe7N\xe3\xd7l\x0f\x8eM~h\xb6\xfe\xd6l;\xcd\x0e\xeb\xda\xf3\x99\xf3\x7f\x9a}\xe7\xaf\xcd\xd3fSL\t\xd3,^\x8bڙa\x91\x04ܸ\x0f\xc0I\xc6y\xb6\x8d~\x81@\x13\x8d\xea\vY\xe9\xed\xc1\xc2\t\x02J\x00t\x83\x85\xcb\xec\x00\xd1\xd9\xe5\x9bܰ\xa8Y\xe0\x810\v;ɰBQk\xd9')6\xec\xa5 \x9fh (C]\xbfx\xcf\xce\xfcDHUz\x16\xc3~&\xc5(\x8c\fU\xce\xcca\x9c\xe4E\xf9\v{ډ=y(\xba{A\xb1(\xaaшMv\xf4\xd8@+\xe8>M\xbe\xc8*\xe0R\xab\xe7ꬻ\xcc\xe2|\xf5(\xfbWG\x81UW\x94\xdd\x1b\xcd4\xb8\x97\xb7P\xe7FӌI\xa2Q\xc1\x1c\x82\t\xfa\xd5q2\xcbR}\x98\xb5\xa4Yd\xca|\xe1\xc8pV\x00\xa8u\xee\xa8&Q\xadD\xca*2JVO\xc6\xca\xf8\x8e\xc10ͶJ\x82\x96B/{6\xd6\v\x99\x01\x84\x99֖(\x7fUf\x1aiS3\xf0ٰ\x01l\rj\xec4\x80\x91\xedɼ(\x18\xf2%\xd0P+\xb8\xf6^\x9e\xd2k\xbe\x1e\xa68K\x1e\x98@~1\xa4\xbb![\x9d@\x83\xccHQP\x8f\xa4\xf4\x1b\x19\xe6ȼj\xfcDP\x82I*g\xa1\x03\xb5\xaf\xac\xfb\x860\xb8/\xcf\xf1\xb0\xd5jw\xb8F\xf4\xdei\xb6\xa9V\x04\x8b\xbdҋfa\xf4\x83\x91\xe0\x97\xc3)ļ)\xd3t:\x9ds]\x8b\x88\x04\xf1/\xcd\xd3\xff\xae\x12C?\xacI\xf0\xe3\xb9\xf8WMz\xd6n\xd3ɜ\x15J\x14ɬ\xc1Y\xbb\xfd\x8b\x8d\xacR\xec\xb3^\x93Wt\x1d\xbfB]\xff\xfaS\x9c\xfe\xb5V6\xfb\xbbWP\x99\xb0~\x89\xd4%\xc6^h\x99,I\x18\x97\xfb\\\x1f\xb8\xca\x15j\xddĀ\x86}Hv\xa5>\x94΅\xe7\xea$]\x91FI\xc7RE\x05\x10n\xad\x91Gkί\x8c\xb7;(\xbf\xa1\x1b\xff\xa0\x11Щ\xcdB\xf5#+\x9cI\xbc\xd9$5\xc4:\xa5hs;W\x8d|o\xccvR \xd1\xe3>/t\\WF Z=\xd5k\xf4\xcc\x06\x86Nd2\xf8\t\xa6\xa81\xe0\xa1\tNhD\xe4\xc6I\x05L\xc3F\xebr\xe6\xf9lɁ\x83)\xd6f5\v0C^f\a\xd1c\xa66k\xf3\x83\x9dr@M\x009\xfd\x9d\xf4Uf\xa4d`=e\xfc/I\xc9\xc7;b\x90\xc2\x12\xc4-6\xeaSC\xf5W\x95Ե\x81&߱\xb4\xb0+bZD\xa7m\xa0$\xa6\xdf50\xa0\x1e\x84\xb5\xd8x\xbd\x06\xe4Z\x06\xae7\xb8\x96\xd3l\xd5\xd0\xe7b\x93\x06<\xf0Ͳ4kXRS\xf8\xd6\xce|K\xd3\xe8\xa5b\x98I\xf1\v\xc93C\x13\xe2\rNw\xb6{>1T\xf1\x17\xe4\x1e\x8e\x11\xeb\t\xc0\xfe7F\x14H\xb7`\x00\x16\xff\xa8\x9eA|W0q\xb2\x1f\xb0\x87\xd2\t\xe0N\x9e\xeeL\xea\xf5I\xf0\x86\xa7\x92\x9f\xe8\xe9\xe9\xe6\xf9\xc49\xb2\x7f6\x12}\"qްԆ&j\xf0\xbff>\xaf\xb6\x14|\xeai\xc8_Fۄ\xfe\r;VF\x05|!\xe4\xc9R\xa8!\\\x9b\x01e\xfdV\xdfH\x01\xbb*k5\xa8\x16i\xc30\x19\xda0J\x19\xb4aA`5\x12\x80\x97\xb6x\xa6\xc51;y\xa6\xfb;\xd9C\xcc\xfd\xa9\xe4!\x0fA\nԊ\xfc\xf7:f\x91Rm\xcc\x17Ѱa\x83\xd3]\x94\x153I\x1b\xd6c\x1235\xbf\xe5\xae\xec\x9b\x18\x05ߣ\xd4\ue3ab\xd4\x17\xb0Y\xa7=\x9dm\x90\xb8\x8e\x96\xae\xe9/#\xc1\"M\xbe؇;`\xe8:{\b\xbdʳz44\xb5\x1d\xcb\x16\x88\x8b$\x8d\xf3\xd7\xe3\xf3,\xeflq^<\xc6\x1b\x9e\xe8ؤ\xbcH\xb7\x1a\xbd\xb6#\xd6\xdc=\x89\xb5\xef\xceMW,\xca\xecaΗ\xd9݈P\xcac<y\x89\x7f\n}\xef\xed\xe5\xa5\xd4\xc78\xb3\xe5*\xca\xd0\nG\xf2|\x8e#3\x1e\x91&k\x01j\x06\xfbu\xf2\x8f\xd8\xc5\xd6\x1bkC\xa9\x8f\xd5f\x02\xfbu\xeb\xba\"\x8f\xa9\x0e\x0f|v<U\xa5\xe1\xea[\xb2\x95\a\x92H\xf7\x94J\xd3\xd7\xe9f[\xa5\x9a\x19W\xef\xc9֪\x9eq*^\x96=14\xc96;\x8cYD\xc3\xca\f@Ǧ<\tQǯp\xa4\x80+\bt\xe6\x1e\x01\x9fA\xcf\xc2H;\x87VCw_\xe1\x88\b`\xe8o\xeb\b\xcadc\xd9\b\xb0\xf2ܐujVS\x04\x9d\x10\x104\xbdsPݍ\xabZ\x91\xf9S$\x91N!N\xe8\xcc\xcd\x16\xffQ\xfcZ\xd8(VoZ\xf5D\x8e\xc0\xa5\x9a\x1d@O\x9cb\xb7I\xe8\x10X\x91\xb4\xdc\xe7\xaf@?d?\xdf;+\xba\xfc\x02\x04~\xbcw\xe2W\xa8m|W|\x82*Vx\xad\xbfN\xab\x90\xe9\x80\xfe\x13\xd3\aP\xda\xd7Z\xfa\x97\xaa\xfa \xaaʋ^\xad-G\x9c8\x1b\x12\xef\x1azŨ\xa8\xa7$\xde\xd9聠a\n\xe2\x1fq:&w\x00\xf8\x89\xfe:AF\x13\x82J\a}2\xfa\x89\x10\xa2j\x80-\x90qQm-ug\xe4,\xe7ƉʻB\x02\x82k\x98%|O+.*j\x94\x17@:\x84%\xd2a\x95\x832\x01;a\xedܨJ\x85\xa2\xbf_\r\xff\x1b\xdc\xff\xfe\xad\xfc'\xac\\\xdf\xe8\xfe\xb7\xd9l\xf6*\xfe\x7f;\xbd\xfe\xf7\xfb\xdfow\xff\xcb{\x04\xba\x06f\xdf\xdf\xc7\xcb\x7fڿ}\x9a\x80\x8a\xff\xa7\xe6q\xc4\xff_\xf3\xb4e\xfa\xffk\xf6\xbe\xc7\x7f\xfcV\xe3\x9f\xf7\b5\xf6%\x00\xd4\xf7A8\x9cL\x96W\xe4˒F`\xe2\x0f}\"0>\xe5\x04\xebM\xbc\xb5\x80\x1f3\x1b4\x8d\xcbl\x93\xc4\xe9\xf2*!y\xf6\xb0y\xdd=\x16\x16\xb2<\xbeKV6\xf8\x96\xa4I\x9cZ0Ϥ(\xad\x88\x7f\x92<\x19\xc6\x05q\xc2\x1dY%\xf1\x06~3\xd4E\xbcIRR\x90j\xa2\x8bx\xbb\xdf\xda\xc0E\x11/\xaf\xe3G\x1b\xaa\x8c\x9f,`\x92>ěĆȓx\xe9\xe6\xf7\xb6\xdc\x1f\xe3\xe4\xa9\xd8?ْ%\xebd\xc9m\xbe-\xd8l\x97m\xb3\xfb̂\xc9\xe3\xc7mb\x85'\x9b\x8d\xad\f\xfb\x87:\xd9\xec\x1f\x93u\x15<\xb4\x80\xe24^C[\x0f\xee\xe0=_\x92\xc6\x1b\x1bQ^iP\xe6\xf9+.\xb8\xeb\vq\x93\x98QM\xbe\xd3\xfe\xe0\\%\xf2\xe7\x88l\xca\xd8Y\xff.\xb8\xf1D\xf0LF~\b\\I\x1e\xb2<!\x85\xf64\xa5Z J\xf6:\xd8$q\xa1ӊ\xdd\x1b#ۯ\xe2\x82\xd6ns\x17\xdb;\xe5\xd0҅\x87\xf7\x16\xd8c\xfc\xb4\x8d\xad\xf0\xad\rJ\xf2\xec\x89\x10\x1b&\xcb\xe3bk/\x8b\r\x96\xd9`\xd4\x01\xb0\x05\xbe+mCrh\x19\xbe\xc3}J\x12\xb0E\xb2\xa0^wy\x92\x95vD\xb6\x9c%if-\xfdk\x0e\xbbvK\xfe\xa3\xb8\xb0\f\xc7\x11\xd9\xe5\x04\xceW\xd66\\ArR\xda\x10\xcfq\x1a?Ĺe\xa0\x8c\x92x\x95'V\t\xd4̋\xa3\xe4\x99\x14\xcb\xc1\xd3>\xdf[\x90\xd9Cni\xf0\xd1~\xb7\xc9^m\x02p\x1f^w\xe5\xd1y\xd3\xdd\xdcŅ5\xf9\xe6u\x1b\xdb\n\uf58fI\xb6\xb3b\xbe\x94$]\x93\xbc\x8a\x19g\x9b\xb5\x18%\a\x87\x12\x10\x86\xab<ٕ\a\xc9.\xe3<~\xadfsI\xb2\xfc\xc1ڗ/7\xf1C\xb6\xb1\xb7\xc6eV>Z\xe1y\x9c\x96\x8f\xb1\x15\xb1{LVrְ\x10\xc0mC\x15\xbc\xffG\f\xa7C6L\xfa\x8fx\x13//\xb3tmE\xe7\xdb\xfdӣ\x1d\xb3O\x1f\x96\x1f\x1f\x89m6\xb8\xb2\x89\xe2*N\x1f\xf6\x1b+<\xb9O\x96A\xf6\x98\xa4\x0f\xafvn\xfb4\xcbl\x882\xb7\xe6D\xeer\xf2b\x83ת\x06WI\x1e?ĩ-\xf7\xd7\x1d\x98\x98U\xe0\x93Q\xb8d\xe7\xdaK\x7fG\xf2\xb8\xccr;Q\x94\xbf\x85jq\x94fI\xef\xe7\xca\xe5,.\x1f\x97\xd2\xfb\xd41\u0090^?T\xa9\xd6${`\xddɂ\xdc\xee`\xcd\xdf,\ay\\\x1d\x8b̑]\xda\xc8yx˜u\xc7\x1f\x7f\xfc\x11\x91\x89\x13W\xca.}$yb\x9d\xe0&iA\a]\x92\xa5\xf1f9\x8f\x1f7\xf1sr\x9c,/\x1f+\xa3\x8d\x15\xabh\xe4\x1c\x86\xb1\uf75c\a\x80D\xa7œ\x82k'\r+\x92v\x14;\x8a\x8fC;rJJ\xb8\x87\xb5㲗:\xd4,Ο\xec\x18\xfe\xa4\xc1\x8acO\x83s˄PS\xe5y\x9e\xa45\xb5\x9a\xc3\xcb6;\x8a=\xbe\xb6\xa3^\xb7wu\"\x8c\x92rS\x93l\xb1\xdbU\xea\x04-\xfdS\xfc\x1cە\xb9\x9f\xb2$\xad\xd7'?\xc6Ii\x9b\xa7>\xc6i\x1a\xafc\x1b\xa2\x8c\x9f\xac\x03\xfec\xfcbe\xf4\x1a?.\xa76\xccc\x9cgţ=\xfbǤ\x8c\xd3e\xb8\x8d7\x9b%__,D[\xdb\xda\xf5\xf11\xfbǓ\x95\xe7~\x1d\xbfĶ\xf9\xfac\x92\xc7\xe52\x88-\xa8\xa9\x05dQ\x84\xa7qf\x03\x96\x89e\x06\x9c\x92\xddʶL\xb1\x11`\x81'ۻ\xbd\r\x9c\x928_\x0ej1\x176La\xe3d\xe9\x18S\x8bf7\xb5\xd51{HV\xf1f\xe9\xe7k\x92/\xdd/\xf0b*\xb1i\x97t\f\xeb`nr\f\b\xba}\xa3v\x95\x94\xd6\xd2\xdaS[\xc1_WV\xeda\xfa\xba\xb6\xc2g\x16P\xfc\x18\xff#N\x13\x1b\xe6).\xe2܆\xd8į\xb1uK<\x8bӵU\t\x9bAX\xbdǘXK\x05F\xb4Ďx\xb2A\x8b8\x8f\xb76\xddCX\xdf~\xa1[z\x1ac\xf3˩\x8a\xb09\x8b\xbfp9#K\xdd/\xb4\x97\xb6,\x019g\xf1\x17\x1a\xb8\x1b0\x8bf\xb3ռ\xbf\xa7XZ\b[\x1d\x89\r\xb6&\xf7q\xb2\xcem\x03aFHI\x92\xe5,~\xb5i_3\xd0M\x97\x1f\x93\xa7\xf8iok\x1e\x92g\xa0\x1f.\x87\xfb\xbcH\x9e\xc9\x01\x8a\x83\n\xf5,\xb1\rݙ\xad\xb8\xd9:\xb1C\xc1\xe3$h\x0fwI\x9a\xa4\x0f˚v\xcb\xd2\az0bA\xe5\xb6\"\xec7\xa5\xbdc\xbe\xc6pE]Ex\x16P|\x17\x97\xf6n\xe7\xc5\x0f\xcb\x19\xd8\r\xda6C^\x9c\xae\x93\xba\x9d\x92g\xd1H<\xf2\xb2\x8c\xe2d9\xb5\xe9V\x1ey\xb1\xccwޓ\xa5֞e.\xf2ltY\n\xa1K\xe3UIE\xbf&\xcby\x96\xa4\x96y\x83{\xe6\xb4\xc0\x8bG˔\xe2\xbd&\xf1\x13I\x1f\x96\xf3}\xbczܓ\xe5\xd56K\x1f\xaat\xfe\x83u\xe7\xeeo\x96\xc3\xc7Ķ\xfc\xf8\x9b\xa5o=\x1e\xf17\xeb\xe5\xd5>}\xb0\x9c\x92\b\xf4\xa4\x8c\xad;c\xc0yY^>.\xe9qZ]\xf29\xbc\x00]\xd5\xe2\x8aڄa\xf6\xb0>\x80\xdc\x1f\xcb8\xda\xe7Ou\x19/^\x1f\x1e\xf7\x96v\xf1\xf3Ķ\x99\U00069078\x05\xbc\x8dS+}\xf9H\xf2\x1a\xf0r\xb0\xd9=\xc6wĺ\xbbd\x14#r\x1f\xef7\xe5r\x02o\x88\x01y\xb0\x8f\xb14T\xbd%[\xb2d\xfb\xea:\xb2\xc9\xe8\xc0.D\x92\xd4\xec?\x18\x9e.\x99\xab\xb8\xa8e\x00[\x98:\x1cU!\xed\x89\xe7\x16P\xfc\x18\xbf<֍\x82y\xbcپ\xe6$\xb5\xf1bAז\xe1kZ\xc6_\xea\xf17\x8fII\x96TS\xb6\x11\xed\x97\xc3$]^Ŗ\xa1:\xb7\xb4\xde\xdc\"\xf6\xb9\x8d\xb1\xe5\x8cn\xfe\x18?\x14\xcbyl\xc3d$M\xec\xba\xc6\xdc2\xd6\xe7\x96\tk\x9e\x13\xb0Z\"kh}8\xb7J\xa9\xddI\xcdbA\xf7\x1b\xf5\a\x16\xf3<ۑ\xbc<v\xd09\xb7\xacw\xf3\"\xde\xc0\x9cY\xbbi\xa4\xfb\x99*\x18\x1cs\x1e*q\x10\xaf\x93U\xbc\xb1\x1c\xf3R@\xabo\x1c\xf2\xb6\xfa\xf2\x90\x17~\x82\xfd\f\xf7W\xde\xea\xff\x8eSvڇ\x8f\x87Q\xcaN[KI\v!\x12\a\xad\xbe\xf3\xf9W^\x96\x0fN\xd0i\x8bO`B\x95 _Z&\xf2\xb3\xe6\x80<\xb0\xad\xf3$\x85\xcaY\x0f\x18\x02\xf2\x8f\xd8\x1c\x1a\xfc\xf9\x03\xd9m\xe2\x15\x01\xb7\x8a\xc3\xc78g\x1a\xd6\xfd\xfd\xfd\x9a\xebP\x01\xdc\xc2T\x19\x86\x16\x10\xbc᷀\xe3m\x9c'\xd6;\x910\xde\xe7q\xf1Xڎ C[\xa6t\x8fu\xb87\x85$-I\xba\"K\xeeQ\xc0\xb2\x96\x85\xb0$۶\x8d\xe1c\xfcl\x1d>a\xb2^[W\xd20Y\xdb'\xe80yH!\x9aebJ\x9d?\xf3\x00g\xccp,)w\xc9\xf9>\x95I\xd3\xc7xc+\x9e\xa5C\x87\xb6Be6\xd8}\xb9\x1ce\xa5\xf5\xa0\xa6v\x05\r\xb3<^\x86\xd9vGl\xf3j\x98\xbdf\xdb;[f\xd5y\x92\xf9\xd5U7_\xfa\xb5\b3\xddA\xe8\x13'\xca\xf4S\x04\xec\xcc\xc3 \xd4O\"\x0e\x10\xea'4H\xe2\xa0`\xda\x0f&\xc2}\xba\x7f\xb1\xa9\xb1\xe1\xeb&+\x93\xa5\x17?ؔOvpb\x83\xe7Il\xe9.Q\xfc\x10o\xb2\a+\xe2.Nm\x8a)\xd5c\x89\x1d\x1eY;+`\xae\x13R\xda1\x9f2\x1b\xfc\xc9V\xbb(\xde&\x1b\x1b8}(b;|o˕l\xf6\x0f{\x1b\x9c\x8d\xdc%\x9d\xe4\xf7\xb1}\xab\x1e=\xc6\xd6\x13\x9e\xe8\xd1vB\x12%w\xc4:\aE\xc9=l!\x1em\x98\xfcq_\xdar\x80\xfef\x9bK)\x82o^\xc5C\x95(k,\xa9\x1eC\xed\a-\x9d\xd4\xde-9\xaa\xb6kۇ\a-]\xb6~\xb46Z\xb6\xc9҇eh\xd5\xfb\xa3\xac\xb45\xff~\xb3_F\xc9C\xbc\xb1\uec28\xe2\\<\xda/\xb5\x17\xb0U\xb0N\x8d\v\xb8\x11 \xeb\xa5<\xa5\xb6\x90@\xddl\x12\xa6\b.\xe1\xa6\x06\xa4B\xac\xac^\u05f6\xbe@\x9dVQ%!$\x1b\xb2\xaa,\x98\x8c\xeb5\xec9\xe8\x03\xbe\x1fZ\xa7?6\x7fl\xfe\xc0S'\xe5\xe3\xd3\xfe7\v\xdf\x1b\xf0m\x95\xd9\xe0p\x86\xbb\x1c&\xb6K\x9a\x83\x9a\xe5'\xf2\xcfĶ\x9d\xffd\x81\xfdb\x01\xc5i|\x17\xff3Η\xe1o\xfb8\xb7d\xf0\x8be\x18\xff\xb2\xb3\xc0\n\xcbt\xbev>w~\xa5\x1d\xef\x8f\xdb\xff\xfcm_\u07b7\xfa\xdf\xc4\xfe\xa7\xd9\xec\xf6ڦ\xfd_\xaf\xfd\xdd\xff˷\xb5\xffa=\x02Y\x01\xb1O滅\xbe\xa9\x80\xf3\xbe\x06\xbcw`z\xb8\x9aU\x05\x84S\xf3G$\x85\x84\x03\x05\x9aC\x19\x9er\xcb[⾫]\x9dk\xddT\xf2\xb1\xe6㦊\x0fO]e\xa8\xeeT\xf6y\x9e=ĥ\xf5\xee\x04\x98LI*Q`\xd8\xfc\x97\xff\xc8\x7f\xa8\xb5\xcf\xfe\xf2m\xc6\x7f\xab\xd3\xea\x9a\xe3\xbf\xdb\xfdn\xff\xf7\xed\xc7\xff\x996\xfcϪ\xa3\x7f'_e\xa9\xb1\x8f\xde\x1c\xb3\x91=\x8d\x8bR\xa3\x86qɇe\x91\xfc\x93\xe0\xc7\fz\x8aI\xca_\xca O\x13\x87S~]>_\x9b\x87\x9bV\xf2x\xef\xe09\x82{\xfa\xd9o6FA\xd0\xdc\"\xb0\x96\x8c\xf1\x03" +
+	"\xaa\xba\v\x159?\r\xb3}Z\xa2,T\xfe\x12k\xab\x9bp\x95*\xbc.\xa4\xdc?\aV\xd9j\xe7@\x94*$\x9b{\xea\xac\xea\f\xd1Ӄ\xc8Ɲ\x83+͕\xc3h<\x8b\xbf \x87\x02\xd7\xe0%\xd1.!\x8a\xd2\xe6q\x13i\x97\xdc_\xbe\xff\xfb#\xf3\xffo\xfb?\xd9\x03\xe0\xf1\xf7\x1f-\xd3\xfe\xbb\xdbi\x7f\x9f\xff\xbf\xd9\xfc\xff\xdb^7\xff\x86\xef\xefC\xe5?\xf2\xdf~\x9f\xac\xff\xec<\x8e\x8d\xff\xd3\xca\xfe\xaf\xd9j~\x1f\xff\xdfj\xfc\xef\x935\x1a\xfd\U0010bbbc\xb3\x18\x1c(,\x16\x93\x91\x00\xec\v\x1e\x89B-\xc4\b\r1dM\xc0u\xb7\n:5@\xc9F\a\x98Y4\x00g8\x1a\x03\x90\xf3\xb9\xd5\xd7<\xde\x00\xb0\xd6\x13\x10C\xbe\xe9\xad?#\xfd\x1aG\x02\xefX\x12\xdd;\xc0\x9d\xdd5\x00#\x15~a\x9e\x1d\xf6\x8d]\xf51HAJ~\x16\xd6xf\x7f\x99\x9ae!\xa3\xd6\x01ച\xfe\xe5d\xf6\xf6\x7f&\xe9:\xcb\xff\xf6\x90m\xe2\xf4\xe1\xc7,\x7f\xf8ۗ\xbf\xa5\xa4\xfc\xdb:-\xe0\xbf-s\xb6\xf6g\x8e\xffv\xb7\xd35\xc7\x7f\xbf\xdbm}\x1f\xff\xdff\xfc\xbf\xadG\xa8\x19\x02\xc3\xe8X\x1c\f\x06\x83\x80\x14\xd9>_\xc9\vU\x80\xc9\xf1\xa9\xbcL`\xd2\x13''\xf1\x86{\xd5\xd7<\xea\xebT\xb5λ\f:(_c[<ȍ\x1a\xc4n\xcdIAG\x0e\xba\xba\x14\xa7W\b\xed\xdf\xdf3\xef8\x95\xe1\xcd*X\xa9\x9d\xf3\xb9k\xd6\xec\r\xd5\xfa\x93\xcbZ\xcd\xc6\":Z!\x1er\xe0͞P9\xfd\x89\xe3\xa6p\x0e=T\xa5i\x9cT\x89`\x80\x954\xc0'L\xe2dŜ\x85hs\xa0\"\xa6;ȟ\xf7\xa4\x00\xaa\xa2q\x98n\x90\x16/$?J\xb5/\x1f3\xb8\x04!G)\xd7\xeb\x84ٿ\x1f\xa0L\xd2UN\xef\xe9CV\x15\xb6\x0f\xaf%\x17ui\xfc&\x7f\xd6\xd2\xd2\xc89\xa2\xb98\xfbz\xceCo0s\x05u\xe3\xd1\x11?\xafH\xbc&9\x1cLh\x14\xb5|f\xb7\a\x99\xccn\x8fr\xf0\u0083\x1c\xbc\xf0(\x87y\x14\x1cd\x81\xf0\xf5M\xe8\x0f\x0e\xf2@\xf8Z\x1e\xd1mt\x90\a\xc2ח#\xb8>\\\x8e\xe0\xfa(\x8f\xc359^\x0f<\x15\xdaY\f\x06ǹ\xf8\xf3\xc3\xd2@\xf8Z\x1e\x8b\xf4)\xcd^҃|\f\x9aZ^\xe3$M\x8a\xc7\x03N\x99$\xe5\x03II\x9e\xac\xc2\xeb\xe1\xc5\xe1\xb6@\x04\xf5\r\xfa/\xe1r\x15E\xf3\xc3\xe3D\xa3\x90|\xd8\xebW<\x92\xa5\x1b'\x00Ҙ\xc0jj6\x86|\xdd\xdac\x90\xfd\x99돑U\xdd\x1a4\xdc\xc4E\xe1h\x17:\r\n\xab\xd5\xe09\xd6\u0090\x1d9R\xfc\xc0\xfb\xc4Y\xff\xddi\xf7z\x1anx5\xf0C\x89\xed\xe8\xb8\xd0s#\x95R\xc3]\xb9\xe1\xc4\x1fIdWCNp:\xfeD\xc1\xcds/+\xe9\x12C֢]9\x82O\xf3\xa3,%Z\x8bk\x9dA\xb48\xeeh\xaa͍~S\xd7\xe6\x06Y]C\xb0\xfe(r\x9c\x8c\xa4\xd5]@\x8a]\x96\x16\x84\xc7Q\xf7w`\xe5\xca\xff|p\xc4*\x1b\x97ɳ \x11^\xcd\xd6\xfc; \xab=ݹ\x8cH\x91\xe4U\xf0\xe09N6\xd4\x0e\x8f!\x80'I\xcbd\x05\x8e\xdf9\x8c*\x12I\xfa0J\n\xa0\x94<ha\xe8\xff\x91`hUx\xf7>q\x1a\xc9\xda\x11=\xf8.)Eo;\xa9\xd0\u05c9F-\x85B<\xe0\x89N\nhvk\f\xc6\xd9\xed\xf1V\xc14\x7f\xe60\xc4\xf9\xd4\xd6O\xb9ֆ\xca1q|\x90z\vܼ\x8a\xdf\x1f\x1c\xae|\x81a$g\xac:AB\f\xb8R\xac\x10\x1c\t\x8ae\f\xab\x85!\x03s:\x95\x94sަu\xd5\x15tl\xdbN\xa9\x8fo\xdbe\xaa\xbaM\x06\x1cyx\xe4\xc5\xf4\x82\x7fB\x1b\x9eKѫ\f[/4:\x86\xf7\x86\xe1\x8ai\xfe̎\xe1\xbdaN\x10\x01̡2t,~n\xf7z<4\xfb\x94\xa4\x0fpl\x94\xa4噬!$\xa8\x8f\x82\xc0\xb0\x97\xd9a\xfc\x9fZ\xe9\x98\xf9&\xae\xe4\x91ɔ\xd4PLK\xe6\x91\x17\xbe\x9aӐ*\"ɣ#\xe6\r\x8eU\xe4\xd5~B%c\xec'\x91\x1a%\x84\xec\xefĀc\xbfT\xd7\xd1t\xae\xba\xbe\xa3\x11\xfd\x99r\xd42\xaa\xeb=|\xa5\xd0\xd7v\x06<\x94\b\a\xe7@\f>8#\xe5f\x13\xc9eǶVu\xfc\xd0\x0eBN\xdeQ`\x8cKm\x9bQ'\\\x8d\xe8\xcf\x14\xae\x96Qm\xbd\xe2\xbc\xf8\x8a\xad;#\xe7\xfb]m\x9em\bE\xd4,\x05OA7\xa7\x83\xf5s\f\xae\xf1\v\xb2\xaa\xdb\xca\v\xfa\\\xa8\xba\x1amCԨ6\x9f\\ӋkR\x1f)k\xf1\x94\xec\x02k\xfe\xf6\xb2\xcaM\xfa\x89\xd3\x10\xbfk\x99\x0f6\x1b|@\xd1\xf8\xfc\xeb\xd1$\xe1S\xb2Cy\xd8\v\x01D\x06o;![y\xb9|\xde.\x14\x96\xac\xf1\x966\x18l6\xeal\xa5\xf1\xf9ף\th\xd9\x05\xff\x83ի;\xb3\x91Ys\x15\xe2\xf5\xeb\xeb'R\xbe\xb9\x8a\xda\xc1Л\xab\x89\xb29\\\xd3\x03\xe7N\xb2\x14R1\xfa\xfa\xeaʤo\xae\xafv\xbc\xf5\xe6\xfa\xe2|\x0eW\xf8\xc0\xf1\x99 \xd5\x0f\xadN\x9c\x86\x06\xa8-\b:\xa3:q\x1a\xb3ۣ\tБ\x14,\xba\xe1\xd1\x04\xf8\x04\xea\xc4i\xa0\xcfz\xe9\xa0\x03\xa7\x13\xa7\x81>k\x93\xe0\xf3%\x88*s\x1b\x1dM\x82\x8f\x93 \x97\xe0\xfah\x12\xadX\xc7\v\xa5\x1d\x16A\x8a\xc1\xe0x\"|6t\xe24\xd0gm\x12\xf3(\b\xae\xf2tPmR\xdbyN\U0007ce8b\xa8ok\x16\x95\x1c#륉9\xbc5\x91~t\x03K%\x06\x18ڜ\x98\xbf\xa5\xf2\x0f\xea\x1f\xfc\xef\x83#K\xfb\x81\x9f\x10\xd0\xff\xab\xc5Y\x1d\x10\xff\x99j\x85ʥN\xa7\b,\xfaZ0<\x14\xd38\xa8U\xe6\xb8\xc5\x18\xe0Y\xcc\x12fnƲ@!\xe9(\x00Ĥ\xe3;:>+'\xf0d\v\x8e\xdf\xc9Z\x12u5\xa2\x80\xdc\xef\v\x84\xediؐ\xe4\xcf$\x1f\xc7\xc9f\x9f\x13I\xd3\xd6i\xf6\xab\x15)\n\x89m\n\xb9\x18\n$?*\xd1'\xec\x0f\xceE\xb6~\x95@\xf8P-\xfc\x96k\xac\xff\x8d+,\\:GE\x1d\xae\x14\xeaßQ\x86\x0f\xa6j\xfd\xbbQ*\xfd\b\xea\xe8\x00\xfa\xe0D\xd1T>\xf1D\xfbQ\xfa8Ԑ\xea\x813\x9e\x1aJ*\x81l\xb3\x9e\xfd\x0f\x85\x80\x85\xb8!\x02A\x85b\x0eQ\xb3\b_\xb9Q\xad2\xb8O\xbe\x80-g]\x11v9\x98z2~\xfa\xbam2\nI鎼\xb0\xd9دw\xf3\xf8u\x031\x1a\t\xf7.O\xbe\x94\xe8\x18\xee=\xdc\f\x17d\xe5\x7f\xe4\xe1\x88\x0e3\x1eya\xe8\x0e\a\x9bM\xf6R\x8dBh\x12sgtk\x9aQ#_\xc9LO\xd8\x1f\xf1\xd0\xd1\x1f\xd4\x1c\xc5|pf\x17\xd9\x17\xfe3\xa4\x8e\xb8d\a\n\xc8}N\x8aG\xf4]\xe6\xaf\xf2\xcb\xfd\xb2Kr\"?gI\xaa:\x9f\xeam\xda]S\xddFR#\xfa3g|-\xa3\xbaI\x1f)\x16\xeat3\xa1\x9a\xae\xdcy\xdf\x10\x88-.?\xe7Y\xae>\xa28\x7f \xa5\xb1\xa1\xd6\xee\xbaj\xe5\x10\\\xff/\xc9!\xb8~\x83\x1c\x90FP+\bT\xd9\x0f\xb0\x05\x8f\xb7pV\x13^\x0f\xe9oT\x7f\xedf\xa8V\x00\x1aU\xfd\xccdБ\x92\xe6\xd6x\"\xaf\x8e\xc8\xfa#y=q\x1a,\x96\xa1\x9c*\x9e\x8c\x18\xa1:\x9f\xb0\x86\xcf{\a\xb3\xa9K=\"\x1bR\x92\x9a\x82h\x83XOWi祭u\x97\x87\x161\x91\x95h\xa5\x8fz\xf6\"\xba\xa4qR$(\x0eh+\x82d0\x9d{\x98#\xd6W\x04x\xe4_\xcd\xc1lF';5\xc9\xdc\xe1\x95A\xd23I&\xf3\xe7\xeeU\x92\x96\x06]\xd7B\u05f7\xd0\xf5\r\xa9\x00TWᴦ\xa9\xe9c\x1a\xcdQ\x01Q\x17Ve\x96\xbf\x1aei\x9a\x84^Ɲ\xa4XD\xda6\x89}P\xaf\r\xa23\x93\x88\xce=:MǤ\x89\xa6a\xb8߁u\x13Y_\xe6\xd9~W\x18)ι\xcc\xd0>Lt\xa6\xe86r>\xffj\x06\x19\xd5\xee\xff놳F\xf4g\xcegZFu\xf3\x19\x14\xcd\xe8\bl\xc3T\x17\x03\xf1ug\xe7\xc6\x1fW\xbf\xeeȀ\xfe\x1f\x8f\x06\n\x05\x1b/\x8eh\x9fi\x98\xe9T\"\xd4\x1d,\xc5\u070e\x03\x85jc\x14\xbb\xdd\xe68-\xd1\xd5\xc4\x1b\xfb\xb2\b\x1d\r\x05\x1b3\x81\xeak\xa9fZ\xaa\xae\x86\xba\x95p-\x89'Yi%\xf3瑀w5\t\xc0\x81\xb2`\xa4\xa5\b})\x99\xbe\x06\x0f\xae\x05\xbc\xa3\xd5\x03\xe6J\x99B++\xf4K\x91\x87\xc6\xeb\xe6\xa3,mK\xc4/6\xb6ײo+5\xdaz\x96^\xb1Ǩ\xeb\xe9\x15\xc2?\xb3\xb7W2\xab\xeb\xf1\xfc\xb0\x97]I}\x7f\xb8p\xf0\xdf\v\x89\x9f\xfe\xec<\x8e\xbe\xff\xe9V\xe2?4\xbf\xc7\x7f\xf9V\xf6\xbf\xd0#\x94u/\xfd\xfa>L\xbe\xff\xfb\xfe\xef\xfb\xbf\xef\xff\xfe\xe3\xfe\xfd\x7f\x03\x00u\x81\xfa\xbb\x00\xe2\v\x00"
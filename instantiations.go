@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/albrow/fo/compiler"
+)
+
+// instantiationReport describes the code that was generated for each generic
+// declaration in a package (see compiler.Instantiation). It holds only plain
+// data so that it can be stored in the build cache along with the generated
+// code.
+type instantiationReport struct {
+	Rows []compiler.Instantiation
+}
+
+// count returns the total number of instantiations.
+func (report *instantiationReport) count() int {
+	count := 0
+	for _, row := range report.Rows {
+		if row.Name != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// write writes the report to w as a table, followed by the totals.
+func (report *instantiationReport) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "GENERIC\tINSTANTIATION\tLINES\tBYTES\tSITES")
+	generics := map[string]bool{}
+	totalLines, totalBytes := 0, 0
+	for _, row := range report.Rows {
+		generics[row.Generic] = true
+		if row.Name == "" {
+			fmt.Fprintf(tw, "%s\t-\t0\t0\t\n", row.Generic)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n", row.Generic, row.Name, row.Lines, row.Bytes, strings.Join(row.Sites, ", "))
+		totalLines += row.Lines
+		totalBytes += row.Bytes
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d instantiations of %d generic declarations (%d lines, %d bytes)\n", report.count(), len(generics), totalLines, totalBytes)
+	return err
+}
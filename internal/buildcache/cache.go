@@ -0,0 +1,179 @@
+// Package buildcache implements a content-addressed cache for the results of
+// compiling Fo packages.
+//
+// Each entry is stored under a key which is the hash of everything that can
+// affect the result: the Fo source files, the version of the compiler, the
+// options it was run with, and the export data of the imported Fo packages.
+// Since a change to any of them results in a different key, entries never
+// need to be invalidated. Old entries are never removed automatically; the
+// cache directory can be deleted at any time.
+package buildcache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// EnvVar is the environment variable which sets the cache directory. Setting
+// it to "off" disables the cache.
+const EnvVar = "FOCACHE"
+
+// A Key identifies an entry in the cache.
+type Key [sha256.Size]byte
+
+// String returns the key in hexadecimal.
+func (k Key) String() string {
+	return hex.EncodeToString(k[:])
+}
+
+// A Hash computes a Key from a sequence of named inputs.
+type Hash struct {
+	h hash.Hash
+}
+
+// NewHash returns a new Hash.
+func NewHash() *Hash {
+	return &Hash{h: sha256.New()}
+}
+
+// Add adds an input to the hash. Both the name and the data are prefixed with
+// their length, so different sequences of inputs never run together into the
+// same bytes.
+func (h *Hash) Add(name string, data []byte) {
+	h.write([]byte(name))
+	h.write(data)
+}
+
+func (h *Hash) write(b []byte) {
+	var n [8]byte
+	binary.BigEndian.PutUint64(n[:], uint64(len(b)))
+	h.h.Write(n[:])
+	h.h.Write(b)
+}
+
+// Sum returns the key for the inputs added so far.
+func (h *Hash) Sum() Key {
+	var key Key
+	copy(key[:], h.h.Sum(nil))
+	return key
+}
+
+// A Cache stores entries as files in a directory.
+type Cache struct {
+	dir string
+}
+
+// Open opens the cache in dir, creating the directory if necessary.
+func Open(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Cache{dir: dir}, nil
+}
+
+// Default opens the cache in the directory named by $FOCACHE, or in the fo
+// directory of the user's cache directory if it is not set. It returns nil if
+// the cache is turned off.
+func Default() (*Cache, error) {
+	dir := os.Getenv(EnvVar)
+	if dir == "off" {
+		return nil, nil
+	}
+	if dir == "" {
+		userDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(userDir, "fo", "build")
+	}
+	return Open(dir)
+}
+
+// Dir returns the directory where the cache is stored.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+func (c *Cache) filename(key Key) string {
+	name := key.String()
+	return filepath.Join(c.dir, name[:2], name)
+}
+
+// Get decodes the entry for key into v, which must be a pointer. It reports
+// whether the entry was found. An entry which can't be decoded (e.g. because
+// it was written by a different version of fo) is treated as missing.
+func (c *Cache) Get(key Key, v interface{}) bool {
+	data, err := ioutil.ReadFile(c.filename(key))
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// Put stores v as the entry for key. The entry is written to a temporary file
+// first, so concurrent readers never see a partially written entry.
+func (c *Cache) Put(key Key, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	name := c.filename(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), name)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+var (
+	executableOnce sync.Once
+	executableID   []byte
+	executableErr  error
+)
+
+// ExecutableID returns the hash of the running executable. It stands in for
+// the version of the compiler, since any change to the compiler results in a
+// different executable.
+func ExecutableID() ([]byte, error) {
+	executableOnce.Do(func() {
+		name, err := os.Executable()
+		if err != nil {
+			executableErr = err
+			return
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			executableErr = err
+			return
+		}
+		defer f.Close()
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			executableErr = err
+			return
+		}
+		executableID = h.Sum(nil)
+	})
+	return executableID, executableErr
+}
@@ -0,0 +1,98 @@
+package buildcache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type entry struct {
+	Outputs [][]byte
+}
+
+func TestHash(t *testing.T) {
+	sum := func(inputs ...string) Key {
+		h := NewHash()
+		for i := 0; i < len(inputs); i += 2 {
+			h.Add(inputs[i], []byte(inputs[i+1]))
+		}
+		return h.Sum()
+	}
+	if sum("a.fo", "package a") != sum("a.fo", "package a") {
+		t.Error("the same inputs have different keys")
+	}
+	different := [][]string{
+		{"a.fo", "package b"},
+		{"b.fo", "package a"},
+		{"a.f", "opackage a"},
+		{"a.fo", "package a", "b.fo", ""},
+	}
+	for _, inputs := range different {
+		if sum(inputs...) == sum("a.fo", "package a") {
+			t.Errorf("inputs %q have the same key as a.fo", inputs)
+		}
+	}
+}
+
+func TestGetPut(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open returned error: %s", err)
+	}
+
+	h := NewHash()
+	h.Add("a.fo", []byte("package a"))
+	key := h.Sum()
+	var got entry
+	if c.Get(key, &got) {
+		t.Fatal("Get found an entry in an empty cache")
+	}
+	want := entry{Outputs: [][]byte{[]byte("package a\n")}}
+	if err := c.Put(key, want); err != nil {
+		t.Fatalf("Put returned error: %s", err)
+	}
+	if !c.Get(key, &got) {
+		t.Fatal("Get did not find the entry which was put")
+	}
+	if len(got.Outputs) != 1 || string(got.Outputs[0]) != "package a\n" {
+		t.Errorf("wrong entry (expected %q but got %q)", want.Outputs, got.Outputs)
+	}
+
+	// Corrupted entries are treated as missing.
+	if err := ioutil.WriteFile(c.filename(key), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if c.Get(key, &got) {
+		t.Error("Get returned a corrupted entry")
+	}
+}
+
+func TestDefault(t *testing.T) {
+	old := os.Getenv(EnvVar)
+	defer os.Setenv(EnvVar, old)
+
+	os.Setenv(EnvVar, "off")
+	if c, err := Default(); err != nil || c != nil {
+		t.Errorf("expected no cache when %s=off but got %v, %v", EnvVar, c, err)
+	}
+
+	dir, err := ioutil.TempDir("", "buildcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(EnvVar, filepath.Join(dir, "cache"))
+	c, err := Default()
+	if err != nil {
+		t.Fatalf("Default returned error: %s", err)
+	}
+	if c.Dir() != filepath.Join(dir, "cache") {
+		t.Errorf("wrong directory (expected %s but got %s)", filepath.Join(dir, "cache"), c.Dir())
+	}
+}
@@ -1,22 +1,28 @@
 package main
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"time"
 
-	"github.com/albrow/fo/ast"
-	"github.com/albrow/fo/format"
-	"github.com/albrow/fo/importer"
-	"github.com/albrow/fo/parser"
-	"github.com/albrow/fo/token"
-	"github.com/albrow/fo/transform"
-	"github.com/albrow/fo/types"
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/mangle"
 	"github.com/urfave/cli"
 )
 
+var typeNamesFlag = cli.BoolFlag{
+	Name:  "typenames",
+	Usage: "register the Fo names of generic types with the fo/runtime package (see foruntime.TypeName)",
+}
+
 func main() {
 	app := cli.NewApp()
 
@@ -24,9 +30,119 @@ func main() {
 	app.Usage = "An experimental language which adds functional programming features to Go."
 	app.Commands = []cli.Command{
 		{
-			Name:   "run",
-			Usage:  "run a single .fo file",
-			Action: run,
+			Name:      "run",
+			Usage:     "build and run a program made of one or more .fo files, passing any remaining arguments to it",
+			ArgsUsage: "<files...> [--] [arguments...]",
+			Action:    run,
+			Flags: []cli.Flag{
+				typeNamesFlag,
+				cli.BoolFlag{
+					Name:  "work",
+					Usage: "print the name of the temporary directory holding the generated Go code and the program, and keep it after exiting",
+				},
+			},
+			// Flags after the file names belong to the program being run.
+			SkipArgReorder: true,
+		},
+		{
+			Name:      "build",
+			Usage:     "build a package of .fo files (and its _test.fo files), writing Go code and Fo export data to the package directory",
+			ArgsUsage: "[directory]",
+			Action:    build,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "importpath",
+					Usage: "import path of the package (determined with 'go list' if not provided)",
+				},
+				typeNamesFlag,
+				cli.BoolFlag{
+					Name:  "instantiations",
+					Usage: "print every generic declaration along with its instantiations, the sites that caused them, and the size of the generated code",
+				},
+				cli.IntFlag{
+					Name:  "max-instantiations",
+					Usage: "fail if the package requires more than the given number of instantiations (0 means no limit)",
+				},
+			},
+		},
+		{
+			Name:      "go",
+			Usage:     "convert every Fo package in the current module to Go in a cache directory and run a go command (" + strings.Join(goCommands, ", ") + ") with the generated code overlaid onto the source tree",
+			ArgsUsage: "<command> [arguments...]",
+			Action:    goCommand,
+			Flags: []cli.Flag{
+				typeNamesFlag,
+			},
+			// Flags after the go command belong to the go tool.
+			SkipArgReorder: true,
+		},
+		{
+			Name:      "watch",
+			Usage:     "check every Fo package in the current module whenever a .fo file changes, rebuilding only the changed packages and the packages which import them",
+			ArgsUsage: "[directory]",
+			Action:    watch,
+			Flags: []cli.Flag{
+				typeNamesFlag,
+				cli.BoolFlag{
+					Name:  "run",
+					Usage: "run the main package in the directory after each rebuild which affects it",
+				},
+				cli.BoolFlag{
+					Name:  "test",
+					Usage: "run the tests of each rebuilt package (only the package in the directory, if one is given)",
+				},
+			},
+		},
+		{
+			Name:      "check",
+			Usage:     "type-check every Fo package in the current module (or only the packages in the given directories) without converting them to Go, and print the errors",
+			ArgsUsage: "[directories...]",
+			Action:    check,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Value: "text",
+					Usage: "output format for the errors (" + strings.Join(checkFormats, ", ") + ")",
+				},
+			},
+		},
+		{
+			Name:   "repl",
+			Usage:  "start an interactive session in which Fo declarations, statements, and expressions can be entered and run",
+			Action: repl,
+		},
+		{
+			Name:   "playground",
+			Usage:  "start a web server with an editor in which Fo programs can be run, formatted, and shared",
+			Action: playgroundServer,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "addr",
+					Value: "localhost:8080",
+					Usage: "address to listen on. Programs run with the permissions of the playground and are not sandboxed, so anyone who can reach the address can run any code on this machine. Only listen on other interfaces (e.g. :8080) if you trust everyone on the network",
+				},
+				cli.StringFlag{
+					Name:  "snippets",
+					Value: "fo-snippets",
+					Usage: "directory in which shared programs are stored",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Value: 10 * time.Second,
+					Usage: "maximum time a program may run for",
+				},
+				cli.IntFlag{
+					Name:  "memory",
+					Value: 256,
+					Usage: "maximum memory a program may allocate, in megabytes (0 means no limit; not enforced on Windows)",
+				},
+			},
+		},
+		{
+			Name:      "demangle",
+			Usage:     "convert generated names back to Fo type expressions (reads from stdin if no names are given)",
+			ArgsUsage: "[names...]",
+			Action:    demangle,
 		},
 	}
 
@@ -37,63 +153,226 @@ func main() {
 }
 
 func run(c *cli.Context) error {
-	// Read arguments and open file.
-	if !c.Args().Present() || len(c.Args().Tail()) != 0 {
-		return errors.New("run expects exactly one argument: the name of a Fo file to run")
+	filenames, args := splitRunArgs(c.Args())
+	if len(filenames) == 0 {
+		return errors.New("run expects one or more Fo files to run, optionally followed by arguments for the program")
 	}
-	f, err := os.Open(c.Args().First())
+
+	// Check types and transform to pure Go.
+	b, err := newBuilder(c.Bool(typeNamesFlag.Name))
 	if err != nil {
-		return fmt.Errorf("could not open file: %s", err)
+		return err
 	}
-	if !strings.HasSuffix(f.Name(), ".fo") {
-		return fmt.Errorf("%s is not a Fo file (expected '.fo' extension)", f.Name())
+	pkg, err := b.compile(".", "main", filenames, nil)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
 	}
 
-	// Parse file.
-	fset := token.NewFileSet()
-	nodes, err := parser.ParseFile(fset, f.Name(), f, 0)
+	// Build and run the program in a temporary directory so that the generated
+	// code never overwrites any files next to the source code.
+	workDir, err := ioutil.TempDir("", "fo-run-")
 	if err != nil {
 		return err
 	}
-
-	// Check types.
-	conf := types.Config{Importer: importer.Default()}
-	info := &types.Info{
-		Selections: map[*ast.SelectorExpr]*types.Selection{},
-		Uses:       map[*ast.Ident]types.Object{},
+	if c.Bool("work") {
+		fmt.Fprintf(os.Stderr, "WORK=%s\n", workDir)
+	}
+	code, err := buildAndRun(workDir, filenames, pkg.Outputs, args)
+	if !c.Bool("work") {
+		os.RemoveAll(workDir)
 	}
-	pkg, err := conf.Check(f.Name(), fset, []*ast.File{nodes}, info)
 	if err != nil {
-		fmt.Println(err.Error())
-		os.Exit(1)
+		return err
+	}
+	if code != 0 {
+		os.Exit(code)
+	}
+	return nil
+}
+
+// splitRunArgs splits the arguments to run into the Fo files to run and the
+// arguments for the program. The files come first and are followed by an
+// optional "--".
+func splitRunArgs(all []string) (filenames []string, args []string) {
+	for i, arg := range all {
+		if arg == "--" {
+			return all[:i], all[i+1:]
+		}
+		if !strings.HasSuffix(arg, ".fo") {
+			return all[:i], all[i:]
+		}
+	}
+	return all, nil
+}
+
+// buildAndRun writes the Go code in outputs to dir, builds it, and runs the
+// resulting program with args. The program shares the standard input and
+// output of the current process. It returns the exit code of the program.
+func buildAndRun(dir string, filenames []string, outputs [][]byte, args []string) (int, error) {
+	var goFiles []string
+	for i, filename := range filenames {
+		goFile := filepath.Join(dir, strings.TrimSuffix(filepath.Base(filename), ".fo")+".go")
+		for _, other := range goFiles {
+			if other == goFile {
+				return 0, fmt.Errorf("more than one file named %s", filepath.Base(filename))
+			}
+		}
+		if err := ioutil.WriteFile(goFile, outputs[i], 0644); err != nil {
+			return 0, err
+		}
+		goFiles = append(goFiles, goFile)
+	}
+	exe := filepath.Join(dir, strings.TrimSuffix(filepath.Base(filenames[0]), ".fo"))
+	if runtime.GOOS == "windows" {
+		exe += ".exe"
+	}
+
+	// The go command is run in the current directory so that imports are
+	// resolved the same way they would be for the source code.
+	build := exec.Command("go", append([]string{"build", "-o", exe}, goFiles...)...)
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return 0, fmt.Errorf("go build failed: %s", err)
+	}
+
+	return runCommand(exec.Command(exe, args...))
+}
+
+// runCommand runs cmd with the standard input and output of the current
+// process and returns its exit code.
+func runCommand(cmd *exec.Cmd) (int, error) {
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if code := exitErr.ExitCode(); code > 0 {
+				return code, nil
+			}
+			// The command was killed by a signal.
+			return 1, nil
+		}
+		return 0, err
 	}
+	return 0, nil
+}
 
-	// Transform to pure Go and write the output.
-	trans := &transform.Transformer{
-		Fset: fset,
-		Pkg:  pkg,
-		Info: info,
+func build(c *cli.Context) error {
+	if len(c.Args()) > 1 {
+		return errors.New("build expects at most one argument: the directory containing the package")
 	}
-	transformed, err := trans.File(nodes)
+	dir := "."
+	if c.Args().Present() {
+		dir = c.Args().First()
+	}
+	filenames, testFilenames, err := foFiles(dir)
 	if err != nil {
 		return err
 	}
-	outputName := strings.TrimSuffix(f.Name(), ".fo") + ".go"
-	output, err := os.Create(outputName)
+	if len(filenames) == 0 {
+		return fmt.Errorf("no Fo files in %s", dir)
+	}
+	importPath := c.String("importpath")
+	if importPath == "" {
+		importPath, err = goImportPath(dir)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check types and transform each file to pure Go, or get the results
+	// from the build cache if nothing has changed.
+	b, err := newBuilder(c.Bool(typeNamesFlag.Name))
 	if err != nil {
 		return err
 	}
-	if err := format.Node(output, fset, transformed); err != nil {
+	pkg, err := b.compile(dir, importPath, filenames, testFilenames)
+	if err != nil {
 		return err
 	}
 
-	// Invoke Go command to run the resulting Go code.
-	cmd := exec.Command("go", "run", outputName)
-	cmd.Stderr = os.Stderr
-	cmd.Stdout = os.Stdout
-	cmd.Stdin = os.Stdin
-	if err := cmd.Run(); err != nil {
+	// Report on and enforce the limit for instantiations before writing
+	// anything.
+	report := pkg.Instantiations
+	if c.Bool("instantiations") {
+		if err := report.write(os.Stdout); err != nil {
+			return err
+		}
+	}
+	if max := c.Int("max-instantiations"); max > 0 && report.count() > max {
+		return fmt.Errorf("package %s requires %d instantiations of generic types and functions, which exceeds the limit of %d (use -instantiations to see them)", importPath, report.count(), max)
+	}
+
+	exportName := filepath.Join(dir, pkg.Name+foexport.Ext)
+	if err := ioutil.WriteFile(exportName, pkg.ExportData, 0644); err != nil {
+		return err
+	}
+	if err := writeOutputs(filenames, pkg.Outputs); err != nil {
 		return err
 	}
+	return writeOutputs(testFilenames, pkg.TestOutputs)
+}
+
+// foFiles returns the names of the .fo files in dir, with the _test.fo files
+// separated from the others.
+func foFiles(dir string) (filenames, testFilenames []string, err error) {
+	allFilenames, err := filepath.Glob(filepath.Join(dir, "*.fo"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(allFilenames)
+	for _, filename := range allFilenames {
+		if strings.HasSuffix(filename, "_test.fo") {
+			testFilenames = append(testFilenames, filename)
+		} else {
+			filenames = append(filenames, filename)
+		}
+	}
+	return filenames, testFilenames, nil
+}
+
+// writeOutputs writes the Go code in outputs to a .go file corresponding to
+// each Fo file in filenames.
+func writeOutputs(filenames []string, outputs [][]byte) error {
+	for i, output := range outputs {
+		outputName := strings.TrimSuffix(filenames[i], ".fo") + ".go"
+		if err := ioutil.WriteFile(outputName, output, 0644); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+func demangle(c *cli.Context) error {
+	if c.Args().Present() {
+		for _, name := range c.Args() {
+			demangled, err := mangle.Demangle(name)
+			if err != nil {
+				return err
+			}
+			fmt.Println(demangled)
+		}
+		return nil
+	}
+	// Act as a filter so that the output of a program (e.g. a stack trace) can
+	// be piped through fo demangle.
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fmt.Println(mangle.DemangleText(scanner.Text()))
+	}
+	return scanner.Err()
+}
+
+// goImportPath uses the go command to determine the import path for the
+// package in dir.
+func goImportPath(dir string) (string, error) {
+	cmd := exec.Command("go", "list", "-e", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("could not determine import path for %s (use -importpath): %s", dir, err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitRunArgs(t *testing.T) {
+	testCases := []struct {
+		all       []string
+		filenames []string
+		args      []string
+	}{
+		{nil, nil, nil},
+		{[]string{"main.fo"}, []string{"main.fo"}, nil},
+		{[]string{"main.fo", "util.fo"}, []string{"main.fo", "util.fo"}, nil},
+		{[]string{"main.fo", "-v", "x"}, []string{"main.fo"}, []string{"-v", "x"}},
+		{[]string{"main.fo", "x", "y.fo"}, []string{"main.fo"}, []string{"x", "y.fo"}},
+		// A program argument ending in .fo needs a "--" before it, since
+		// otherwise it is taken to be one of the files to run.
+		{[]string{"main.fo", "input.fo"}, []string{"main.fo", "input.fo"}, nil},
+		{[]string{"main.fo", "--", "input.fo"}, []string{"main.fo"}, []string{"input.fo"}},
+		{[]string{"main.fo", "--", "--", "-v"}, []string{"main.fo"}, []string{"--", "-v"}},
+		{[]string{"main.fo", "--"}, []string{"main.fo"}, []string{}},
+		{[]string{"--", "main.fo"}, []string{}, []string{"main.fo"}},
+		{[]string{"-v", "main.fo"}, []string{}, []string{"-v", "main.fo"}},
+	}
+	for _, tc := range testCases {
+		filenames, args := splitRunArgs(tc.all)
+		if !reflect.DeepEqual(filenames, tc.filenames) || !reflect.DeepEqual(args, tc.args) {
+			t.Errorf("splitRunArgs(%q): expected %q and %q but got %q and %q", tc.all, tc.filenames, tc.args, filenames, args)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package mangle
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	keywordToken
+	predeclaredToken
+	sepToken
+)
+
+type mangledToken struct {
+	kind tokenKind
+	text string
+}
+
+// Demangle returns the Fo type expression for a name that was generated for
+// an instantiation of a generic declaration, e.g. List[map[string]ast.Ident]
+// for List__map_string_ast_Ident. It returns an error if name is not a valid
+// mangled name.
+func Demangle(name string) (string, error) {
+	if !strings.Contains(name, argSep) {
+		return "", fmt.Errorf("%s is not a mangled name", name)
+	}
+	tokens, err := tokenize(name)
+	if err != nil {
+		return "", err
+	}
+	d := &demangler{tokens: tokens}
+	result, err := d.name()
+	if err != nil {
+		return "", err
+	}
+	var args []string
+	for !d.done() {
+		if _, err := d.expect(sepToken); err != nil {
+			return "", err
+		}
+		arg, err := d.typ()
+		if err != nil {
+			return "", err
+		}
+		args = append(args, arg)
+	}
+	return result + "[" + strings.Join(args, ", ") + "]", nil
+}
+
+var identRegexp = regexp.MustCompile(`[\pL_][\pL\pN_]*`)
+
+// DemangleText replaces every mangled name in text with the corresponding Fo
+// type expression. Identifiers which are not valid mangled names are left as
+// is. It is useful for making stack traces and profiles easier to read.
+func DemangleText(text string) string {
+	return identRegexp.ReplaceAllStringFunc(text, func(ident string) string {
+		if demangled, err := Demangle(ident); err == nil {
+			return demangled
+		}
+		return ident
+	})
+}
+
+func tokenize(s string) ([]mangledToken, error) {
+	var tokens []mangledToken
+	for _, part := range strings.Split(s, sep) {
+		if part == "" {
+			tokens = append(tokens, mangledToken{kind: sepToken})
+			continue
+		}
+		switch part[0] {
+		case '0':
+			if len(tokens) == 0 || tokens[len(tokens)-1].kind != identToken {
+				return nil, fmt.Errorf("invalid mangled name %s: unexpected _%s", s, part)
+			}
+			tokens[len(tokens)-1].text += "_" + part[1:]
+		case '1':
+			tokens = append(tokens, mangledToken{kind: identToken, text: "_" + part[1:]})
+		case '2':
+			tokens = append(tokens, mangledToken{kind: identToken, text: part[1:]})
+		default:
+			switch {
+			case part[0] >= '0' && part[0] <= '9':
+				return nil, fmt.Errorf("invalid mangled name %s: unexpected %s", s, part)
+			case isKeyword(part):
+				tokens = append(tokens, mangledToken{kind: keywordToken, text: part})
+			case isPredeclared(part):
+				tokens = append(tokens, mangledToken{kind: predeclaredToken, text: part})
+			default:
+				tokens = append(tokens, mangledToken{kind: identToken, text: part})
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type demangler struct {
+	tokens []mangledToken
+	pos    int
+}
+
+var errUnexpectedEnd = errors.New("invalid mangled name: unexpected end")
+
+func (d *demangler) done() bool {
+	return d.pos >= len(d.tokens)
+}
+
+func (d *demangler) next() (mangledToken, error) {
+	if d.done() {
+		return mangledToken{}, errUnexpectedEnd
+	}
+	tok := d.tokens[d.pos]
+	d.pos++
+	return tok, nil
+}
+
+func (d *demangler) expect(kind tokenKind) (string, error) {
+	tok, err := d.next()
+	if err != nil {
+		return "", err
+	}
+	if tok.kind != kind {
+		return "", fmt.Errorf("invalid mangled name: unexpected %q", tok.text)
+	}
+	return tok.text, nil
+}
+
+// name parses the name of the generic declaration, which is either a single
+// identifier or a qualified identifier.
+func (d *demangler) name() (string, error) {
+	name, err := d.expect(identToken)
+	if err != nil {
+		return "", err
+	}
+	if !d.done() && d.tokens[d.pos].kind == identToken {
+		d.pos++
+		name += "." + d.tokens[d.pos-1].text
+	}
+	return name, nil
+}
+
+func (d *demangler) qualifiedIdent() (string, error) {
+	pkg, err := d.expect(identToken)
+	if err != nil {
+		return "", err
+	}
+	name, err := d.expect(identToken)
+	if err != nil {
+		return "", err
+	}
+	return pkg + "." + name, nil
+}
+
+func (d *demangler) typ() (string, error) {
+	tok, err := d.next()
+	if err != nil {
+		return "", err
+	}
+	switch tok.kind {
+	case predeclaredToken:
+		return tok.text, nil
+	case identToken:
+		d.pos--
+		return d.qualifiedIdent()
+	case keywordToken:
+		return d.composite(tok.text)
+	}
+	return "", fmt.Errorf("invalid mangled name: unexpected %q", tok.text)
+}
+
+func (d *demangler) composite(keyword string) (string, error) {
+	switch keyword {
+	case "ptr":
+		return d.prefixed("*")
+	case "slice":
+		return d.prefixed("[]")
+	case "chan":
+		return d.prefixed("chan ")
+	case "sendchan":
+		return d.prefixed("chan<- ")
+	case "recvchan":
+		return d.prefixed("<-chan ")
+	case "map":
+		key, err := d.typ()
+		if err != nil {
+			return "", err
+		}
+		return d.prefixed("map[" + key + "]")
+	}
+	switch {
+	case strings.HasPrefix(keyword, "constneg"):
+		return "-" + strings.TrimPrefix(keyword, "constneg"), nil
+	case strings.HasPrefix(keyword, "const"):
+		return strings.TrimPrefix(keyword, "const"), nil
+	case strings.HasPrefix(keyword, "array"):
+		return d.prefixed("[" + strings.TrimPrefix(keyword, "array") + "]")
+	case strings.HasPrefix(keyword, "func"):
+		return d.signature(keyword, "func")
+	case strings.HasPrefix(keyword, "struct"):
+		fields, err := d.fields(strings.TrimPrefix(keyword, "struct"), false)
+		if err != nil {
+			return "", err
+		}
+		return "struct{" + fields + "}", nil
+	case strings.HasPrefix(keyword, "iface"):
+		methods, err := d.fields(strings.TrimPrefix(keyword, "iface"), true)
+		if err != nil {
+			return "", err
+		}
+		return "interface{" + methods + "}", nil
+	case strings.HasPrefix(keyword, "tuple"):
+		n, _ := strconv.Atoi(strings.TrimPrefix(keyword, "tuple"))
+		elems, err := d.types(n)
+		if err != nil {
+			return "", err
+		}
+		return "(" + strings.Join(elems, ", ") + ")", nil
+	case strings.HasPrefix(keyword, "gen"):
+		n, _ := strconv.Atoi(strings.TrimPrefix(keyword, "gen"))
+		name, err := d.qualifiedIdent()
+		if err != nil {
+			return "", err
+		}
+		args, err := d.types(n)
+		if err != nil {
+			return "", err
+		}
+		return name + "[" + strings.Join(args, ", ") + "]", nil
+	}
+	return "", fmt.Errorf("invalid mangled name: unexpected %q", keyword)
+}
+
+func (d *demangler) prefixed(prefix string) (string, error) {
+	elem, err := d.typ()
+	if err != nil {
+		return "", err
+	}
+	return prefix + elem, nil
+}
+
+func (d *demangler) types(n int) ([]string, error) {
+	result := make([]string, n)
+	for i := range result {
+		typ, err := d.typ()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = typ
+	}
+	return result, nil
+}
+
+// signature parses the parameter and result types for the func keyword and
+// returns the signature with the given prefix.
+func (d *demangler) signature(keyword string, prefix string) (string, error) {
+	counts := strings.TrimPrefix(keyword, "func")
+	variadic := strings.HasSuffix(counts, "v")
+	counts = strings.TrimSuffix(counts, "v")
+	parts := strings.Split(counts, "to")
+	numParams, _ := strconv.Atoi(parts[0])
+	numResults, _ := strconv.Atoi(parts[1])
+	params, err := d.types(numParams)
+	if err != nil {
+		return "", err
+	}
+	if variadic && numParams > 0 {
+		last := params[numParams-1]
+		if !strings.HasPrefix(last, "[]") {
+			return "", fmt.Errorf("invalid mangled name: variadic parameter %s is not a slice", last)
+		}
+		params[numParams-1] = "..." + strings.TrimPrefix(last, "[]")
+	}
+	results, err := d.types(numResults)
+	if err != nil {
+		return "", err
+	}
+	result := prefix + "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		result += " " + results[0]
+	default:
+		result += " (" + strings.Join(results, ", ") + ")"
+	}
+	return result, nil
+}
+
+// fields parses the fields of a struct or, if methods is true, the methods of
+// an interface.
+func (d *demangler) fields(count string, methods bool) (string, error) {
+	n, _ := strconv.Atoi(count)
+	fields := make([]string, n)
+	for i := range fields {
+		tok, err := d.next()
+		if err != nil {
+			return "", err
+		}
+		if tok.kind == keywordToken && tok.text == embedded {
+			if fields[i], err = d.typ(); err != nil {
+				return "", err
+			}
+			continue
+		}
+		if tok.kind != identToken {
+			return "", fmt.Errorf("invalid mangled name: unexpected %q", tok.text)
+		}
+		if !methods {
+			fieldType, err := d.typ()
+			if err != nil {
+				return "", err
+			}
+			fields[i] = tok.text + " " + fieldType
+			continue
+		}
+		sig, err := d.next()
+		if err != nil {
+			return "", err
+		}
+		if sig.kind != keywordToken || !strings.HasPrefix(sig.text, "func") {
+			return "", fmt.Errorf("invalid mangled name: expected method signature but got %q", sig.text)
+		}
+		signature, err := d.signature(sig.text, "")
+		if err != nil {
+			return "", err
+		}
+		fields[i] = tok.text + signature
+	}
+	return strings.Join(fields, "; "), nil
+}
@@ -0,0 +1,327 @@
+// Package mangle implements the naming scheme for concrete instantiations of
+// generic types and functions.
+//
+// Go does not support generics, so every instantiation of a generic
+// declaration needs a name of its own which is also a valid Go identifier. The
+// name is made up of the name of the generic declaration followed by each of
+// the type arguments, separated by double underscores:
+//
+//	List[int]                     List__int
+//	Map[string, *bytes.Buffer]    Map__string__ptr_bytes_Buffer
+//	List[map[string]ast.Ident]    List__map_string_ast_Ident
+//
+// Each type argument is written as a sequence of tokens separated by single
+// underscores in prefix order. Predeclared types are written as is, and all
+// other named types are qualified with the name of the package they were
+// declared in (e.g. main_Item). Composite types start with a keyword:
+//
+//	ptr T                 *T
+//	slice T               []T
+//	arrayN T              [N]T
+//	map K V               map[K]V
+//	chan T                chan T
+//	sendchan T            chan<- T
+//	recvchan T            <-chan T
+//	funcNtoM P... R...    func(P...) (R...), or funcNtoMv if variadic
+//	structN F T...        struct{F T; ...}
+//	ifaceN M S...         interface{M S; ...}, where S is a func type
+//	tupleN T...           (T, ...)
+//	genN P Name A...      P.Name[A...]
+//
+// The arguments for constant type parameters are written as constN, or
+// constnegN if the constant is negative (e.g. Vec[float64, 3] becomes
+// Vec__float64__const3).
+//
+// Embedded struct fields and interfaces use the name "type". Underscores in
+// identifiers are escaped so that the encoding can always be reversed: an
+// underscore inside of an identifier is written as "_0", a leading underscore
+// is written as "1", and identifiers which start with a keyword or the name of
+// a predeclared type are prefixed with "2".
+//
+// Named types are qualified with the name of their package rather than its
+// import path, which keeps the names short. If two packages with the same name
+// are used in the same package, their names are followed by a hash of the
+// import path to tell them apart (see PackageName), e.g. List__util_0a1b2c3d4_T
+// for List[util_a1b2c3d4.T].
+//
+// The name of an instantiation only depends on the type arguments, so it does
+// not change from one build to the next.
+package mangle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+const (
+	sep      = "_"
+	argSep   = "__"
+	embedded = "type"
+)
+
+var keywordRegexp = regexp.MustCompile(`^(ptr|slice|map|chan|sendchan|recvchan|type|array\d+|func\d+to\d+v?|struct\d+|iface\d+|tuple\d+|gen\d+|const\d+|constneg\d+)$`)
+
+func isKeyword(s string) bool {
+	return keywordRegexp.MatchString(s)
+}
+
+// isPredeclared reports whether name is a predeclared type. The predeclared
+// constraints (e.g. Number) are excluded since they are never type arguments.
+func isPredeclared(name string) bool {
+	obj, ok := types.Universe.Lookup(name).(*types.TypeName)
+	if !ok {
+		return false
+	}
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+	return !ok || len(iface.TypeList()) == 0
+}
+
+// Ident returns the encoded form of a single identifier.
+func Ident(name string) string {
+	parts := strings.Split(name, "_")
+	first := parts[0]
+	switch {
+	case first == "" && len(parts) > 1:
+		parts = parts[1:]
+		first = "1" + parts[0]
+	case isKeyword(first) || isPredeclared(first):
+		first = "2" + first
+	}
+	result := first
+	for _, part := range parts[1:] {
+		result += sep + "0" + part
+	}
+	return result
+}
+
+// PackageName returns the name which qualifies the declarations of the package
+// with the given name and import path when it has to be told apart from
+// another package with the same name. It is the name followed by the first
+// eight hexadecimal digits of the SHA-256 hash of the path, e.g. util_4f2a91c0.
+func PackageName(name, path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return name + "_" + hex.EncodeToString(sum[:4])
+}
+
+// Qualified returns the encoded form of the qualified identifier pkg.name.
+func Qualified(pkg, name string) string {
+	return Ident(pkg) + sep + Ident(name)
+}
+
+// Name returns the name for an instantiation of a generic declaration. name
+// must already be encoded (e.g. with Ident or Qualified). Each of the type
+// arguments must be in the form accepted by Type.
+func Name(name string, typeArgs []ast.Expr) (string, error) {
+	result := name
+	for _, arg := range typeArgs {
+		s, err := Type(arg)
+		if err != nil {
+			return "", err
+		}
+		result += argSep + s
+	}
+	return result, nil
+}
+
+// Type returns the encoded form of the type expression expr. All named types
+// in expr other than predeclared types must be qualified with the name of
+// their package (e.g. main.Item instead of Item), array lengths must be
+// integer literals, and type arguments must be written as *ast.TypeArgExpr or
+// *ast.IndexExpr. The arguments for constant type parameters must be integer
+// literals, optionally preceded by "-".
+func Type(expr ast.Expr) (string, error) {
+	e := &encoder{}
+	if err := e.typ(expr); err != nil {
+		return "", err
+	}
+	return strings.Join(e.tokens, sep), nil
+}
+
+type encoder struct {
+	tokens []string
+}
+
+func (e *encoder) add(tokens ...string) {
+	e.tokens = append(e.tokens, tokens...)
+}
+
+func (e *encoder) typ(expr ast.Expr) error {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		if !isPredeclared(x.Name) {
+			return fmt.Errorf("type name %s is not qualified", x.Name)
+		}
+		e.add(x.Name)
+	case *ast.SelectorExpr:
+		pkg, ok := x.X.(*ast.Ident)
+		if !ok {
+			return fmt.Errorf("invalid qualified type name: %T", x.X)
+		}
+		e.add(Ident(pkg.Name), Ident(x.Sel.Name))
+	case *ast.ParenExpr:
+		return e.typ(x.X)
+	case *ast.StarExpr:
+		e.add("ptr")
+		return e.typ(x.X)
+	case *ast.ArrayType:
+		if x.Len == nil {
+			e.add("slice")
+			return e.typ(x.Elt)
+		}
+		lit, ok := x.Len.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return fmt.Errorf("array length must be an integer literal")
+		}
+		length, err := strconv.ParseInt(lit.Value, 0, 64)
+		if err != nil {
+			return fmt.Errorf("invalid array length %s: %s", lit.Value, err)
+		}
+		e.add("array" + strconv.FormatInt(length, 10))
+		return e.typ(x.Elt)
+	case *ast.MapType:
+		e.add("map")
+		if err := e.typ(x.Key); err != nil {
+			return err
+		}
+		return e.typ(x.Value)
+	case *ast.ChanType:
+		switch x.Dir {
+		case ast.SEND:
+			e.add("sendchan")
+		case ast.RECV:
+			e.add("recvchan")
+		default:
+			e.add("chan")
+		}
+		return e.typ(x.Value)
+	case *ast.FuncType:
+		return e.signature(x)
+	case *ast.StructType:
+		return e.fields("struct", x.Fields, e.typ)
+	case *ast.InterfaceType:
+		return e.fields("iface", x.Methods, func(expr ast.Expr) error {
+			if sig, ok := expr.(*ast.FuncType); ok {
+				return e.signature(sig)
+			}
+			return e.typ(expr)
+		})
+	case *ast.TupleExpr:
+		e.add("tuple" + strconv.Itoa(len(x.Elts)))
+		for _, elt := range x.Elts {
+			if err := e.typ(elt); err != nil {
+				return err
+			}
+		}
+	case *ast.TypeArgExpr:
+		return e.generic(x.X, x.Types)
+	case *ast.IndexExpr:
+		return e.generic(x.X, []ast.Expr{x.Index})
+	case *ast.BasicLit:
+		return e.constant("const", x)
+	case *ast.UnaryExpr:
+		if x.Op != token.SUB {
+			return fmt.Errorf("unsupported constant type argument: %s%T", x.Op, x.X)
+		}
+		return e.constant("constneg", x.X)
+	default:
+		return fmt.Errorf("unsupported type expression: %T", expr)
+	}
+	return nil
+}
+
+// constant adds the keyword for the integer literal expr, which is the
+// argument for a constant type parameter.
+func (e *encoder) constant(keyword string, expr ast.Expr) error {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return fmt.Errorf("constant type argument must be an integer literal")
+	}
+	n, err := strconv.ParseUint(lit.Value, 0, 64)
+	if err != nil {
+		return fmt.Errorf("invalid constant type argument %s: %s", lit.Value, err)
+	}
+	e.add(keyword + strconv.FormatUint(n, 10))
+	return nil
+}
+
+func (e *encoder) generic(x ast.Expr, typeArgs []ast.Expr) error {
+	sel, ok := x.(*ast.SelectorExpr)
+	if !ok {
+		return fmt.Errorf("generic type name must be qualified")
+	}
+	e.add("gen" + strconv.Itoa(len(typeArgs)))
+	if err := e.typ(sel); err != nil {
+		return err
+	}
+	for _, arg := range typeArgs {
+		if err := e.typ(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *encoder) fields(keyword string, list *ast.FieldList, typ func(ast.Expr) error) error {
+	e.add(keyword + strconv.Itoa(list.NumFields()))
+	if list == nil {
+		return nil
+	}
+	for _, field := range list.List {
+		if field.Tag != nil {
+			return fmt.Errorf("struct tags are not supported")
+		}
+		if len(field.Names) == 0 {
+			e.add(embedded)
+			if err := typ(field.Type); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, name := range field.Names {
+			e.add(Ident(name.Name))
+			if err := typ(field.Type); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *encoder) signature(sig *ast.FuncType) error {
+	keyword := fmt.Sprintf("func%dto%d", sig.Params.NumFields(), sig.Results.NumFields())
+	var params []ast.Expr
+	if sig.Params != nil {
+		for i, field := range sig.Params.List {
+			typ := field.Type
+			if ellipsis, ok := typ.(*ast.Ellipsis); ok && i == len(sig.Params.List)-1 {
+				keyword += "v"
+				typ = &ast.ArrayType{Elt: ellipsis.Elt}
+			}
+			for j := 0; j < len(field.Names) || j == 0; j++ {
+				params = append(params, typ)
+			}
+		}
+	}
+	if sig.Results != nil {
+		for _, field := range sig.Results.List {
+			for j := 0; j < len(field.Names) || j == 0; j++ {
+				params = append(params, field.Type)
+			}
+		}
+	}
+	e.add(keyword)
+	for _, param := range params {
+		if err := e.typ(param); err != nil {
+			return err
+		}
+	}
+	return nil
+}
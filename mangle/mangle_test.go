@@ -0,0 +1,161 @@
+package mangle
+
+import (
+	"testing"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/parser"
+)
+
+func TestIdent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"Box", "Box"},
+		{"my_box", "my_0box"},
+		{"_box", "1box"},
+		{"_", "1"},
+		{"a__b_", "a_0_0b_0"},
+		{"ptr", "2ptr"},
+		{"int", "2int"},
+		{"struct2_x", "2struct2_0x"},
+		{"const3", "2const3"},
+		{"tuple2", "2tuple2"},
+	}
+	for _, tc := range testCases {
+		if got := Ident(tc.name); got != tc.expected {
+			t.Errorf("Ident(%q): expected %q but got %q", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	a := PackageName("util", "example.com/a/util")
+	b := PackageName("util", "example.com/b/util")
+	if a != "util_64ce3230" || b != "util_f967770b" {
+		t.Errorf("expected util_64ce3230 and util_f967770b but got %s and %s", a, b)
+	}
+	mangled := "List__" + Qualified(b, "T")
+	if mangled != "List__util_0f967770b_T" {
+		t.Errorf("expected List__util_0f967770b_T but got %s", mangled)
+	}
+	if demangled, err := Demangle(mangled); err != nil || demangled != "List[util_f967770b.T]" {
+		t.Errorf("expected List[util_f967770b.T] but got %s (error: %v)", demangled, err)
+	}
+}
+
+func TestNameRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name     string
+		typeArgs []string
+		mangled  string
+	}{
+		{"List", []string{"int"}, "List__int"},
+		{"List", []string{"map[string]ast.Ident"}, "List__map_string_ast_Ident"},
+		{"Map", []string{"string", "*bytes.Buffer"}, "Map__string__ptr_bytes_Buffer"},
+		{"Box", []string{"**string"}, "Box__ptr_ptr_string"},
+		{"Box", []string{"[]**string"}, "Box__slice_ptr_ptr_string"},
+		{"Box", []string{"**[]string"}, "Box__ptr_ptr_slice_string"},
+		{"Box", []string{"[][]string"}, "Box__slice_slice_string"},
+		{"Box", []string{"[3]main.Item"}, "Box__array3_main_Item"},
+		{"Box", []string{"chan<- int", "<-chan error", "chan bool"}, "Box__sendchan_int__recvchan_error__chan_bool"},
+		{"Box", []string{"func(int, ...string) (bool, error)"}, "Box__func2to2v_int_slice_string_bool_error"},
+		{"Box", []string{"struct{x int; main.Item; my_field []byte}"}, "Box__struct3_x_int_type_main_Item_my_0field_slice_byte"},
+		{"Box", []string{"interface{String() string; main.Other}"}, "Box__iface2_String_func0to1_string_type_main_Other"},
+		{"Box", []string{"interface{}"}, "Box__iface0"},
+		{"Box", []string{"main.List[my_pkg.T, int]"}, "Box__gen2_main_List_my_0pkg_T_int"},
+		{"Box", []string{"struct{_ int; ptr string}"}, "Box__struct2_1_int_2ptr_string"},
+		{"my_box", []string{"int"}, "my_0box__int"},
+		{"Vec", []string{"float64", "3"}, "Vec__float64__const3"},
+		{"Box", []string{"main.Vec[int, -2]"}, "Box__gen2_main_Vec_int_constneg2"},
+		{"Box", []string{"(int, string)"}, "Box__tuple2_int_string"},
+		{"Box", []string{"[](int, (bool, *main.Item))"}, "Box__slice_tuple2_int_tuple2_bool_ptr_main_Item"},
+	}
+	for _, tc := range testCases {
+		var typeArgs []ast.Expr
+		for _, arg := range tc.typeArgs {
+			expr, err := parser.ParseExpr(arg)
+			if err != nil {
+				t.Fatalf("could not parse %s: %s", arg, err)
+			}
+			typeArgs = append(typeArgs, expr)
+		}
+		mangled, err := Name(Ident(tc.name), typeArgs)
+		if err != nil {
+			t.Errorf("Name(%s, %v) returned error: %s", tc.name, tc.typeArgs, err)
+			continue
+		}
+		if mangled != tc.mangled {
+			t.Errorf("Name(%s, %v): expected %s but got %s", tc.name, tc.typeArgs, tc.mangled, mangled)
+		}
+		demangled, err := Demangle(mangled)
+		if err != nil {
+			t.Errorf("Demangle(%s) returned error: %s", mangled, err)
+			continue
+		}
+		expected := tc.name + "["
+		for i, arg := range tc.typeArgs {
+			if i != 0 {
+				expected += ", "
+			}
+			expected += arg
+		}
+		expected += "]"
+		if demangled != expected {
+			t.Errorf("Demangle(%s): expected %s but got %s", mangled, expected, demangled)
+		}
+	}
+}
+
+func TestTypeUnqualified(t *testing.T) {
+	if _, err := Type(ast.NewIdent("Item")); err == nil {
+		t.Error("expected an error for unqualified type name but got none")
+	}
+}
+
+func TestDemangleQualified(t *testing.T) {
+	got, err := Demangle("lib_Box__gen1_lib_Box_int")
+	if err != nil {
+		t.Fatalf("Demangle returned error: %s", err)
+	}
+	if expected := "lib.Box[lib.Box[int]]"; got != expected {
+		t.Errorf("expected %s but got %s", expected, got)
+	}
+}
+
+func TestDemangleInvalid(t *testing.T) {
+	for _, name := range []string{
+		"List",
+		"List__",
+		"List__Item",
+		"List__map_int",
+		"List___int",
+		"List__int_int",
+		"init__main_T_extra",
+	} {
+		if got, err := Demangle(name); err == nil {
+			t.Errorf("Demangle(%s): expected an error but got %s", name, got)
+		}
+	}
+}
+
+func TestDemangleText(t *testing.T) {
+	text := `panic: oops
+
+goroutine 1 [running]:
+main.(*List__map_string_ast_Ident).Push(...)
+	/tmp/main.go:12
+main.my_0func__int(0x2a)
+main.main()`
+	expected := `panic: oops
+
+goroutine 1 [running]:
+main.(*List[map[string]ast.Ident]).Push(...)
+	/tmp/main.go:12
+main.my_func[int](0x2a)
+main.main()`
+	if got := DemangleText(text); got != expected {
+		t.Errorf("wrong result.\nexpected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -300,6 +300,23 @@ func (p *parser) consumeCommentGroup(n int) (comments *ast.CommentGroup, endline
 	return
 }
 
+// peek returns the token after the current token without advancing the
+// parser. Comments are skipped.
+func (p *parser) peek() token.Token {
+	s := p.scanner
+	for {
+		_, tok, _ := s.Scan()
+		if tok != token.COMMENT {
+			return tok
+		}
+	}
+}
+
+// isVal reports whether the current token is the contextual keyword val.
+func (p *parser) isVal() bool {
+	return p.tok == token.IDENT && p.lit == "val"
+}
+
 // Advance to the next non-comment token. In the process, collect
 // any comment groups encountered, and remember the last lead and
 // and line comments.
@@ -659,7 +676,7 @@ func (p *parser) parseTypeName(allowTypeParams bool) ast.Expr {
 	// a type parameter expression.
 	if allowTypeParams && p.tok == token.LBRACK && x != nil {
 		lbrack := p.expect(token.LBRACK)
-		params := p.parseTypeList()
+		params := p.parseTypeArgList()
 		rbrack := p.expect(token.RBRACK)
 		return &ast.TypeArgExpr{
 			X:      x,
@@ -741,7 +758,7 @@ func (p *parser) parseFieldDecl(scope *ast.Scope) *ast.Field {
 				if p.tok == token.COMMA {
 					// TypeArgExpr
 					p.next()
-					params := append([]ast.Expr{len}, p.parseTypeList()...)
+					params := append([]ast.Expr{len}, p.parseTypeArgList()...)
 					rbrack := p.expect(token.RBRACK)
 					x = &ast.TypeArgExpr{
 						X:      x,
@@ -904,6 +921,24 @@ func (p *parser) parseVarType(isParam bool, allowTypeParams bool) ast.Expr {
 	return typ
 }
 
+// parseParamVal parses the "val" which marks the parameter names before it as
+// read-only (e.g. xs val []int) and returns its position, or token.NoPos if
+// there is none. "val" is only treated as a keyword when a type follows it, so
+// a parameter type named val can still be used.
+func (p *parser) parseParamVal() token.Pos {
+	if !p.isVal() {
+		return token.NoPos
+	}
+	switch p.peek() {
+	case token.IDENT, token.LBRACK, token.MUL, token.LPAREN, token.ARROW, token.ELLIPSIS,
+		token.FUNC, token.MAP, token.CHAN, token.STRUCT, token.INTERFACE:
+		pos := p.pos
+		p.next()
+		return pos
+	}
+	return token.NoPos
+}
+
 func (p *parser) parseParameterList(scope *ast.Scope, ellipsisOk bool) (params []*ast.Field) {
 	if p.trace {
 		defer un(trace(p, "ParameterList"))
@@ -929,7 +964,7 @@ func (p *parser) parseParameterList(scope *ast.Scope, ellipsisOk bool) (params [
 				if p.tok == token.COMMA {
 					// TypeArgExpr
 					p.next()
-					params := append([]ast.Expr{len}, p.parseTypeList()...)
+					params := append([]ast.Expr{len}, p.parseTypeArgList()...)
 					rbrack := p.expect(token.RBRACK)
 					x = &ast.TypeArgExpr{
 						X:      x,
@@ -1023,8 +1058,9 @@ func (p *parser) parseParameterList(scope *ast.Scope, ellipsisOk bool) (params [
 		//   Type { "," IdentifierList Type }
 		//
 		idents := p.makeIdentList(list)
+		val := p.parseParamVal()
 		typ := p.parseVarType(ellipsisOk, true)
-		field := &ast.Field{Names: idents, Type: typ}
+		field := &ast.Field{Names: idents, Val: val, Type: typ}
 		params = append(params, field)
 		// Go spec: The scope of an identifier denoting a function
 		// parameter or result variable is the function body.
@@ -1043,8 +1079,9 @@ func (p *parser) parseParameterList(scope *ast.Scope, ellipsisOk bool) (params [
 		//   IdentifierList Type { "," IdentifierList Type }
 		//
 		idents := p.parseIdentList()
+		val := p.parseParamVal()
 		typ := p.parseVarType(ellipsisOk, true)
-		field := &ast.Field{Names: idents, Type: typ}
+		field := &ast.Field{Names: idents, Val: val, Type: typ}
 		params = append(params, field)
 		// Go spec: The scope of an identifier denoting a function
 		// parameter or result variable is the function body.
@@ -1080,7 +1117,13 @@ func (p *parser) parseResult(scope *ast.Scope) *ast.FieldList {
 	}
 
 	if p.tok == token.LPAREN {
-		return p.parseParameters(scope, false)
+		results := p.parseParameters(scope, false)
+		for _, field := range results.List {
+			if field.Val.IsValid() {
+				p.error(field.Val, "result parameters cannot be read-only")
+			}
+		}
+		return results
 	}
 
 	typ := p.tryType()
@@ -1144,6 +1187,34 @@ func (p *parser) parseMethodSpec(scope *ast.Scope) *ast.Field {
 	return spec
 }
 
+// parseTypeListSpec parses the type list of an interface which is used as a
+// constraint (e.g. type int, float64). Each type in the list is returned as a
+// separate field named "type".
+func (p *parser) parseTypeListSpec() []*ast.Field {
+	if p.trace {
+		defer un(trace(p, "TypeListSpec"))
+	}
+
+	doc := p.leadComment
+	pos := p.expect(token.TYPE)
+	var list []*ast.Field
+	for {
+		list = append(list, &ast.Field{
+			Names: []*ast.Ident{{NamePos: pos, Name: "type"}},
+			Type:  p.parseType(),
+		})
+		if p.tok != token.COMMA {
+			break
+		}
+		p.next()
+	}
+	p.expectSemi() // call before accessing p.linecomment
+
+	list[0].Doc = doc
+	list[len(list)-1].Comment = p.lineComment
+	return list
+}
+
 func (p *parser) parseInterfaceType() *ast.InterfaceType {
 	if p.trace {
 		defer un(trace(p, "InterfaceType"))
@@ -1153,8 +1224,12 @@ func (p *parser) parseInterfaceType() *ast.InterfaceType {
 	lbrace := p.expect(token.LBRACE)
 	scope := ast.NewScope(nil) // interface scope
 	var list []*ast.Field
-	for p.tok == token.IDENT {
-		list = append(list, p.parseMethodSpec(scope))
+	for p.tok == token.IDENT || p.tok == token.TYPE {
+		if p.tok == token.TYPE {
+			list = append(list, p.parseTypeListSpec()...)
+		} else {
+			list = append(list, p.parseMethodSpec(scope))
+		}
 	}
 	rbrace := p.expect(token.RBRACE)
 
@@ -1232,6 +1307,9 @@ func (p *parser) tryIdentOrType(isParam bool, allowTypeParams bool) ast.Expr {
 		lparen := p.pos
 		p.next()
 		typ := p.parseType()
+		if p.tok == token.COMMA {
+			return p.parseTuple(lparen, typ, p.parseType)
+		}
 		rparen := p.expect(token.RPAREN)
 		return &ast.ParenExpr{Lparen: lparen, X: typ, Rparen: rparen}
 	}
@@ -1240,6 +1318,28 @@ func (p *parser) tryIdentOrType(isParam bool, allowTypeParams bool) ast.Expr {
 	return nil
 }
 
+// parseTuple parses the rest of a tuple literal or tuple type after its first
+// element x. Each of the remaining elements is parsed with parseElt.
+func (p *parser) parseTuple(lparen token.Pos, x ast.Expr, parseElt func() ast.Expr) *ast.TupleExpr {
+	if p.trace {
+		defer un(trace(p, "Tuple"))
+	}
+
+	elts := []ast.Expr{x}
+	for p.tok == token.COMMA {
+		p.next()
+		if p.tok == token.RPAREN {
+			break
+		}
+		elts = append(elts, parseElt())
+	}
+	rparen := p.expect(token.RPAREN)
+	if len(elts) < 2 {
+		p.error(lparen, "a tuple must have at least two elements")
+	}
+	return &ast.TupleExpr{Lparen: lparen, Elts: elts, Rparen: rparen}
+}
+
 func (p *parser) tryType() ast.Expr {
 	typ := p.tryIdentOrType(false, true)
 	if typ != nil {
@@ -1341,6 +1441,11 @@ func (p *parser) parseOperand(lhs bool) ast.Expr {
 		p.next()
 		p.exprLev++
 		x := p.parseRhsOrType() // types may be parenthesized: (some type)
+		if p.tok == token.COMMA {
+			tuple := p.parseTuple(lparen, x, p.parseRhsOrType)
+			p.exprLev--
+			return tuple
+		}
 		p.exprLev--
 		rparen := p.expect(token.RPAREN)
 		return &ast.ParenExpr{Lparen: lparen, X: x, Rparen: rparen}
@@ -1424,7 +1529,7 @@ func (p *parser) parseBracketExpr(x ast.Expr) ast.Expr {
 			// If the next token is a comma, we are dealing with a type parameter
 			// expression.
 			p.expect(token.COMMA)
-			params := append([]ast.Expr{index[0]}, p.parseTypeList()...)
+			params := append([]ast.Expr{index[0]}, p.parseTypeArgList()...)
 			rbrack := p.expect(token.RBRACK)
 			p.exprLev--
 			return &ast.TypeArgExpr{
@@ -1620,6 +1725,7 @@ func (p *parser) checkExpr(x ast.Expr) ast.Expr {
 	case *ast.CompositeLit:
 	case *ast.ParenExpr:
 		panic("unreachable")
+	case *ast.TupleExpr:
 	case *ast.SelectorExpr:
 	case *ast.IndexExpr:
 	case *ast.SliceExpr:
@@ -1645,6 +1751,20 @@ func (p *parser) checkExpr(x ast.Expr) ast.Expr {
 	return x
 }
 
+// isTupleIndex reports whether lit is a period followed by a decimal integer
+// without leading zeros.
+func isTupleIndex(lit string) bool {
+	if len(lit) < 2 || lit[0] != '.' || (lit[1] == '0' && len(lit) > 2) {
+		return false
+	}
+	for _, ch := range lit[1:] {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // isTypeName reports whether x is a (qualified) TypeName.
 func isTypeName(x ast.Expr) bool {
 	switch t := x.(type) {
@@ -1740,6 +1860,18 @@ L:
 				sel := &ast.Ident{NamePos: pos, Name: "_"}
 				x = &ast.SelectorExpr{X: x, Sel: sel}
 			}
+		case token.FLOAT:
+			// The scanner treats the index in a tuple selector (e.g. t.0) as
+			// a floating-point literal.
+			if !isTupleIndex(p.lit) {
+				break L
+			}
+			if lhs {
+				p.resolve(x)
+			}
+			sel := &ast.Ident{NamePos: p.pos + 1, Name: p.lit[1:]}
+			p.next()
+			x = &ast.SelectorExpr{X: p.checkExpr(x), Sel: sel}
 		case token.LBRACK:
 			if lhs {
 				p.resolve(x)
@@ -1867,7 +1999,13 @@ func (p *parser) parseBinaryExpr(lhs bool, prec1 int) ast.Expr {
 			lhs = false
 		}
 		y := p.parseBinaryExpr(false, oprec+1)
-		x = &ast.BinaryExpr{X: p.checkExpr(x), OpPos: pos, Op: op, Y: p.checkExpr(y)}
+		if op == token.PIPE {
+			// x |> T is the conversion T(x).
+			y = p.checkExprOrType(y)
+		} else {
+			y = p.checkExpr(y)
+		}
+		x = &ast.BinaryExpr{X: p.checkExpr(x), OpPos: pos, Op: op, Y: y}
 	}
 }
 
@@ -2143,6 +2281,32 @@ func (p *parser) parseTypeList() (list []ast.Expr) {
 	return
 }
 
+// parseTypeArgList parses a list of type arguments. It is like parseTypeList,
+// except that it also accepts constant expressions which start with a literal
+// or a sign (e.g. 3 or -1), which are used as the arguments for constant type
+// parameters.
+func (p *parser) parseTypeArgList() (list []ast.Expr) {
+	if p.trace {
+		defer un(trace(p, "TypeArgList"))
+	}
+
+	list = append(list, p.parseTypeArg())
+	for p.tok == token.COMMA {
+		p.next()
+		list = append(list, p.parseTypeArg())
+	}
+
+	return
+}
+
+func (p *parser) parseTypeArg() ast.Expr {
+	switch p.tok {
+	case token.INT, token.FLOAT, token.IMAG, token.CHAR, token.STRING, token.ADD, token.SUB:
+		return p.parseRhs()
+	}
+	return p.parseType()
+}
+
 func (p *parser) parseCaseClause(typeSwitch bool) *ast.CaseClause {
 	if p.trace {
 		defer un(trace(p, "CaseClause"))
@@ -2425,6 +2589,17 @@ func (p *parser) parseStmt() (s ast.Stmt) {
 		token.IDENT, token.INT, token.FLOAT, token.IMAG, token.CHAR, token.STRING, token.FUNC, token.LPAREN, // operands
 		token.LBRACK, token.STRUCT, token.MAP, token.CHAN, token.INTERFACE, // composite types
 		token.ADD, token.SUB, token.MUL, token.AND, token.XOR, token.ARROW, token.NOT: // unary operators
+		if p.tok == token.FUNC && p.peek() == token.IDENT {
+			// A local generic function declaration (e.g. func f[T](x T) T {}).
+			s = &ast.DeclStmt{Decl: p.parseFuncDecl()}
+			break
+		}
+		if p.isVal() && p.peek() == token.IDENT {
+			// A read-only variable declaration (e.g. val x = f()). A name
+			// can't be followed by another name in any other statement.
+			s = &ast.DeclStmt{Decl: p.parseGenDecl(token.VAL, p.parseValueSpec)}
+			break
+		}
 		s, _ = p.parseSimpleStmt(labelOk)
 		// because of the required look-ahead, labeled statements are
 		// parsed by parseSimpleStmt - don't expect a semicolon after
@@ -2551,6 +2726,10 @@ func (p *parser) parseValueSpec(doc *ast.CommentGroup, keyword token.Token, iota
 		if values == nil && (iota == 0 || typ != nil) {
 			p.error(pos, "missing constant value")
 		}
+	case token.VAL:
+		if values == nil {
+			p.error(pos, "missing val initialization")
+		}
 	}
 
 	// Go spec: The scope of a constant or variable identifier declared inside
@@ -2565,7 +2744,7 @@ func (p *parser) parseValueSpec(doc *ast.CommentGroup, keyword token.Token, iota
 		Comment: p.lineComment,
 	}
 	kind := ast.Con
-	if keyword == token.VAR {
+	if keyword == token.VAR || keyword == token.VAL {
 		kind = ast.Var
 	}
 	p.declare(spec, iota, p.topScope, kind, idents...)
@@ -2596,7 +2775,13 @@ func (p *parser) parseTypeSpec(doc *ast.CommentGroup, _ token.Token, _ int) ast.
 		// a set of type parameters. We can sometimes disambiguate by looking ahead.
 		lbrack := p.expect(token.LBRACK)
 
-		if p.tok == token.IDENT {
+		if p.tok == token.CONST || p.tok == token.IDENT && (p.peek() == token.ASSIGN || p.peek() == token.IDENT || p.peek() == token.INTERFACE) {
+			// A constant type parameter, a default type argument, or a constraint
+			// disambiguates. We are dealing with a list of type parameters.
+			spec.TypeParams = p.parseTypeParamList(lbrack, nil)
+			spec.Type = p.parseType()
+
+		} else if p.tok == token.IDENT {
 
 			first := p.parseRhs()
 			if p.tok == token.COMMA {
@@ -2607,13 +2792,8 @@ func (p *parser) parseTypeSpec(doc *ast.CommentGroup, _ token.Token, _ int) ast.
 					p.errorExpected(first.Pos(), token.IDENT.String())
 				}
 				p.next()
-				names := append([]*ast.Ident{name}, p.parseIdentList()...)
-				rbrack := p.expect(token.RBRACK)
-				spec.TypeParams = &ast.TypeParamDecl{
-					Lbrack: lbrack,
-					Names:  names,
-					Rbrack: rbrack,
-				}
+				spec.TypeParams = p.parseTypeParamList(lbrack, nil)
+				spec.TypeParams.Names = append([]*ast.Ident{name}, spec.TypeParams.Names...)
 
 				// We expect the type to follow the type parameters.
 				spec.Type = p.parseType()
@@ -2666,7 +2846,14 @@ func (p *parser) parseGenDecl(keyword token.Token, f parseSpecFunction) *ast.Gen
 	}
 
 	doc := p.leadComment
-	pos := p.expect(keyword)
+	var pos token.Pos
+	if keyword == token.VAL {
+		// val is scanned as an identifier.
+		pos = p.pos
+		p.next()
+	} else {
+		pos = p.expect(keyword)
+	}
 	var lparen, rparen token.Pos
 	var list []ast.Spec
 	if p.tok == token.LPAREN {
@@ -2739,7 +2926,11 @@ func (p *parser) parseFuncDecl() *ast.FuncDecl {
 		//
 		// init() functions cannot be referred to and there may
 		// be more than one - don't put them in the pkgScope
-		if ident.Name != "init" {
+		if p.topScope != p.pkgScope {
+			// Fo extension: a local function declaration is scoped to the
+			// innermost containing block.
+			p.declare(decl, nil, p.topScope, ast.Fun, ident)
+		} else if ident.Name != "init" {
 			p.declare(decl, nil, p.pkgScope, ast.Fun, ident)
 		}
 	}
@@ -2763,6 +2954,12 @@ func (p *parser) parseDecl(sync func(*parser)) ast.Decl {
 	case token.FUNC:
 		return p.parseFuncDecl()
 
+	case token.IDENT:
+		if p.isVal() {
+			return p.parseGenDecl(token.VAL, p.parseValueSpec)
+		}
+		fallthrough
+
 	default:
 		pos := p.pos
 		p.errorExpected(pos, "declaration")
@@ -2775,13 +2972,63 @@ func (p *parser) parseDecl(sync func(*parser)) ast.Decl {
 
 func (p *parser) parseTypeParamDecl() *ast.TypeParamDecl {
 	lbrack := p.expect(token.LBRACK)
-	names := p.parseIdentList()
-	rbrack := p.expect(token.RBRACK)
-	return &ast.TypeParamDecl{
-		Lbrack: lbrack,
-		Names:  names,
-		Rbrack: rbrack,
+	return p.parseTypeParamList(lbrack, nil)
+}
+
+// parseTypeParamList parses the remainder of a list of type parameters after
+// the "[" and the first name (if it was already consumed). Each name may be
+// followed by "=" and a default type argument, but once a type parameter has a
+// default, all of the type parameters after it must have one too. A constant
+// type parameter is written as "const" followed by its name and type, and a
+// constrained type parameter is written as its name followed by the
+// constraint.
+func (p *parser) parseTypeParamList(lbrack token.Pos, first *ast.Ident) *ast.TypeParamDecl {
+	if p.trace {
+		defer un(trace(p, "TypeParamList"))
+	}
+
+	decl := &ast.TypeParamDecl{Lbrack: lbrack}
+	name := first
+	for {
+		isConst := false
+		if name == nil {
+			if p.tok == token.CONST {
+				p.next()
+				name = p.parseIdent()
+				decl.Consts = append(decl.Consts, &ast.Field{
+					Names: []*ast.Ident{name},
+					Type:  p.parseType(),
+				})
+				isConst = true
+			} else {
+				name = p.parseIdent()
+				if p.tok != token.COMMA && p.tok != token.ASSIGN && p.tok != token.RBRACK {
+					decl.Constraints = append(decl.Constraints, &ast.Field{
+						Names: []*ast.Ident{name},
+						Type:  p.parseType(),
+					})
+				}
+			}
+		}
+		decl.Names = append(decl.Names, name)
+		if p.tok == token.ASSIGN {
+			p.next()
+			if isConst {
+				decl.Defaults = append(decl.Defaults, p.parseRhs())
+			} else {
+				decl.Defaults = append(decl.Defaults, p.parseType())
+			}
+		} else if len(decl.Defaults) > 0 {
+			p.error(name.Pos(), "missing default type argument for type parameter "+name.Name)
+		}
+		if p.tok != token.COMMA {
+			break
+		}
+		p.next()
+		name = nil
 	}
+	decl.Rbrack = p.expect(token.RBRACK)
+	return decl
 }
 
 // ----------------------------------------------------------------------------
@@ -63,6 +63,16 @@ var valids = []string{
 	`package p; type T[U, V] map[U]V`,
 	`package p; type T struct{ U[V] }`,
 	`package p; type T struct{ a U[V]; b U[V]; }`,
+	`package p; type T[V = int] struct { v V }`,
+	`package p; type T[U, V = interface{}] map[U]V`,
+	`package p; type T[U = []int, V = map[string]bool] struct{}`,
+	`package p; type T[const N int] [N]float64`,
+	`package p; type T[U, const N int] [N]U`,
+	`package p; type T[U, const N int = 3] [N]U`,
+	`package p; var v T[float64, 3]`,
+	`package p; type T[U Ordered] []U`,
+	`package p; type T[U interface{ String() string }, V Number = int] struct{}`,
+	`package p; type Number interface { type int, float64; String() string }`,
 
 	// Function and method declarations
 	`package p; func f[T] (t T) {}`,
@@ -70,12 +80,23 @@ var valids = []string{
 	`package p; func f[T] () T {}`,
 	`package p; func (t T) f[T] () {}`,
 	`package p; func (t T) f[T, U, V] (u U) V {}`,
+	`package p; func f[T = string] () T {}`,
+	`package p; func f[T, U = V[int]] (t T) U {}`,
+	`package p; func f[T, const N int] (t T) [N]T {}`,
+	`package p; func f[T Number] (a, b T) T { return a + b }`,
 	`package p; func _(x []int, y T[U]) { for range x {} }`,
 	`package p; func _(T[int], T[string, bool]) { }`,
 	`package p; func _([5]string, T[int, bool]) { }`,
 	`package p; func (T[U]) _() U { }`,
 	`package p; func (x T[U]) _() U { }`,
 
+	// Local declarations
+	`package p; func _() { type T[U] struct { u U } }`,
+	`package p; func _() { func f[T] (t T) T { return t }; f[int](1) }`,
+	`package p; func _() { func f[T, U] (t T) U {} }`,
+	`package p; func _() { func() {}() }`,
+	`package p; func _() { func /* comment */ f[T] () {} }`,
+
 	// Top-level variable assignments and declarations
 	`package p; var _ T[U]`,
 	`package p; var _ = T[U]{}`,
@@ -123,6 +144,42 @@ var valids = []string{
 	`package p; func _() { switch n := x.(type) { case T[U]: break } }`,
 	`package p; func _() { _ = x.(T[U]) }`,
 	`package p; func _() { _ = T[U](x) }`,
+
+	// Tuples
+	`package p; var _ (int, string)`,
+	`package p; var _ = (1, "a")`,
+	`package p; var _ = (1, (2, 3), f())`,
+	`package p; var _ = (
+		1,
+		2,
+	)`,
+	`package p; type T (int, []string, map[U]V[W])`,
+	`package p; func f(t (int, string)) ((int, string)) { return t }`,
+	`package p; func _() { a, b := t; _ = t.0 + t.1; t.2.10 = 1 }`,
+	`package p; func _() { _ = f[(int, string)]((1, "a")) }`,
+	`package p; var _ map[(int, int)](bool, error)`,
+
+	// Pipes
+	`package p; var _ = x |> f`,
+	`package p; var _ = x |> f |> g.h |> Map[int, string]`,
+	`package p; var _ = a + b |> f`,
+	`package p; var _ = x |> []byte`,
+	`package p; func _() { x |> fmt.Println }`,
+	`package p; var _ = xs |>
+		Filter[int](even) |>
+		Sum[int]`,
+
+	// Read-only bindings
+	`package p; val x = 1`,
+	`package p; val x, y int = f()`,
+	`package p; val (a = 1; b string = "b")`,
+	`package p; func _() { val x = f(); val y []int = x }`,
+	`package p; func _(xs val []int, m val map[string]int) {}`,
+	`package p; func _(a, b val int, c val ...int) {}`,
+	`package p; func (s val *S) _() {}`,
+	`package p; var _ = func(p val *T) {}`,
+	`package p; func _(val int, x val) { val = val + 1; val(x); val.f = 1 }`,
+	`package p; func _(x val.T) {}`,
 }
 
 func TestValid(t *testing.T) {
@@ -216,7 +273,19 @@ var invalids = []string{
 	`package p; func main() { x := T[] /* ERROR "expected operand, found '\]'" */ { val: "" } }`,
 	`package p; func main() { x := T[V, ] /* ERROR "expected type, found '\]'" */ { val: "" } }`,
 	`package p; func _(T[]) /* ERROR "expected type, found '\)'" */ {}`,
+	`package p; type T[U = int, V /* ERROR "missing default type argument" */ ] struct{}`,
+	`package p; func f[T = int, U /* ERROR "missing default type argument" */ ] () {}`,
+	`package p; type T[U, const N ] /* ERROR "expected type, found '\]'" */ [N]U`,
+	`package p; type I interface { type ; /* ERROR "expected type, found ';'" */ }`,
 	`package p; func _() T[] /* ERROR "expected type, found '\]'" */ {}`,
+	`package p; var _ = ( /* ERROR "a tuple must have at least two elements" */ 1,)`,
+	`package p; var _ = x |> [... /* ERROR "expected array length" */ ]int`,
+	`package p; var _ = x |> ; /* ERROR "expected operand" */`,
+	`package p; val x /* ERROR "missing val initialization" */ int`,
+	`package p; func _() { val x /* ERROR "missing val initialization" */ int }`,
+	`package p; func _() (x val /* ERROR "result parameters cannot be read-only" */ int) {}`,
+	`package p; var _ ( /* ERROR "a tuple must have at least two elements" */ int,)`,
+	`package p; func _() { _ = t.01 /* ERROR "expected ';', found 'FLOAT' .01" */ }`,
 }
 
 func TestInvalid(t *testing.T) {
@@ -0,0 +1,386 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/format"
+	"github.com/urfave/cli"
+)
+
+const (
+	// maxSnippetSize is the maximum size of a program sent to the playground.
+	maxSnippetSize = 64 << 10
+	// maxOutputSize is the maximum amount of output kept from a program.
+	maxOutputSize = 1 << 20
+	// buildTimeout limits the time it takes to build a program, which is
+	// usually much less than the time to run it.
+	buildTimeout = time.Minute
+)
+
+// snippetID matches the IDs of shared snippets.
+var snippetID = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// playground is an HTTP server for editing, running, and sharing Fo programs,
+// like the Go playground.
+type playground struct {
+	snippetDir string
+	limits     runLimits
+	// running limits the number of programs which are built and run at the
+	// same time.
+	running chan struct{}
+}
+
+// runLimits are the limits on the resources used by a program in the
+// playground. On systems without a shell (i.e. Windows), only the time is
+// limited.
+type runLimits struct {
+	timeout time.Duration
+	// memory is the maximum size of the data segment in bytes, which is where
+	// the Go runtime allocates the heap. 0 means no limit.
+	memory int64
+}
+
+func playgroundServer(c *cli.Context) error {
+	if c.Args().Present() {
+		return fmt.Errorf("playground expects no arguments")
+	}
+	p := &playground{
+		snippetDir: c.String("snippets"),
+		limits: runLimits{
+			timeout: c.Duration("timeout"),
+			memory:  int64(c.Int("memory")) << 20,
+		},
+		running: make(chan struct{}, runtime.NumCPU()),
+	}
+	if err := os.MkdirAll(p.snippetDir, 0755); err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", p.serveEditor)
+	mux.HandleFunc("/p/", p.serveEditor)
+	mux.HandleFunc("/compile", p.serveCompile)
+	mux.HandleFunc("/fmt", p.serveFmt)
+	mux.HandleFunc("/share", p.serveShare)
+	addr := c.String("addr")
+	log.Printf("Fo playground listening on %s (shared snippets are stored in %s)", addr, p.snippetDir)
+	return http.ListenAndServe(addr, mux)
+}
+
+// serveEditor serves the editor page, with the shared snippet in the path
+// (/p/<id>) or an example program loaded.
+func (p *playground) serveEditor(w http.ResponseWriter, r *http.Request) {
+	src := playgroundExample
+	if id := strings.TrimPrefix(r.URL.Path, "/p/"); id != r.URL.Path {
+		if !snippetID.MatchString(id) {
+			http.NotFound(w, r)
+			return
+		}
+		data, err := ioutil.ReadFile(filepath.Join(p.snippetDir, id+".fo"))
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		src = string(data)
+	} else if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := playgroundPage.Execute(w, src); err != nil {
+		log.Printf("playground: %s", err)
+	}
+}
+
+// compileResult is the response to /compile. Errors holds the errors from
+// converting or building the program, in which case it wasn't run.
+type compileResult struct {
+	Errors   string
+	Output   string
+	ExitCode int
+}
+
+// serveCompile converts the program in the request body into Go, then builds
+// and runs it.
+func (p *playground) serveCompile(w http.ResponseWriter, r *http.Request) {
+	src, ok := readSnippet(w, r)
+	if !ok {
+		return
+	}
+	p.running <- struct{}{}
+	defer func() { <-p.running }()
+	result, err := p.compile(r.Context(), src)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, result)
+}
+
+func (p *playground) compile(ctx context.Context, src []byte) (*compileResult, error) {
+	compiled, err := compiler.Compile(&compiler.Config{ImportPath: "main"}, []compiler.Input{{Name: "prog.fo", Src: src}})
+	if err != nil {
+		return &compileResult{Errors: err.Error()}, nil
+	}
+	if len(compiled.Diagnostics) > 0 {
+		return &compileResult{Errors: diagnosticsError(compiled.Diagnostics).Error()}, nil
+	}
+
+	dir, err := ioutil.TempDir("", "fo-playground-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "prog.go"), compiled.Files[0].Src, 0644); err != nil {
+		return nil, err
+	}
+	exe := filepath.Join(dir, "prog")
+	if runtime.GOOS == "windows" {
+		exe += ".exe"
+	}
+	buildCtx, cancel := context.WithTimeout(ctx, buildTimeout)
+	defer cancel()
+	build := exec.CommandContext(buildCtx, "go", "build", "-o", exe, "prog.go")
+	build.Dir = dir
+	if output, err := build.CombinedOutput(); err != nil {
+		return &compileResult{Errors: fmt.Sprintf("go build failed: %s\n%s", err, output)}, nil
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, p.limits.timeout)
+	defer cancel()
+	cmd := limitCommand(runCtx, p.limits, exe)
+	cmd.Dir = dir
+	output := &limitedBuffer{max: maxOutputSize}
+	cmd.Stdout = output
+	cmd.Stderr = output
+	result := &compileResult{}
+	if err := cmd.Run(); err != nil {
+		exitErr, ok := err.(*exec.ExitError)
+		if !ok {
+			return nil, err
+		}
+		result.ExitCode = exitErr.ExitCode()
+		if result.ExitCode < 0 {
+			result.ExitCode = 1
+		}
+		if runCtx.Err() == context.DeadlineExceeded {
+			fmt.Fprintf(output, "\nprogram took longer than %s and was stopped\n", p.limits.timeout)
+		}
+	}
+	result.Output = output.String()
+	return result, nil
+}
+
+// fmtResult is the response to /fmt.
+type fmtResult struct {
+	Body  string
+	Error string
+}
+
+// serveFmt formats the program in the request body.
+func (p *playground) serveFmt(w http.ResponseWriter, r *http.Request) {
+	src, ok := readSnippet(w, r)
+	if !ok {
+		return
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		writeJSON(w, fmtResult{Error: err.Error()})
+		return
+	}
+	writeJSON(w, fmtResult{Body: string(formatted)})
+}
+
+// serveShare stores the program in the request body and responds with its ID.
+// The ID is derived from the program, so sharing the same program twice
+// results in the same ID.
+func (p *playground) serveShare(w http.ResponseWriter, r *http.Request) {
+	src, ok := readSnippet(w, r)
+	if !ok {
+		return
+	}
+	sum := sha256.Sum256(src)
+	id := base64.RawURLEncoding.EncodeToString(sum[:9])
+	if err := ioutil.WriteFile(filepath.Join(p.snippetDir, id+".fo"), src, 0644); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprint(w, id)
+}
+
+// readSnippet reads the program from the body of a POST request. If it can't,
+// it responds with an error and returns false.
+func readSnippet(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected a POST request", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+	src, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSnippetSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not read program: %s", err), http.StatusBadRequest)
+		return nil, false
+	}
+	return src, true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("playground: %s", err)
+	}
+}
+
+// limitedBuffer keeps the first max bytes written to it and discards the rest.
+type limitedBuffer struct {
+	bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if n := b.max - b.Len(); len(p) > n {
+		b.Buffer.Write(p[:n])
+		if !b.truncated {
+			b.truncated = true
+			b.Buffer.WriteString("\n[output truncated]\n")
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+var _ io.Writer = (*limitedBuffer)(nil)
+
+const playgroundExample = `package main
+
+import "fmt"
+
+type Box[T] struct {
+	V T
+}
+
+func (b Box[T]) Map[U](f func(T) U) Box[U] {
+	return Box[U]{V: f(b.V)}
+}
+
+func main() {
+	b := Box[int]{V: 21}
+	doubled := b.Map[int](func(x int) int { return x * 2 })
+	fmt.Println(doubled.V)
+}
+`
+
+var playgroundPage = template.Must(template.New("playground").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>The Fo Playground</title>
+<style>
+body { margin: 0; font-family: sans-serif; display: flex; flex-direction: column; height: 100vh; }
+header { padding: 8px; background: #e0ebf5; }
+header button { margin-right: 4px; }
+#share-url { width: 24em; }
+textarea { flex: 2; border: none; padding: 8px; font-family: monospace; font-size: 14px; resize: none; tab-size: 4; }
+pre { flex: 1; margin: 0; padding: 8px; overflow: auto; background: #f8f8f8; border-top: 1px solid #ccc; }
+.error { color: #c00; }
+</style>
+</head>
+<body>
+<header>
+<strong>The Fo Playground</strong>
+<button id="run">Run</button>
+<button id="format">Format</button>
+<button id="share">Share</button>
+<input id="share-url" readonly hidden>
+</header>
+<textarea id="code" spellcheck="false">{{.}}</textarea>
+<pre id="output"></pre>
+<script>
+var code = document.getElementById("code");
+var output = document.getElementById("output");
+
+function post(path) {
+	return fetch(path, {method: "POST", body: code.value}).then(function(resp) {
+		if (!resp.ok) {
+			return resp.text().then(function(text) { throw new Error(text); });
+		}
+		return resp;
+	});
+}
+
+function show(text, isError) {
+	output.textContent = text;
+	output.className = isError ? "error" : "";
+}
+
+document.getElementById("run").onclick = function() {
+	show("Running...");
+	post("/compile").then(function(resp) { return resp.json(); }).then(function(result) {
+		if (result.Errors) {
+			show(result.Errors, true);
+			return;
+		}
+		var text = result.Output;
+		if (result.ExitCode !== 0) {
+			text += "\nProgram exited with status " + result.ExitCode + ".";
+		}
+		show(text, result.ExitCode !== 0);
+	}).catch(function(err) { show(err.message, true); });
+};
+
+document.getElementById("format").onclick = function() {
+	post("/fmt").then(function(resp) { return resp.json(); }).then(function(result) {
+		if (result.Error) {
+			show(result.Error, true);
+			return;
+		}
+		code.value = result.Body;
+	}).catch(function(err) { show(err.message, true); });
+};
+
+document.getElementById("share").onclick = function() {
+	post("/share").then(function(resp) { return resp.text(); }).then(function(id) {
+		var url = location.origin + "/p/" + id;
+		history.pushState(null, "", "/p/" + id);
+		var input = document.getElementById("share-url");
+		input.value = url;
+		input.hidden = false;
+		input.select();
+	}).catch(function(err) { show(err.message, true); });
+};
+
+code.onkeydown = function(e) {
+	if (e.key === "Tab") {
+		e.preventDefault();
+		var start = code.selectionStart;
+		code.value = code.value.slice(0, start) + "\t" + code.value.slice(code.selectionEnd);
+		code.selectionStart = code.selectionEnd = start + 1;
+	} else if (e.key === "Enter" && e.shiftKey) {
+		e.preventDefault();
+		document.getElementById("run").onclick();
+	}
+};
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestPlayground(t *testing.T) (*playground, func()) {
+	dir, err := ioutil.TempDir("", "fo-playground-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snippetDir := filepath.Join(dir, "snippets")
+	if err := os.Mkdir(snippetDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	p := &playground{
+		snippetDir: snippetDir,
+		limits:     runLimits{timeout: 10 * time.Second},
+		running:    make(chan struct{}, 1),
+	}
+	return p, func() { os.RemoveAll(dir) }
+}
+
+// serve sends a request with the given method, path, and body to handler and
+// returns the response.
+func serve(handler http.HandlerFunc, method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+	return w
+}
+
+func TestPlaygroundFmt(t *testing.T) {
+	p, cleanup := newTestPlayground(t)
+	defer cleanup()
+
+	w := serve(p.serveFmt, "POST", "/fmt", "package main\nfunc  main( ) {\nx:=1\n_ = x}")
+	var result fmtResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid response %q: %s", w.Body, err)
+	}
+	if expected := "package main\n\nfunc main() {\n\tx := 1\n\t_ = x\n}\n"; result.Body != expected || result.Error != "" {
+		t.Errorf("expected formatted program %q but got %+v", expected, result)
+	}
+
+	w = serve(p.serveFmt, "POST", "/fmt", "package main\nfunc main() {")
+	result = fmtResult{}
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid response %q: %s", w.Body, err)
+	}
+	if result.Error == "" || result.Body != "" {
+		t.Errorf("expected an error for a syntax error but got %+v", result)
+	}
+}
+
+func TestPlaygroundRequests(t *testing.T) {
+	p, cleanup := newTestPlayground(t)
+	defer cleanup()
+	tooBig := strings.Repeat("x", maxSnippetSize+1)
+	for _, handler := range []http.HandlerFunc{p.serveCompile, p.serveFmt, p.serveShare} {
+		if w := serve(handler, "GET", "/", ""); w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("expected status %d for a GET request but got %d", http.StatusMethodNotAllowed, w.Code)
+		}
+		if w := serve(handler, "POST", "/", tooBig); w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d for a program which is too big but got %d", http.StatusBadRequest, w.Code)
+		}
+	}
+	files, err := ioutil.ReadDir(p.snippetDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected nothing to be shared but found %d files", len(files))
+	}
+}
+
+func TestPlaygroundShare(t *testing.T) {
+	p, cleanup := newTestPlayground(t)
+	defer cleanup()
+	src := "package main\n\nfunc main() { println(1 < 2) }\n"
+
+	w := serve(p.serveShare, "POST", "/share", src)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 but got %d: %s", w.Code, w.Body)
+	}
+	id := w.Body.String()
+	if !snippetID.MatchString(id) {
+		t.Fatalf("invalid ID %q", id)
+	}
+	if again := serve(p.serveShare, "POST", "/share", src).Body.String(); again != id {
+		t.Errorf("expected the same ID for the same program but got %s and %s", id, again)
+	}
+	if other := serve(p.serveShare, "POST", "/share", src+"\n").Body.String(); other == id {
+		t.Errorf("expected different IDs for different programs but got %s twice", id)
+	}
+	if stored, err := ioutil.ReadFile(filepath.Join(p.snippetDir, id+".fo")); err != nil || string(stored) != src {
+		t.Errorf("expected the program to be stored but got %q (error: %v)", stored, err)
+	}
+
+	w = serve(p.serveEditor, "GET", "/p/"+id, "")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "println(1 &lt; 2)") {
+		t.Errorf("expected the editor with the shared program but got status %d:\n%s", w.Code, w.Body)
+	}
+	w = serve(p.serveEditor, "GET", "/", "")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "Box[int]{V: 21}") {
+		t.Errorf("expected the editor with the example but got status %d", w.Code)
+	}
+
+	// The snippet directory must not be escaped through the ID.
+	if err := ioutil.WriteFile(filepath.Join(p.snippetDir, "..", "secret.fo"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/p/", "/p/unknown", "/p/../secret", "/p/..%2fsecret", "/p/a.b", "/p/" + id + "/x", "/other"} {
+		if w := serve(p.serveEditor, "GET", path, ""); w.Code != http.StatusNotFound {
+			t.Errorf("%s: expected status 404 but got %d", path, w.Code)
+		}
+	}
+}
+
+func TestPlaygroundCompile(t *testing.T) {
+	p, cleanup := newTestPlayground(t)
+	defer cleanup()
+	compile := func(src string) compileResult {
+		w := serve(p.serveCompile, "POST", "/compile", src)
+		var result compileResult
+		if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+			t.Fatalf("invalid response %q: %s", w.Body, err)
+		}
+		return result
+	}
+
+	result := compile("package main\n\nfunc main() { x := 1 }\n")
+	if !strings.Contains(result.Errors, "prog.fo:3:15: x declared but not used") || result.Output != "" {
+		t.Errorf("expected a type error but got %+v", result)
+	}
+
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("running programs needs the go command")
+	}
+	if testing.Short() {
+		t.Skip("running programs builds them")
+	}
+	result = compile(playgroundExample)
+	if result.Errors != "" || result.Output != "42\n" || result.ExitCode != 0 {
+		t.Errorf("expected the example to print 42 but got %+v", result)
+	}
+	result = compile("package main\n\nimport \"os\"\n\nfunc main() {\n\tprintln(\"bye\")\n\tos.Exit(3)\n}\n")
+	if result.Errors != "" || result.Output != "bye\n" || result.ExitCode != 3 {
+		t.Errorf("expected exit code 3 but got %+v", result)
+	}
+	p.limits.timeout = time.Second
+	result = compile("package main\n\nfunc main() {\n\tfor {\n\t}\n}\n")
+	if result.ExitCode == 0 || !strings.Contains(result.Output, "program took longer than 1s and was stopped") {
+		t.Errorf("expected the program to be stopped but got %+v", result)
+	}
+}
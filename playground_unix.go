@@ -0,0 +1,25 @@
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// limitCommand returns a command which runs the program exe with the given
+// limits. The limits are set by the shell with ulimit before it replaces
+// itself with the program, so that they don't apply to the playground.
+func limitCommand(ctx context.Context, limits runLimits, exe string) *exec.Cmd {
+	// The CPU time limit is rounded up to whole seconds. The timeout still
+	// applies to programs which mostly sleep or wait.
+	cpu := int64((limits.timeout + 999e6) / 1e9)
+	memory := "unlimited"
+	if limits.memory > 0 {
+		memory = fmt.Sprint(limits.memory >> 10)
+	}
+	script := fmt.Sprintf(`ulimit -d %s && ulimit -t %d && exec "$0"`, memory, cpu)
+	return exec.CommandContext(ctx, "/bin/sh", "-c", script, exe)
+}
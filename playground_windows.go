@@ -0,0 +1,12 @@
+package main
+
+import (
+	"context"
+	"os/exec"
+)
+
+// limitCommand returns a command which runs the program exe. Only the timeout
+// is enforced on Windows (by ctx).
+func limitCommand(ctx context.Context, limits runLimits, exe string) *exec.Cmd {
+	return exec.CommandContext(ctx, exe)
+}
@@ -273,7 +273,32 @@ func (p *printer) exprList(prev0 token.Pos, list []ast.Expr, depth int, mode exp
 func (p *printer) typeParams(x *ast.TypeParamDecl) {
 	if x != nil {
 		p.print(token.LBRACK)
-		p.identList(x.Names, false)
+		if len(x.Defaults) == 0 && len(x.Consts) == 0 && len(x.Constraints) == 0 {
+			p.identList(x.Names, false)
+		} else {
+			first := len(x.Names) - len(x.Defaults)
+			for i, name := range x.Names {
+				if i > 0 {
+					p.print(token.COMMA, blank)
+				}
+				if typ := x.ConstType(name); typ != nil {
+					p.print(token.CONST, blank)
+					p.expr(name)
+					p.print(blank)
+					p.expr(typ)
+				} else if constraint := x.Constraint(name); constraint != nil {
+					p.expr(name)
+					p.print(blank)
+					p.expr(constraint)
+				} else {
+					p.expr(name)
+				}
+				if i >= first {
+					p.print(blank, token.ASSIGN, blank)
+					p.expr(x.Defaults[i-first])
+				}
+			}
+		}
 		p.print(token.RBRACK)
 	}
 }
@@ -323,6 +348,9 @@ func (p *printer) parameters(fields *ast.FieldList) {
 				p.identList(par.Names, ws == indent)
 				p.print(blank)
 			}
+			if par.Val.IsValid() {
+				p.print(par.Val, token.VAL, blank)
+			}
 			// parameter type
 			p.expr(stripParensAlways(par.Type))
 			prevLine = parLineEnd
@@ -354,9 +382,13 @@ func (p *printer) signature(params, result *ast.FieldList) {
 		// result != nil
 		p.print(blank)
 		if n == 1 && result.List[0].Names == nil {
-			// single anonymous result; no ()'s
-			p.expr(stripParensAlways(result.List[0].Type))
-			return
+			// single anonymous result; no ()'s unless it is a tuple, which
+			// would look like multiple results without them
+			typ := stripParensAlways(result.List[0].Type)
+			if _, isTuple := typ.(*ast.TupleExpr); !isTuple {
+				p.expr(typ)
+				return
+			}
 		}
 		p.parameters(result)
 	}
@@ -393,6 +425,12 @@ func (p *printer) isOneLineFieldList(list []*ast.Field) bool {
 	return namesSize+typeSize <= maxSize
 }
 
+// isTypeListEntry reports whether f is an entry of the type list of an
+// interface.
+func isTypeListEntry(f *ast.Field) bool {
+	return len(f.Names) == 1 && f.Names[0].Name == "type"
+}
+
 func (p *printer) setLineComment(text string) {
 	p.setComment(&ast.CommentGroup{List: []*ast.Comment{{Slash: token.NoPos, Text: text}}})
 }
@@ -489,12 +527,22 @@ func (p *printer) fieldList(fields *ast.FieldList, isStruct, isIncomplete bool)
 
 		var line int
 		for i, f := range list {
+			if i > 0 && isTypeListEntry(f) && isTypeListEntry(list[i-1]) && f.Names[0].NamePos == list[i-1].Names[0].NamePos {
+				// continuation of a type list
+				p.print(token.COMMA, blank)
+				p.expr(f.Type)
+				p.setComment(f.Comment)
+				continue
+			}
 			if i > 0 {
 				p.linebreak(p.lineFor(f.Pos()), 1, ignore, p.linesFrom(line) > 0)
 			}
 			p.setComment(f.Doc)
 			p.recordLine(&line)
-			if ftyp, isFtyp := f.Type.(*ast.FuncType); isFtyp {
+			if isTypeListEntry(f) {
+				p.print(f.Names[0].Pos(), token.TYPE, blank)
+				p.expr(f.Type)
+			} else if ftyp, isFtyp := f.Type.(*ast.FuncType); isFtyp {
 				// method
 				p.expr(f.Names[0])
 				p.signature(ftyp.Params, ftyp.Results)
@@ -758,6 +806,11 @@ func (p *printer) expr1(expr ast.Expr, prec1, depth int) {
 			p.print(x.Rparen, token.RPAREN)
 		}
 
+	case *ast.TupleExpr:
+		p.print(x.Lparen, token.LPAREN)
+		p.exprList(x.Lparen, x.Elts, depth+1, commaTerm, x.Rparen)
+		p.print(x.Rparen, token.RPAREN)
+
 	case *ast.SelectorExpr:
 		p.selectorExpr(x, depth, false)
 
@@ -1482,8 +1535,8 @@ func (p *printer) genDecl(d *ast.GenDecl) {
 		p.print(d.Lparen, token.LPAREN)
 		if n := len(d.Specs); n > 0 {
 			p.print(indent, formfeed)
-			if n > 1 && (d.Tok == token.CONST || d.Tok == token.VAR) {
-				// two or more grouped const/var declarations:
+			if n > 1 && (d.Tok == token.CONST || d.Tok == token.VAR || d.Tok == token.VAL) {
+				// two or more grouped const/var/val declarations:
 				// determine if the type column must be kept
 				keepType := keepTypeColumn(d.Specs)
 				var line int
@@ -197,6 +197,9 @@ var data = []entry{
 	{"statements.input", "statements.golden", 0},
 	{"slow.input", "slow.golden", idempotent},
 	{"generics.input", "generics.golden", 0},
+	{"tuples.input", "tuples.golden", idempotent},
+	{"pipes.input", "pipes.golden", idempotent},
+	{"vals.input", "vals.golden", idempotent},
 }
 
 func TestFiles(t *testing.T) {
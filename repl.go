@@ -0,0 +1,630 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/format"
+	"github.com/albrow/fo/importer"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/scanner"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/transform"
+	"github.com/albrow/fo/types"
+	"github.com/urfave/cli"
+)
+
+const replHelp = `Enter Fo declarations, statements, or expressions. Declarations (import,
+const, var, val, type, and func) are added to the session, replacing earlier
+ones which declare any of the same names. A short variable declaration
+(x := 1) on its own is added as a var declaration. Other statements are run
+once, and the values of expressions are printed.
+
+Every input is run as a new program, made of the declarations in the session
+and the input, so state is only kept through declarations. The initial values
+of variables are computed again (with any side effects) every time a program
+runs, and changes which statements make to them (such as x++) are lost.
+
+Commands:
+  :type <expr>  print the type of an expression
+  :go <expr>    print the Go code generated for an expression, including any
+                new instantiations of generic types and functions
+  :source       print the imports and declarations of the session
+  :reset        remove all imports and declarations from the session
+  :help         print this message
+  :quit         exit (as does end of input)
+`
+
+// replFmt is the name under which the session imports fmt to print the values
+// of expressions. Imports which end up unused are removed by the transformer.
+const replFmt = "fo_repl_fmt"
+
+// replFmtImport is the import spec for replFmt. It is left out of the source
+// shown to the user.
+const replFmtImport = replFmt + ` "fmt"`
+
+// replSession is the program which is built up in the REPL. It is a single
+// package main, made of the imports and declarations entered so far. Each
+// statement or expression is run on its own as the body of the main function.
+type replSession struct {
+	imports []string
+	decls   []replDecl
+
+	// goImporter holds the imported Go packages. Fo packages are imported
+	// again every time the session is checked, along with a new file set,
+	// so that neither grows with every input.
+	goImporter types.Importer
+	// workDir holds the generated Go code and the program.
+	workDir string
+	// in is the standard input of the program.
+	in  io.Reader
+	out io.Writer
+}
+
+// replDecl is a top-level declaration in the session. names holds the
+// declared names, so that a declaration can be replaced.
+type replDecl struct {
+	names []string
+	src   string
+}
+
+func repl(c *cli.Context) error {
+	workDir, err := ioutil.TempDir("", "fo-repl-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+	s := newReplSession(workDir, os.Stdin, os.Stdout)
+
+	fmt.Fprintln(s.out, "Fo REPL (type :help for help)")
+	in := bufio.NewReader(os.Stdin)
+	for {
+		input, err := readReplInput(in, s.out)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if quit := s.eval(input); quit {
+			return nil
+		}
+	}
+}
+
+func newReplSession(workDir string, in io.Reader, out io.Writer) *replSession {
+	return &replSession{
+		goImporter: importer.Default(),
+		workDir:    workDir,
+		in:         in,
+		out:        out,
+	}
+}
+
+// readReplInput reads lines from in until the brackets in them are balanced.
+func readReplInput(in *bufio.Reader, out io.Writer) (string, error) {
+	prompt := "fo> "
+	var input string
+	for {
+		fmt.Fprint(out, prompt)
+		line, err := in.ReadString('\n')
+		if err == io.EOF && line != "" {
+			err = nil
+		}
+		if err != nil {
+			if err == io.EOF {
+				fmt.Fprintln(out)
+			}
+			return "", err
+		}
+		input += line
+		if bracketDepth(input) <= 0 {
+			return strings.TrimSpace(input), nil
+		}
+		prompt = "... "
+	}
+}
+
+// bracketDepth returns the number of brackets in src which are still open.
+func bracketDepth(src string) int {
+	var s scanner.Scanner
+	file := token.NewFileSet().AddFile("", -1, len(src))
+	s.Init(file, []byte(src), func(token.Position, string) {}, 0)
+	depth := 0
+	for {
+		_, tok, _ := s.Scan()
+		switch tok {
+		case token.EOF:
+			return depth
+		case token.LPAREN, token.LBRACK, token.LBRACE:
+			depth++
+		case token.RPAREN, token.RBRACK, token.RBRACE:
+			depth--
+		}
+	}
+}
+
+// eval handles one input and reports whether the REPL should exit.
+func (s *replSession) eval(input string) bool {
+	if input == "" {
+		return false
+	}
+	var err error
+	if strings.HasPrefix(input, ":") {
+		name, arg := input, ""
+		if i := strings.IndexAny(input, " \t\n"); i >= 0 {
+			name, arg = input[:i], strings.TrimSpace(input[i:])
+		}
+		switch name {
+		case ":q", ":quit":
+			return true
+		case ":help":
+			fmt.Fprint(s.out, replHelp)
+		case ":type":
+			err = s.printType(arg)
+		case ":go":
+			err = s.printGo(arg)
+		case ":source":
+			fmt.Fprint(s.out, s.source(s.imports, s.decls))
+		case ":reset":
+			*s = *newReplSession(s.workDir, s.in, s.out)
+		default:
+			err = fmt.Errorf("unknown command %s (type :help for help)", name)
+		}
+	} else {
+		err = s.add(input)
+	}
+	if err != nil {
+		fmt.Fprintln(s.out, replError(err))
+	}
+	return false
+}
+
+// replError returns the message of err without the position, since positions
+// in the session source don't mean anything to the user.
+func replError(err error) string {
+	var msgs []string
+	for _, d := range compiler.Diagnostics(err, func(string) []byte { return nil }) {
+		msgs = append(msgs, d.Message)
+	}
+	return "error: " + strings.Join(msgs, "\nerror: ")
+}
+
+// add adds a declaration to the session, runs a statement, or prints the value
+// of an expression.
+func (s *replSession) add(input string) error {
+	var sc scanner.Scanner
+	file := token.NewFileSet().AddFile("", -1, len(input))
+	sc.Init(file, []byte(input), nil, 0)
+	_, tok, _ := sc.Scan()
+	switch tok {
+	case token.IMPORT:
+		return s.addImport(strings.TrimSpace(strings.TrimPrefix(input, "import")))
+	case token.CONST, token.VAR, token.VAL, token.TYPE, token.FUNC:
+		// A func keyword may also start a function literal which is called
+		// right away.
+		if tok != token.FUNC || !isExpr(input) {
+			return s.addDecl(input)
+		}
+	}
+	if decl, ok := shortVarDecl(input); ok {
+		return s.addDecl(decl)
+	}
+	if isExpr(input) {
+		tv, err := s.typeOf(input)
+		if err != nil {
+			return err
+		}
+		if !tv.IsVoid() {
+			return s.printValue(input, tv)
+		}
+	}
+	return s.runStmt(input)
+}
+
+func isExpr(input string) bool {
+	_, err := parser.ParseExpr(input)
+	return err == nil
+}
+
+func (s *replSession) addImport(spec string) error {
+	var specs []string
+	if strings.HasPrefix(spec, "(") && strings.HasSuffix(spec, ")") {
+		for _, line := range strings.Split(spec[1:len(spec)-1], "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				specs = append(specs, line)
+			}
+		}
+	} else {
+		specs = []string{spec}
+	}
+	imports := append(append([]string{}, s.imports...), specs...)
+	if _, _, err := s.check(s.program(imports, s.decls, "")); err != nil {
+		return err
+	}
+	s.imports = imports
+	return nil
+}
+
+// addDecl adds a declaration to the session, replacing the earlier ones which
+// declare any of the same names. Variable declarations are run, so that
+// variables whose initial values can't be computed are not kept.
+func (s *replSession) addDecl(src string) error {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+src, 0)
+	if err != nil {
+		return err
+	}
+	decl := replDecl{names: declNames(f.Decls), src: src}
+	declared := map[string]bool{}
+	for _, name := range decl.names {
+		declared[name] = true
+	}
+	var decls []replDecl
+	for _, old := range s.decls {
+		replaced := false
+		for _, name := range old.names {
+			replaced = replaced || declared[name]
+		}
+		if !replaced {
+			decls = append(decls, old)
+		}
+	}
+	decls = append(decls, decl)
+	if isVarDecl(f.Decls) {
+		err = s.run(decls, "")
+	} else {
+		_, _, err = s.check(s.program(s.imports, decls, ""))
+	}
+	if err != nil {
+		return err
+	}
+	s.decls = decls
+	return nil
+}
+
+func isVarDecl(decls []ast.Decl) bool {
+	for _, decl := range decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && (decl.Tok == token.VAR || decl.Tok == token.VAL) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortVarDecl returns the var declaration for input if it is a single short
+// variable declaration, e.g. "var x, y = 1, 2" for "x, y := 1, 2".
+func shortVarDecl(input string) (string, bool) {
+	const prefix = "package main\nfunc main() {\n"
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", prefix+input+"\n}", 0)
+	if err != nil {
+		return "", false
+	}
+	body := f.Decls[0].(*ast.FuncDecl).Body.List
+	if len(body) != 1 {
+		return "", false
+	}
+	assign, ok := body[0].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE {
+		return "", false
+	}
+	names := make([]string, len(assign.Lhs))
+	for i, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+		names[i] = ident.Name
+	}
+	start := fset.Position(assign.Rhs[0].Pos()).Offset - len(prefix)
+	end := fset.Position(assign.Rhs[len(assign.Rhs)-1].End()).Offset - len(prefix)
+	return fmt.Sprintf("var %s = %s", strings.Join(names, ", "), input[start:end]), true
+}
+
+// declNames returns the names declared by decls, other than blank ones.
+func declNames(decls []ast.Decl) []string {
+	var names []string
+	for _, decl := range decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil && len(decl.Recv.List) > 0 {
+				name = types.ExprString(decl.Recv.List[0].Type) + "." + name
+			}
+			names = append(names, name)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if name.Name != "_" {
+							names = append(names, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// runStmt runs stmt in the body of main. The statement is not kept.
+func (s *replSession) runStmt(stmt string) error {
+	// Variables declared by the statement are used right away, since the Go
+	// compiler doesn't allow unused variables.
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package main\nfunc main() {\n"+stmt+"\n}", 0)
+	if err != nil {
+		return err
+	}
+	for _, name := range declaredNames(f.Decls[0].(*ast.FuncDecl).Body.List) {
+		stmt += "\n_ = " + name
+	}
+	return s.run(s.decls, stmt)
+}
+
+// declaredNames returns the names of the variables declared by stmts.
+func declaredNames(stmts []ast.Stmt) []string {
+	var names []string
+	for _, stmt := range stmts {
+		switch stmt := stmt.(type) {
+		case *ast.AssignStmt:
+			if stmt.Tok != token.DEFINE {
+				continue
+			}
+			for _, lhs := range stmt.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name != "_" {
+					names = append(names, ident.Name)
+				}
+			}
+		case *ast.DeclStmt:
+			decl, ok := stmt.Decl.(*ast.GenDecl)
+			if !ok || (decl.Tok != token.VAR && decl.Tok != token.VAL) {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					if name.Name != "_" {
+						names = append(names, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// printValue runs statements which print the value of expr, whose type is
+// given by tv, in the body of main. The values of an expression with multiple values (a function call) are
+// assigned to variables first and printed on one line, separated by commas.
+func (s *replSession) printValue(expr string, tv types.TypeAndValue) error {
+	tuple, _ := tv.Type.(*types.Tuple)
+	if tuple == nil || tuple.Len() == 1 {
+		return s.run(s.decls, fmt.Sprintf("%s.Printf(\"%%#v\\n\", %s)", replFmt, expr))
+	}
+	vars := make([]string, tuple.Len())
+	verbs := make([]string, tuple.Len())
+	for i := range vars {
+		vars[i] = fmt.Sprintf("fo_repl_v%d", i)
+		verbs[i] = "%#v"
+	}
+	return s.run(s.decls, fmt.Sprintf("%s := %s\n%s.Printf(\"%s\\n\", %s)",
+		strings.Join(vars, ", "), expr, replFmt, strings.Join(verbs, ", "), strings.Join(vars, ", ")))
+}
+
+// typeOf returns the type of expr in the body of main.
+func (s *replSession) typeOf(expr string) (types.TypeAndValue, error) {
+	f, trans, err := s.check(s.program(s.imports, s.decls, ""))
+	if err != nil {
+		return types.TypeAndValue{}, err
+	}
+	return types.Eval(trans.Fset, trans.Pkg, mainBody(f).Rbrace, expr)
+}
+
+func (s *replSession) printType(expr string) error {
+	if expr == "" {
+		return errors.New(":type expects an expression")
+	}
+	f, trans, err := s.check(s.program(s.imports, s.decls, ""))
+	if err != nil {
+		return err
+	}
+	tv, err := types.Eval(trans.Fset, trans.Pkg, mainBody(f).Rbrace, expr)
+	if err != nil {
+		return err
+	}
+	if tv.IsVoid() {
+		fmt.Fprintln(s.out, "no value")
+		return nil
+	}
+	typ := types.TypeString(tv.Type, types.RelativeTo(trans.Pkg))
+	switch {
+	case tv.IsType():
+		fmt.Fprintf(s.out, "type %s\n", typ)
+	case tv.Value != nil:
+		fmt.Fprintf(s.out, "%s = %s\n", typ, tv.Value)
+	default:
+		fmt.Fprintln(s.out, typ)
+	}
+	return nil
+}
+
+// printGo prints the Go code for expr, preceded by the declarations for any
+// instantiations which the session didn't need without it.
+func (s *replSession) printGo(expr string) error {
+	if expr == "" {
+		return errors.New(":go expects an expression")
+	}
+	tv, err := s.typeOf(expr)
+	if err != nil {
+		return err
+	}
+	before, err := s.transform(s.program(s.imports, s.decls, ""))
+	if err != nil {
+		return err
+	}
+	instantiated := map[string]bool{}
+	for _, inst := range before.trans.Instantiations() {
+		instantiated[inst.Name] = true
+	}
+
+	stmt := expr
+	if !tv.IsVoid() {
+		stmt = "_ = " + expr
+	}
+	after, err := s.transform(s.program(s.imports, s.decls, stmt))
+	if err != nil {
+		return err
+	}
+	for _, inst := range after.trans.Instantiations() {
+		if instantiated[inst.Name] {
+			continue
+		}
+		for _, decl := range inst.Decls {
+			if spec, ok := decl.(ast.Spec); ok {
+				decl = &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{spec}}
+			}
+			if err := format.Node(s.out, after.trans.Fset, decl); err != nil {
+				return err
+			}
+			fmt.Fprint(s.out, "\n\n")
+		}
+	}
+	body := mainBody(after.file).List
+	var node ast.Node = body[len(body)-1]
+	if assign, ok := node.(*ast.AssignStmt); ok && !tv.IsVoid() {
+		node = assign.Rhs[0]
+	}
+	if err := format.Node(s.out, after.trans.Fset, node); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out)
+	return nil
+}
+
+// source returns the Fo code for a session with the given imports and
+// declarations.
+func (s *replSession) source(imports []string, decls []replDecl) string {
+	buf := &bytes.Buffer{}
+	buf.WriteString("package main\n\n")
+	if len(imports) > 0 {
+		buf.WriteString("import (\n")
+		for _, spec := range imports {
+			fmt.Fprintf(buf, "\t%s\n", spec)
+		}
+		buf.WriteString(")\n\n")
+	}
+	for _, decl := range decls {
+		fmt.Fprintf(buf, "%s\n\n", decl.src)
+	}
+	return buf.String()
+}
+
+// program returns the Fo code for a session with the given imports and
+// declarations, with stmt as the body of main. It also imports fmt as
+// replFmt.
+func (s *replSession) program(imports []string, decls []replDecl, stmt string) string {
+	return s.source(append([]string{replFmtImport}, imports...), decls) + "func main() {\n" + stmt + "\n}\n"
+}
+
+// check parses and type-checks the source of a session.
+func (s *replSession) check(src string) (*ast.File, *transform.Transformer, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "repl.fo", src, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	imp := foexport.NewImporter(fset, nil, s.goImporter)
+	conf := types.Config{
+		Importer: imp,
+		// Imports are often entered before the code which uses them.
+		DisableUnusedImportCheck: true,
+	}
+	info := &types.Info{
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Uses:       map[*ast.Ident]types.Object{},
+	}
+	pkg, err := conf.Check("main", fset, []*ast.File{f}, info)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, &transform.Transformer{
+		Fset:    fset,
+		Pkg:     pkg,
+		Info:    info,
+		Imports: imp.Packages(),
+	}, nil
+}
+
+// replProgram is the Go code generated for a session.
+type replProgram struct {
+	trans *transform.Transformer
+	file  *ast.File
+}
+
+func (s *replSession) transform(src string) (*replProgram, error) {
+	f, trans, err := s.check(src)
+	if err != nil {
+		return nil, err
+	}
+	transformed, err := trans.File(f)
+	if err != nil {
+		return nil, err
+	}
+	return &replProgram{trans: trans, file: transformed}, nil
+}
+
+// run builds and runs the program made of the imports of the session, decls,
+// and stmt as the body of main.
+func (s *replSession) run(decls []replDecl, stmt string) error {
+	prog, err := s.transform(s.program(s.imports, decls, stmt))
+	if err != nil {
+		return err
+	}
+	goFile := filepath.Join(s.workDir, "main.go")
+	buf := &bytes.Buffer{}
+	if err := format.Node(buf, prog.trans.Fset, prog.file); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(goFile, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	exe := filepath.Join(s.workDir, "repl")
+	if runtime.GOOS == "windows" {
+		exe += ".exe"
+	}
+	// The go command is run in the current directory so that imports are
+	// resolved the same way they would be for a program next to it.
+	if output, err := exec.Command("go", "build", "-o", exe, goFile).CombinedOutput(); err != nil {
+		return fmt.Errorf("go build failed: %s\n%s", err, output)
+	}
+
+	cmd := exec.Command(exe)
+	cmd.Stdin = s.in
+	cmd.Stdout = s.out
+	cmd.Stderr = s.out
+	return cmd.Run()
+}
+
+// mainBody returns the body of the main function of a session.
+func mainBody(f *ast.File) *ast.BlockStmt {
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "main" {
+			return fn.Body
+		}
+	}
+	panic("session has no main function")
+}
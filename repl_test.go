@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestREPL(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the REPL needs the go command")
+	}
+	if testing.Short() {
+		t.Skip("the REPL builds a program for every input")
+	}
+	workDir, err := ioutil.TempDir("", "fo-repl-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(workDir)
+	out := &bytes.Buffer{}
+	s := newReplSession(workDir, nil, out)
+
+	testCases := []struct {
+		input    string
+		stdin    string
+		expected string
+	}{
+		{`import ("bufio"; "fmt"; "os"; "strconv")`, "", ""},
+		// Multiple values are printed on one line.
+		{`fmt.Println("hi")`, "", "hi\n3, <nil>\n"},
+		{`strconv.Atoi("12")`, "", "12, <nil>\n"},
+		{`x := 1`, "", ""},
+		// Statements run once, so the output of earlier ones is not repeated.
+		{`fmt.Println("x is", x)`, "", "x is 1\n7, <nil>\n"},
+		// Changes which statements make to variables are not kept.
+		{`x++; fmt.Println(x)`, "", "2\n"},
+		{`x`, "", "1\n"},
+		// Variables are replaced by later declarations.
+		{`r := bufio.NewReader(os.Stdin)`, "", ""},
+		{`x, y := 3, "a"`, "", ""},
+		{`x`, "", "3\n"},
+		{`fmt.Print(r.ReadString('\n'))`, "first line\n", "first line\n<nil>16, <nil>\n"},
+		{`fmt.Print(r.ReadString('\n'))`, "second line\n", "second line\n<nil>17, <nil>\n"},
+		{`:type strconv.Itoa`, "", "func(i int) string\n"},
+		{`:source`, "", "package main\n\nimport (\n\t\"bufio\"; \"fmt\"; \"os\"; \"strconv\"\n)\n\nvar r = bufio.NewReader(os.Stdin)\n\nvar x, y = 3, \"a\"\n\n"},
+		// A variable whose initial value can't be computed is not added.
+		{`var z = func() int { os.Exit(3); return 0 }()`, "", "error: exit status 3\n"},
+		{`:type z`, "", "error: undeclared name: z\n"},
+	}
+	for _, tc := range testCases {
+		out.Reset()
+		s.in = strings.NewReader(tc.stdin)
+		if quit := s.eval(tc.input); quit {
+			t.Fatalf("%s: unexpected quit", tc.input)
+		}
+		if got := out.String(); got != tc.expected {
+			t.Errorf("%s: expected output %q but got %q", tc.input, tc.expected, got)
+		}
+	}
+}
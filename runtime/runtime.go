@@ -0,0 +1,236 @@
+// Package foruntime provides optional runtime support for programs written in
+// Fo.
+//
+// Fo converts each concrete instantiation of a generic type into an ordinary
+// Go type with a generated name, so the %T verb and the reflect package report
+// names like main.Box__int instead of main.Box[int]. When a program is
+// generated with type name registration enabled (e.g. fo run -typenames), the
+// Fo name of each concrete type is registered with this package. TypeName and
+// Value can then be used to show the original spelling in logs and errors.
+//
+// Types which have not been registered are formatted the same way as the
+// reflect package formats them. This includes the instantiations of generic
+// types declared inside of a function (e.g. main.Local__int), which are never
+// registered: the registrations are generated at the package level, where
+// local types can't be referred to.
+package foruntime
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	// names maps types to their Fo names.
+	names map[reflect.Type]string
+	// goNames maps the names reported by reflect to Fo names.
+	goNames map[string]string
+}{
+	names:   map[reflect.Type]string{},
+	goNames: map[string]string{},
+}
+
+// RegisterTypeName records name as the Fo name for a type. ptr must be a nil
+// pointer to the type, e.g.:
+//
+//	foruntime.RegisterTypeName((*Box__int)(nil), "main.Box[int]")
+//
+// RegisterTypeName is typically called from code generated by Fo.
+func RegisterTypeName(ptr interface{}, name string) {
+	typ := reflect.TypeOf(ptr)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		panic(fmt.Errorf("foruntime: RegisterTypeName expects a pointer but got %T", ptr))
+	}
+	typ = typ.Elem()
+	registry.Lock()
+	defer registry.Unlock()
+	registry.names[typ] = name
+	registry.goNames[typ.String()] = name
+}
+
+// TypeName returns the Fo name for the type of v. It is like the %T verb,
+// except that concrete instantiations of generic types are written with their
+// type arguments (e.g. main.Box[int]).
+func TypeName(v interface{}) string {
+	if v == nil {
+		return "<nil>"
+	}
+	return TypeString(reflect.TypeOf(v))
+}
+
+// TypeString returns the Fo name for typ.
+func TypeString(typ reflect.Type) string {
+	registry.RLock()
+	defer registry.RUnlock()
+	buf := &bytes.Buffer{}
+	writeType(buf, typ)
+	return buf.String()
+}
+
+func writeType(buf *bytes.Buffer, typ reflect.Type) {
+	if name, found := registry.names[typ]; found {
+		buf.WriteString(name)
+		return
+	}
+	if typ.Name() != "" {
+		buf.WriteString(typ.String())
+		return
+	}
+	switch typ.Kind() {
+	case reflect.Ptr:
+		buf.WriteString("*")
+		writeType(buf, typ.Elem())
+	case reflect.Slice:
+		buf.WriteString("[]")
+		writeType(buf, typ.Elem())
+	case reflect.Array:
+		buf.WriteString("[" + strconv.Itoa(typ.Len()) + "]")
+		writeType(buf, typ.Elem())
+	case reflect.Map:
+		buf.WriteString("map[")
+		writeType(buf, typ.Key())
+		buf.WriteString("]")
+		writeType(buf, typ.Elem())
+	case reflect.Chan:
+		switch typ.ChanDir() {
+		case reflect.SendDir:
+			buf.WriteString("chan<- ")
+		case reflect.RecvDir:
+			buf.WriteString("<-chan ")
+		default:
+			buf.WriteString("chan ")
+		}
+		writeType(buf, typ.Elem())
+	case reflect.Func:
+		buf.WriteString("func")
+		writeSignature(buf, typ)
+	case reflect.Struct:
+		writeStruct(buf, typ)
+	case reflect.Interface:
+		writeInterface(buf, typ)
+	default:
+		buf.WriteString(typ.String())
+	}
+}
+
+func writeSignature(buf *bytes.Buffer, typ reflect.Type) {
+	buf.WriteString("(")
+	for i := 0; i < typ.NumIn(); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if typ.IsVariadic() && i == typ.NumIn()-1 {
+			buf.WriteString("...")
+			writeType(buf, typ.In(i).Elem())
+		} else {
+			writeType(buf, typ.In(i))
+		}
+	}
+	buf.WriteString(")")
+	switch typ.NumOut() {
+	case 0:
+	case 1:
+		buf.WriteString(" ")
+		writeType(buf, typ.Out(0))
+	default:
+		buf.WriteString(" (")
+		for i := 0; i < typ.NumOut(); i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeType(buf, typ.Out(i))
+		}
+		buf.WriteString(")")
+	}
+}
+
+func writeStruct(buf *bytes.Buffer, typ reflect.Type) {
+	if typ.NumField() == 0 {
+		buf.WriteString("struct {}")
+		return
+	}
+	buf.WriteString("struct { ")
+	for i := 0; i < typ.NumField(); i++ {
+		if i > 0 {
+			buf.WriteString("; ")
+		}
+		field := typ.Field(i)
+		if !field.Anonymous {
+			buf.WriteString(field.Name + " ")
+		}
+		writeType(buf, field.Type)
+		if field.Tag != "" {
+			buf.WriteString(" " + strconv.Quote(string(field.Tag)))
+		}
+	}
+	buf.WriteString(" }")
+}
+
+func writeInterface(buf *bytes.Buffer, typ reflect.Type) {
+	if typ.NumMethod() == 0 {
+		buf.WriteString("interface {}")
+		return
+	}
+	buf.WriteString("interface { ")
+	for i := 0; i < typ.NumMethod(); i++ {
+		if i > 0 {
+			buf.WriteString("; ")
+		}
+		method := typ.Method(i)
+		buf.WriteString(method.Name)
+		writeSignature(buf, method.Type)
+	}
+	buf.WriteString(" }")
+}
+
+// Value returns a fmt.Formatter for v. It formats v in the same way as the fmt
+// package, except that the names of registered types (which appear in the
+// output of verbs like %#v and %+v for some types) are replaced with their Fo
+// names. Note that the %T verb is handled by the fmt package directly and
+// cannot be changed; use TypeName instead.
+func Value(v interface{}) fmt.Formatter {
+	return value{v: v}
+}
+
+type value struct {
+	v interface{}
+}
+
+var qualifiedIdentRegexp = regexp.MustCompile(`[\pL_][\pL\pN_]*\.[\pL_][\pL\pN_]*`)
+
+func (v value) Format(f fmt.State, verb rune) {
+	s := fmt.Sprintf(formatDirective(f, verb), v.v)
+	registry.RLock()
+	s = qualifiedIdentRegexp.ReplaceAllStringFunc(s, func(ident string) string {
+		if name, found := registry.goNames[ident]; found {
+			return name
+		}
+		return ident
+	})
+	registry.RUnlock()
+	io.WriteString(f, s)
+}
+
+// formatDirective reconstructs the formatting directive (e.g. %-8.2f) that
+// was used to format a value.
+func formatDirective(f fmt.State, verb rune) string {
+	directive := "%"
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			directive += string(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		directive += strconv.Itoa(width)
+	}
+	if precision, ok := f.Precision(); ok {
+		directive += "." + strconv.Itoa(precision)
+	}
+	return directive + string(verb)
+}
@@ -0,0 +1,80 @@
+package foruntime
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type Box__int struct {
+	V int
+}
+
+type Box__string struct {
+	V string
+}
+
+type unregistered struct{}
+
+func init() {
+	RegisterTypeName((*Box__int)(nil), "foruntime.Box[int]")
+	RegisterTypeName((*Box__string)(nil), "foruntime.Box[string]")
+}
+
+func TestTypeName(t *testing.T) {
+	// Instantiations of local generic types are never registered.
+	type Local__int struct {
+		V int
+	}
+	testCases := []struct {
+		v        interface{}
+		expected string
+	}{
+		{Box__int{}, "foruntime.Box[int]"},
+		{&Box__int{}, "*foruntime.Box[int]"},
+		{[]Box__string{}, "[]foruntime.Box[string]"},
+		{[2]Box__int{}, "[2]foruntime.Box[int]"},
+		{map[Box__int]*Box__string{}, "map[foruntime.Box[int]]*foruntime.Box[string]"},
+		{make(<-chan Box__int), "<-chan foruntime.Box[int]"},
+		{func(Box__int, ...Box__string) (bool, error) { return false, nil }, "func(foruntime.Box[int], ...foruntime.Box[string]) (bool, error)"},
+		{struct{ B Box__int }{}, "struct { B foruntime.Box[int] }"},
+		{unregistered{}, "foruntime.unregistered"},
+		{Local__int{}, "foruntime.Local__int"},
+		{42, "int"},
+		{nil, "<nil>"},
+	}
+	for _, tc := range testCases {
+		if got := TypeName(tc.v); got != tc.expected {
+			t.Errorf("TypeName(%#v): expected %s but got %s", tc.v, tc.expected, got)
+		}
+	}
+}
+
+func TestTypeStringInterface(t *testing.T) {
+	typ := reflect.TypeOf((*interface {
+		Get() Box__int
+	})(nil)).Elem()
+	expected := "interface { Get() foruntime.Box[int] }"
+	if got := TypeString(typ); got != expected {
+		t.Errorf("expected %s but got %s", expected, got)
+	}
+}
+
+func TestValue(t *testing.T) {
+	testCases := []struct {
+		format   string
+		v        interface{}
+		expected string
+	}{
+		{"%v", Box__int{V: 42}, "{42}"},
+		{"%+v", Box__int{V: 42}, "{V:42}"},
+		{"%#v", Box__int{V: 42}, "foruntime.Box[int]{V:42}"},
+		{"%#v", []Box__string{{V: "a"}}, `[]foruntime.Box[string]{foruntime.Box[string]{V:"a"}}`},
+		{"%5d", 42, "   42"},
+	}
+	for _, tc := range testCases {
+		if got := fmt.Sprintf(tc.format, Value(tc.v)); got != tc.expected {
+			t.Errorf("Sprintf(%q, Value(%v)): expected %s but got %s", tc.format, tc.v, tc.expected, got)
+		}
+	}
+}
@@ -125,6 +125,7 @@ var tokens = [...]elt{
 	{token.ARROW, "<-", operator},
 	{token.INC, "++", operator},
 	{token.DEC, "--", operator},
+	{token.PIPE, "|>", operator},
 
 	{token.EQL, "==", operator},
 	{token.LSS, "<", operator},
@@ -388,6 +389,7 @@ var lines = []string{
 	"<-\n",
 	"++$\n",
 	"--$\n",
+	"|>\n",
 
 	"==\n",
 	"<\n",
@@ -0,0 +1,149 @@
+package heap_test
+
+import (
+	_ "github.com/albrow/fo/std/heap"
+	"testing"
+)
+
+func less(a, b int) bool {
+	return a < b
+}
+
+func popAll(h *heap_Heap__int) []int {
+	var result []int
+	for h.Len() > 0 {
+		result = append(result, h.Pop())
+	}
+	return result
+}
+
+func checkInts(t *testing.T, got, want []int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestHeap(t *testing.T) {
+	h := heap_New__int(less)
+	for _, x := range []int{5, 2, 8, 1, 9, 3} {
+		h.Push(x)
+	}
+	if h.Peek() != 1 {
+		t.Errorf("h.Peek() = %d, want 1", h.Peek())
+	}
+	checkInts(t, popAll(h), []int{1, 2, 3, 5, 8, 9})
+}
+
+func TestNewFromSlice(t *testing.T) {
+	h := heap_NewFromSlice__int([]int{7, 3, 9, 1, 4}, func(a, b int) bool {
+		return a > b
+	})
+	checkInts(t, popAll(h), []int{9, 7, 4, 3, 1})
+}
+
+func TestRemove(t *testing.T) {
+	h := heap_NewFromSlice__int([]int{0, 1, 2, 3, 4, 5, 6, 7}, less)
+	h.Remove(3)
+	h.Remove(h.Len() - 1)
+	if x := h.Remove(0); x != 0 {
+		t.Errorf("h.Remove(0) = %d, want 0", x)
+	}
+	if h.Len() != 5 {
+		t.Fatalf("h.Len() = %d, want 5", h.Len())
+	}
+	got := popAll(h)
+	for i := 1; i < len(got); i++ {
+		if got[i] < got[i-1] {
+			t.Fatalf("popped %v out of order", got)
+		}
+	}
+}
+
+type heap_Heap__int struct {
+	data []int
+	less func(a, b int) bool
+}
+
+func heap_New__int(less func(a, b int) bool) *heap_Heap__int {
+	return &heap_Heap__int{less: less}
+}
+func heap_NewFromSlice__int(data []int, less func(a, b int) bool) *heap_Heap__int {
+	h := &heap_Heap__int{data: data, less: less}
+	n := len(h.data)
+	for i := n/2 - 1; i >= 0; i-- {
+		h.down(i, n)
+	}
+	return h
+}
+func (h *heap_Heap__int) Len() int {
+	return len(h.data)
+}
+func (h *heap_Heap__int) Push(x int) {
+	h.data = append(h.data, x)
+	h.up(len(h.data) - 1)
+}
+func (h *heap_Heap__int) Peek() int {
+	return h.data[0]
+}
+func (h *heap_Heap__int) Pop() int {
+	n := len(h.data) - 1
+	h.swap(0, n)
+	h.down(0, n)
+	x := h.data[n]
+	var zero int
+	h.data[n] = zero
+	h.data = h.data[:n]
+	return x
+}
+func (h *heap_Heap__int) Remove(i int) int {
+	n := len(h.data) - 1
+	if n != i {
+		h.swap(i, n)
+		if !h.down(i, n) {
+			h.up(i)
+		}
+	}
+	x := h.data[n]
+	var zero int
+	h.data[n] = zero
+	h.data = h.data[:n]
+	return x
+}
+func (h *heap_Heap__int) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+}
+func (h *heap_Heap__int) up(j int) {
+	for {
+		i := (j - 1) / 2
+		if i == j || !h.less(h.data[j], h.data[i]) {
+			break
+		}
+		h.swap(i, j)
+		j = i
+	}
+}
+func (h *heap_Heap__int) down(i0, n int) bool {
+	i := i0
+	for {
+		j1 := 2*i + 1
+		if j1 >= n || j1 < 0 {
+			break
+		}
+		j := j1
+		if j2 := j1 + 1; j2 < n && h.less(h.data[j2], h.data[j1]) {
+			j = j2
+		}
+		if !h.less(h.data[j], h.data[i]) {
+			break
+		}
+		h.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
@@ -0,0 +1,85 @@
+package iter_test
+
+import (
+	_ "github.com/albrow/fo/std/iter"
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	got := iter_Map__string__int([]string{"a", "bb", "ccc"}, func(s string) int {
+		return len(s)
+	})
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Map returned %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Map returned %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	got := iter_Filter__int([]int{1, 2, 3, 4, 5, 6}, func(x int) bool {
+		return x%2 == 0
+	})
+	want := []int{2, 4, 6}
+	if len(got) != len(want) {
+		t.Fatalf("Filter returned %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Filter returned %v, want %v", got, want)
+		}
+	}
+	if got := iter_Filter__int(nil, nil); got == nil || len(got) != 0 {
+		t.Errorf("Filter(nil, nil) = %#v, want an empty slice", got)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := iter_Reduce__int__int([]int{1, 2, 3, 4}, 0, func(acc, x int) int {
+		return acc + x
+	})
+	if sum != 10 {
+		t.Errorf("sum = %d, want 10", sum)
+	}
+	joined := iter_Reduce__string__string([]string{"a", "b", "c"}, "", func(acc, s string) string {
+		return acc + strings.ToUpper(s)
+	})
+	if joined != "ABC" {
+		t.Errorf("joined = %q, want %q", joined, "ABC")
+	}
+}
+func iter_Map__string__int(s []string, f func(string) int) []int {
+	result := make([]int, len(s))
+	for i, v := range s {
+		result[i] = f(v)
+	}
+	return result
+}
+func iter_Filter__int(s []int, keep func(int) bool) []int {
+	result := []int{}
+	for _, v := range s {
+		if keep(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+func iter_Reduce__int__int(s []int, initial int, f func(int, int) int) int {
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+func iter_Reduce__string__string(s []string, initial string, f func(string, string) string) string {
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
@@ -0,0 +1,369 @@
+package list_test
+
+import (
+	_ "github.com/albrow/fo/std/list"
+	"testing"
+)
+
+func checkList(t *testing.T, l *list_List__int, es []int) {
+	t.Helper()
+	if l.Len() != len(es) {
+		t.Fatalf("l.Len() = %d, want %d", l.Len(), len(es))
+	}
+	i := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e.Value != es[i] {
+			t.Errorf("elt[%d].Value = %v, want %v", i, e.Value, es[i])
+		}
+		i++
+	}
+	i = len(es) - 1
+	for e := l.Back(); e != nil; e = e.Prev() {
+		if e.Value != es[i] {
+			t.Errorf("reverse elt[%d].Value = %v, want %v", i, e.Value, es[i])
+		}
+		i--
+	}
+}
+
+func TestList(t *testing.T) {
+	l := list_New__int()
+	checkList(t, l, []int{})
+
+	e4 := l.PushBack(4)
+	e1 := l.PushFront(1)
+	l.InsertBefore(3, e4)
+	l.InsertAfter(2, e1)
+	checkList(t, l, []int{1, 2, 3, 4})
+
+	l.MoveToFront(e4)
+	checkList(t, l, []int{4, 1, 2, 3})
+	l.MoveToBack(e4)
+	checkList(t, l, []int{1, 2, 3, 4})
+	l.MoveBefore(e4, e1)
+	checkList(t, l, []int{4, 1, 2, 3})
+	l.MoveAfter(e4, e1)
+	checkList(t, l, []int{1, 4, 2, 3})
+
+	if v := l.Remove(e4); v != 4 {
+		t.Errorf("l.Remove(e4) = %d, want 4", v)
+	}
+	checkList(t, l, []int{1, 2, 3})
+
+	other := list_New__int()
+	e := other.PushBack(5)
+	l.Remove(e)
+	checkList(t, l, []int{1, 2, 3})
+	checkList(t, other, []int{5})
+}
+
+func TestZeroList(t *testing.T) {
+	var l1 list_List__int
+	l1.PushFront(1)
+	checkList(t, &l1, []int{1})
+
+	var l2 list_List__int
+	l2.PushBack(1)
+	checkList(t, &l2, []int{1})
+}
+
+func TestPushList(t *testing.T) {
+	l1 := list_New__int()
+	l1.PushBack(1)
+	l1.PushBack(2)
+	l2 := list_New__int()
+	l2.PushBack(3)
+	l2.PushBack(4)
+
+	l1.PushBackList(l2)
+	checkList(t, l1, []int{1, 2, 3, 4})
+	l1.PushFrontList(l2)
+	checkList(t, l1, []int{3, 4, 1, 2, 3, 4})
+	l2.PushBackList(l2)
+	checkList(t, l2, []int{3, 4, 3, 4})
+}
+
+func TestStrings(t *testing.T) {
+	l := list_New__string()
+	l.PushBack("b")
+	l.PushFront("a")
+	if got := l.Front().Value + l.Back().Value; got != "ab" {
+		t.Errorf("got %q, want %q", got, "ab")
+	}
+}
+
+type (
+	list_Element__int struct {
+		next, prev *list_Element__int
+		list       *list_List__int
+		Value      int
+	}
+	list_Element__string struct {
+		next, prev *list_Element__string
+		list       *list_List__string
+		Value      string
+	}
+)
+
+func (e *list_Element__int) Next() *list_Element__int {
+	if p := e.next; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+func (e *list_Element__string) Next() *list_Element__string {
+	if p := e.next; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+func (e *list_Element__int) Prev() *list_Element__int {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+func (e *list_Element__string) Prev() *list_Element__string {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+type (
+	list_List__int struct {
+		root list_Element__int
+		len  int
+	}
+	list_List__string struct {
+		root list_Element__string
+		len  int
+	}
+)
+
+func (l *list_List__int) Init() *list_List__int {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+	return l
+}
+func (l *list_List__string) Init() *list_List__string {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+	return l
+}
+func list_New__int() *list_List__int {
+	return (&list_List__int{}).Init()
+}
+func list_New__string() *list_List__string {
+	return (&list_List__string{}).Init()
+}
+func (l *list_List__int) Len() int {
+	return l.len
+}
+func (l *list_List__string) Len() int {
+	return l.len
+}
+func (l *list_List__int) Front() *list_Element__int {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+func (l *list_List__string) Front() *list_Element__string {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+func (l *list_List__int) Back() *list_Element__int {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+func (l *list_List__string) Back() *list_Element__string {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+func (l *list_List__int) lazyInit() {
+	if l.root.next == nil {
+		l.Init()
+	}
+}
+func (l *list_List__string) lazyInit() {
+	if l.root.next == nil {
+		l.Init()
+	}
+}
+func (l *list_List__int) insert(e, at *list_Element__int) *list_Element__int {
+	n := at.next
+	at.next = e
+	e.prev = at
+	e.next = n
+	n.prev = e
+	e.list = l
+	l.len++
+	return e
+}
+func (l *list_List__string) insert(e, at *list_Element__string) *list_Element__string {
+	n := at.next
+	at.next = e
+	e.prev = at
+	e.next = n
+	n.prev = e
+	e.list = l
+	l.len++
+	return e
+}
+func (l *list_List__int) insertValue(v int, at *list_Element__int) *list_Element__int {
+	return l.insert(&list_Element__int{Value: v}, at)
+}
+func (l *list_List__string) insertValue(v string, at *list_Element__string) *list_Element__string {
+	return l.insert(&list_Element__string{Value: v}, at)
+}
+func (l *list_List__int) remove(e *list_Element__int) *list_Element__int {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.len--
+	return e
+}
+func (l *list_List__string) remove(e *list_Element__string) *list_Element__string {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	l.len--
+	return e
+}
+func (l *list_List__int) Remove(e *list_Element__int) int {
+	if e.list == l {
+		l.remove(e)
+	}
+	return e.Value
+}
+func (l *list_List__string) Remove(e *list_Element__string) string {
+	if e.list == l {
+		l.remove(e)
+	}
+	return e.Value
+}
+func (l *list_List__int) PushFront(v int) *list_Element__int {
+	l.lazyInit()
+	return l.insertValue(v, &l.root)
+}
+func (l *list_List__string) PushFront(v string) *list_Element__string {
+	l.lazyInit()
+	return l.insertValue(v, &l.root)
+}
+func (l *list_List__int) PushBack(v int) *list_Element__int {
+	l.lazyInit()
+	return l.insertValue(v, l.root.prev)
+}
+func (l *list_List__string) PushBack(v string) *list_Element__string {
+	l.lazyInit()
+	return l.insertValue(v, l.root.prev)
+}
+func (l *list_List__int) InsertBefore(v int, mark *list_Element__int) *list_Element__int {
+	if mark.list != l {
+		return nil
+	}
+	return l.insertValue(v, mark.prev)
+}
+func (l *list_List__string) InsertBefore(v string, mark *list_Element__string) *list_Element__string {
+	if mark.list != l {
+		return nil
+	}
+	return l.insertValue(v, mark.prev)
+}
+func (l *list_List__int) InsertAfter(v int, mark *list_Element__int) *list_Element__int {
+	if mark.list != l {
+		return nil
+	}
+	return l.insertValue(v, mark)
+}
+func (l *list_List__string) InsertAfter(v string, mark *list_Element__string) *list_Element__string {
+	if mark.list != l {
+		return nil
+	}
+	return l.insertValue(v, mark)
+}
+func (l *list_List__int) MoveToFront(e *list_Element__int) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.insert(l.remove(e), &l.root)
+}
+func (l *list_List__string) MoveToFront(e *list_Element__string) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.insert(l.remove(e), &l.root)
+}
+func (l *list_List__int) MoveToBack(e *list_Element__int) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.insert(l.remove(e), l.root.prev)
+}
+func (l *list_List__string) MoveToBack(e *list_Element__string) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.insert(l.remove(e), l.root.prev)
+}
+func (l *list_List__int) MoveBefore(e, mark *list_Element__int) {
+	if e.list != l || e == mark || mark.list != l {
+		return
+	}
+	l.insert(l.remove(e), mark.prev)
+}
+func (l *list_List__string) MoveBefore(e, mark *list_Element__string) {
+	if e.list != l || e == mark || mark.list != l {
+		return
+	}
+	l.insert(l.remove(e), mark.prev)
+}
+func (l *list_List__int) MoveAfter(e, mark *list_Element__int) {
+	if e.list != l || e == mark || mark.list != l {
+		return
+	}
+	l.insert(l.remove(e), mark)
+}
+func (l *list_List__string) MoveAfter(e, mark *list_Element__string) {
+	if e.list != l || e == mark || mark.list != l {
+		return
+	}
+	l.insert(l.remove(e), mark)
+}
+func (l *list_List__int) PushBackList(other *list_List__int) {
+	l.lazyInit()
+	for i, e := other.Len(), other.Front(); i > 0; i, e = i-1, e.Next() {
+		l.insertValue(e.Value, l.root.prev)
+	}
+}
+func (l *list_List__string) PushBackList(other *list_List__string) {
+	l.lazyInit()
+	for i, e := other.Len(), other.Front(); i > 0; i, e = i-1, e.Next() {
+		l.insertValue(e.Value, l.root.prev)
+	}
+}
+func (l *list_List__int) PushFrontList(other *list_List__int) {
+	l.lazyInit()
+	for i, e := other.Len(), other.Back(); i > 0; i, e = i-1, e.Prev() {
+		l.insertValue(e.Value, &l.root)
+	}
+}
+func (l *list_List__string) PushFrontList(other *list_List__string) {
+	l.lazyInit()
+	for i, e := other.Len(), other.Back(); i > 0; i, e = i-1, e.Prev() {
+		l.insertValue(e.Value, &l.root)
+	}
+}
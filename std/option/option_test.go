@@ -0,0 +1,147 @@
+package option_test
+
+import (
+	_ "github.com/albrow/fo/std/option"
+	"testing"
+)
+
+func TestSome(t *testing.T) {
+	o := option_Some__int(42)
+	if !o.IsSome() || o.IsNone() {
+		t.Fatalf("Some(42) is not Some")
+	}
+	if v, ok := o.Get(); !ok || v != 42 {
+		t.Errorf("o.Get() = %d, %v, want 42, true", v, ok)
+	}
+	if v := o.Unwrap(); v != 42 {
+		t.Errorf("o.Unwrap() = %d, want 42", v)
+	}
+	if v := o.UnwrapOr(7); v != 42 {
+		t.Errorf("o.UnwrapOr(7) = %d, want 42", v)
+	}
+}
+
+func TestNone(t *testing.T) {
+	o := option_None__int()
+	var zero option_Option__int
+	if o != zero {
+		t.Errorf("None() = %v, want the zero value", o)
+	}
+	if o.IsSome() || !o.IsNone() {
+		t.Fatalf("None() is not None")
+	}
+	if v, ok := o.Get(); ok || v != 0 {
+		t.Errorf("o.Get() = %d, %v, want 0, false", v, ok)
+	}
+	if v := o.UnwrapOr(7); v != 7 {
+		t.Errorf("o.UnwrapOr(7) = %d, want 7", v)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("o.Unwrap() did not panic")
+		}
+	}()
+	o.Unwrap()
+}
+
+func TestFromPair(t *testing.T) {
+	m := map[string]int{"a": 1}
+	v, ok := m["a"]
+	if o := option_FromPair__int(v, ok); o.UnwrapOr(0) != 1 {
+		t.Errorf(`FromPair(m["a"]) = %v, want Some(1)`, o)
+	}
+	v, ok = m["b"]
+	if o := option_FromPair__int(v, ok); o.IsSome() {
+		t.Errorf(`FromPair(m["b"]) = %v, want None`, o)
+	}
+}
+
+func TestMap(t *testing.T) {
+	length := func(s string) int {
+		return len(s)
+	}
+	if o := option_Map__string__int(option_Some__string("abc"), length); o.UnwrapOr(0) != 3 {
+		t.Errorf("Map(Some(\"abc\"), length) = %v, want Some(3)", o)
+	}
+	if o := option_Map__string__int(option_None__string(), length); o.IsSome() {
+		t.Errorf("Map(None(), length) = %v, want None", o)
+	}
+}
+
+type (
+	option_Option__int struct {
+		value int
+		ok    bool
+	}
+	option_Option__string struct {
+		value string
+		ok    bool
+	}
+)
+
+func option_Some__int(v int) option_Option__int {
+	return option_Option__int{value: v, ok: true}
+}
+func option_Some__string(v string) option_Option__string {
+	return option_Option__string{value: v, ok: true}
+}
+func option_None__int() option_Option__int {
+	return option_Option__int{}
+}
+func option_None__string() option_Option__string {
+	return option_Option__string{}
+}
+func option_FromPair__int(v int, ok bool) option_Option__int {
+	if !ok {
+		return option_None__int()
+	}
+	return option_Some__int(v)
+}
+func (o option_Option__int) IsSome() bool {
+	return o.ok
+}
+func (o option_Option__string) IsSome() bool {
+	return o.ok
+}
+func (o option_Option__int) IsNone() bool {
+	return !o.ok
+}
+func (o option_Option__string) IsNone() bool {
+	return !o.ok
+}
+func (o option_Option__int) Get() (int, bool) {
+	return o.value, o.ok
+}
+func (o option_Option__string) Get() (string, bool) {
+	return o.value, o.ok
+}
+func (o option_Option__int) Unwrap() int {
+	if !o.ok {
+		panic("option: Unwrap called on None")
+	}
+	return o.value
+}
+func (o option_Option__string) Unwrap() string {
+	if !o.ok {
+		panic("option: Unwrap called on None")
+	}
+	return o.value
+}
+func (o option_Option__int) UnwrapOr(def int) int {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+func (o option_Option__string) UnwrapOr(def string) string {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+func option_Map__string__int(o option_Option__string, f func(string) int) option_Option__int {
+	if !o.ok {
+		return option_None__int()
+	}
+	return option_Some__int(f(o.value))
+}
@@ -0,0 +1,126 @@
+package orderedmap_test
+
+import (
+	_ "github.com/albrow/fo/std/orderedmap"
+	"testing"
+)
+
+func checkKeys(t *testing.T, m *orderedmap_Map__string__int, keys []string) {
+	t.Helper()
+	if m.Len() != len(keys) {
+		t.Fatalf("m.Len() = %d, want %d", m.Len(), len(keys))
+	}
+	got := m.Keys()
+	for i := range keys {
+		if got[i] != keys[i] {
+			t.Fatalf("m.Keys() = %v, want %v", got, keys)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	m := orderedmap_New__string__int()
+	checkKeys(t, m, []string{})
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	checkKeys(t, m, []string{"c", "a", "b"})
+
+	m.Set("c", 30)
+	checkKeys(t, m, []string{"c", "a", "b"})
+	if v, found := m.Get("c"); !found || v != 30 {
+		t.Errorf(`m.Get("c") = %d, %v, want 30, true`, v, found)
+	}
+	if v, found := m.Get("d"); found || v != 0 {
+		t.Errorf(`m.Get("d") = %d, %v, want 0, false`, v, found)
+	}
+
+	m.Delete("a")
+	m.Delete("d")
+	checkKeys(t, m, []string{"c", "b"})
+
+	m.Set("a", 10)
+	checkKeys(t, m, []string{"c", "b", "a"})
+	values := m.Values()
+	want := []int{30, 2, 10}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Fatalf("m.Values() = %v, want %v", values, want)
+		}
+	}
+}
+
+func TestEach(t *testing.T) {
+	m := orderedmap_New__string__int()
+	m.Set("x", 1)
+	m.Set("y", 2)
+	m.Set("z", 3)
+
+	var keys []string
+	sum := 0
+	m.Each(func(key string, value int) bool {
+		keys = append(keys, key)
+		sum += value
+		return key != "y"
+	})
+	if len(keys) != 2 || keys[0] != "x" || keys[1] != "y" {
+		t.Errorf("Each visited %v, want [x y]", keys)
+	}
+	if sum != 3 {
+		t.Errorf("sum = %d, want 3", sum)
+	}
+}
+
+type orderedmap_Map__string__int struct {
+	keys   []string
+	values map[string]int
+}
+
+func orderedmap_New__string__int() *orderedmap_Map__string__int {
+	return &orderedmap_Map__string__int{values: map[string]int{}}
+}
+func (m *orderedmap_Map__string__int) Len() int {
+	return len(m.keys)
+}
+func (m *orderedmap_Map__string__int) Set(key string, value int) {
+	if _, found := m.values[key]; !found {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+func (m *orderedmap_Map__string__int) Get(key string) (int, bool) {
+	value, found := m.values[key]
+	return value, found
+}
+func (m *orderedmap_Map__string__int) Delete(key string) {
+	if _, found := m.values[key]; !found {
+		return
+	}
+	delete(m.values, key)
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+func (m *orderedmap_Map__string__int) Keys() []string {
+	keys := make([]string, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+func (m *orderedmap_Map__string__int) Values() []int {
+	values := make([]int, len(m.keys))
+	for i, key := range m.keys {
+		values[i] = m.values[key]
+	}
+	return values
+}
+func (m *orderedmap_Map__string__int) Each(f func(key string, value int) bool) {
+	for _, key := range m.keys {
+		if !f(key, m.values[key]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package result_test
+
+import (
+	"errors"
+	_ "github.com/albrow/fo/std/result"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestOk(t *testing.T) {
+	r := result_Ok__string("hello")
+	if !r.IsOk() || r.IsErr() {
+		t.Fatalf("Ok(\"hello\") is not Ok")
+	}
+	if v, err := r.Get(); err != nil || v != "hello" {
+		t.Errorf("r.Get() = %q, %v, want \"hello\", nil", v, err)
+	}
+	if err := r.Error(); err != nil {
+		t.Errorf("r.Error() = %v, want nil", err)
+	}
+	if v := r.Unwrap(); v != "hello" {
+		t.Errorf("r.Unwrap() = %q, want \"hello\"", v)
+	}
+	if v := r.UnwrapOr("bye"); v != "hello" {
+		t.Errorf("r.UnwrapOr(\"bye\") = %q, want \"hello\"", v)
+	}
+}
+
+func TestErr(t *testing.T) {
+	r := result_Err__string(errTest)
+	if r.IsOk() || !r.IsErr() {
+		t.Fatalf("Err(errTest) is not Err")
+	}
+	if _, err := r.Get(); err != errTest {
+		t.Errorf("r.Get() returned error %v, want %v", err, errTest)
+	}
+	if v := r.UnwrapOr("bye"); v != "bye" {
+		t.Errorf("r.UnwrapOr(\"bye\") = %q, want \"bye\"", v)
+	}
+	defer func() {
+		if got := recover(); got != errTest {
+			t.Errorf("r.Unwrap() panicked with %v, want %v", got, errTest)
+		}
+	}()
+	r.Unwrap()
+}
+
+func TestFrom(t *testing.T) {
+	if r := result_From__int(1, nil); r.UnwrapOr(0) != 1 {
+		t.Errorf("From(1, nil) = %v, want Ok(1)", r)
+	}
+	if r := result_From__int(1, errTest); r.Error() != errTest {
+		t.Errorf("From(1, errTest) = %v, want Err(errTest)", r)
+	}
+}
+
+func TestMap(t *testing.T) {
+	double := func(x int) int {
+		return x * 2
+	}
+	if r := result_Map__int__int(result_Ok__int(21), double); r.UnwrapOr(0) != 42 {
+		t.Errorf("Map(Ok(21), double) = %v, want Ok(42)", r)
+	}
+	if r := result_Map__int__int(result_Err__int(errTest), double); r.Error() != errTest {
+		t.Errorf("Map(Err(errTest), double) = %v, want Err(errTest)", r)
+	}
+}
+
+type (
+	result_Result__int struct {
+		value int
+		err   error
+	}
+	result_Result__string struct {
+		value string
+		err   error
+	}
+)
+
+func result_Ok__int(v int) result_Result__int {
+	return result_Result__int{value: v}
+}
+func result_Ok__string(v string) result_Result__string {
+	return result_Result__string{value: v}
+}
+func result_Err__int(err error) result_Result__int {
+	if err == nil {
+		panic("result: Err called with a nil error")
+	}
+	return result_Result__int{err: err}
+}
+func result_Err__string(err error) result_Result__string {
+	if err == nil {
+		panic("result: Err called with a nil error")
+	}
+	return result_Result__string{err: err}
+}
+func result_From__int(v int, err error) result_Result__int {
+	if err != nil {
+		return result_Err__int(err)
+	}
+	return result_Ok__int(v)
+}
+func (r result_Result__int) IsOk() bool {
+	return r.err == nil
+}
+func (r result_Result__string) IsOk() bool {
+	return r.err == nil
+}
+func (r result_Result__int) IsErr() bool {
+	return r.err != nil
+}
+func (r result_Result__string) IsErr() bool {
+	return r.err != nil
+}
+func (r result_Result__int) Get() (int, error) {
+	return r.value, r.err
+}
+func (r result_Result__string) Get() (string, error) {
+	return r.value, r.err
+}
+func (r result_Result__int) Error() error {
+	return r.err
+}
+func (r result_Result__string) Error() error {
+	return r.err
+}
+func (r result_Result__int) Unwrap() int {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+func (r result_Result__string) Unwrap() string {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+func (r result_Result__int) UnwrapOr(def int) int {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+func (r result_Result__string) UnwrapOr(def string) string {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+func result_Map__int__int(r result_Result__int, f func(int) int) result_Result__int {
+	if r.err != nil {
+		return result_Err__int(r.err)
+	}
+	return result_Ok__int(f(r.value))
+}
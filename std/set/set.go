@@ -0,0 +1,3 @@
+package set
+
+import _ "fmt"
@@ -0,0 +1,171 @@
+package set_test
+
+import (
+	"fmt"
+	_ "github.com/albrow/fo/std/set"
+	"sort"
+	"testing"
+)
+
+func checkSet(t *testing.T, s set_Set__int, es []int) {
+	t.Helper()
+	got := s.Slice()
+	sort.Ints(got)
+	if len(got) != len(es) {
+		t.Fatalf("s.Slice() = %v, want %v", got, es)
+	}
+	for i := range es {
+		if got[i] != es[i] {
+			t.Fatalf("s.Slice() = %v, want %v", got, es)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := set_New__int()
+	checkSet(t, s, []int{})
+
+	s.Add(3, 1, 2, 1)
+	checkSet(t, s, []int{1, 2, 3})
+	if s.Len() != 3 {
+		t.Errorf("s.Len() = %d, want 3", s.Len())
+	}
+	if !s.Contains(2) {
+		t.Errorf("s.Contains(2) = false, want true")
+	}
+
+	s.Remove(2)
+	s.Remove(4)
+	checkSet(t, s, []int{1, 3})
+	if s.Contains(2) {
+		t.Errorf("s.Contains(2) = true, want false")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := set_NewFromSlice__int([]int{1, 2, 3})
+	b := set_NewFromSlice__int([]int{2, 3, 4})
+	checkSet(t, set_Union__int(a, b), []int{1, 2, 3, 4})
+	checkSet(t, set_Intersect__int(a, b), []int{2, 3})
+	checkSet(t, set_Diff__int(a, b), []int{1})
+	checkSet(t, set_Diff__int(b, a), []int{4})
+}
+
+func TestString(t *testing.T) {
+	s := set_NewFromSlice__string([]string{"a", "a"})
+	if got := s.String(); got != "[a]" {
+		t.Errorf("s.String() = %q, want %q", got, "[a]")
+	}
+}
+
+type (
+	set_Set__int map[int]struct {
+	}
+	set_Set__string map[string]struct {
+	}
+)
+
+func set_New__int() set_Set__int {
+	return set_Set__int{}
+}
+func set_New__string() set_Set__string {
+	return set_Set__string{}
+}
+func set_NewFromSlice__int(slice []int) set_Set__int {
+	s := set_New__int()
+	for _, v := range slice {
+		s.Add(v)
+	}
+	return s
+}
+func set_NewFromSlice__string(slice []string) set_Set__string {
+	s := set_New__string()
+	for _, v := range slice {
+		s.Add(v)
+	}
+	return s
+}
+func (s set_Set__int) Add(vs ...int) {
+	for _, v := range vs {
+		s[v] = struct {
+		}{}
+	}
+}
+func (s set_Set__string) Add(vs ...string) {
+	for _, v := range vs {
+		s[v] = struct {
+		}{}
+	}
+}
+func (s set_Set__int) Remove(v int) {
+	delete(s, v)
+}
+func (s set_Set__string) Remove(v string) {
+	delete(s, v)
+}
+func (s set_Set__int) Contains(v int) bool {
+	_, ok := s[v]
+	return ok
+}
+func (s set_Set__string) Contains(v string) bool {
+	_, ok := s[v]
+	return ok
+}
+func (s set_Set__int) Len() int {
+	return len(s)
+}
+func (s set_Set__string) Len() int {
+	return len(s)
+}
+func (s set_Set__int) Slice() []int {
+	slice := make([]int, len(s))
+	i := 0
+	for v := range s {
+		slice[i] = v
+		i++
+	}
+	return slice
+}
+func (s set_Set__string) Slice() []string {
+	slice := make([]string, len(s))
+	i := 0
+	for v := range s {
+		slice[i] = v
+		i++
+	}
+	return slice
+}
+func (s set_Set__int) String() string {
+	return fmt.Sprint(s.Slice())
+}
+func (s set_Set__string) String() string {
+	return fmt.Sprint(s.Slice())
+}
+func set_Union__int(a, b set_Set__int) set_Set__int {
+	result := set_New__int()
+	for v := range a {
+		result.Add(v)
+	}
+	for v := range b {
+		result.Add(v)
+	}
+	return result
+}
+func set_Intersect__int(a, b set_Set__int) set_Set__int {
+	result := set_New__int()
+	for v := range a {
+		if b.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+func set_Diff__int(a, b set_Set__int) set_Set__int {
+	result := set_New__int()
+	for v := range a {
+		if !b.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
@@ -64,6 +64,7 @@ const (
 	ARROW // <-
 	INC   // ++
 	DEC   // --
+	PIPE  // |>
 
 	EQL    // ==
 	LSS    // <
@@ -122,6 +123,11 @@ const (
 	TYPE
 	VAR
 	keyword_end
+
+	// Fo contextual keywords. These are scanned as identifiers, so they can
+	// still be used as names, and are only recognized by the parser where an
+	// identifier could not appear.
+	VAL
 )
 
 var tokens = [...]string{
@@ -168,6 +174,7 @@ var tokens = [...]string{
 	ARROW: "<-",
 	INC:   "++",
 	DEC:   "--",
+	PIPE:  "|>",
 
 	EQL:    "==",
 	LSS:    "<",
@@ -222,6 +229,8 @@ var tokens = [...]string{
 	SWITCH: "switch",
 	TYPE:   "type",
 	VAR:    "var",
+
+	VAL: "val",
 }
 
 // String returns the string corresponding to the token tok.
@@ -263,7 +272,7 @@ func (op Token) Precedence() int {
 		return 1
 	case LAND:
 		return 2
-	case EQL, NEQ, LSS, LEQ, GTR, GEQ:
+	case EQL, NEQ, LSS, LEQ, GTR, GEQ, PIPE:
 		return 3
 	case ADD, SUB, OR, XOR:
 		return 4
@@ -0,0 +1,459 @@
+package transform
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/astclone"
+	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/mangle"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// generic returns the generic declaration for the given key, which may refer
+// to a declaration in Pkg or to the local name of a declaration from one of the
+// imported Fo packages.
+func (trans *Transformer) generic(key string) (*types.GenericDecl, bool) {
+	if decl, found := trans.Pkg.Generics()[key]; found {
+		return decl, true
+	}
+	decl, found := trans.imported[key]
+	return decl, found
+}
+
+// importedName returns the local name for the generic declaration with the
+// given name in an imported Fo package. The local name is mangled so that
+// instantiations can be demangled to the qualified name (e.g. lib.Box[int]).
+func (trans *Transformer) importedName(pkg *types.Package, name string) string {
+	return mangle.Qualified(trans.pkgName(pkg), name)
+}
+
+// localName returns the name that should be used to refer to obj in the
+// transformed code.
+func (trans *Transformer) localName(obj types.Object) string {
+	if obj.Pkg() != nil && obj.Pkg() != trans.Pkg {
+		if _, found := trans.Imports[obj.Pkg().Path()]; found {
+			return trans.importedName(obj.Pkg(), obj.Name())
+		}
+	}
+	return obj.Name()
+}
+
+// importedPackage returns the imported Fo package referred to by sel (e.g.
+// lib in lib.Box) or nil if sel is not a qualified identifier for an imported
+// Fo package.
+func (trans *Transformer) importedPackage(sel *ast.SelectorExpr) *foexport.Package {
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok || trans.Info == nil {
+		return nil
+	}
+	pkgName, ok := trans.Info.Uses[ident].(*types.PkgName)
+	if !ok {
+		return nil
+	}
+	return trans.Imports[pkgName.Imported().Path()]
+}
+
+// addImportedGenerics returns a copy of f with declarations added for each
+// generic type or function from an imported Fo package that has at least one
+// usage. The added declarations are still generic; they are instantiated
+// along with the rest of the file.
+func (trans *Transformer) addImportedGenerics(f *ast.File) (*ast.File, error) {
+	if trans.imported == nil {
+		trans.imported = map[string]*types.GenericDecl{}
+	}
+	paths := make([]string, 0, len(trans.Imports))
+	for path := range trans.Imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	// Register all the local names first so that generic declarations can
+	// refer to each other across packages.
+	for _, path := range paths {
+		pkg := trans.Imports[path].Types
+		for key, decl := range pkg.Generics() {
+			if decl.Local() {
+				continue
+			}
+			localDecl := *decl
+			var localKey string
+			if i := strings.Index(key, "."); i >= 0 {
+				// Methods keep their names but the receiver type is renamed.
+				localKey = trans.importedName(pkg, key[:i]) + key[i:]
+			} else {
+				localKey = trans.importedName(pkg, key)
+				localDecl.Name = localKey
+			}
+			trans.imported[localKey] = &localDecl
+		}
+	}
+
+	newFile := *f
+	newFile.Decls = append([]ast.Decl{}, f.Decls...)
+	for _, path := range paths {
+		l := &localizer{
+			trans: trans,
+			pkg:   trans.Imports[path],
+			file:  &newFile,
+		}
+		decls, err := l.decls()
+		if err != nil {
+			return nil, err
+		}
+		newFile.Decls = append(newFile.Decls, decls...)
+	}
+	return &newFile, nil
+}
+
+// blankUnusedImports renames imports which are no longer used after all
+// generic types and functions have been replaced with concrete ones. This
+// happens for imported Fo packages, and for any package which was only used
+// inside of generic declarations. The import is kept so that package
+// initialization still happens.
+func (trans *Transformer) blankUnusedImports(f *ast.File) {
+	for _, spec := range f.Imports {
+		path := strings.Trim(spec.Path.Value, `"`)
+		name := trans.importedPackageName(path)
+		if name == "" {
+			continue
+		}
+		if spec.Name != nil {
+			if spec.Name.Name == "_" || spec.Name.Name == "." {
+				continue
+			}
+			name = spec.Name.Name
+		}
+		used := false
+		ast.Inspect(f, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == name {
+					used = true
+				}
+			}
+			return !used
+		})
+		if !used {
+			spec.Name = ast.NewIdent("_")
+		}
+	}
+}
+
+// importedPackageName returns the name of the package imported with the given
+// path, or "" if it is unknown.
+func (trans *Transformer) importedPackageName(path string) string {
+	if pkg, found := trans.Imports[path]; found {
+		return pkg.Types.Name()
+	}
+	if trans.Pkg == nil {
+		return ""
+	}
+	for _, pkg := range trans.Pkg.Imports() {
+		if pkg.Path() == path {
+			return pkg.Name()
+		}
+	}
+	return ""
+}
+
+// localizer copies generic declarations from an imported Fo package into the
+// package being transformed. References to other declarations in the imported
+// package are rewritten so that they are valid in their new location.
+type localizer struct {
+	trans *Transformer
+	pkg   *foexport.Package
+	file  *ast.File
+
+	// uses and selections are keyed by position so that they still work for
+	// cloned nodes.
+	uses       map[token.Pos]types.Object
+	selections map[token.Pos]*types.Selection
+}
+
+func (l *localizer) decls() ([]ast.Decl, error) {
+	l.uses = map[token.Pos]types.Object{}
+	for ident, obj := range l.pkg.Info.Uses {
+		l.uses[ident.Pos()] = obj
+	}
+	l.selections = map[token.Pos]*types.Selection{}
+	for sel, selection := range l.pkg.Info.Selections {
+		l.selections[sel.Sel.Pos()] = selection
+	}
+
+	generics := l.pkg.Types.Generics()
+	used := func(key string) bool {
+		decl, found := generics[key]
+		return found && len(decl.Usages) > 0
+	}
+
+	var results []ast.Decl
+	for _, decl := range l.pkg.File.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if !used(typeSpec.Name.Name) {
+					continue
+				}
+				newSpec, err := l.localize(typeSpec)
+				if err != nil {
+					return nil, err
+				}
+				newSpec.(*ast.TypeSpec).Name = ast.NewIdent(l.trans.importedName(l.pkg.Types, typeSpec.Name.Name))
+				results = append(results, &ast.GenDecl{
+					Tok:   token.TYPE,
+					Specs: []ast.Spec{newSpec.(*ast.TypeSpec)},
+				})
+			}
+		case *ast.FuncDecl:
+			key := decl.Name.Name
+			recvName := recvTypeName(decl)
+			if recvName != "" {
+				key = recvName + "." + key
+			}
+			if !used(key) && (recvName == "" || !used(recvName)) {
+				continue
+			}
+			newFunc, err := l.localize(decl)
+			if err != nil {
+				return nil, err
+			}
+			if recvName == "" {
+				// Keep the position so that specializations can still be told
+				// apart from the generic function.
+				newFunc.(*ast.FuncDecl).Name = &ast.Ident{
+					NamePos: decl.Name.NamePos,
+					Name:    l.trans.importedName(l.pkg.Types, decl.Name.Name),
+				}
+			}
+			results = append(results, newFunc.(*ast.FuncDecl))
+		}
+	}
+	return results, nil
+}
+
+// recvTypeName returns the name of the receiver base type for decl or an empty
+// string if decl is not a method.
+func recvTypeName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return ""
+	}
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if typeArgExpr, ok := recv.(*ast.TypeArgExpr); ok {
+		recv = typeArgExpr.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func (l *localizer) localize(node ast.Node) (ast.Node, error) {
+	var err error
+	// typeArgs holds the index expressions which are actually type arguments.
+	// They are replaced after their children so that the generic identifier is
+	// localized too.
+	typeArgs := map[*ast.IndexExpr]bool{}
+	result := astutil.Apply(astclone.Clone(node), func(c *astutil.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch n := c.Node().(type) {
+		case *ast.IndexExpr:
+			// The parser cannot always tell the difference between indexing and
+			// a single type argument, but the type-checker already did.
+			if l.isGeneric(n.X) {
+				typeArgs[n] = true
+			}
+		case *ast.SelectorExpr:
+			if ident, ok := n.X.(*ast.Ident); ok {
+				if pkgName, ok := l.uses[ident.Pos()].(*types.PkgName); ok {
+					imported := pkgName.Imported()
+					if _, found := l.trans.Imports[imported.Path()]; found && l.isGeneric(n) {
+						c.Replace(ast.NewIdent(l.trans.importedName(imported, n.Sel.Name)))
+						return false
+					}
+					ident.Name = l.importName(imported, pkgName.Name())
+					return false
+				}
+			}
+			if selection, found := l.selections[n.Sel.Pos()]; found {
+				obj := selection.Obj()
+				if !obj.Exported() && obj.Pkg() == l.pkg.Types && !isGenericRecv(selection.Recv()) {
+					err = l.unexportedError(n.Sel.Pos(), obj)
+					return false
+				}
+			}
+		case *ast.Ident:
+			obj, found := l.uses[n.Pos()]
+			if !found || obj.Pkg() != l.pkg.Types || obj.Parent() != l.pkg.Types.Scope() {
+				return true
+			}
+			if _, ok := obj.Type().(types.GenericType); ok {
+				c.Replace(ast.NewIdent(l.trans.importedName(l.pkg.Types, obj.Name())))
+			} else if obj.Exported() {
+				name := l.importName(l.pkg.Types, l.pkg.Types.Name())
+				if name == "." {
+					c.Replace(ast.NewIdent(obj.Name()))
+				} else {
+					c.Replace(&ast.SelectorExpr{
+						X:   ast.NewIdent(name),
+						Sel: ast.NewIdent(obj.Name()),
+					})
+				}
+			} else {
+				err = l.unexportedError(n.Pos(), obj)
+				return false
+			}
+		}
+		return true
+	}, func(c *astutil.Cursor) bool {
+		if n, ok := c.Node().(*ast.IndexExpr); ok && typeArgs[n] {
+			c.Replace(&ast.TypeArgExpr{
+				X:      n.X,
+				Lbrack: n.Lbrack,
+				Types:  []ast.Expr{n.Index},
+				Rbrack: n.Rbrack,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The positions refer to the export data, which may not even be in the
+	// same file set, so they need to be removed.
+	clearPositions(result)
+	return result, nil
+}
+
+var posType = reflect.TypeOf(token.NoPos)
+
+// clearPositions sets all positions in the tree rooted at root to
+// token.NoPos. The position of the ellipsis in a call is the only record that
+// the last argument is spread, and the position of val in a parameter list is
+// the only record that the parameters are read-only, so they are set to a
+// placeholder position instead.
+func clearPositions(root ast.Node) {
+	setPositions(root, token.NoPos)
+}
+
+// setPositions sets all positions in the tree rooted at root to pos, except
+// for the ellipsis in a call and val in a parameter list (see
+// clearPositions).
+func setPositions(root ast.Node, pos token.Pos) {
+	ast.Inspect(root, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		v := reflect.ValueOf(n)
+		if v.Kind() != reflect.Ptr || v.IsNil() {
+			return true
+		}
+		call, isCall := n.(*ast.CallExpr)
+		spread := isCall && call.Ellipsis.IsValid()
+		param, isField := n.(*ast.Field)
+		readOnly := isField && param.Val.IsValid()
+		v = v.Elem()
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Field(i); field.Type() == posType && field.CanSet() {
+				field.SetInt(int64(pos))
+			}
+		}
+		if isCall && !spread {
+			call.Ellipsis = token.NoPos
+		} else if spread && !pos.IsValid() {
+			call.Ellipsis = 1
+		}
+		if isField && !readOnly {
+			param.Val = token.NoPos
+		} else if readOnly && !pos.IsValid() {
+			param.Val = 1
+		}
+		return true
+	})
+}
+
+// isGeneric returns true if expr refers to a generic type, function, or
+// method in the imported package.
+func (l *localizer) isGeneric(expr ast.Expr) bool {
+	var obj types.Object
+	switch x := expr.(type) {
+	case *ast.Ident:
+		obj = l.uses[x.Pos()]
+	case *ast.SelectorExpr:
+		if selection, found := l.selections[x.Sel.Pos()]; found {
+			obj = selection.Obj()
+		} else {
+			obj = l.uses[x.Sel.Pos()]
+		}
+	}
+	switch obj.(type) {
+	case *types.TypeName, *types.Func:
+	default:
+		// Variables can have a partially generic type (e.g. Vec[T, N]), but they
+		// are never instantiated.
+		return false
+	}
+	switch obj.Type().(type) {
+	case types.GenericType, types.PartialGenericType:
+		return true
+	}
+	return false
+}
+
+func isGenericRecv(typ types.Type) bool {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	switch typ.(type) {
+	case *types.GenericNamed, *types.PartialGenericNamed, *types.ConcreteNamed:
+		return true
+	}
+	return false
+}
+
+// unexportedError returns the error for a reference to the unexported obj at
+// pos, which is a position in the export data of the imported package.
+func (l *localizer) unexportedError(pos token.Pos, obj types.Object) error {
+	return fmt.Errorf(
+		"%s: cannot instantiate generic declaration from package %s: it refers to unexported %s",
+		l.pkg.Fset.Position(pos),
+		l.pkg.Types.Path(),
+		obj.Name(),
+	)
+}
+
+// importName returns the name which can be used to refer to pkg in the file
+// that the localized declarations are added to, adding an import if
+// necessary.
+func (l *localizer) importName(pkg *types.Package, name string) string {
+	for _, spec := range l.file.Imports {
+		if strings.Trim(spec.Path.Value, `"`) != pkg.Path() {
+			continue
+		}
+		if spec.Name == nil {
+			return pkg.Name()
+		}
+		if spec.Name.Name != "_" {
+			return spec.Name.Name
+		}
+	}
+	if name == pkg.Name() {
+		astutil.AddImport(l.trans.Fset, l.file, pkg.Path())
+	} else {
+		astutil.AddNamedImport(l.trans.Fset, l.file, name, pkg.Path())
+	}
+	return name
+}
@@ -0,0 +1,148 @@
+package transform
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// An Instantiation is a concrete instantiation of a generic type or function
+// which was generated by the Transformer.
+type Instantiation struct {
+	// Generic is the Fo name of the generic declaration, e.g. Box, Box.Map, or
+	// lib.Box for a declaration in an imported package.
+	Generic string
+	// Name is the Fo name of the instantiation, e.g. Box[int] or
+	// Box[int].Map[string].
+	Name string
+	// Decls holds the generated declarations. For generic types, this is the
+	// type spec followed by the methods of the type.
+	Decls []ast.Node
+	// Sites are the positions of the type argument expressions which caused
+	// the instantiation.
+	Sites []token.Pos
+}
+
+// Instantiations returns all of the instantiations which have been generated
+// so far, sorted by the name of the generic declaration and then by name.
+func (trans *Transformer) Instantiations() []*Instantiation {
+	results := make([]*Instantiation, 0, len(trans.instantiations))
+	for _, inst := range trans.instantiations {
+		results = append(results, inst)
+	}
+	sort.Slice(results, func(i int, j int) bool {
+		if results[i].Generic != results[j].Generic {
+			return results[i].Generic < results[j].Generic
+		}
+		return results[i].Name < results[j].Name
+	})
+	return results
+}
+
+// GenericName returns the Fo name of a generic declaration, e.g. Box.Map for a
+// method.
+func (trans *Transformer) GenericName(decl *types.GenericDecl) string {
+	obj := decl.Type.Object()
+	name := obj.Name()
+	if sig, ok := decl.Type.(*types.GenericSignature); ok && sig.Recv() != nil {
+		name = recvObjName(sig.Recv().Type()) + "." + name
+	}
+	if obj.Pkg() != nil && obj.Pkg() != trans.Pkg {
+		name = obj.Pkg().Name() + "." + name
+	}
+	return name
+}
+
+// addInstantiation records decl as one of the declarations generated for the
+// given usage of genDecl.
+func (trans *Transformer) addInstantiation(genDecl *types.GenericDecl, usg types.ConcreteType, decl ast.Node) {
+	if trans.instantiations == nil {
+		trans.instantiations = map[types.ConcreteType]*Instantiation{}
+	}
+	inst, found := trans.instantiations[usg]
+	if !found {
+		inst = &Instantiation{
+			Generic: trans.GenericName(genDecl),
+			Name:    trans.instantiationName(genDecl, usg),
+			Sites:   genDecl.Sites(usg),
+		}
+		trans.instantiations[usg] = inst
+	}
+	inst.Decls = append(inst.Decls, decl)
+}
+
+// instantiationName returns the Fo name for the given usage of genDecl.
+func (trans *Transformer) instantiationName(genDecl *types.GenericDecl, usg types.ConcreteType) string {
+	name := trans.GenericName(genDecl)
+	if sig, ok := usg.(*types.ConcreteSignature); ok && sig.Recv() != nil {
+		name = trans.recvName(sig.Recv().Type(), usg.TypeMap()) + "." + genDecl.Name
+	}
+	return name + trans.typeArgs(genDecl.Type.TypeParams(), usg.TypeMap())
+}
+
+// recvName returns the Fo name of a receiver type, including any type
+// arguments (e.g. Box[int]). Type parameters of the receiver type are looked
+// up in typeMap.
+func (trans *Transformer) recvName(recvType types.Type, typeMap map[string]types.Type) string {
+	if ptr, ok := recvType.(*types.Pointer); ok {
+		recvType = ptr.Elem()
+	}
+	name := recvObjName(recvType)
+	if named, ok := recvType.(interface {
+		Obj() *types.TypeName
+	}); ok && named.Obj().Pkg() != nil && named.Obj().Pkg() != trans.Pkg {
+		name = named.Obj().Pkg().Name() + "." + name
+	}
+	generic, ok := recvType.(types.ConcreteType)
+	if !ok {
+		return name
+	}
+	recvTypeMap := map[string]types.Type{}
+	for param, typ := range generic.TypeMap() {
+		if tp, ok := typ.(*types.TypeParam); ok {
+			typ = typeMap[tp.String()]
+		}
+		recvTypeMap[param] = typ
+	}
+	return name + trans.typeArgs(generic.GenericType().TypeParams(), recvTypeMap)
+}
+
+// typeArgs returns the type arguments for typeParams in Fo syntax, e.g.
+// [int, string], or an empty string if there are no type parameters.
+func (trans *Transformer) typeArgs(typeParams []*types.TypeParam, typeMap map[string]types.Type) string {
+	if len(typeParams) == 0 {
+		return ""
+	}
+	typeArgs := make([]string, len(typeParams))
+	for i, param := range typeParams {
+		if typ := typeMap[param.String()]; typ != nil {
+			typeArgs[i] = types.TypeString(typ, trans.qualifier)
+		} else {
+			typeArgs[i] = param.String()
+		}
+	}
+	return "[" + strings.Join(typeArgs, ", ") + "]"
+}
+
+// qualifier qualifies types from other packages by their package name.
+func (trans *Transformer) qualifier(pkg *types.Package) string {
+	if pkg == trans.Pkg {
+		return ""
+	}
+	return pkg.Name()
+}
+
+func recvObjName(recvType types.Type) string {
+	if ptr, ok := recvType.(*types.Pointer); ok {
+		recvType = ptr.Elem()
+	}
+	if named, ok := recvType.(interface {
+		Obj() *types.TypeName
+	}); ok {
+		return named.Obj().Name()
+	}
+	return recvType.String()
+}
@@ -0,0 +1,186 @@
+package transform
+
+import (
+	"sort"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/astclone"
+	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// localGeneric returns the generic declaration inside of a function body whose
+// name is at the given position.
+func (trans *Transformer) localGeneric(pos token.Pos) (*types.GenericDecl, bool) {
+	if trans.localGenerics == nil {
+		trans.localGenerics = map[token.Pos]*types.GenericDecl{}
+		for _, decl := range trans.Pkg.Generics() {
+			if decl.Local() {
+				trans.localGenerics[decl.Type.Object().Pos()] = decl
+			}
+		}
+	}
+	decl, found := trans.localGenerics[pos]
+	return decl, found
+}
+
+// use returns the object denoted by ident, which may be a copy of a node from
+// the original source code, or nil if it is not known.
+func (trans *Transformer) use(ident *ast.Ident) types.Object {
+	if obj, found := trans.Info.Uses[ident]; found {
+		return obj
+	}
+	if !ident.Pos().IsValid() {
+		return nil
+	}
+	if trans.usesByPos == nil {
+		trans.usesByPos = map[token.Pos]types.Object{}
+		for ident, obj := range trans.Info.Uses {
+			trans.usesByPos[ident.Pos()] = obj
+		}
+	}
+	return trans.usesByPos[ident.Pos()]
+}
+
+// identGeneric returns the generic declaration that ident refers to. Local
+// declarations take precedence over package-level declarations with the same
+// name.
+func (trans *Transformer) identGeneric(ident *ast.Ident) (*types.GenericDecl, bool) {
+	if obj := trans.use(ident); obj != nil && obj.Pkg() == trans.Pkg && obj.Parent() != trans.Pkg.Scope() {
+		return trans.localGeneric(obj.Pos())
+	}
+	return trans.generic(ident.Name)
+}
+
+// generateLocals replaces the generic declarations inside of body with their
+// instantiations. outer holds the type arguments of the enclosing generic
+// function.
+func (trans *Transformer) generateLocals(body *ast.BlockStmt, outer map[string]types.Type) {
+	if body != nil {
+		astutil.Apply(body, trans.generateConcreteTypes(outer), nil)
+	}
+}
+
+// localUsages returns the usages of decl which belong to the instantiation of
+// the enclosing generic function with the type arguments in outer. Local
+// declarations inherit the type parameters of the enclosing function, so
+// there is a separate usage for each of its instantiations.
+func (trans *Transformer) localUsages(decl *types.GenericDecl, outer map[string]types.Type) []types.ConcreteType {
+	var results []types.ConcreteType
+	for _, usg := range decl.Usages {
+		matches := true
+		for name, typ := range outer {
+			if typeArg, found := usg.TypeMap()[name]; found && !types.Identical(typeArg, typ) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			results = append(results, usg)
+		}
+	}
+	sort.Slice(results, func(i int, j int) bool {
+		return trans.concreteTypeName(decl, results[i]) < trans.concreteTypeName(decl, results[j])
+	})
+	return results
+}
+
+// generateLocalDecls returns the statements which should replace a
+// declaration inside of a function body, and whether the declaration was
+// generic.
+func (trans *Transformer) generateLocalDecls(stmt *ast.DeclStmt, outer map[string]types.Type) ([]ast.Stmt, bool) {
+	switch decl := stmt.Decl.(type) {
+	case *ast.GenDecl:
+		if decl.Tok != token.TYPE {
+			return nil, false
+		}
+		isGeneric := false
+		var newTypeSpecs []ast.Spec
+		for _, spec := range decl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			genericDecl, found := trans.localGeneric(typeSpec.Name.Pos())
+			if !found {
+				newTypeSpecs = append(newTypeSpecs, typeSpec)
+				continue
+			}
+			isGeneric = true
+			usages := trans.localUsages(genericDecl, outer)
+			newTypeSpecs = append(newTypeSpecs, trans.generateTypeSpecs(typeSpec, genericDecl, usages)...)
+		}
+		if !isGeneric {
+			return nil, false
+		}
+		if len(newTypeSpecs) == 0 {
+			return nil, true
+		}
+		sortSpecs(newTypeSpecs)
+		newDecl := astclone.Clone(decl).(*ast.GenDecl)
+		newDecl.Specs = newTypeSpecs
+		return []ast.Stmt{&ast.DeclStmt{Decl: newDecl}}, true
+
+	case *ast.FuncDecl:
+		genericDecl, found := trans.localGeneric(decl.Name.Pos())
+		if !found {
+			return nil, false
+		}
+		return trans.generateLocalFuncs(decl, genericDecl, trans.localUsages(genericDecl, outer)), true
+	}
+	return nil, false
+}
+
+// generateLocalFuncs returns the statements for the given usages of a generic
+// function declared inside of a function body. Each instantiation becomes a
+// variable holding a function literal, so that it can still refer to the
+// variables in the enclosing function. The variables are all declared before
+// they are assigned so that the instantiations can call themselves and each
+// other:
+//
+//	var (
+//		f__int    func(x int) int
+//		f__string func(x string) string
+//	)
+//	f__int = func(x int) int { ... }
+//	f__string = func(x string) string { ... }
+func (trans *Transformer) generateLocalFuncs(funcDecl *ast.FuncDecl, genDecl *types.GenericDecl, usages []types.ConcreteType) []ast.Stmt {
+	if len(usages) == 0 {
+		return nil
+	}
+	varDecl := &ast.GenDecl{Tok: token.VAR}
+	stmts := []ast.Stmt{&ast.DeclStmt{Decl: varDecl}}
+	for _, usg := range usages {
+		newFunc := astclone.Clone(funcDecl).(*ast.FuncDecl)
+		newFunc.TypeParams = nil
+		trans.replaceIdentsInScope(newFunc, usg.TypeMap())
+		trans.generateLocals(newFunc.Body, usg.TypeMap())
+		name := trans.concreteTypeName(genDecl, usg)
+		varDecl.Specs = append(varDecl.Specs, &ast.ValueSpec{
+			Names: []*ast.Ident{ast.NewIdent(name)},
+			Type:  astclone.Clone(newFunc.Type).(*ast.FuncType),
+		})
+		assign := &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent(name)},
+			Tok: token.ASSIGN,
+			Rhs: []ast.Expr{&ast.FuncLit{Type: newFunc.Type, Body: newFunc.Body}},
+		}
+		trans.addInstantiation(genDecl, usg, assign)
+		stmts = append(stmts, assign)
+	}
+	if len(varDecl.Specs) > 1 {
+		varDecl.Lparen = funcDecl.Pos()
+	}
+	return stmts
+}
+
+// replaceStmt replaces the statement at c with stmts.
+func replaceStmt(c *astutil.Cursor, stmts []ast.Stmt) {
+	if c.Index() < 0 {
+		// The statement is not part of a list (e.g. it is labeled).
+		c.Replace(&ast.BlockStmt{List: stmts})
+		return
+	}
+	for _, stmt := range stmts {
+		c.InsertBefore(stmt)
+	}
+	c.Delete()
+}
@@ -0,0 +1,39 @@
+package transform
+
+import (
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/token"
+)
+
+// lowerPipes replaces each pipe expression x |> f with the call f(x). Nested
+// pipes are replaced from the inside out, so x |> f |> g becomes g(f(x)). As
+// with any call, f is evaluated before x, which the language documents. It
+// must run after all other passes which depend on the positions of nodes.
+func lowerPipes(c *astutil.Cursor) bool {
+	n, ok := c.Node().(*ast.BinaryExpr)
+	if !ok || n.Op != token.PIPE {
+		return true
+	}
+	call := &ast.CallExpr{
+		Fun:  pipeFunc(n.Y),
+		Args: []ast.Expr{n.X},
+	}
+	// The function comes after its argument in the source code, so the
+	// original positions would make the printer insert line breaks in the
+	// wrong places. Instead the whole call is placed where the pipe started.
+	setPositions(call, n.Pos())
+	c.Replace(call)
+	return true
+}
+
+// pipeFunc returns f, in parentheses if they are needed to call it.
+func pipeFunc(f ast.Expr) ast.Expr {
+	switch f.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.TypeArgExpr,
+		*ast.SliceExpr, *ast.TypeAssertExpr, *ast.CallExpr, *ast.ParenExpr,
+		*ast.FuncLit, *ast.ArrayType, *ast.MapType:
+		return f
+	}
+	return &ast.ParenExpr{X: f}
+}
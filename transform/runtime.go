@@ -0,0 +1,69 @@
+package transform
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/mangle"
+	"github.com/albrow/fo/token"
+)
+
+const (
+	runtimeImportPath = "github.com/albrow/fo/runtime"
+	runtimePkgName    = "foruntime"
+)
+
+// registerTypeNames adds an init function to f which registers the Fo name of
+// each concrete type that was generated for f.
+func (trans *Transformer) registerTypeNames(f *ast.File) {
+	if len(trans.concreteTypes) == 0 {
+		return
+	}
+	names := append([]string{}, trans.concreteTypes...)
+	sort.Strings(names)
+	var stmts []ast.Stmt
+	for _, name := range names {
+		// foruntime.RegisterTypeName((*Box__int)(nil), "main.Box[int]")
+		stmts = append(stmts, &ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun: &ast.SelectorExpr{
+					X:   ast.NewIdent(runtimePkgName),
+					Sel: ast.NewIdent("RegisterTypeName"),
+				},
+				Args: []ast.Expr{
+					&ast.CallExpr{
+						Fun:  &ast.ParenExpr{X: &ast.StarExpr{X: ast.NewIdent(name)}},
+						Args: []ast.Expr{ast.NewIdent("nil")},
+					},
+					&ast.BasicLit{
+						Kind:  token.STRING,
+						Value: strconv.Quote(trans.foTypeName(name)),
+					},
+				},
+			},
+		})
+	}
+	f.Decls = append(f.Decls, &ast.FuncDecl{
+		Name: ast.NewIdent("init"),
+		Type: &ast.FuncType{Params: &ast.FieldList{}},
+		Body: &ast.BlockStmt{List: stmts},
+	})
+	astutil.AddNamedImport(trans.Fset, f, runtimePkgName, runtimeImportPath)
+}
+
+// foTypeName returns the qualified Fo name for the concrete type with the given
+// mangled name, e.g. main.Box[int] for Box__int.
+func (trans *Transformer) foTypeName(name string) string {
+	demangled, err := mangle.Demangle(name)
+	if err != nil {
+		return trans.Pkg.Name() + "." + name
+	}
+	if i := strings.Index(demangled, "["); strings.Contains(demangled[:i], ".") {
+		// Types from imported Fo packages are already qualified.
+		return demangled
+	}
+	return trans.Pkg.Name() + "." + demangled
+}
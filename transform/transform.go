@@ -9,6 +9,7 @@ import (
 	"github.com/albrow/fo/ast"
 	"github.com/albrow/fo/astclone"
 	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/foexport"
 	"github.com/albrow/fo/printer"
 	"github.com/albrow/fo/token"
 	"github.com/albrow/fo/types"
@@ -21,69 +22,109 @@ type Transformer struct {
 	Fset *token.FileSet
 	Pkg  *types.Package
 	Info *types.Info
+	// Imports holds the Fo packages which were imported from export data, keyed
+	// by import path. Any generic types or functions from these packages which
+	// are used by Pkg are instantiated in the first transformed file.
+	Imports map[string]*foexport.Package
+	// RuntimeTypeNames causes the transformed code to register the Fo name of
+	// each concrete type with the fo/runtime package, so that it can be
+	// retrieved with foruntime.TypeName.
+	RuntimeTypeNames bool
+
+	// imported holds the generic declarations from Imports, keyed by their
+	// local name in Pkg.
+	imported    map[string]*types.GenericDecl
+	importsDone bool
+	// concreteTypes holds the names of the concrete types which have been
+	// generated for the current file.
+	concreteTypes []string
+	// instantiations holds the generated declarations for each usage.
+	instantiations map[types.ConcreteType]*Instantiation
+	// selectionsByPos holds Info.Selections keyed by the position of the
+	// selector, so that selections can be found for copies of the original
+	// nodes.
+	selectionsByPos map[token.Pos]*types.Selection
+	// usesByPos holds Info.Uses keyed by the position of the identifier, for the
+	// same reason.
+	usesByPos map[token.Pos]types.Object
+	// localGenerics holds the generic declarations inside of function bodies,
+	// keyed by the position of their name.
+	localGenerics map[token.Pos]*types.GenericDecl
+	// pkgNames holds the name which qualifies the declarations of each
+	// package in mangled names, keyed by import path (see pkgName), and
+	// pkgNameTaken holds the names which are in use.
+	pkgNames     map[string]string
+	pkgNameTaken map[string]bool
+	// importNames holds the names of the packages imported by the current
+	// file which were renamed, keyed by import path.
+	importNames map[string]string
 }
 
 func (trans *Transformer) File(f *ast.File) (*ast.File, error) {
-	withConcreteTypes := astutil.Apply(f, trans.generateConcreteTypes(), nil)
-	result := astutil.Apply(withConcreteTypes, trans.replaceGenericIdents(), nil)
+	if !trans.importsDone && len(trans.Imports) > 0 {
+		var err error
+		f, err = trans.addImportedGenerics(f)
+		if err != nil {
+			return nil, err
+		}
+		trans.importsDone = true
+	}
+	trans.concreteTypes = nil
+	trans.importNames = map[string]string{}
+	for _, spec := range f.Imports {
+		if spec.Name != nil {
+			trans.importNames[strings.Trim(spec.Path.Value, `"`)] = spec.Name.Name
+		}
+	}
+	withTuples := trans.lowerTuples(f)
+	withConcreteTypes := astutil.Apply(withTuples, trans.generateConcreteTypes(nil), nil)
+	withGenericIdents := astutil.Apply(withConcreteTypes, trans.replaceGenericIdents(), nil)
+	withTupleTypes := astutil.Apply(withGenericIdents, nil, replaceTupleTypes)
+	withPipes := astutil.Apply(withTupleTypes, nil, lowerPipes)
+	result := astutil.Apply(withPipes, nil, lowerVals)
 	resultFile, ok := result.(*ast.File)
 	if !ok {
 		panic(fmt.Errorf("astutil.Apply returned a non-file type: %T", result))
 	}
+	trans.blankUnusedImports(resultFile)
+	if trans.RuntimeTypeNames {
+		trans.registerTypeNames(resultFile)
+	}
 
 	return resultFile, nil
 }
 
-func (trans *Transformer) formatTypeArgs(args []ast.Expr) string {
-	result := ""
-	for i, arg := range args {
-		// Check if the type argument is a type alias.
-		if ident, ok := arg.(*ast.Ident); ok {
-			if obj, found := trans.Info.Uses[ident]; found {
-				if typeName, ok := obj.(*types.TypeName); ok {
-					if typeName.IsAlias() {
-						// If it is, use the underling type as the type argument string.
-						// (e.g. "string" in `type S = string`)
-						if i != 0 {
-							result += "__"
-						}
-						result += typeToSafeString(typeName.Type().Underlying())
-						continue
-					}
-				}
-			}
-		}
-		// Otherwise format the type as a string normally.
-		if i != 0 {
-			result += "__"
-		}
-		result += exprToSafeString(arg)
-	}
-	return result
-}
-
-// TODO(albrow): this could be optimized
+// concreteTypeName returns the name of the concrete type or function for the
+// given usage of a generic declaration.
 func (trans *Transformer) concreteTypeName(decl *types.GenericDecl, usg types.ConcreteType) string {
-	stringParams := []string{}
+	typeArgs := []ast.Expr{}
 	for _, param := range decl.Type.TypeParams() {
 		typ := usg.TypeMap()[param.String()]
-		stringParams = append(stringParams, typeToSafeString(typ))
+		typeArgs = append(typeArgs, typeToExpr(typ, trans.mangledTypeName))
 	}
-	if len(stringParams) == 0 {
+	if len(typeArgs) == 0 {
 		return decl.Name
 	}
-	return decl.Name + "__" + strings.Join(stringParams, "__")
+	return trans.mangledName(decl.Name, typeArgs)
 }
 
 func (trans *Transformer) concreteTypeExpr(e *ast.TypeArgExpr) ast.Node {
+	typeArgs := append(trans.canonicalTypeExprs(e.Types), trans.defaultTypeArgs(e)...)
 	switch x := e.X.(type) {
 	case *ast.Ident:
 		newIdent := astclone.Clone(x).(*ast.Ident)
-		newIdent.Name = newIdent.Name + "__" + trans.formatTypeArgs(e.Types)
+		newIdent.Name = trans.mangledName(newIdent.Name, typeArgs)
 		return newIdent
 	case *ast.SelectorExpr:
+		if pkg := trans.importedPackage(x); pkg != nil {
+			// Generics from imported Fo packages are instantiated locally.
+			return ast.NewIdent(trans.mangledName(trans.importedName(pkg.Types, x.Sel.Name), typeArgs))
+		}
+		// A method value or method expression. The operand (e.g. A[string] in
+		// A[string].F[int]) may need to be transformed too.
 		newSel := astclone.Clone(x).(*ast.SelectorExpr)
-		newSel.Sel = ast.NewIdent(newSel.Sel.Name + "__" + trans.formatTypeArgs(e.Types))
+		newSel.X = astutil.Apply(x.X, trans.replaceGenericIdents(), nil).(ast.Expr)
+		newSel.Sel = ast.NewIdent(trans.mangledName(newSel.Sel.Name, typeArgs))
 		return newSel
 	default:
 		panic(fmt.Errorf("type arguments for expr %v of type %T are not yet supported", e.X, e.X))
@@ -93,7 +134,7 @@ func (trans *Transformer) concreteTypeExpr(e *ast.TypeArgExpr) ast.Node {
 func (trans *Transformer) recvTypeParams(typeParams []*types.TypeParam, typeMap map[string]types.Type) []ast.Expr {
 	types := []ast.Expr{}
 	for _, param := range typeParams {
-		types = append(types, typeToExpr(typeMap[param.String()]))
+		types = append(types, trans.typeExpr(typeMap[param.String()]))
 	}
 	if len(types) > 0 {
 		return types
@@ -124,10 +165,25 @@ func (trans *Transformer) expandReceiverType(funcDecl *ast.FuncDecl, genDecl *ty
 	}, nil)
 }
 
-func (trans *Transformer) generateConcreteTypes() func(c *astutil.Cursor) bool {
+// generateConcreteTypes replaces generic declarations with their
+// instantiations. outer holds the type arguments of the enclosing generic
+// function when generating the local declarations in its body, and only the
+// instantiations of local declarations which match it are generated.
+func (trans *Transformer) generateConcreteTypes(outer map[string]types.Type) func(c *astutil.Cursor) bool {
 	return func(c *astutil.Cursor) bool {
 		switch n := c.Node().(type) {
+		case *ast.DeclStmt:
+			stmts, isGeneric := trans.generateLocalDecls(n, outer)
+			if !isGeneric {
+				return true
+			}
+			replaceStmt(c, stmts)
+			return false
 		case *ast.GenDecl:
+			if _, ok := c.Parent().(*ast.DeclStmt); ok {
+				// Local declarations are handled above.
+				return true
+			}
 			var newTypeSpecs []ast.Spec
 			used := false
 			for _, spec := range n.Specs {
@@ -137,25 +193,16 @@ func (trans *Transformer) generateConcreteTypes() func(c *astutil.Cursor) bool {
 					used = true
 					continue
 				}
-				if _, found := trans.Pkg.Generics()[typeSpec.Name.Name]; !found {
+				genericDecl, found := trans.generic(typeSpec.Name.Name)
+				if !found {
 					newTypeSpecs = append(newTypeSpecs, typeSpec)
 					used = true
 					continue
 				}
-				newTypeSpecs = append(newTypeSpecs, trans.generateTypeSpecs(typeSpec)...)
+				newTypeSpecs = append(newTypeSpecs, trans.generateTypeSpecs(typeSpec, genericDecl, genericDecl.Usages)...)
 			}
 			if len(newTypeSpecs) > 0 {
-				sort.Slice(newTypeSpecs, func(i int, j int) bool {
-					iSpec, ok := newTypeSpecs[i].(*ast.TypeSpec)
-					if !ok {
-						return true
-					}
-					jSpec, ok := newTypeSpecs[j].(*ast.TypeSpec)
-					if !ok {
-						return true
-					}
-					return iSpec.Name.Name < jSpec.Name.Name
-				})
+				sortSpecs(newTypeSpecs)
 				newDecl := astclone.Clone(n).(*ast.GenDecl)
 				newDecl.Specs = newTypeSpecs
 				c.Replace(newDecl)
@@ -167,6 +214,7 @@ func (trans *Transformer) generateConcreteTypes() func(c *astutil.Cursor) bool {
 			if len(newFuncs) == 0 {
 				if recvIsGeneric || n.TypeParams != nil {
 					c.Delete()
+					return false
 				}
 				return true
 			}
@@ -175,11 +223,28 @@ func (trans *Transformer) generateConcreteTypes() func(c *astutil.Cursor) bool {
 				c.InsertBefore(newFunc)
 			}
 			c.Delete()
+			// The body of each new function has already been handled by
+			// generateFuncDecls.
+			return false
 		}
 		return true
 	}
 }
 
+func sortSpecs(specs []ast.Spec) {
+	sort.Slice(specs, func(i int, j int) bool {
+		iSpec, ok := specs[i].(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		jSpec, ok := specs[j].(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		return iSpec.Name.Name < jSpec.Name.Name
+	})
+}
+
 func sortFuncs(funcs []*ast.FuncDecl) {
 	sort.Slice(funcs, func(i int, j int) bool {
 		if funcs[i].Name.Name == funcs[j].Name.Name {
@@ -207,7 +272,7 @@ func (trans *Transformer) replaceGenericIdents() func(c *astutil.Cursor) bool {
 			// TypeArgExpr.
 			switch x := n.X.(type) {
 			case *ast.Ident:
-				if _, found := trans.Pkg.Generics()[x.Name]; found {
+				if _, found := trans.identGeneric(x); found {
 					typeArgExpr := &ast.TypeArgExpr{
 						X:      n.X,
 						Lbrack: n.Lbrack,
@@ -217,21 +282,8 @@ func (trans *Transformer) replaceGenericIdents() func(c *astutil.Cursor) bool {
 					c.Replace(trans.concreteTypeExpr(typeArgExpr))
 				}
 			case *ast.SelectorExpr:
-				selection, found := trans.Info.Selections[x]
-				if !found {
-					return true
-				}
-				var key string
-				switch selection.Kind() {
-				case types.FieldVal:
-					key = selection.Obj().Name()
-				case types.MethodVal:
-					if named, ok := selection.Recv().(*types.ConcreteNamed); ok {
-						key = named.Obj().Name() + "." + selection.Obj().Name()
-					}
-				}
-				if key != "" {
-					if _, found := trans.Pkg.Generics()[key]; found {
+				if key := trans.selectorKey(x); key != "" {
+					if _, found := trans.generic(key); found {
 						typeArgExpr := &ast.TypeArgExpr{
 							X:      n.X,
 							Lbrack: n.Lbrack,
@@ -243,17 +295,118 @@ func (trans *Transformer) replaceGenericIdents() func(c *astutil.Cursor) bool {
 					}
 				}
 			}
+		case *ast.InterfaceType:
+			// Go has no type lists, so interfaces which are used as constraints
+			// become ordinary interfaces. The generic declarations which use them
+			// are not part of the output.
+			if stripped := stripTypeList(n); stripped != n {
+				c.Replace(stripped)
+			}
 		}
 		return true
 	}
 }
 
-func (trans *Transformer) generateTypeSpecs(typeSpec *ast.TypeSpec) []ast.Spec {
-	key := typeSpec.Name.Name
-	genericDecl, found := trans.Pkg.Generics()[key]
+// stripTypeList returns a copy of iface without its type list, or iface itself
+// if it does not have one.
+func stripTypeList(iface *ast.InterfaceType) *ast.InterfaceType {
+	if iface.Methods == nil {
+		return iface
+	}
+	var methods []*ast.Field
+	for _, f := range iface.Methods.List {
+		if len(f.Names) == 1 && f.Names[0].Name == "type" {
+			continue
+		}
+		methods = append(methods, f)
+	}
+	if len(methods) == len(iface.Methods.List) {
+		return iface
+	}
+	stripped := *iface
+	stripped.Methods = &ast.FieldList{
+		Opening: iface.Methods.Opening,
+		List:    methods,
+		Closing: iface.Methods.Closing,
+	}
+	return &stripped
+}
+
+// selectorKey returns the key of the generic declaration that sel may refer
+// to, or an empty string if it does not refer to one.
+func (trans *Transformer) selectorKey(sel *ast.SelectorExpr) string {
+	if pkg := trans.importedPackage(sel); pkg != nil {
+		return trans.importedName(pkg.Types, sel.Sel.Name)
+	}
+	selection, found := trans.selection(sel)
 	if !found {
-		panic(fmt.Errorf("could not find generic type declaration for %s", key))
+		return ""
+	}
+	switch selection.Kind() {
+	case types.FieldVal:
+		return selection.Obj().Name()
+	case types.MethodVal, types.MethodExpr:
+		recv := selection.Recv()
+		if ptr, ok := recv.(*types.Pointer); ok {
+			recv = ptr.Elem()
+		}
+		if named, ok := recv.(interface {
+			Obj() *types.TypeName
+		}); ok {
+			return trans.localName(named.Obj()) + "." + selection.Obj().Name()
+		}
+	}
+	return ""
+}
+
+// defaultTypeArgs returns the default type arguments for the type parameters
+// that were omitted in e, in canonical form.
+func (trans *Transformer) defaultTypeArgs(e *ast.TypeArgExpr) []ast.Expr {
+	var decl *types.GenericDecl
+	switch x := e.X.(type) {
+	case *ast.Ident:
+		decl, _ = trans.identGeneric(x)
+	case *ast.SelectorExpr:
+		if key := trans.selectorKey(x); key != "" {
+			decl, _ = trans.generic(key)
+		}
+	}
+	if decl == nil {
+		return nil
+	}
+	var defaults []ast.Expr
+	typeParams := decl.Type.TypeParams()
+	for i := len(e.Types); i < len(typeParams); i++ {
+		if typeParams[i].Default() == nil {
+			break
+		}
+		defaults = append(defaults, typeToExpr(typeParams[i].Default(), trans.mangledTypeName))
+	}
+	return defaults
+}
+
+// selection returns the selection for sel, which may be a copy of a node from
+// the original source code.
+func (trans *Transformer) selection(sel *ast.SelectorExpr) (*types.Selection, bool) {
+	if selection, found := trans.Info.Selections[sel]; found {
+		return selection, true
 	}
+	if !sel.Sel.Pos().IsValid() {
+		return nil, false
+	}
+	if trans.selectionsByPos == nil {
+		trans.selectionsByPos = map[token.Pos]*types.Selection{}
+		for sel, selection := range trans.Info.Selections {
+			trans.selectionsByPos[sel.Sel.Pos()] = selection
+		}
+	}
+	selection, found := trans.selectionsByPos[sel.Sel.Pos()]
+	return selection, found
+}
+
+// generateTypeSpecs returns a type spec for each of the given usages of the
+// generic type declared by typeSpec.
+func (trans *Transformer) generateTypeSpecs(typeSpec *ast.TypeSpec, genericDecl *types.GenericDecl, usages []types.ConcreteType) []ast.Spec {
 	var results []ast.Spec
 	// Check if we are dealing with an ambiguous ArrayType from the parser. In
 	// some cases we need to disambiguate this by adding type parameters and
@@ -271,11 +424,17 @@ func (trans *Transformer) generateTypeSpecs(typeSpec *ast.TypeSpec) []ast.Spec {
 			}
 		}
 	}
-	for _, usg := range genericDecl.Usages {
+	for _, usg := range usages {
 		newTypeSpec := astclone.Clone(typeSpec).(*ast.TypeSpec)
 		newTypeSpec.Name = ast.NewIdent(trans.concreteTypeName(genericDecl, usg))
+		if !genericDecl.Local() {
+			// Local types cannot be registered by name from outside of the
+			// function.
+			trans.concreteTypes = append(trans.concreteTypes, newTypeSpec.Name.Name)
+		}
 		newTypeSpec.TypeParams = nil
 		trans.replaceIdentsInScope(newTypeSpec, usg.TypeMap())
+		trans.addInstantiation(genericDecl, usg, newTypeSpec)
 		results = append(results, newTypeSpec)
 	}
 	return results
@@ -303,7 +462,7 @@ func (trans *Transformer) generateFuncDecls(funcDecl *ast.FuncDecl) (newFuncs []
 			panic(fmt.Errorf("invalid receiver type expression: %T %s", recv, recv))
 		}
 		var found bool
-		genRecvDecl, found = trans.Pkg.Generics()[recvTypeName.Name]
+		genRecvDecl, found = trans.generic(recvTypeName.Name)
 		if !found && recvHasTypeArgs {
 			panic(fmt.Errorf("could not find generic type declaration for %s", recvTypeName.Name))
 		} else {
@@ -314,35 +473,89 @@ func (trans *Transformer) generateFuncDecls(funcDecl *ast.FuncDecl) (newFuncs []
 	if recvTypeName != nil {
 		fkey = recvTypeName.Name + "." + fkey
 	}
-	genFuncDecl, found := trans.Pkg.Generics()[fkey]
+	genFuncDecl, found := trans.generic(fkey)
 	if !found && funcDecl.TypeParams != nil {
 		panic(fmt.Errorf("could not find generic type declaration for %s", fkey))
 	}
+	if newFunc, isSpec := trans.generateSpecialization(funcDecl, genFuncDecl, genRecvDecl); isSpec {
+		if newFunc != nil {
+			newFuncs = append(newFuncs, newFunc)
+		}
+		return newFuncs, true
+	}
 	if genFuncDecl != nil {
 		for _, usg := range genFuncDecl.Usages {
+			if genFuncDecl.Specialization(usg, "") != nil {
+				continue
+			}
 			newFunc := astclone.Clone(funcDecl).(*ast.FuncDecl)
 			trans.expandReceiverType(newFunc, genRecvDecl, usg)
 			newFunc.Name = ast.NewIdent(trans.concreteTypeName(genFuncDecl, usg))
 			newFunc.TypeParams = nil
 			trans.replaceIdentsInScope(newFunc, usg.TypeMap())
+			trans.generateLocals(newFunc.Body, usg.TypeMap())
+			trans.addInstantiation(genFuncDecl, usg, newFunc)
 			newFuncs = append(newFuncs, newFunc)
 		}
 	} else if genRecvDecl != nil {
 		for _, usg := range genRecvDecl.Usages {
+			if genRecvDecl.Specialization(usg, funcDecl.Name.Name) != nil {
+				continue
+			}
 			newFunc := astclone.Clone(funcDecl).(*ast.FuncDecl)
 			trans.expandReceiverType(newFunc, genRecvDecl, usg)
 			trans.replaceIdentsInScope(newFunc, usg.TypeMap())
+			trans.generateLocals(newFunc.Body, usg.TypeMap())
+			trans.addInstantiation(genRecvDecl, usg, newFunc)
 			newFuncs = append(newFuncs, newFunc)
 		}
 	}
 	return newFuncs, recvIsGeneric
 }
 
+// generateSpecialization returns the instantiation of the generic function or
+// method specialized by funcDecl (e.g. func Sum[int]). isSpec is false if
+// funcDecl is not a specialization. newFunc is nil if the specialized
+// instantiation is not used.
+func (trans *Transformer) generateSpecialization(funcDecl *ast.FuncDecl, genFuncDecl, genRecvDecl *types.GenericDecl) (newFunc *ast.FuncDecl, isSpec bool) {
+	genDecl, method := genFuncDecl, ""
+	if genDecl == nil {
+		genDecl, method = genRecvDecl, funcDecl.Name.Name
+	}
+	if genDecl == nil {
+		return nil, false
+	}
+	for _, spec := range genDecl.Specializations() {
+		if spec.Pos() == funcDecl.Name.Pos() {
+			isSpec = true
+			break
+		}
+	}
+	if !isSpec {
+		return nil, false
+	}
+	for _, usg := range genDecl.Usages {
+		spec := genDecl.Specialization(usg, method)
+		if spec == nil || spec.Pos() != funcDecl.Name.Pos() {
+			continue
+		}
+		newFunc = astclone.Clone(funcDecl).(*ast.FuncDecl)
+		if funcDecl.Recv == nil {
+			newFunc.Name = ast.NewIdent(trans.concreteTypeName(genDecl, usg))
+		}
+		newFunc.TypeParams = nil
+		trans.generateLocals(newFunc.Body, nil)
+		trans.addInstantiation(genDecl, usg, newFunc)
+		return newFunc, true
+	}
+	return nil, true
+}
+
 func (trans *Transformer) replaceIdentsInScope(n ast.Node, typeMap map[string]types.Type) ast.Node {
 	return astutil.Apply(n, nil, func(c *astutil.Cursor) bool {
 		if ident, ok := c.Node().(*ast.Ident); ok {
 			if typ, found := typeMap[ident.Name]; found {
-				c.Replace(typeToExpr(typ))
+				c.Replace(trans.typeExpr(typ))
 			}
 		}
 		return true
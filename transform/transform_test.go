@@ -2,10 +2,15 @@ package transform
 
 import (
 	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/foexport"
 	"github.com/albrow/fo/format"
 	"github.com/albrow/fo/importer"
 	"github.com/albrow/fo/parser"
@@ -652,15 +657,15 @@ func main() {
 import "bytes"
 
 type (
-	A____string                  []string
-	A__map_string___bytes_Buffer map[string][]bytes.Buffer
-	A__map_string_int            map[string]int
+	A__map_string_int                map[string]int
+	A__map_string_slice_bytes_Buffer map[string][]bytes.Buffer
+	A__slice_string                  []string
 )
 
 func main() {
-	var _ A____string
+	var _ A__slice_string
 	var _ A__map_string_int
-	var _ A__map_string___bytes_Buffer
+	var _ A__map_string_slice_bytes_Buffer
 }
 `
 
@@ -691,7 +696,7 @@ func main() {
 	expected := `package main
 
 type (
-	Box__S struct {
+	Box__main_S struct {
 		v S
 	}
 	Box__string struct {
@@ -702,7 +707,7 @@ type (
 type S string
 
 func main() {
-	var _ = Box__S{
+	var _ = Box__main_S{
 		v: "",
 	}
 	var _ = Box__string{
@@ -802,55 +807,55 @@ import (
 )
 
 type (
-	List___5_ast_Ident         [][5]ast.Ident
-	List____ast_Ident          [][]ast.Ident
-	List___ast_Ident           []*ast.Ident
-	List__ast_Ident            []ast.Ident
+	List__array5_ast_Ident     [][5]ast.Ident
+	List__chan_ast_Ident       []chan ast.Ident
 	List__map_string_ast_Ident []map[string]ast.Ident
+	List__ptr_ast_Ident        []*ast.Ident
+	List__slice_ast_Ident      [][]ast.Ident
 )
 
-func NewList___5_ast_Ident() List___5_ast_Ident {
-	return List___5_ast_Ident{}
+func NewList__array5_ast_Ident() List__array5_ast_Ident {
+	return List__array5_ast_Ident{}
 }
-func NewList____ast_Ident() List____ast_Ident {
-	return List____ast_Ident{}
-}
-func NewList___ast_Ident() List___ast_Ident {
-	return List___ast_Ident{}
-}
-func NewList__ast_Ident() List__ast_Ident {
-	return List__ast_Ident{}
+func NewList__chan_ast_Ident() List__chan_ast_Ident {
+	return List__chan_ast_Ident{}
 }
 func NewList__map_string_ast_Ident() List__map_string_ast_Ident {
 	return List__map_string_ast_Ident{}
 }
+func NewList__ptr_ast_Ident() List__ptr_ast_Ident {
+	return List__ptr_ast_Ident{}
+}
+func NewList__slice_ast_Ident() List__slice_ast_Ident {
+	return List__slice_ast_Ident{}
+}
 
-func (l List__ast_Ident) Head() ast.Ident {
+func (l List__ptr_ast_Ident) Head() *ast.Ident {
 	if len(l) > 0 {
 		return l[0]
 	}
-	var x ast.Ident
+	var x *ast.Ident
 	return x
 }
-func (l List___ast_Ident) Head() *ast.Ident {
+func (l List__array5_ast_Ident) Head() [5]ast.Ident {
 	if len(l) > 0 {
 		return l[0]
 	}
-	var x *ast.Ident
+	var x [5]ast.Ident
 	return x
 }
-func (l List___5_ast_Ident) Head() [5]ast.Ident {
+func (l List__slice_ast_Ident) Head() []ast.Ident {
 	if len(l) > 0 {
 		return l[0]
 	}
-	var x [5]ast.Ident
+	var x []ast.Ident
 	return x
 }
-func (l List____ast_Ident) Head() []ast.Ident {
+func (l List__chan_ast_Ident) Head() chan ast.Ident {
 	if len(l) > 0 {
 		return l[0]
 	}
-	var x []ast.Ident
+	var x chan ast.Ident
 	return x
 }
 func (l List__map_string_ast_Ident) Head() map[string]ast.Ident {
@@ -861,23 +866,23 @@ func (l List__map_string_ast_Ident) Head() map[string]ast.Ident {
 	return x
 }
 
-func (l List__ast_Ident) Append(v ast.Ident) List__ast_Ident {
-	var result List__ast_Ident = make([]ast.Ident, len(l))
+func (l List__ptr_ast_Ident) Append(v *ast.Ident) List__ptr_ast_Ident {
+	var result List__ptr_ast_Ident = make([]*ast.Ident, len(l))
 	result = append(result, v)
 	return result
 }
-func (l List___ast_Ident) Append(v *ast.Ident) List___ast_Ident {
-	var result List___ast_Ident = make([]*ast.Ident, len(l))
+func (l List__array5_ast_Ident) Append(v [5]ast.Ident) List__array5_ast_Ident {
+	var result List__array5_ast_Ident = make([][5]ast.Ident, len(l))
 	result = append(result, v)
 	return result
 }
-func (l List___5_ast_Ident) Append(v [5]ast.Ident) List___5_ast_Ident {
-	var result List___5_ast_Ident = make([][5]ast.Ident, len(l))
+func (l List__slice_ast_Ident) Append(v []ast.Ident) List__slice_ast_Ident {
+	var result List__slice_ast_Ident = make([][]ast.Ident, len(l))
 	result = append(result, v)
 	return result
 }
-func (l List____ast_Ident) Append(v []ast.Ident) List____ast_Ident {
-	var result List____ast_Ident = make([][]ast.Ident, len(l))
+func (l List__chan_ast_Ident) Append(v chan ast.Ident) List__chan_ast_Ident {
+	var result List__chan_ast_Ident = make([]chan ast.Ident, len(l))
 	result = append(result, v)
 	return result
 }
@@ -888,12 +893,12 @@ func (l List__map_string_ast_Ident) Append(v map[string]ast.Ident) List__map_str
 }
 
 func main() {
-	list := NewList___ast_Ident()
+	list := NewList__ptr_ast_Ident()
 	list = list.Append(ast.NewIdent(""))
 	var _ *ast.Ident = list.Head()
 
-	var _ = NewList____ast_Ident()
-	var _ = NewList___5_ast_Ident()
+	var _ = NewList__slice_ast_Ident()
+	var _ = NewList__array5_ast_Ident()
 	var _ = NewList__map_string_ast_Ident()
 	var _ = NewList__chan_ast_Ident()
 }
@@ -1023,15 +1028,15 @@ func F__float64__complex64() (float64, complex64) {
 	return F__float64__complex64()
 }
 
-func G____int() []int {
-	return H____int()
+func G__slice_int() []int {
+	return H__slice_int()
 }
 func G__string() string {
 	return H__string()
 }
 
-func H____int() []int {
-	return G____int()
+func H__slice_int() []int {
+	return G__slice_int()
 }
 func H__string() string {
 	return G__string()
@@ -1066,7 +1071,7 @@ func main() {
 	print(f1)
 
 	var _ string = H__string()
-	var _ []int = G____int()
+	var _ []int = G__slice_int()
 }
 `
 
@@ -1093,59 +1098,1676 @@ func main() {
 	expected := `package main
 
 type (
-	Box______string struct {
+	Box__ptr_ptr_ptr_ptr_string struct {
 		val ****string
 	}
-	Box______string_0 struct {
-		val []**string
-	}
-	Box______string_1 struct {
+	Box__ptr_ptr_slice_string struct {
 		val **[]string
 	}
-	Box______string_2 struct {
+	Box__ptr_ptr_string struct {
+		val **string
+	}
+	Box__slice_ptr_ptr_string struct {
+		val []**string
+	}
+	Box__slice_slice_string struct {
 		val [][]string
 	}
-	Box____string struct {
+	Box__slice_string struct {
 		val []string
 	}
-	Box____string_0 struct {
-		val **string
+)
+
+func main() {
+	var _ = Box__ptr_ptr_string{}
+	var _ = Box__slice_string{}
+	var _ = Box__ptr_ptr_ptr_ptr_string{}
+	var _ = Box__slice_ptr_ptr_string{}
+	var _ = Box__ptr_ptr_slice_string{}
+	var _ = Box__slice_slice_string{}
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformNestedTypeArgs(t *testing.T) {
+	src := `package main
+
+type List[T] []T
+
+type Box[T] struct {
+	val T
+}
+
+type Item struct{}
+
+func main() {
+	var _ Box[List[int]]
+	var _ Box[map[string]*Item]
+	var _ Box[func(int, ...string) error]
+}
+`
+
+	expected := `package main
+
+type List__int []int
+
+type (
+	Box__func2to1v_int_slice_string_error struct {
+		val func(int, ...string) error
+	}
+	Box__gen1_main_List_int struct {
+		val List__int
+	}
+	Box__map_string_ptr_main_Item struct {
+		val map[string]*Item
 	}
 )
 
+type Item struct{}
+
 func main() {
-	var _ = Box____string_0{}
-	var _ = Box____string{}
-	var _ = Box______string{}
-	var _ = Box______string_0{}
-	var _ = Box______string_1{}
-	var _ = Box______string_2{}
+	var _ Box__gen1_main_List_int
+	var _ Box__map_string_ptr_main_Item
+	var _ Box__func2to1v_int_slice_string_error
 }
 `
 	testParseFile(t, src, expected)
 }
 
-func testParseFile(t *testing.T, src string, expected string) {
-	t.Helper()
-	fset := token.NewFileSet()
-	orig, err := parser.ParseFile(fset, "transform_test", src, 0)
-	if err != nil {
-		t.Fatalf("ParseFile returned error: %s", err.Error())
+func TestTransformCompoundTypeArgsInherited(t *testing.T) {
+	src := `package main
+
+type Box[T] struct {
+	v T
+}
+
+func Wrap[T](v T) Box[T] {
+	return Box[T]{v: v}
+}
+
+func Pair[T](v T) Box[[]T] {
+	return Wrap[[]T]([]T{v, v})
+}
+
+func main() {
+	_ = Pair[int](1)
+	_ = Pair[string]("a")
+}
+`
+
+	expected := `package main
+
+type (
+	Box__slice_int struct {
+		v []int
 	}
-	conf := types.Config{}
-	conf.Importer = importer.Default()
-	info := &types.Info{
-		Selections: map[*ast.SelectorExpr]*types.Selection{},
-		Uses:       map[*ast.Ident]types.Object{},
+	Box__slice_string struct {
+		v []string
 	}
-	pkg, err := conf.Check("transformtest", fset, []*ast.File{orig}, info)
-	if err != nil {
-		t.Fatalf("conf.Check returned error: %s", err.Error())
+)
+
+func Wrap__slice_int(v []int) Box__slice_int {
+	return Box__slice_int{v: v}
+}
+func Wrap__slice_string(v []string) Box__slice_string {
+	return Box__slice_string{v: v}
+}
+
+func Pair__int(v int) Box__slice_int {
+	return Wrap__slice_int([]int{v, v})
+}
+func Pair__string(v string) Box__slice_string {
+	return Wrap__slice_string([]string{v, v})
+}
+
+func main() {
+	_ = Pair__int(1)
+	_ = Pair__string("a")
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformGenericMethodValues(t *testing.T) {
+	src := `package main
+
+type A[T] struct {
+	v T
+}
+
+func (a A[T]) F[U](u U) (T, U) {
+	return a.v, u
+}
+
+func (a A[T]) Use[U](u U) U {
+	f := A[T].F[U]
+	_, v := f(a, u)
+	return v
+}
+
+func apply[R, P, Q](f func(R, P) Q, r R, p P) Q {
+	return f(r, p)
+}
+
+func main() {
+	a := A[string]{v: "a"}
+	f := a.F[int]
+	_, _ = f(1)
+	g := (*A[string]).F[bool]
+	_, _ = g(&a, true)
+	_ = apply[A[string], int, int](A[string].Use[int], a, 1)
+}
+`
+
+	expected := `package main
+
+type A__string struct {
+	v string
+}
+
+func (a A__string) F__bool(u bool) (string, bool) {
+	return a.v, u
+}
+func (a A__string) F__int(u int) (string, int) {
+	return a.v, u
+}
+
+func (a A__string) Use__int(u int) int {
+	f := A__string.F__int
+	_, v := f(a, u)
+	return v
+}
+
+func apply__gen1_main_A_string__int__int(f func(A__string, int) int, r A__string, p int) int {
+	return f(r, p)
+}
+
+func main() {
+	a := A__string{v: "a"}
+	f := a.F__int
+	_, _ = f(1)
+	g := (*A__string).F__bool
+	_, _ = g(&a, true)
+	_ = apply__gen1_main_A_string__int__int(A__string.Use__int, a, 1)
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformGenericFuncLit(t *testing.T) {
+	src := `package main
+
+func G[T](v T) T {
+	return v
+}
+
+func F[T](v T) T {
+	f := func() T {
+		return G[T](v)
 	}
-	trans := &Transformer{
-		Fset: fset,
-		Pkg:  pkg,
-		Info: info,
+	return f()
+}
+
+func main() {
+	_ = F[int](1)
+}
+`
+
+	expected := `package main
+
+func G__int(v int) int {
+	return v
+}
+
+func F__int(v int) int {
+	f := func() int {
+		return G__int(v)
+	}
+	return f()
+}
+
+func main() {
+	_ = F__int(1)
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformLocalGenerics(t *testing.T) {
+	src := `package main
+
+func Count[T](xs []T) int {
+	type counter[U] struct {
+		n    int
+		last U
+	}
+	func step[U](c counter[U], x U) counter[U] {
+		return counter[U]{n: c.n + 1, last: x}
+	}
+	c := counter[T]{}
+	for _, x := range xs {
+		c = step[T](c, x)
+	}
+	return c.n
+}
+
+func main() {
+	total := 0
+	type Box[T] struct {
+		v T
+	}
+	func sum[T](xs []Box[T], f func(T) int) int {
+		for _, x := range xs {
+			total += f(x.v)
+		}
+		return total
+	}
+	sum[string]([]Box[string]{{v: "a"}}, func(s string) int { return len(s) })
+	sum[int]([]Box[int]{{v: 1}}, func(n int) int { return n })
+	_ = Count[int]([]int{1, 2, 3})
+}
+`
+
+	expected := `package main
+
+func Count__int(xs []int) int {
+	type counter__int struct {
+		n    int
+		last int
+	}
+	var step__int func(c counter__int, x int) counter__int
+	step__int = func(c counter__int, x int) counter__int {
+		return counter__int{n: c.n + 1, last: x}
+	}
+	c := counter__int{}
+	for _, x := range xs {
+		c = step__int(c, x)
+	}
+	return c.n
+}
+
+func main() {
+	total := 0
+	type (
+		Box__int struct {
+			v int
+		}
+		Box__string struct {
+			v string
+		}
+	)
+	var (
+		sum__int    func(xs []Box__int, f func(int) int) int
+		sum__string func(xs []Box__string, f func(string) int) int
+	)
+	sum__int = func(xs []Box__int, f func(int) int) int {
+		for _, x := range xs {
+			total += f(x.v)
+		}
+		return total
+	}
+	sum__string = func(xs []Box__string, f func(string) int) int {
+		for _, x := range xs {
+			total += f(x.v)
+		}
+		return total
+	}
+	sum__string([]Box__string{{v: "a"}}, func(s string) int { return len(s) })
+	sum__int([]Box__int{{v: 1}}, func(n int) int { return n })
+	_ = Count__int([]int{1, 2, 3})
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformSpecializations(t *testing.T) {
+	src := `package main
+
+type Set[T] map[T]struct{}
+
+func (s Set[T]) Has(v T) bool {
+	_, found := s[v]
+	return found
+}
+
+func (s Set[string]) Has(v string) bool {
+	if v == "" {
+		return false
+	}
+	_, found := s[v]
+	return found
+}
+
+func Count[T](xs []T) int {
+	return len(xs)
+}
+
+func Count[int](xs []int) int {
+	n := 0
+	for range xs {
+		n++
+	}
+	return n
+}
+
+func Count[bool](xs []bool) int {
+	return 0
+}
+
+func main() {
+	s := Set[string]{}
+	_ = s.Has("a")
+	_ = Set[int]{}.Has(1)
+	_ = Count[int]([]int{1}) + Count[string]([]string{"a"})
+}
+`
+
+	expected := `package main
+
+type (
+	Set__int map[int]struct {
+	}
+	Set__string map[string]struct {
+	}
+)
+
+func (s Set__int) Has(v int) bool {
+	_, found := s[v]
+	return found
+}
+
+func (s Set__string) Has(v string) bool {
+	if v == "" {
+		return false
+	}
+	_, found := s[v]
+	return found
+}
+
+func Count__string(xs []string) int {
+	return len(xs)
+}
+
+func Count__int(xs []int) int {
+	n := 0
+	for range xs {
+		n++
+	}
+	return n
+}
+
+func main() {
+	s := Set__string{}
+	_ = s.Has("a")
+	_ = Set__int{}.Has(1)
+	_ = Count__int([]int{1}) + Count__string([]string{"a"})
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformImportFoSpecializations(t *testing.T) {
+	libSrc := `package lib
+
+func Count[T](xs []T) int {
+	return len(xs)
+}
+
+func Count[int](xs []int) int {
+	return -1
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	_ = lib.Count[int](nil) + lib.Count[string](nil)
+}
+`
+
+	expected := `package main
+
+import _ "example.com/lib"
+
+func main() {
+	_ = lib_Count__int(nil) + lib_Count__string(nil)
+}
+func lib_Count__string(xs []string) int {
+	return len(xs)
+}
+func lib_Count__int(xs []int) int {
+	return -1
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformDefaultTypeArgs(t *testing.T) {
+	src := `package main
+
+type Map[K, V = interface{}] map[K]V
+
+func Keys[K](m Map[K]) []K {
+	var keys []K
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func Zero[T = int, U = bool]() (T, U) {
+	var t T
+	var u U
+	return t, u
+}
+
+func main() {
+	m := Map[string]{"a": 1}
+	_ = Map[string, interface{}]{}
+	_ = Map[int, bool]{}
+	_ = Keys[string](m)
+	_, _ = Zero[string]()
+}
+`
+
+	expected := `package main
+
+type (
+	Map__int__bool      map[int]bool
+	Map__string__iface0 map[string]interface {
+	}
+)
+
+func Keys__string(m Map__string__iface0) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func Zero__string__bool() (string, bool) {
+	var t string
+	var u bool
+	return t, u
+}
+
+func main() {
+	m := Map__string__iface0{"a": 1}
+	_ = Map__string__iface0{}
+	_ = Map__int__bool{}
+	_ = Keys__string(m)
+	_, _ = Zero__string__bool()
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformConstTypeParams(t *testing.T) {
+	src := `package main
+
+type Vec[T, const N int] [N]T
+
+func (v Vec[T, N]) Len() int {
+	return N
+}
+
+type Matrix[T, const R int, const C int] [R]Vec[T, C]
+
+func Fill[T, const N int = 2](x T) Vec[T, N] {
+	var out Vec[T, N]
+	for i := range out {
+		out[i] = x
+	}
+	return out
+}
+
+const Dim = 3
+
+func main() {
+	v := Fill[float64, Dim](1.5)
+	_ = v.Len()
+	_ = Vec[float64, 3]{1, 2, 3}
+	_ = Fill[string]("a")
+	_ = Matrix[int, 2, 0x3]{}
+}
+`
+
+	expected := `package main
+
+type (
+	Vec__float64__const3 [3]float64
+	Vec__int__const3     [3]int
+	Vec__string__const2  [2]string
+)
+
+func (v Vec__float64__const3) Len() int {
+	return 3
+}
+func (v Vec__int__const3) Len() int {
+	return 3
+}
+func (v Vec__string__const2) Len() int {
+	return 2
+}
+
+type Matrix__int__const2__const3 [2]Vec__int__const3
+
+func Fill__float64__const3(x float64) Vec__float64__const3 {
+	var out Vec__float64__const3
+	for i := range out {
+		out[i] = x
+	}
+	return out
+}
+func Fill__string__const2(x string) Vec__string__const2 {
+	var out Vec__string__const2
+	for i := range out {
+		out[i] = x
+	}
+	return out
+}
+
+const Dim = 3
+
+func main() {
+	v := Fill__float64__const3(1.5)
+	_ = v.Len()
+	_ = Vec__float64__const3{1, 2, 3}
+	_ = Fill__string__const2("a")
+	_ = Matrix__int__const2__const3{}
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformImportFoConstTypeParams(t *testing.T) {
+	libSrc := `package lib
+
+type Vec[T, const N int] [N]T
+
+func Fill[T, const N int = 2](x T) Vec[T, N] {
+	var out Vec[T, N]
+	for i := range out {
+		out[i] = x
+	}
+	return out
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	_ = lib.Fill[float64, 3](1)
+	_ = lib.Fill[int](1)
+}
+`
+
+	expected := `package main
+
+import _ "example.com/lib"
+
+func main() {
+	_ = lib_Fill__float64__const3(1)
+	_ = lib_Fill__int__const2(1)
+}
+
+type (
+	lib_Vec__float64__const3 [3]float64
+	lib_Vec__int__const2     [2]int
+)
+
+func lib_Fill__float64__const3(x float64) lib_Vec__float64__const3 {
+	var out lib_Vec__float64__const3
+	for i := range out {
+		out[i] = x
+	}
+	return out
+}
+func lib_Fill__int__const2(x int) lib_Vec__int__const2 {
+	var out lib_Vec__int__const2
+	for i := range out {
+		out[i] = x
+	}
+	return out
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformConstraints(t *testing.T) {
+	src := `package main
+
+type Celsius float64
+
+type Real interface {
+	type float32, float64
+}
+
+func Sum[T Number](values []T) T {
+	var sum T
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func Max[T Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func SortSlice[T Ordered](s []T) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && s[j] < s[j-1]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+func Half[T Real](x T) T {
+	return x / 2
+}
+
+func main() {
+	_ = Sum[int]([]int{1, 2, 3})
+	_ = Sum[Celsius]([]Celsius{1.5, 2})
+	_ = Max[string]("a", "b")
+	SortSlice[float64]([]float64{3, 1, 2})
+	_ = Half[float32](3)
+}
+`
+
+	expected := `package main
+
+type Celsius float64
+
+type Real interface {
+}
+
+func Sum__int(values []int) int {
+	var sum int
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+func Sum__main_Celsius(values []Celsius) Celsius {
+	var sum Celsius
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func Max__string(a, b string) string {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func SortSlice__float64(s []float64) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && s[j] < s[j-1]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+func Half__float32(x float32) float32 {
+	return x / 2
+}
+
+func main() {
+	_ = Sum__int([]int{1, 2, 3})
+	_ = Sum__main_Celsius([]Celsius{1.5, 2})
+	_ = Max__string("a", "b")
+	SortSlice__float64([]float64{3, 1, 2})
+	_ = Half__float32(3)
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformImportFoConstraints(t *testing.T) {
+	libSrc := `package lib
+
+type Real interface {
+	type float32, float64
+}
+
+func Half[T Real](x T) T {
+	return x / 2
+}
+
+func Max[T Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	_ = lib.Half[float64](3)
+	_ = lib.Max[int](1, 2)
+}
+`
+
+	expected := `package main
+
+import _ "example.com/lib"
+
+func main() {
+	_ = lib_Half__float64(3)
+	_ = lib_Max__int(1, 2)
+}
+func lib_Half__float64(x float64) float64 {
+	return x / 2
+}
+func lib_Max__int(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformTuples(t *testing.T) {
+	src := `package main
+
+type Pair (int, string)
+
+type Box[T] struct {
+	v T
+}
+
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func Swap[T, U](t (T, U)) (U, T) {
+	return (t.1, t.0)
+}
+
+func first(p (int, int)) int {
+	return p.0
+}
+
+func main() {
+	var p Pair = (1, "one")
+	n, s := p
+	q, r := (divmod(7, 2))
+	var b Box[(string, int)]
+	b.v = (s, n)
+	b.v.1++
+	var d (int, int) = divmod(7, 2)
+	_ = first(divmod(9, 4))
+	_, _ = Swap[string, int](b.v)
+	_, _, _ = q, r, d
+}
+`
+
+	expected := `package main
+
+type Pair struct {
+	F0 int
+	F1 string
+}
+
+type Box__tuple2_string_int struct {
+	v struct {
+		F0 string
+		F1 int
+	}
+}
+
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func Swap__string__int(t struct {
+	F0 string
+	F1 int
+}) (int, string) {
+	return t.F1, t.F0
+}
+
+func first(p struct {
+	F0 int
+	F1 int
+}) int {
+	return p.F0
+}
+
+func main() {
+	var p Pair = struct {
+		F0 int
+		F1 string
+	}{1, "one"}
+	n, s := p.F0, p.F1
+	q, r := (divmod(7, 2))
+	var b Box__tuple2_string_int
+	b.v = struct {
+		F0 string
+		F1 int
+	}{s, n}
+	b.v.F1++
+	var d struct {
+		F0 int
+		F1 int
+	} = func() struct {
+		F0 int
+		F1 int
+	} {
+		tuple0, tuple1 := divmod(7, 2)
+		return struct {
+			F0 int
+			F1 int
+		}{tuple0, tuple1}
+	}()
+	_ = first(func() struct {
+		F0 int
+		F1 int
+	} {
+		tuple0, tuple1 := divmod(9, 4)
+		return struct {
+			F0 int
+			F1 int
+		}{tuple0, tuple1}
+	}())
+	_, _ = Swap__string__int(b.v)
+	_, _, _ = q, r, d
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformPipes(t *testing.T) {
+	src := `package main
+
+func double(x int) int {
+	return 2 * x
+}
+
+func itoa(x int) string {
+	return string(rune('0' + x))
+}
+
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func sum(p (int, int)) int {
+	return p.0 + p.1
+}
+
+func Map[T, U](f func(T) U) func([]T) []U {
+	return func(xs []T) []U {
+		var ys []U
+		for _, x := range xs {
+			ys = append(ys, f(x))
+		}
+		return ys
+	}
+}
+
+func main() {
+	strs := []int{1, 2, 3} |>
+		Map[int, int](double) |>
+		Map[int, string](itoa)
+	strs |> len |> println
+	_ = 3 |> double |> double == 12
+	_ = divmod(7, 2) |> sum
+	_ = 1.5 |> float32
+}
+`
+
+	expected := `package main
+
+func double(x int) int {
+	return 2 * x
+}
+
+func itoa(x int) string {
+	return string(rune('0' + x))
+}
+
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func sum(p struct {
+	F0 int
+	F1 int
+}) int {
+	return p.F0 + p.F1
+}
+
+func Map__int__int(f func(int) int) func([]int) []int {
+	return func(xs []int) []int {
+		var ys []int
+		for _, x := range xs {
+			ys = append(ys, f(x))
+		}
+		return ys
+	}
+}
+func Map__int__string(f func(int) string) func([]int) []string {
+	return func(xs []int) []string {
+		var ys []string
+		for _, x := range xs {
+			ys = append(ys, f(x))
+		}
+		return ys
+	}
+}
+
+func main() {
+	strs := Map__int__string(itoa)(Map__int__int(double)([]int{1, 2, 3}))
+
+	println(len(strs))
+	_ = double(double(3)) == 12
+	_ = sum(func() struct {
+		F0 int
+		F1 int
+	} {
+		tuple0, tuple1 := divmod(7, 2)
+		return struct {
+			F0 int
+			F1 int
+		}{tuple0, tuple1}
+	}())
+	_ = float32(1.5)
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformPipeEvaluationOrder(t *testing.T) {
+	// The function on the right of a pipe is evaluated before the value on
+	// its left, as in the call the pipe becomes.
+	src := `package main
+
+func next() int {
+	println("next")
+	return 1
+}
+
+func handler() func(int) {
+	println("handler")
+	return func(int) {}
+}
+
+func main() {
+	next() |> handler()
+}
+`
+
+	expected := `package main
+
+func next() int {
+	println("next")
+	return 1
+}
+
+func handler() func(int) {
+	println("handler")
+	return func(int) {}
+}
+
+func main() {
+	handler()(next())
+}
+`
+
+	testParseFile(t, src, expected)
+}
+
+func TestTransformVals(t *testing.T) {
+	src := `package main
+
+val limit = 3
+
+func sum(xs val []int) int {
+	total := 0
+	for _, x := range xs {
+		total += x
+	}
+	return total
+}
+
+type Box[T] struct {
+	v T
+}
+
+func (b val Box[T]) Get() T {
+	return b.v
+}
+
+func Apply[T](x val T, f func(T) T) T {
+	val y = f(x)
+	return y
+}
+
+func main() {
+	val xs = []int{1, 2, limit}
+	_ = sum(xs)
+	_ = Box[string]{v: "a"}.Get()
+	_ = Apply[int](1, func(x val int) int { return x + 1 })
+}
+`
+
+	expected := `package main
+
+var limit = 3
+
+func sum(xs []int) int {
+	total := 0
+	for _, x := range xs {
+		total += x
+	}
+	return total
+}
+
+type Box__string struct {
+	v string
+}
+
+func (b Box__string) Get() string {
+	return b.v
+}
+
+func Apply__int(x int, f func(int) int) int {
+	var y = f(x)
+	return y
+}
+
+func main() {
+	var xs = []int{1, 2, limit}
+	_ = sum(xs)
+	_ = Box__string{v: "a"}.Get()
+	_ = Apply__int(1, func(x int) int { return x + 1 })
+}
+`
+	testParseFile(t, src, expected)
+}
+
+func TestTransformImportFo(t *testing.T) {
+	libSrc := `package lib
+
+type Box[T] struct {
+	V T
+}
+
+func (b Box[T]) Get() T {
+	return b.V
+}
+
+func (b Box[T]) Map[U](f func(T) U) Box[U] {
+	return Box[U]{V: f(b.V)}
+}
+
+func MakeBox[T](v T) Box[T] {
+	return Box[T]{V: Wrap(v)}
+}
+
+func Wrap(v interface{}) interface{} {
+	return v
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	b := lib.MakeBox[int](1)
+	var _ lib.Box[string] = b.Map[string](func(int) string { return "" })
+	_ = b.Get()
+}
+`
+
+	expected := `package main
+
+import "example.com/lib"
+
+func main() {
+	b := lib_MakeBox__int(1)
+	var _ lib_Box__string = b.Map__string(func(int) string { return "" })
+	_ = b.Get()
+}
+
+type (
+	lib_Box__int struct {
+		V int
+	}
+	lib_Box__string struct {
+		V string
+	}
+)
+
+func (b lib_Box__int) Get() int {
+	return b.V
+}
+func (b lib_Box__string) Get() string {
+	return b.V
+}
+func (b lib_Box__int) Map__string(f func(int) string) lib_Box__string {
+	return lib_Box__string{V: f(b.V)}
+}
+func lib_MakeBox__int(v int) lib_Box__int {
+	return lib_Box__int{V: lib.Wrap(v)}
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformImportFoUnused(t *testing.T) {
+	libSrc := `package lib
+
+type Box[T] struct {
+	V T
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	var _ = lib.Box[int]{}
+}
+`
+
+	expected := `package main
+
+import _ "example.com/lib"
+
+func main() {
+	var _ = lib_Box__int{}
+}
+
+type lib_Box__int struct {
+	V int
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformImportFoUnexported(t *testing.T) {
+	libSrc := `package lib
+
+func Get[T](x T) T {
+	return T(double(x))
+}
+
+func double(x interface{}) interface{} {
+	return x
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	_ = lib.Get[int](1)
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "transform_test", src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile returned error: %s", err.Error())
+	}
+	info := &types.Info{
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Uses:       map[*ast.Ident]types.Object{},
+	}
+	pkg, err := (&types.Config{Importer: imp}).Check("transformtest", fset, []*ast.File{f}, info)
+	if err != nil {
+		t.Fatalf("conf.Check returned error: %s", err.Error())
+	}
+	trans := &Transformer{Fset: fset, Pkg: pkg, Info: info, Imports: imp.Packages()}
+	_, err = trans.File(f)
+	// The position is in the export data of lib, not in the file being
+	// transformed.
+	expected := "example.com/lib.foexport:5:11: cannot instantiate generic declaration from package example.com/lib: it refers to unexported double"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q but got %v", expected, err)
+	}
+}
+
+func TestTransformImportFoGenericCalls(t *testing.T) {
+	libSrc := `package lib
+
+type Set[T] map[T]struct{}
+
+func New[T]() Set[T] {
+	return Set[T]{}
+}
+
+func FromSlice[T](vs []T) Set[T] {
+	s := New[T]()
+	s.Add(vs...)
+	return s
+}
+
+func (s Set[T]) Add(vs ...T) {
+	for _, v := range vs {
+		s[v] = struct{}{}
+	}
+}
+`
+
+	src := `package main
+
+import "example.com/lib"
+
+func main() {
+	_ = lib.FromSlice[int](nil)
+}
+`
+
+	expected := `package main
+
+import _ "example.com/lib"
+
+func main() {
+	_ = lib_FromSlice__int(nil)
+}
+
+type lib_Set__int map[int]struct {
+}
+
+func lib_New__int() lib_Set__int {
+	return lib_Set__int{}
+}
+func lib_FromSlice__int(vs []int) lib_Set__int {
+	s := lib_New__int()
+	s.Add(vs...)
+	return s
+}
+func (s lib_Set__int) Add(vs ...int) {
+	for _, v := range vs {
+		s[v] = struct {
+		}{}
+	}
+}
+`
+
+	imp := newTestFoImporter(t, "example.com/lib", libSrc)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformUnusedImport(t *testing.T) {
+	src := `package main
+
+import "unsafe"
+
+func Size[T](v T) uintptr {
+	return unsafe.Sizeof(v)
+}
+
+func main() {}
+`
+
+	expected := `package main
+
+import _ "unsafe"
+
+func main() {}
+`
+	testParseFile(t, src, expected)
+}
+
+// newTestFoImporter returns an importer which can import the Fo package with
+// the given path and source code from export data.
+func TestTransformRuntimeTypeNames(t *testing.T) {
+	src := `package main
+
+type Box[T] struct {
+	v T
+}
+
+func main() {
+	var _ = Box[string]{}
+	var _ = Box[[]int]{}
+}
+`
+
+	expected := `package main
+
+import foruntime "github.com/albrow/fo/runtime"
+
+type (
+	Box__slice_int struct {
+		v []int
+	}
+	Box__string struct {
+		v string
+	}
+)
+
+func main() {
+	var _ = Box__string{}
+	var _ = Box__slice_int{}
+}
+func init() {
+	foruntime.RegisterTypeName((*Box__slice_int)(nil), "main.Box[[]int]")
+	foruntime.RegisterTypeName((*Box__string)(nil), "main.Box[string]")
+}
+`
+	testTransformFile(t, src, expected, nil, func(trans *Transformer) {
+		trans.RuntimeTypeNames = true
+	})
+}
+
+// Instantiations of local generic types are not registered, since they can't
+// be referred to from the init function.
+func TestTransformRuntimeTypeNamesLocal(t *testing.T) {
+	src := `package main
+
+type Box[T] struct {
+	v T
+}
+
+func main() {
+	type Local[T] struct {
+		v T
+	}
+	var _ = Local[int]{}
+	var _ = Box[int]{}
+}
+`
+
+	expected := `package main
+
+import foruntime "github.com/albrow/fo/runtime"
+
+type Box__int struct {
+	v int
+}
+
+func main() {
+	type Local__int struct {
+		v int
+	}
+
+	var _ = Local__int{}
+	var _ = Box__int{}
+}
+func init() {
+	foruntime.RegisterTypeName((*Box__int)(nil), "main.Box[int]")
+}
+`
+	testTransformFile(t, src, expected, nil, func(trans *Transformer) {
+		trans.RuntimeTypeNames = true
+	})
+}
+
+func newTestFoImporter(t *testing.T, path string, src string) *foexport.Importer {
+	t.Helper()
+	return newTestFoPackagesImporter(t, map[string]string{path: src})
+}
+
+// newTestFoPackagesImporter returns an importer for the Fo packages with the
+// given sources, keyed by import path. The packages can't import each other.
+func newTestFoPackagesImporter(t *testing.T, srcs map[string]string) *foexport.Importer {
+	t.Helper()
+	exportData := map[string][]byte{}
+	for path, src := range srcs {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, path, src, 0)
+		if err != nil {
+			t.Fatalf("ParseFile returned error: %s", err.Error())
+		}
+		pkg, err := (&types.Config{}).Check(path, fset, []*ast.File{f}, nil)
+		if err != nil {
+			t.Fatalf("conf.Check returned error: %s", err.Error())
+		}
+		data := &bytes.Buffer{}
+		if err := foexport.Write(data, fset, pkg, []*ast.File{f}); err != nil {
+			t.Fatalf("foexport.Write returned error: %s", err.Error())
+		}
+		exportData[path] = data.Bytes()
+	}
+	return foexport.NewImporter(token.NewFileSet(), func(p string) (io.ReadCloser, error) {
+		data, found := exportData[p]
+		if !found {
+			return nil, os.ErrNotExist
+		}
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}, importer.Default())
+}
+
+func TestTransformPackagesWithSameName(t *testing.T) {
+	srcs := map[string]string{
+		"example.com/a/util": "package util\n\ntype T struct{}\n\ntype Box[U] struct {\n\tV U\n}\n",
+		"example.com/b/util": "package util\n\ntype T struct{ x int }\n\ntype Box[U] struct {\n\tV U\n}\n",
+	}
+
+	src := `package main
+
+import (
+	autil "example.com/a/util"
+	butil "example.com/b/util"
+)
+
+type List[T] []T
+
+func main() {
+	var _ List[autil.T]
+	var _ List[butil.T]
+	var _ autil.Box[int]
+	var _ butil.Box[int]
+}
+`
+
+	expected := `package main
+
+import (
+	autil "example.com/a/util"
+	butil "example.com/b/util"
+)
+
+type (
+	List__util_064ce3230_T []autil.T
+	List__util_0f967770b_T []butil.T
+)
+
+func main() {
+	var _ List__util_064ce3230_T
+	var _ List__util_0f967770b_T
+	var _ util_064ce3230_Box__int
+	var _ util_0f967770b_Box__int
+}
+
+type util_064ce3230_Box__int struct {
+	V int
+}
+type util_0f967770b_Box__int struct {
+	V int
+}
+`
+
+	imp := newTestFoPackagesImporter(t, srcs)
+	testParseFileWithImporter(t, src, expected, imp)
+}
+
+func TestTransformInstantiations(t *testing.T) {
+	src := `package main
+
+type Box[T] struct {
+	v T
+}
+
+func (b Box[T]) Get() T {
+	return b.v
+}
+
+func (b *Box) Reset() {
+	b.Reset()
+}
+
+func Wrap[T](v T) Box[T] {
+	return Box[T]{v: v}
+}
+
+func main() {
+	_ = Wrap[int](1)
+	_ = Wrap[string]("a")
+}
+`
+
+	expected := `package main
+
+type (
+	Box__int struct {
+		v int
+	}
+	Box__string struct {
+		v string
+	}
+)
+
+func (b Box__int) Get() int {
+	return b.v
+}
+func (b Box__string) Get() string {
+	return b.v
+}
+
+func (b *Box__int) Reset() {
+	b.Reset()
+}
+func (b *Box__string) Reset() {
+	b.Reset()
+}
+
+func Wrap__int(v int) Box__int {
+	return Box__int{v: v}
+}
+func Wrap__string(v string) Box__string {
+	return Box__string{v: v}
+}
+
+func main() {
+	_ = Wrap__int(1)
+	_ = Wrap__string("a")
+}
+`
+	var trans *Transformer
+	testTransformFile(t, src, expected, nil, func(t *Transformer) {
+		trans = t
+	})
+
+	expectedInsts := []struct {
+		generic string
+		name    string
+		decls   int
+		lines   []int
+	}{
+		{"Box", "Box[int]", 2, []int{16}},
+		{"Box", "Box[string]", 2, []int{16}},
+		{"Box.Get", "Box[int].Get", 1, nil},
+		{"Box.Get", "Box[string].Get", 1, nil},
+		{"Wrap", "Wrap[int]", 1, []int{20}},
+		{"Wrap", "Wrap[string]", 1, []int{21}},
+	}
+	insts := trans.Instantiations()
+	if len(insts) != len(expectedInsts) {
+		t.Fatalf("wrong number of instantiations (expected %d but got %d)", len(expectedInsts), len(insts))
+	}
+	for i, expected := range expectedInsts {
+		inst := insts[i]
+		if inst.Generic != expected.generic || inst.Name != expected.name {
+			t.Errorf("wrong instantiation %d (expected %s %s but got %s %s)", i, expected.generic, expected.name, inst.Generic, inst.Name)
+		}
+		if len(inst.Decls) != expected.decls {
+			t.Errorf("wrong number of declarations for %s (expected %d but got %d)", inst.Name, expected.decls, len(inst.Decls))
+		}
+		var lines []int
+		for _, pos := range inst.Sites {
+			lines = append(lines, trans.Fset.Position(pos).Line)
+		}
+		if !reflect.DeepEqual(lines, expected.lines) {
+			t.Errorf("wrong sites for %s (expected lines %v but got %v)", inst.Name, expected.lines, lines)
+		}
+	}
+}
+
+func testParseFile(t *testing.T, src string, expected string) {
+	t.Helper()
+	testParseFileWithImporter(t, src, expected, nil)
+}
+
+func testParseFileWithImporter(t *testing.T, src string, expected string, foImporter *foexport.Importer) {
+	t.Helper()
+	testTransformFile(t, src, expected, foImporter, nil)
+}
+
+// testTransformFile parses, checks, and transforms src and compares the result
+// to expected. If configure is not nil it is called before the file is
+// transformed.
+func testTransformFile(t *testing.T, src string, expected string, foImporter *foexport.Importer, configure func(trans *Transformer)) {
+	t.Helper()
+	fset := token.NewFileSet()
+	orig, err := parser.ParseFile(fset, "transform_test", src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile returned error: %s", err.Error())
+	}
+	conf := types.Config{}
+	conf.Importer = importer.Default()
+	var imports map[string]*foexport.Package
+	if foImporter != nil {
+		conf.Importer = foImporter
+		imports = foImporter.Packages()
+	}
+	info := &types.Info{
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Uses:       map[*ast.Ident]types.Object{},
+	}
+	pkg, err := conf.Check("transformtest", fset, []*ast.File{orig}, info)
+	if err != nil {
+		t.Fatalf("conf.Check returned error: %s", err.Error())
+	}
+	trans := &Transformer{
+		Fset:    fset,
+		Pkg:     pkg,
+		Info:    info,
+		Imports: imports,
+	}
+	if configure != nil {
+		configure(trans)
 	}
 	transformed, err := trans.File(orig)
 	if err != nil {
@@ -0,0 +1,243 @@
+package transform
+
+import (
+	"strconv"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// Go has no tuples, so each tuple type becomes an anonymous struct with one
+// exported field for each element:
+//
+//	(int, string)    struct{F0 int; F1 string}
+//
+// Since the struct is anonymous and its fields are exported, the same tuple
+// type in two different packages becomes the same Go type.
+
+// tupleFieldPrefix is the prefix for the field names of the structs which
+// tuple types become.
+const tupleFieldPrefix = "F"
+
+// tupleVarName is the name of the temporary variable which holds a tuple while
+// it is being destructured or packed.
+const tupleVarName = "tuple"
+
+// lowerTuples replaces the tuple literals, destructuring assignments and
+// multi-valued function calls that are used as tuples in n with equivalent Go
+// code. It relies on the type information for the original nodes, so it must
+// run before any other pass. The remaining tuple types and element selectors
+// are replaced by replaceTupleTypes.
+func (trans *Transformer) lowerTuples(n ast.Node) ast.Node {
+	// numResults holds the number of results of each enclosing function.
+	var numResults []int
+	pre := func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.FuncType:
+			if _, ok := c.Parent().(*ast.FuncLit); ok {
+				numResults = append(numResults, n.Results.NumFields())
+			} else if _, ok := c.Parent().(*ast.FuncDecl); ok {
+				numResults = append(numResults, n.Results.NumFields())
+			}
+		case *ast.ReturnStmt:
+			if len(n.Results) == 1 && len(numResults) > 0 && numResults[len(numResults)-1] > 1 {
+				if tuple := trans.tupleValue(n.Results[0]); tuple != nil {
+					n.Results = trans.destructureTuple(n.Results[0], tuple)
+				}
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) > 1 && len(n.Rhs) == 1 {
+				if tuple := trans.tupleValue(n.Rhs[0]); tuple != nil {
+					n.Rhs = trans.destructureTuple(n.Rhs[0], tuple)
+				}
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) > 1 && len(n.Values) == 1 {
+				if tuple := trans.tupleValue(n.Values[0]); tuple != nil {
+					n.Values = trans.destructureTuple(n.Values[0], tuple)
+				}
+			}
+		}
+		return true
+	}
+	post := func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			numResults = numResults[:len(numResults)-1]
+		case *ast.TupleExpr:
+			if tuple := trans.tupleValue(n); tuple != nil {
+				c.Replace(&ast.CompositeLit{
+					Type:   trans.typeExpr(tuple),
+					Lbrace: n.Lparen,
+					Elts:   n.Elts,
+					Rbrace: n.Rparen,
+				})
+			}
+		case *ast.CallExpr, *ast.ParenExpr, *ast.BinaryExpr:
+			expr := n.(ast.Expr)
+			if tuple := trans.tupleValue(expr); tuple != nil && trans.isMultiValuedCall(expr) {
+				c.Replace(trans.packTuple(expr, tuple))
+			}
+		}
+		return true
+	}
+	return astutil.Apply(n, pre, post)
+}
+
+// tupleValue returns the tuple type of expr, or nil if expr is not a value of
+// a tuple type.
+func (trans *Transformer) tupleValue(expr ast.Expr) *types.TupleType {
+	tv, found := trans.Info.Types[expr]
+	if !found || !tv.IsValue() {
+		return nil
+	}
+	tuple, _ := tv.Type.Underlying().(*types.TupleType)
+	return tuple
+}
+
+// isMultiValuedCall returns true if expr is a (possibly parenthesized) call or
+// pipe expression for a function with more than one result.
+func (trans *Transformer) isMultiValuedCall(expr ast.Expr) bool {
+	var fun ast.Expr
+	switch x := astutil.Unparen(expr).(type) {
+	case *ast.CallExpr:
+		fun = x.Fun
+	case *ast.BinaryExpr:
+		if x.Op != token.PIPE {
+			return false
+		}
+		fun = x.Y
+	default:
+		return false
+	}
+	tv, found := trans.Info.Types[fun]
+	if !found || tv.IsType() {
+		return false
+	}
+	var sig *types.Signature
+	switch t := tv.Type.Underlying().(type) {
+	case *types.Signature:
+		sig = t
+	case *types.GenericSignature:
+		sig = t.Signature
+	case *types.PartialGenericSignature:
+		sig = t.Signature
+	case *types.ConcreteSignature:
+		sig = t.Signature
+	default:
+		return false
+	}
+	return sig.Results().Len() > 1
+}
+
+// destructureTuple returns the expressions for each of the elements of the
+// tuple value expr. The elements of a tuple literal are used as is, and if expr
+// is an identifier its elements are selected directly. Otherwise expr is
+// assigned to a temporary variable in a function literal which returns each of
+// the elements, so that it is only evaluated once:
+//
+//	func() (int, string) { tuple := expr; return tuple.0, tuple.1 }()
+func (trans *Transformer) destructureTuple(expr ast.Expr, tuple *types.TupleType) []ast.Expr {
+	switch x := astutil.Unparen(expr).(type) {
+	case *ast.TupleExpr:
+		return x.Elts
+	case *ast.Ident:
+		return tupleElemSelectors(x, tuple.Len())
+	}
+	results := &ast.FieldList{}
+	for _, elem := range tuple.Elems() {
+		results.List = append(results.List, &ast.Field{Type: trans.typeExpr(elem)})
+	}
+	return []ast.Expr{
+		&ast.CallExpr{
+			Fun: &ast.FuncLit{
+				Type: &ast.FuncType{Params: &ast.FieldList{}, Results: results},
+				Body: &ast.BlockStmt{
+					List: []ast.Stmt{
+						&ast.AssignStmt{
+							Lhs: []ast.Expr{ast.NewIdent(tupleVarName)},
+							Tok: token.DEFINE,
+							Rhs: []ast.Expr{expr},
+						},
+						&ast.ReturnStmt{
+							Results: tupleElemSelectors(ast.NewIdent(tupleVarName), tuple.Len()),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// packTuple returns an expression for the tuple which holds the results of the
+// multi-valued function call expr:
+//
+//	func() ((int, string)) { tuple0, tuple1 := expr; return (tuple0, tuple1) }()
+func (trans *Transformer) packTuple(expr ast.Expr, tuple *types.TupleType) ast.Expr {
+	var vars []ast.Expr
+	for i := 0; i < tuple.Len(); i++ {
+		vars = append(vars, ast.NewIdent(tupleVarName+strconv.Itoa(i)))
+	}
+	return &ast.CallExpr{
+		Fun: &ast.FuncLit{
+			Type: &ast.FuncType{
+				Params: &ast.FieldList{},
+				Results: &ast.FieldList{
+					List: []*ast.Field{{Type: trans.typeExpr(tuple)}},
+				},
+			},
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.AssignStmt{
+						Lhs: vars,
+						Tok: token.DEFINE,
+						Rhs: []ast.Expr{expr},
+					},
+					&ast.ReturnStmt{
+						Results: []ast.Expr{
+							&ast.CompositeLit{Type: trans.typeExpr(tuple), Elts: vars},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func tupleElemSelectors(x ast.Expr, n int) []ast.Expr {
+	sels := make([]ast.Expr, n)
+	for i := range sels {
+		sels[i] = &ast.SelectorExpr{X: x, Sel: ast.NewIdent(strconv.Itoa(i))}
+	}
+	return sels
+}
+
+// isTupleElem returns true if sel selects an element of a tuple. Go
+// identifiers cannot start with a digit, so no other selector can.
+func isTupleElem(sel *ast.SelectorExpr) bool {
+	name := sel.Sel.Name
+	return name != "" && name[0] >= '0' && name[0] <= '9'
+}
+
+// replaceTupleTypes replaces each tuple type with the equivalent struct type
+// and each tuple element selector with the name of the corresponding field.
+func replaceTupleTypes(c *astutil.Cursor) bool {
+	switch n := c.Node().(type) {
+	case *ast.TupleExpr:
+		fields := &ast.FieldList{Opening: n.Lparen, Closing: n.Rparen}
+		for i, elt := range n.Elts {
+			fields.List = append(fields.List, &ast.Field{
+				Names: []*ast.Ident{ast.NewIdent(tupleFieldPrefix + strconv.Itoa(i))},
+				Type:  elt,
+			})
+		}
+		c.Replace(&ast.StructType{Struct: n.Lparen, Fields: fields})
+	case *ast.SelectorExpr:
+		if isTupleElem(n) {
+			n.Sel = &ast.Ident{NamePos: n.Sel.NamePos, Name: tupleFieldPrefix + n.Sel.Name}
+		}
+	}
+	return true
+}
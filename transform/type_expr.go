@@ -0,0 +1,463 @@
+package transform
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/constant"
+	"github.com/albrow/fo/mangle"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+)
+
+// typeNameFunc returns an expression which refers to the type name obj.
+type typeNameFunc func(obj *types.TypeName) ast.Expr
+
+// qualifiedTypeName returns an expression for obj which is qualified with the
+// name of its package unless obj is predeclared.
+func qualifiedTypeName(obj *types.TypeName) ast.Expr {
+	if obj.Pkg() == nil {
+		return ast.NewIdent(obj.Name())
+	}
+	return &ast.SelectorExpr{
+		X:   ast.NewIdent(obj.Pkg().Name()),
+		Sel: ast.NewIdent(obj.Name()),
+	}
+}
+
+// mangledTypeName is like qualifiedTypeName, but qualifies obj with the name
+// returned by pkgName. It is used for the type arguments in mangled names.
+func (trans *Transformer) mangledTypeName(obj *types.TypeName) ast.Expr {
+	if obj.Pkg() == nil {
+		return ast.NewIdent(obj.Name())
+	}
+	return &ast.SelectorExpr{
+		X:   ast.NewIdent(trans.pkgName(obj.Pkg())),
+		Sel: ast.NewIdent(obj.Name()),
+	}
+}
+
+// pkgName returns the name which qualifies the declarations of pkg in mangled
+// names. It is the name of the package, unless Pkg uses another package with
+// the same name, in which case a hash of the import path is added (see
+// mangle.PackageName).
+func (trans *Transformer) pkgName(pkg *types.Package) string {
+	if trans.pkgNames == nil {
+		trans.initPkgNames()
+	}
+	if name, found := trans.pkgNames[pkg.Path()]; found {
+		return name
+	}
+	// A package which Pkg only depends on through export data which doesn't
+	// list its imports. The name is only kept if no other package has it yet.
+	name := pkg.Name()
+	if trans.pkgNameTaken[name] {
+		name = mangle.PackageName(pkg.Name(), pkg.Path())
+	}
+	trans.pkgNames[pkg.Path()] = name
+	trans.pkgNameTaken[name] = true
+	return name
+}
+
+// initPkgNames finds the names of Pkg and every package it depends on. Names
+// which are shared by several packages are disambiguated for all of them, so
+// that they don't depend on the order in which the packages are used.
+func (trans *Transformer) initPkgNames() {
+	trans.pkgNames = map[string]string{}
+	trans.pkgNameTaken = map[string]bool{}
+	paths := map[string][]string{}
+	seen := map[*types.Package]bool{}
+	var visit func(pkg *types.Package)
+	visit = func(pkg *types.Package) {
+		if pkg == nil || seen[pkg] {
+			return
+		}
+		seen[pkg] = true
+		if _, found := trans.pkgNames[pkg.Path()]; !found {
+			trans.pkgNames[pkg.Path()] = ""
+			paths[pkg.Name()] = append(paths[pkg.Name()], pkg.Path())
+		}
+		for _, imported := range pkg.Imports() {
+			visit(imported)
+		}
+	}
+	visit(trans.Pkg)
+	for _, pkg := range trans.Imports {
+		visit(pkg.Types)
+	}
+	for name, list := range paths {
+		for _, path := range list {
+			if len(list) == 1 {
+				trans.pkgNames[path] = name
+			} else {
+				trans.pkgNames[path] = mangle.PackageName(name, path)
+			}
+			trans.pkgNameTaken[trans.pkgNames[path]] = true
+		}
+	}
+}
+
+// typeName returns an expression which can be used to refer to obj in the
+// transformed code.
+func (trans *Transformer) typeName(obj *types.TypeName) ast.Expr {
+	if obj.Pkg() == nil || obj.Pkg() == trans.Pkg {
+		return ast.NewIdent(obj.Name())
+	}
+	if _, ok := obj.Type().(*types.GenericNamed); ok {
+		return ast.NewIdent(trans.localName(obj))
+	}
+	switch name := trans.importNames[obj.Pkg().Path()]; name {
+	case "", "_":
+		return qualifiedTypeName(obj)
+	case ".":
+		return ast.NewIdent(obj.Name())
+	default:
+		return &ast.SelectorExpr{X: ast.NewIdent(name), Sel: ast.NewIdent(obj.Name())}
+	}
+}
+
+// typeExpr returns an expression which can be used to refer to typ in the
+// transformed code.
+func (trans *Transformer) typeExpr(typ types.Type) ast.Expr {
+	return typeToExpr(typ, trans.typeName)
+}
+
+// mangledName returns the name for the instantiation of the generic type or
+// function with the given name and type arguments. Each type argument must be
+// in the canonical form returned by canonicalTypeExpr.
+func (trans *Transformer) mangledName(name string, typeArgs []ast.Expr) string {
+	if _, found := trans.imported[name]; !found {
+		// The local names of imported generics are already mangled.
+		name = mangle.Ident(name)
+	}
+	result, err := mangle.Name(name, typeArgs)
+	if err != nil {
+		panic(fmt.Errorf("could not create name for %s: %s", name, err))
+	}
+	return result
+}
+
+// canonicalTypeExpr returns an equivalent type expression for expr in which
+// all type names are qualified and aliases have been resolved, so that
+// equivalent types always have the same mangled name. expr may come from the
+// original source code or it may have been created by the transformer.
+func (trans *Transformer) canonicalTypeExpr(expr ast.Expr) ast.Expr {
+	if tv, found := trans.Info.Types[expr]; found && tv.IsType() && !isGenericType(tv.Type) {
+		return typeToExpr(tv.Type, trans.mangledTypeName)
+	} else if found && tv.Value != nil && tv.Value.Kind() != constant.Unknown {
+		// The argument for a constant type parameter.
+		return constToExpr(tv.Value)
+	}
+	switch x := expr.(type) {
+	case *ast.Ident:
+		if obj, ok := trans.Info.Uses[x].(*types.TypeName); ok {
+			return trans.canonicalTypeNameExpr(obj)
+		}
+		if obj, ok := trans.Pkg.Scope().Lookup(x.Name).(*types.TypeName); ok {
+			return trans.canonicalTypeNameExpr(obj)
+		}
+		if decl, found := trans.imported[x.Name]; found {
+			if obj, ok := decl.Type.Object().(*types.TypeName); ok {
+				return trans.mangledTypeName(obj)
+			}
+		}
+		if obj, ok := types.Universe.Lookup(x.Name).(*types.TypeName); ok {
+			return trans.mangledTypeName(obj)
+		}
+		// Types declared inside of a function.
+		return &ast.SelectorExpr{
+			X:   ast.NewIdent(trans.pkgName(trans.Pkg)),
+			Sel: ast.NewIdent(x.Name),
+		}
+	case *ast.ParenExpr:
+		return trans.canonicalTypeExpr(x.X)
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: trans.canonicalTypeExpr(x.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: x.Len, Elt: trans.canonicalTypeExpr(x.Elt)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: trans.canonicalTypeExpr(x.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{
+			Key:   trans.canonicalTypeExpr(x.Key),
+			Value: trans.canonicalTypeExpr(x.Value),
+		}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: x.Dir, Value: trans.canonicalTypeExpr(x.Value)}
+	case *ast.FuncType:
+		return &ast.FuncType{
+			Params:  trans.canonicalFieldList(x.Params),
+			Results: trans.canonicalFieldList(x.Results),
+		}
+	case *ast.StructType:
+		return &ast.StructType{Fields: trans.canonicalFieldList(x.Fields)}
+	case *ast.TupleExpr:
+		return &ast.TupleExpr{Elts: trans.canonicalTypeExprs(x.Elts)}
+	case *ast.InterfaceType:
+		return &ast.InterfaceType{Methods: trans.canonicalFieldList(x.Methods)}
+	case *ast.TypeArgExpr:
+		return &ast.TypeArgExpr{
+			X:     trans.canonicalTypeExpr(x.X),
+			Types: trans.canonicalTypeExprs(x.Types),
+		}
+	case *ast.IndexExpr:
+		return &ast.TypeArgExpr{
+			X:     trans.canonicalTypeExpr(x.X),
+			Types: []ast.Expr{trans.canonicalTypeExpr(x.Index)},
+		}
+	}
+	return expr
+}
+
+func (trans *Transformer) canonicalTypeNameExpr(obj *types.TypeName) ast.Expr {
+	if isGenericType(obj.Type()) {
+		return trans.mangledTypeName(obj)
+	}
+	return typeToExpr(obj.Type(), trans.mangledTypeName)
+}
+
+// isGenericType returns true if typ still has type parameters.
+func isGenericType(typ types.Type) bool {
+	switch typ.(type) {
+	case types.GenericType, types.PartialGenericType, *types.TypeParam:
+		return true
+	}
+	return false
+}
+
+func (trans *Transformer) canonicalTypeExprs(exprs []ast.Expr) []ast.Expr {
+	result := make([]ast.Expr, len(exprs))
+	for i, expr := range exprs {
+		result[i] = trans.canonicalTypeExpr(expr)
+	}
+	return result
+}
+
+func (trans *Transformer) canonicalFieldList(fieldList *ast.FieldList) *ast.FieldList {
+	if fieldList == nil {
+		return nil
+	}
+	result := &ast.FieldList{}
+	for _, field := range fieldList.List {
+		result.List = append(result.List, &ast.Field{
+			Names: field.Names,
+			Type:  trans.canonicalTypeExpr(field.Type),
+			Tag:   field.Tag,
+		})
+	}
+	return result
+}
+
+// typeToExpr returns an expression for typ. typeName is used to refer to named
+// types.
+func typeToExpr(typ types.Type, typeName typeNameFunc) ast.Expr {
+	switch typ := typ.(type) {
+	case *types.Basic:
+		return basicTypeToExpr(typ)
+	case *types.Pointer:
+		return pointerTypeToExpr(typ, typeName)
+	case *types.Slice:
+		return sliceTypeToExpr(typ, typeName)
+	case *types.Array:
+		return arrayTypeToExpr(typ, typeName)
+	case *types.Map:
+		return mapTypetoExpr(typ, typeName)
+	case *types.Chan:
+		return chanTypeToExpr(typ, typeName)
+	case *types.Struct:
+		return structTypeToExpr(typ, typeName)
+	case *types.Interface:
+		return interfaceTypeToExpr(typ, typeName)
+	case *types.Signature:
+		return signatureTypeToExpr(typ, typeName)
+	case *types.TupleType:
+		return tupleTypeToExpr(typ, typeName)
+	case *types.Named:
+		return namedTypeToExpr(typ, typeName)
+	case *types.ConcreteNamed:
+		return concreteNamedTypeToExpr(typ, typeName)
+	case *types.ConstArg:
+		return constToExpr(typ.Val())
+	}
+	return ast.NewIdent(typ.String())
+}
+
+func basicTypeToExpr(basic *types.Basic) ast.Expr {
+	if basic.Kind() == types.UnsafePointer {
+		return &ast.SelectorExpr{
+			X:   ast.NewIdent("unsafe"),
+			Sel: ast.NewIdent("Pointer"),
+		}
+	}
+	return ast.NewIdent(basic.Name())
+}
+
+func pointerTypeToExpr(ptr *types.Pointer, typeName typeNameFunc) ast.Expr {
+	return &ast.StarExpr{
+		X: typeToExpr(ptr.Elem(), typeName),
+	}
+}
+
+func sliceTypeToExpr(slice *types.Slice, typeName typeNameFunc) ast.Expr {
+	return &ast.ArrayType{
+		Len: nil,
+		Elt: typeToExpr(slice.Elem(), typeName),
+	}
+}
+
+func arrayTypeToExpr(array *types.Array, typeName typeNameFunc) ast.Expr {
+	if tp := array.LenParam(); tp != nil {
+		return &ast.ArrayType{
+			Len: ast.NewIdent(tp.String()),
+			Elt: typeToExpr(array.Elem(), typeName),
+		}
+	}
+	return &ast.ArrayType{
+		Len: &ast.BasicLit{
+			Kind:  token.INT,
+			Value: strconv.Itoa(int(array.Len())),
+		},
+		Elt: typeToExpr(array.Elem(), typeName),
+	}
+}
+
+// constToExpr returns an integer literal for val, which is the argument for a
+// constant type parameter.
+func constToExpr(val constant.Value) ast.Expr {
+	if constant.Sign(val) < 0 {
+		return &ast.UnaryExpr{
+			Op: token.SUB,
+			X:  constToExpr(constant.UnaryOp(token.SUB, val, 0)),
+		}
+	}
+	return &ast.BasicLit{
+		Kind:  token.INT,
+		Value: constant.ToInt(val).ExactString(),
+	}
+}
+
+func mapTypetoExpr(m *types.Map, typeName typeNameFunc) ast.Expr {
+	return &ast.MapType{
+		Key:   typeToExpr(m.Key(), typeName),
+		Value: typeToExpr(m.Elem(), typeName),
+	}
+}
+
+func chanTypeToExpr(ch *types.Chan, typeName typeNameFunc) ast.Expr {
+	var chanDir ast.ChanDir
+	switch ch.Dir() {
+	case types.SendRecv:
+		chanDir = ast.SEND | ast.RECV
+	case types.SendOnly:
+		chanDir = ast.SEND
+	case types.RecvOnly:
+		chanDir = ast.RECV
+	}
+	return &ast.ChanType{
+		Dir:   chanDir,
+		Value: typeToExpr(ch.Elem(), typeName),
+	}
+}
+
+func structTypeToExpr(st *types.Struct, typeName typeNameFunc) ast.Expr {
+	fieldList := make([]*ast.Field, st.NumFields())
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		fieldList[i] = &ast.Field{
+			Type: typeToExpr(field.Type(), typeName),
+		}
+		if !field.Anonymous() {
+			fieldList[i].Names = []*ast.Ident{ast.NewIdent(field.Name())}
+		}
+		if tag := st.Tag(i); tag != "" {
+			fieldList[i].Tag = &ast.BasicLit{
+				Kind:  token.STRING,
+				Value: strconv.Quote(tag),
+			}
+		}
+	}
+	return &ast.StructType{
+		Fields: &ast.FieldList{
+			List: fieldList,
+		},
+	}
+}
+
+func interfaceTypeToExpr(iface *types.Interface, typeName typeNameFunc) ast.Expr {
+	methods := []*ast.Field{}
+	for i := 0; i < iface.NumExplicitMethods(); i++ {
+		method := iface.ExplicitMethod(i)
+		methods = append(methods, &ast.Field{
+			Names: []*ast.Ident{ast.NewIdent(method.Name())},
+			Type:  signatureTypeToExpr(method.Type().(*types.Signature), typeName),
+		})
+	}
+	for i := 0; i < iface.NumEmbeddeds(); i++ {
+		methods = append(methods, &ast.Field{
+			Type: typeToExpr(iface.Embedded(i), typeName),
+		})
+	}
+	return &ast.InterfaceType{
+		Methods: &ast.FieldList{
+			List: methods,
+		},
+	}
+}
+
+func signatureTypeToExpr(sig *types.Signature, typeName typeNameFunc) ast.Expr {
+	params := tupleToFieldList(sig.Params(), typeName)
+	if sig.Variadic() {
+		last := params.List[len(params.List)-1]
+		last.Type = &ast.Ellipsis{
+			Elt: last.Type.(*ast.ArrayType).Elt,
+		}
+	}
+	return &ast.FuncType{
+		Params:  params,
+		Results: tupleToFieldList(sig.Results(), typeName),
+	}
+}
+
+func tupleTypeToExpr(tuple *types.TupleType, typeName typeNameFunc) ast.Expr {
+	elts := make([]ast.Expr, tuple.Len())
+	for i, elem := range tuple.Elems() {
+		elts[i] = typeToExpr(elem, typeName)
+	}
+	return &ast.TupleExpr{Elts: elts}
+}
+
+func namedTypeToExpr(named *types.Named, typeName typeNameFunc) ast.Expr {
+	if named.Obj() == nil {
+		return ast.NewIdent(named.String())
+	}
+	return typeName(named.Obj())
+}
+
+func concreteNamedTypeToExpr(named *types.ConcreteNamed, typeName typeNameFunc) ast.Expr {
+	genType := named.GenericType()
+	typeArgs := []ast.Expr{}
+	for _, param := range genType.TypeParams() {
+		typeArgs = append(typeArgs, typeToExpr(named.TypeMap()[param.String()], typeName))
+	}
+	return &ast.TypeArgExpr{
+		X:     typeName(genType.Object().(*types.TypeName)),
+		Types: typeArgs,
+	}
+}
+
+func tupleToFieldList(tuple *types.Tuple, typeName typeNameFunc) *ast.FieldList {
+	fieldList := make([]*ast.Field, tuple.Len())
+	for i := 0; i < tuple.Len(); i++ {
+		field := tuple.At(i)
+		fieldList[i] = &ast.Field{
+			Type: typeToExpr(field.Type(), typeName),
+		}
+		if field.Name() != "" {
+			fieldList[i].Names = []*ast.Ident{ast.NewIdent(field.Name())}
+		}
+	}
+	return &ast.FieldList{
+		List: fieldList,
+	}
+}
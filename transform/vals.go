@@ -0,0 +1,22 @@
+package transform
+
+import (
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/astutil"
+	"github.com/albrow/fo/token"
+)
+
+// lowerVals replaces each val declaration with a var declaration and removes
+// val from read-only parameters. Read-only variables are only checked by the
+// type checker, so they become ordinary Go variables.
+func lowerVals(c *astutil.Cursor) bool {
+	switch n := c.Node().(type) {
+	case *ast.GenDecl:
+		if n.Tok == token.VAL {
+			n.Tok = token.VAR
+		}
+	case *ast.Field:
+		n.Val = token.NoPos
+	}
+	return true
+}
@@ -131,6 +131,14 @@ type Config struct {
 	// If DisableUnusedImportCheck is set, packages are not checked
 	// for unused imports.
 	DisableUnusedImportCheck bool
+
+	// MaxInstantiationDepth is the maximum length of a chain of
+	// instantiations of generic types and functions, where each
+	// instantiation in the chain is caused by the one before it
+	// (e.g. F[int] calls G[[]int] which calls F[[][]int]). Chains
+	// which exceed it are reported as instantiation cycles. If
+	// MaxInstantiationDepth <= 0, a default of 100 is used.
+	MaxInstantiationDepth int
 }
 
 // Info holds result type information for a type-checked package.
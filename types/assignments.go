@@ -18,6 +18,7 @@ import (
 // Use T == nil to indicate assignment to an untyped blank identifier.
 // x.mode is set to invalid if the assignment failed.
 func (check *Checker) assignment(x *operand, T Type, context string) {
+	check.packTuple(x, T)
 	check.singleValue(x)
 
 	switch x.typ.(type) {
@@ -133,6 +134,9 @@ func (check *Checker) initVar(lhs *Var, x *operand, context string) Type {
 	if x.mode == invalid {
 		return nil
 	}
+	if !lhs.readOnly && lhs.name != "_" {
+		check.readOnlyCopy(x, lhs.typ, context)
+	}
 
 	return x.typ
 }
@@ -142,17 +146,36 @@ func (check *Checker) assignVar(lhs ast.Expr, x *operand) Type {
 		return nil
 	}
 
+	T, ok := check.lhsVar(lhs)
+	if !ok {
+		return nil
+	}
+	check.assignment(x, T, assignmentContext(T))
+	if x.mode == invalid {
+		return nil
+	}
+	check.readOnlyCopy(x, T, "assignment")
+
+	return x.typ
+}
+
+func assignmentContext(T Type) string {
+	if T == nil {
+		return "assignment to _ identifier"
+	}
+	return "assignment"
+}
+
+// lhsVar type-checks the lhs of an assignment and returns its type, or nil
+// if lhs is the blank identifier. ok is false if lhs cannot be assigned to.
+func (check *Checker) lhsVar(lhs ast.Expr) (T Type, ok bool) {
 	// Determine if the lhs is a (possibly parenthesized) identifier.
 	ident, _ := unparen(lhs).(*ast.Ident)
 
 	// Don't evaluate lhs if it is the blank identifier.
 	if ident != nil && ident.Name == "_" {
 		check.recordDef(ident, nil)
-		check.assignment(x, nil, "assignment to _ identifier")
-		if x.mode == invalid {
-			return nil
-		}
-		return x.typ
+		return nil, true
 	}
 
 	// If the lhs is an identifier denoting a variable v, this assignment
@@ -179,14 +202,14 @@ func (check *Checker) assignVar(lhs ast.Expr, x *operand) Type {
 	}
 
 	if z.mode == invalid || z.typ == Typ[Invalid] {
-		return nil
+		return nil, false
 	}
 
 	// spec: "Each left-hand side operand must be addressable, a map index
 	// expression, or the blank identifier. Operands may be parenthesized."
 	switch z.mode {
 	case invalid:
-		return nil
+		return nil, false
 	case variable, mapindex:
 		// ok
 	default:
@@ -195,26 +218,75 @@ func (check *Checker) assignVar(lhs ast.Expr, x *operand) Type {
 			check.expr(&op, sel.X)
 			if op.mode == mapindex {
 				check.errorf(z.pos(), "cannot assign to struct field %s in map", ExprString(z.expr))
-				return nil
+				return nil, false
 			}
 		}
 		check.errorf(z.pos(), "cannot assign to %s", &z)
-		return nil
+		return nil, false
 	}
 
-	check.assignment(x, z.typ, "assignment")
-	if x.mode == invalid {
-		return nil
+	if v := check.readOnlyVar(lhs); v != nil {
+		check.errorf(z.pos(), "cannot assign to %s (%s is read-only)", lhs, v.name)
+		return nil, false
 	}
 
-	return x.typ
+	return z.typ, true
+}
+
+// readOnlyVar returns the read-only variable which the addressable or map
+// index expression e is reached through, or nil if there is none. Everything
+// reached from a read-only variable by selecting fields, indexing, slicing or
+// indirecting pointers is itself read-only, so that a val slice or map is a
+// read-only view of its elements.
+func (check *Checker) readOnlyVar(e ast.Expr) *Var {
+	for {
+		switch x := e.(type) {
+		case *ast.Ident:
+			_, obj := check.scope.LookupParent(x.Name, token.NoPos)
+			if v, _ := obj.(*Var); v != nil && v.readOnly {
+				return v
+			}
+			return nil
+		case *ast.SelectorExpr:
+			if ident, _ := x.X.(*ast.Ident); ident != nil {
+				_, obj := check.scope.LookupParent(ident.Name, token.NoPos)
+				if pname, _ := obj.(*PkgName); pname != nil {
+					// A qualified identifier (e.g. pkg.X).
+					if v, _ := pname.imported.scope.Lookup(x.Sel.Name).(*Var); v != nil && v.readOnly {
+						return v
+					}
+					return nil
+				}
+			}
+			e = x.X
+		case *ast.ParenExpr:
+			e = x.X
+		case *ast.IndexExpr:
+			e = x.X
+		case *ast.SliceExpr:
+			e = x.X
+		case *ast.StarExpr:
+			e = x.X
+		default:
+			return nil
+		}
+	}
 }
 
 // If returnPos is valid, initVars is called to type-check the assignment of
 // return expressions, and returnPos is the position of the return statement.
 func (check *Checker) initVars(lhs []*Var, rhs []ast.Expr, returnPos token.Pos) {
 	l := len(lhs)
-	get, r, commaOk := unpack(func(x *operand, i int) { check.multiExpr(x, rhs[i]) }, len(rhs), l == 2 && !returnPos.IsValid())
+	get, r, commaOk := unpack(func(x *operand, i int) {
+		var T Type
+		if l == len(rhs) {
+			T = lhs[i].typ
+		}
+		check.assignedExpr(x, rhs[i], T)
+	}, len(rhs), l == 2 && !returnPos.IsValid())
+	if get != nil && l > 1 && !commaOk {
+		get, r = unpackTuple(get, r)
+	}
 	if get == nil || l != r {
 		// invalidate lhs and use rhs
 		for _, obj := range lhs {
@@ -258,11 +330,33 @@ func (check *Checker) initVars(lhs []*Var, rhs []ast.Expr, returnPos token.Pos)
 
 func (check *Checker) assignVars(lhs, rhs []ast.Expr) {
 	l := len(lhs)
+	if l == len(rhs) {
+		// Each lhs is evaluated before the corresponding rhs so that its type
+		// is known for tuple literals and multi-valued function calls.
+		for i, lhs := range lhs {
+			T, ok := check.lhsVar(lhs)
+			var x operand
+			check.assignedExpr(&x, rhs[i], T)
+			if t, isTuple := x.typ.(*Tuple); isTuple && l == 1 && x.mode != invalid {
+				check.errorf(rhs[0].Pos(), "cannot assign %d values to %d variables", t.Len(), l)
+				return
+			}
+			if ok && x.mode != invalid && x.typ != Typ[Invalid] {
+				check.assignment(&x, T, assignmentContext(T))
+				check.readOnlyCopy(&x, T, "assignment")
+			}
+		}
+		return
+	}
+
 	get, r, commaOk := unpack(func(x *operand, i int) { check.multiExpr(x, rhs[i]) }, len(rhs), l == 2)
 	if get == nil {
 		check.useLHS(lhs...)
 		return // error reported by unpack
 	}
+	if l > 1 && !commaOk {
+		get, r = unpackTuple(get, r)
+	}
 	if l != r {
 		check.useGetter(get, r)
 		check.errorf(rhs[0].Pos(), "cannot assign %d values to %d variables", r, l)
@@ -304,6 +398,9 @@ func (check *Checker) shortVarDecl(pos token.Pos, lhs, rhs []ast.Expr) {
 				// redeclared object must be a variable
 				if alt, _ := alt.(*Var); alt != nil {
 					obj = alt
+					if alt.readOnly {
+						check.errorf(lhs.Pos(), "cannot assign to %s (%s is read-only)", lhs, name)
+					}
 				} else {
 					check.errorf(lhs.Pos(), "cannot assign to %s", lhs)
 				}
@@ -341,3 +438,103 @@ func (check *Checker) shortVarDecl(pos token.Pos, lhs, rhs []ast.Expr) {
 		check.softErrorf(pos, "no new variables on left side of :=")
 	}
 }
+
+// readOnlySource returns the read-only variable which the value of e is read
+// from, or nil if there is none. It is like readOnlyVar, but also looks
+// through conversions and calls to append, whose result may share the
+// elements of its first argument.
+func (check *Checker) readOnlySource(e ast.Expr) *Var {
+	switch x := unparen(e).(type) {
+	case *ast.CallExpr:
+		if len(x.Args) > 0 && (check.isBuiltin(x.Fun, _Append) || len(x.Args) == 1 && check.isTypeExpr(x.Fun)) {
+			return check.readOnlySource(x.Args[0])
+		}
+		return nil
+	case *ast.SliceExpr:
+		return check.readOnlySource(x.X)
+	}
+	return check.readOnlyVar(e)
+}
+
+// isBuiltin reports whether e denotes the predeclared function with the given
+// id.
+func (check *Checker) isBuiltin(e ast.Expr, id builtinId) bool {
+	ident, _ := unparen(e).(*ast.Ident)
+	if ident == nil {
+		return false
+	}
+	_, obj := check.scope.LookupParent(ident.Name, token.NoPos)
+	b, _ := obj.(*Builtin)
+	return b != nil && b.id == id
+}
+
+// isTypeExpr reports whether e, which has already been checked, denotes a
+// type, so that a call of e is a conversion.
+func (check *Checker) isTypeExpr(e ast.Expr) bool {
+	switch x := e.(type) {
+	case *ast.ParenExpr:
+		return check.isTypeExpr(x.X)
+	case *ast.StarExpr:
+		return check.isTypeExpr(x.X)
+	case *ast.IndexExpr:
+		return check.isTypeExpr(x.X)
+	case *ast.TypeArgExpr:
+		return check.isTypeExpr(x.X)
+	case *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType,
+		*ast.InterfaceType, *ast.StructType, *ast.TupleExpr:
+		return true
+	case *ast.Ident:
+		_, obj := check.scope.LookupParent(x.Name, token.NoPos)
+		_, ok := obj.(*TypeName)
+		return ok
+	case *ast.SelectorExpr:
+		if ident, _ := x.X.(*ast.Ident); ident != nil {
+			_, obj := check.scope.LookupParent(ident.Name, token.NoPos)
+			if pname, _ := obj.(*PkgName); pname != nil {
+				_, ok := pname.imported.scope.Lookup(x.Sel.Name).(*TypeName)
+				return ok
+			}
+		}
+	}
+	return false
+}
+
+// sharesData reports whether a copy of a value of type typ shares memory with
+// the original, so that the original can be changed through the copy. This is
+// the case for slices, maps and pointers, and for arrays, structs and tuples
+// which contain them. Interfaces, functions and channels are not included.
+func sharesData(typ Type) bool {
+	switch t := typ.Underlying().(type) {
+	case *Slice, *Map, *Pointer:
+		return true
+	case *Array:
+		return sharesData(t.elem)
+	case *Struct:
+		for _, f := range t.fields {
+			if sharesData(f.typ) {
+				return true
+			}
+		}
+	case *TupleType:
+		for _, elem := range t.elems {
+			if sharesData(elem.typ) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readOnlyCopy reports an error if x is read from a read-only variable and
+// copied into a mutable location of type T, where it would share memory with
+// the variable (see sharesData). Otherwise a val slice could be changed by
+// assigning it to an ordinary variable first. Copies into interfaces are
+// allowed, since the value can't be changed without a type assertion.
+func (check *Checker) readOnlyCopy(x *operand, T Type, context string) {
+	if x.mode == invalid || T == nil || IsInterface(T) || !sharesData(x.typ) {
+		return
+	}
+	if v := check.readOnlySource(x.expr); v != nil {
+		check.errorf(x.pos(), "cannot use %s as mutable value in %s (%s is read-only)", x.expr, context, v.name)
+	}
+}
@@ -118,6 +118,12 @@ func (check *Checker) builtin(x *operand, call *ast.CallExpr, id builtinId) (_ b
 		// check general case by creating custom signature
 		sig := makeSig(S, S, NewSlice(T)) // []T required for variadic signature
 		sig.variadic = true
+		// The elements of s are never changed, so s may be read-only (but the
+		// result shares them, see readOnlySource). The values appended to s
+		// are copied into the result, so they must not be.
+		for _, v := range sig.params.vars {
+			v.readOnly = true
+		}
 		check.arguments(x, call, sig, func(x *operand, i int) {
 			// only evaluate arguments that have not been evaluated before
 			if i < len(alist) {
@@ -125,6 +131,15 @@ func (check *Checker) builtin(x *operand, call *ast.CallExpr, id builtinId) (_ b
 				return
 			}
 			arg(x, i)
+			if call.Ellipsis.IsValid() {
+				if x.mode != invalid && sharesData(T) {
+					if v := check.readOnlySource(x.expr); v != nil {
+						check.errorf(x.pos(), "cannot append elements of %s to %s (%s is read-only)", x.expr, call.Args[0], v.name)
+					}
+				}
+			} else {
+				check.readOnlyCopy(x, T, "argument to append")
+			}
 		}, nargs)
 		// ok to continue even if check.arguments reported errors
 
@@ -159,7 +174,12 @@ func (check *Checker) builtin(x *operand, call *ast.CallExpr, id builtinId) (_ b
 			// function calls; in this case s is not evaluated."
 			if !check.hasCallOrRecv {
 				mode = constant_
-				val = constant.MakeInt64(t.len)
+				if t.lenParam != nil {
+					// The length is not known until the type parameter is replaced.
+					val = constant.MakeUnknown()
+				} else {
+					val = constant.MakeInt64(t.len)
+				}
 			}
 
 		case *Slice, *Chan:
@@ -324,6 +344,17 @@ func (check *Checker) builtin(x *operand, call *ast.CallExpr, id builtinId) (_ b
 			return
 		}
 
+		if v := check.readOnlySource(x.expr); v != nil {
+			check.invalidArg(x.pos(), "cannot copy into %s (%s is read-only)", x.expr, v.name)
+			return
+		}
+		if sharesData(src) {
+			if v := check.readOnlySource(y.expr); v != nil {
+				check.invalidArg(y.pos(), "cannot copy elements of %s (%s is read-only)", y.expr, v.name)
+				return
+			}
+		}
+
 		if check.Types != nil {
 			check.recordBuiltinType(call.Fun, makeSig(Typ[Int], x.typ, y.typ))
 		}
@@ -337,6 +368,10 @@ func (check *Checker) builtin(x *operand, call *ast.CallExpr, id builtinId) (_ b
 			check.invalidArg(x.pos(), "%s is not a map", x)
 			return
 		}
+		if v := check.readOnlyVar(x.expr); v != nil {
+			check.invalidArg(x.pos(), "cannot delete from %s (%s is read-only)", x.expr, v.name)
+			return
+		}
 		arg(x, 1) // k
 		if x.mode == invalid {
 			return
@@ -77,7 +77,15 @@ func (check *Checker) call(x *operand, e *ast.CallExpr) exprKind {
 			return statement
 		}
 
-		arg, n, _ := unpack(func(x *operand, i int) { check.multiExpr(x, e.Args[i]) }, len(e.Args), false)
+		arg, n, _ := unpack(func(x *operand, i int) {
+			// A multi-valued call passed as the only argument is packed
+			// into a tuple only if there is exactly one parameter.
+			var T Type
+			if i < sig.params.Len() && (len(e.Args) > 1 || sig.params.Len() == 1) {
+				T = sig.params.vars[i].typ
+			}
+			check.assignedExpr(x, e.Args[i], T)
+		}, len(e.Args), false)
 		if arg != nil {
 			check.arguments(x, e, sig, arg, n)
 		} else {
@@ -103,6 +111,21 @@ func (check *Checker) call(x *operand, e *ast.CallExpr) exprKind {
 	}
 }
 
+// pipe type-checks the pipe expression e. x |> f is the same as the call f(x).
+func (check *Checker) pipe(x *operand, e *ast.BinaryExpr) exprKind {
+	// There are no parentheses, so errors about the call are reported at the
+	// position of the operator.
+	call := &ast.CallExpr{
+		Fun:    e.Y,
+		Lparen: e.OpPos,
+		Args:   []ast.Expr{e.X},
+		Rparen: e.OpPos,
+	}
+	kind := check.call(x, call)
+	x.expr = e
+	return kind
+}
+
 // use type-checks each argument.
 // Useful to make sure expressions are evaluated
 // (and variables are "used") in the presence of other errors.
@@ -283,11 +306,14 @@ func (check *Checker) argument(fun ast.Expr, sig *Signature, i int, x *operand,
 
 	// determine parameter type
 	var typ Type
+	var param *Var
 	switch {
 	case i < n:
-		typ = sig.params.vars[i].typ
+		param = sig.params.vars[i]
+		typ = param.typ
 	case sig.variadic:
-		typ = sig.params.vars[n-1].typ
+		param = sig.params.vars[n-1]
+		typ = param.typ
 		if debug {
 			if _, ok := typ.(*Slice); !ok {
 				check.dump("%s: expected unnamed slice type, got %s", sig.params.vars[n-1].Pos(), typ)
@@ -313,7 +339,11 @@ func (check *Checker) argument(fun ast.Expr, sig *Signature, i int, x *operand,
 		typ = typ.(*Slice).elem
 	}
 
-	check.assignment(x, typ, check.sprintf("argument to %s", fun))
+	context := check.sprintf("argument to %s", fun)
+	check.assignment(x, typ, context)
+	if !param.readOnly {
+		check.readOnlyCopy(x, typ, context)
+	}
 }
 
 func (check *Checker) selector(x *operand, e *ast.SelectorExpr) {
@@ -411,29 +441,42 @@ func (check *Checker) selector(x *operand, e *ast.SelectorExpr) {
 
 		check.recordSelection(e, MethodExpr, x.typ, m, index, indirect)
 
-		// the receiver type becomes the type of the first function
-		// argument of the method expression's function type
-		var params []*Var
 		// TODO(albrow): de-duplicate this code
 		var sig *Signature
+		var generic *PartialGenericSignature
 		switch t := m.typ.(type) {
 		case *Signature:
 			sig = t
 		case *GenericSignature:
 			sig = t.Signature
+			if len(t.typeParams) > 0 {
+				// A method with its own type parameters. Type arguments for the
+				// receiver type are inherited, but type arguments for the method
+				// must be provided (e.g. A[string].F[int]).
+				generic = &PartialGenericSignature{
+					Signature: t.Signature,
+					genType:   t,
+				}
+				recvTyp, _ := deref(x.typ)
+				if genRecv, ok := recvTyp.(ConcreteType); ok {
+					generic.typeMap = createMethodTypeMap(t.recv.typ, genRecv.TypeMap())
+				}
+			}
 		case *PartialGenericSignature:
 			sig = t.Signature
+			if len(t.TypeParams()) > 0 {
+				partial := *t
+				generic = &partial
+			}
 		case *ConcreteSignature:
 			sig = t.Signature
 		}
-		if sig.params != nil {
-			params = sig.params.vars
-		}
 		x.mode = value
-		x.typ = &Signature{
-			params:   NewTuple(append([]*Var{NewVar(token.NoPos, check.pkg, "", x.typ)}, params...)...),
-			results:  sig.results,
-			variadic: sig.variadic,
+		if generic != nil {
+			generic.methodExprRecv = x.typ
+			x.typ = generic
+		} else {
+			x.typ = check.methodExprSignature(x.typ, sig)
 		}
 
 		check.addDeclDep(m)
@@ -448,6 +491,14 @@ func (check *Checker) selector(x *operand, e *ast.SelectorExpr) {
 			} else {
 				x.mode = value
 			}
+			if recv, _ := deref(x.typ); len(index) == 1 {
+				if partial, ok := recv.(*PartialGenericNamed); ok {
+					// Replace the type parameters which are known (e.g. the field b.v
+					// for a Box[int] declared inside of a generic function).
+					x.typ = check.replaceTypes(obj.typ, partial.typeMap)
+					break
+				}
+			}
 			x.typ = obj.typ
 
 		case *Func:
@@ -455,6 +506,23 @@ func (check *Checker) selector(x *operand, e *ast.SelectorExpr) {
 			// addressability, should we report the type &(x.typ) instead?
 			check.recordSelection(e, MethodVal, x.typ, obj, index, indirect)
 
+			// A method with a pointer receiver could modify a read-only value,
+			// unless the receiver is read-only too. So could a method with a
+			// value receiver if the receiver shares memory with the value.
+			if recv := recvVar(obj); !IsInterface(x.typ) && !recv.readOnly {
+				if ptrRecv(obj) {
+					if v := check.readOnlyVar(e.X); v != nil {
+						check.errorf(e.Sel.Pos(), "cannot call pointer method %s on %s (%s is read-only)", sel, e.X, v.name)
+						goto Error
+					}
+				} else if sharesData(recv.typ) {
+					if v := check.readOnlySource(e.X); v != nil {
+						check.errorf(e.Sel.Pos(), "cannot call method %s on %s (%s is read-only)", sel, e.X, v.name)
+						goto Error
+					}
+				}
+			}
+
 			if debug {
 				// Verify that LookupFieldOrMethod and MethodSet.Lookup agree.
 				typ := x.typ
@@ -524,3 +592,18 @@ Error:
 	x.mode = invalid
 	x.expr = e
 }
+
+// methodExprSignature returns the function type for a method expression with
+// the given receiver type and method signature. The receiver type becomes the
+// type of the first function argument.
+func (check *Checker) methodExprSignature(recv Type, sig *Signature) *Signature {
+	var params []*Var
+	if sig.params != nil {
+		params = sig.params.vars
+	}
+	return &Signature{
+		params:   NewTuple(append([]*Var{NewVar(token.NoPos, check.pkg, "", recv)}, params...)...),
+		results:  sig.results,
+		variadic: sig.variadic,
+	}
+}
@@ -49,6 +49,12 @@ type funcInfo struct {
 	body   *ast.BlockStmt
 }
 
+// A specInfo describes a specialization of a generic function or method.
+type specInfo struct {
+	obj  *Func
+	decl *declInfo
+}
+
 // A context represents the context within which an object is type-checked.
 type context struct {
 	decl          *declInfo      // package-level declaration whose init expression/function body is checked
@@ -82,6 +88,7 @@ type Checker struct {
 	*Info
 	objMap map[Object]*declInfo   // maps package-level object to declaration info
 	impMap map[importKey]*Package // maps (import path, source directory) to (complete or fake) package
+	cache  typeCache              // maps generic types and type arguments to their instantiations
 
 	// information collected during type-checking of a set of package files
 	// (initialized by Files, valid only for the duration of check.Files;
@@ -93,8 +100,15 @@ type Checker struct {
 	methods  map[string][]*Func    // maps type names to associated methods
 	untyped  map[ast.Expr]exprInfo // map of expressions without final type
 	funcs    []funcInfo            // list of functions to type-check
+	specs    []specInfo            // list of specializations to type-check
 	delayed  []func()              // delayed checks requiring fully setup types
 
+	// instantiation chains, used to detect generics which expand infinitely
+	instChain  []instance                  // chain of instantiations currently being expanded
+	instPos    token.Pos                   // position of the dependent currently being instantiated
+	instChains map[ConcreteType][]instance // chain of instantiations which led to each usage
+	replacing  []replacement               // named types currently being copied by replaceTypesInNamed
+
 	// context within which the current object is type-checked
 	// (valid only for the duration of type-checking a specific object)
 	context
@@ -178,6 +192,7 @@ func NewChecker(conf *Config, fset *token.FileSet, pkg *Package, info *Info) *Ch
 		Info:   info,
 		objMap: make(map[Object]*declInfo),
 		impMap: make(map[importKey]*Package),
+		cache:  typeCache{},
 	}
 }
 
@@ -192,7 +207,10 @@ func (check *Checker) initFiles(files []*ast.File) {
 	check.methods = nil
 	check.untyped = nil
 	check.funcs = nil
+	check.specs = nil
 	check.delayed = nil
+	check.instChain = nil
+	check.instChains = nil
 
 	// determine package name and collect valid files
 	pkg := check.pkg
@@ -242,12 +260,10 @@ func (check *Checker) checkFiles(files []*ast.File) (err error) {
 
 	check.packageObjects(check.resolveOrder())
 
+	check.specializations()
+
 	check.functionBodies()
 
-	// HACK(albrow): The order in which we iterate through generic declarations
-	// sometimes means we can miss dependent usages. If we iterate through twice,
-	// it's obviously less effecient but should work.
-	check.genericDependents()
 	check.genericDependents()
 
 	check.initOrder()
@@ -96,9 +96,19 @@ var tests = [][]string{
 	{"testdata/genericfuncs.src"},
 	{"testdata/genericmethods.src"},
 	{"testdata/genericmethodsinherited.src"},
+	{"testdata/genericmethodvalues.src"},
 	{"testdata/genericstructs.src"},
 	{"testdata/genericsinherited.src"},
 	{"testdata/genericsrecursive.src"},
+	{"testdata/genericscycles.src"},
+	{"testdata/genericslocal.src"},
+	{"testdata/genericspecializations.src"},
+	{"testdata/genericdefaults.src"},
+	{"testdata/genericconsts.src"},
+	{"testdata/genericconstraints.src"},
+	{"testdata/tuples.src"},
+	{"testdata/pipes.src"},
+	{"testdata/vals.src"},
 	{"testdata/importgo.src"},
 }
 
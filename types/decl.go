@@ -170,7 +170,8 @@ func (check *Checker) varDecl(obj *Var, lhs []*Var, typ, init ast.Expr) {
 	if lhs == nil || len(lhs) == 1 {
 		assert(lhs == nil || lhs[0] == obj)
 		var x operand
-		check.expr(&x, init)
+		check.assignedExpr(&x, init, obj.typ)
+		check.singleValue(&x)
 		check.initVar(obj, &x, "variable declaration")
 		return
 	}
@@ -230,7 +231,7 @@ func (check *Checker) typeDecl(obj *TypeName, typ ast.Expr, def *Named, path []*
 
 	// Disambiguate cases where `ArrayType` should actually be
 	// `TypeParamDecl Type`.
-	if arrayType, ok := typ.(*ast.ArrayType); ok {
+	if arrayType, ok := typ.(*ast.ArrayType); ok && tpDecl == nil {
 		if length, ok := arrayType.Len.(*ast.Ident); ok {
 			if _, obj := check.scope.LookupParent(length.Name, length.NamePos); obj == nil {
 				// If the ident inside the brackets is not a declared type, assume we
@@ -260,14 +261,16 @@ func (check *Checker) typeDecl(obj *TypeName, typ ast.Expr, def *Named, path []*
 		var typeParams []*TypeParam
 		if tpDecl != nil {
 			origScope := check.scope
-			tpScope := NewScope(check.scope, check.scope.Pos(), check.scope.End(), "named type type parameters")
+			tpScope := NewScope(check.scope, tpDecl.Pos(), typ.End(), "named type type parameters")
 			for _, ident := range tpDecl.Names {
-				tp := NewTypeParam(ident.Name)
+				check.checkTypeParamShadowing(ident)
+				tp := check.newTypeParam(tpDecl, ident)
 				typeParams = append(typeParams, tp)
 				paramObj := NewTypeName(ident.Pos(), check.pkg, ident.Name, tp)
 				scopePos := ident.Pos()
 				check.declare(tpScope, ident, paramObj, scopePos)
 			}
+			check.typeParamDefaults(tpDecl, typeParams)
 			check.scope = tpScope
 			defer func() {
 				check.scope = origScope
@@ -275,8 +278,9 @@ func (check *Checker) typeDecl(obj *TypeName, typ ast.Expr, def *Named, path []*
 		}
 		if typeParams != nil {
 			genNamed := &GenericNamed{
-				Named:      named,
-				typeParams: typeParams,
+				Named:           named,
+				typeParams:      typeParams,
+				outerTypeParams: check.enclosingTypeParams(),
 			}
 			def.setUnderlying(genNamed)
 			obj.typ = genNamed
@@ -410,6 +414,8 @@ func (check *Checker) funcDecl(obj *Func, decl *declInfo) {
 		}
 		obj.typ = genSig
 		check.genericFuncType(genSig, fdecl.Recv, fdecl.Type, typeParams)
+		// The type parameters are only in scope within the declaration.
+		sig.scope.parent.pos, sig.scope.parent.end = fdecl.Pos(), fdecl.End()
 		if (obj.name == "init" && sig.recv == nil) || obj.name == "main" {
 			if len(genSig.typeParams) > 0 || len(genSig.recvTypeParams) > 0 {
 				check.errorf(fdecl.Pos(), "func %s must have no type parameters", obj.name)
@@ -454,6 +460,126 @@ func isRecvGeneric(recvPar *ast.FieldList) bool {
 	return false
 }
 
+// specializations type-checks the specializations of generic functions and
+// methods. They must be checked after all package objects so that the generic
+// declarations they specialize are complete.
+func (check *Checker) specializations() {
+	for _, spec := range check.specs {
+		func() {
+			defer func(ctxt context) {
+				check.context = ctxt
+			}(check.context)
+			check.context = context{
+				scope: spec.decl.file,
+			}
+			check.specialization(spec.obj, spec.decl)
+		}()
+	}
+}
+
+// specialization type-checks the declaration of a function or method which
+// replaces the instantiation of a generic function or method for particular
+// type arguments (e.g. func Sum[int](xs []int) int alongside func Sum[T]). Its
+// signature must be identical to the signature of the instantiation.
+func (check *Checker) specialization(obj *Func, decl *declInfo) {
+	fdecl := decl.fdecl
+	sig := new(Signature)
+	obj.typ = sig
+
+	// determine the generic declaration which is specialized and the names of
+	// its type parameters
+	var genType GenericType
+	var typeParams []*TypeParam
+	var orig *Signature
+	var method string
+	if fdecl.Recv == nil {
+		genObj, _ := check.pkg.scope.Lookup(obj.name).(*Func)
+		if genObj == nil {
+			check.errorf(obj.pos, "cannot specialize %s (not a generic function)", obj.name)
+			return
+		}
+		genSig, ok := genObj.typ.(*GenericSignature)
+		if !ok {
+			check.errorf(obj.pos, "cannot specialize %s (not a generic function)", obj.name)
+			return
+		}
+		genType, typeParams, orig = genSig, genSig.typeParams, genSig.Signature
+	} else {
+		base, _ := specializationArgs(fdecl)
+		tname, _ := check.pkg.scope.Lookup(base).(*TypeName)
+		if tname == nil {
+			check.errorf(obj.pos, "cannot specialize method %s of undeclared type %s", obj.name, base)
+			return
+		}
+		genNamed, ok := tname.typ.(*GenericNamed)
+		if !ok {
+			check.errorf(obj.pos, "cannot specialize method %s of non-generic type %s", obj.name, base)
+			return
+		}
+		var m *Func
+		for _, meth := range genNamed.methods {
+			if meth.name == obj.name {
+				m = meth
+				break
+			}
+		}
+		if m == nil {
+			check.errorf(obj.pos, "cannot specialize %s.%s (no such method)", base, obj.name)
+			return
+		}
+		switch t := m.typ.(type) {
+		case *Signature:
+			genType, typeParams, orig = genNamed, genNamed.typeParams, t
+			method = obj.name
+		case *GenericSignature:
+			if len(t.typeParams) > 0 || fdecl.TypeParams != nil {
+				check.errorf(obj.pos, "cannot specialize method %s.%s with type parameters", base, obj.name)
+				return
+			}
+			genType, typeParams, orig = t, t.recvTypeParams, t.Signature
+		default:
+			return
+		}
+	}
+
+	// determine the type arguments
+	_, args := specializationArgs(fdecl)
+	if len(args) != len(typeParams) {
+		check.errorf(obj.pos, "wrong number of type arguments for %s (expected %d but got %d)", obj.name, len(typeParams), len(args))
+		return
+	}
+	typeMap := make(map[string]Type, len(args))
+	for i, arg := range args {
+		typ := check.typ(arg)
+		if typ == Typ[Invalid] {
+			return
+		}
+		typeMap[typeParams[i].String()] = typ
+	}
+
+	check.funcType(sig, fdecl.Recv, fdecl.Type)
+	want := check.replaceTypesInSignature(orig, typeMap)
+	if !identical(sig.params, want.params, false, nil) || !identical(sig.results, want.results, false, nil) || sig.variadic != want.variadic {
+		check.errorf(obj.pos, "cannot specialize %s: %s does not match %s", obj.name, sig, want)
+		return
+	}
+
+	genDecl := obj.pkg.generics[declKey(genType)]
+	if alt := genDecl.addSpecialization(method, typeMap, obj); alt != nil {
+		check.errorf(obj.pos, "%s already specialized for the same type arguments", obj.name)
+		check.reportAltDecl(alt)
+		return
+	}
+
+	if fdecl.Body == nil {
+		check.softErrorf(obj.pos, "missing function body")
+		return
+	}
+	if !check.conf.IgnoreFuncBodies {
+		check.later(obj.name, decl, sig, nil, fdecl.Body)
+	}
+}
+
 func (check *Checker) declStmt(decl ast.Decl) {
 	pkg := check.pkg
 
@@ -501,10 +627,11 @@ func (check *Checker) declStmt(decl ast.Decl) {
 						check.declare(check.scope, name, lhs[i], scopePos)
 					}
 
-				case token.VAR:
+				case token.VAR, token.VAL:
 					lhs0 := make([]*Var, len(s.Names))
 					for i, name := range s.Names {
 						lhs0[i] = NewVar(name.Pos(), pkg, name.Name, nil)
+						lhs0[i].readOnly = d.Tok == token.VAL
 					}
 
 					// initialize all variables
@@ -568,7 +695,43 @@ func (check *Checker) declStmt(decl ast.Decl) {
 			}
 		}
 
+	case *ast.FuncDecl:
+		check.localFuncDecl(d)
+
 	default:
 		check.invalidAST(d.Pos(), "unknown ast.Decl node %T", d)
 	}
 }
+
+// localFuncDecl type-checks a generic function declared inside of a function
+// body (a Fo extension). Unlike package-level functions, the body is checked
+// right away so that it can refer to the variables which are in scope at the
+// declaration, just like a function literal.
+func (check *Checker) localFuncDecl(fdecl *ast.FuncDecl) {
+	if fdecl.TypeParams == nil {
+		check.errorf(fdecl.Pos(), "local function %s must have type parameters (use a function literal instead)", fdecl.Name.Name)
+	}
+	if fdecl.Body == nil {
+		check.errorf(fdecl.Pos(), "missing function body")
+	}
+
+	obj := NewFunc(fdecl.Name.Pos(), check.pkg, fdecl.Name.Name, nil)
+	// The scope of a local function begins at the identifier so that it can be
+	// called recursively.
+	check.declare(check.scope, fdecl.Name, obj, fdecl.Name.Pos())
+
+	sig := new(Signature)
+	genSig := &GenericSignature{
+		Signature:       sig,
+		obj:             obj,
+		outerTypeParams: check.enclosingTypeParams(),
+	}
+	obj.typ = genSig
+	check.genericFuncType(genSig, nil, fdecl.Type, fdecl.TypeParams)
+	sig.scope.parent.pos, sig.scope.parent.end = fdecl.Pos(), fdecl.End()
+	addGenericDecl(obj, genSig)
+
+	if fdecl.Body != nil {
+		check.funcBody(check.decl, obj.name, sig, genSig, fdecl.Body)
+	}
+}
@@ -169,6 +169,12 @@ func TestEvalPos(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	testEvalComments(t, fset, pkg, files)
+}
+
+// testEvalComments evaluates the expressions in the /*-style comments of files
+// (see TestEvalPos).
+func testEvalComments(t *testing.T, fset *token.FileSet, pkg *Package, files []*ast.File) {
 	for _, file := range files {
 		for _, group := range file.Comments {
 			for _, comment := range group.List {
@@ -183,6 +189,50 @@ func TestEvalPos(t *testing.T) {
 	}
 }
 
+// Type parameters must only be in scope within their declarations.
+func TestEvalPosGeneric(t *testing.T) {
+	src := `
+	package p
+	type Box[T] struct {
+		V T
+	}
+	func (b Box[T]) Get() T {
+		/* b.V => , T */
+		return b.V
+	}
+	func Map[T, U](x T, f func(T) U) U {
+		/* x => , T */
+		return f(x)
+	}
+	func main() {
+		b := Box[int]{V: 3}
+		func Local[T](x T) T { return x }
+		/* b => , p.Box[int] */
+		/* b.Get() => , int */
+		/* Local[string] => , func(x string) string */
+		_ = b
+	}
+	/* Box[string]{} => , p.Box[string] */
+	`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("could not parse file: %s", err)
+	}
+	pkg, err := (&Config{}).Check("p", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEvalComments(t, fset, pkg, []*ast.File{file})
+}
+
+func TestEvalError(t *testing.T) {
+	_, err := Eval(token.NewFileSet(), nil, token.NoPos, "undeclared + 1")
+	if err == nil || !strings.Contains(err.Error(), "undeclared name: undeclared") {
+		t.Errorf("Eval returned error %v, want undeclared name error", err)
+	}
+}
+
 // split splits string s at the first occurrence of s.
 func split(s, sep string) (string, string) {
 	i := strings.Index(s, sep)
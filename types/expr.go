@@ -91,6 +91,11 @@ func (check *Checker) unary(x *operand, e *ast.UnaryExpr, op token.Token) {
 			x.mode = invalid
 			return
 		}
+		if v := check.readOnlyVar(x.expr); v != nil {
+			check.invalidOp(x.pos(), "cannot take address of %s (%s is read-only)", x.expr, v.name)
+			x.mode = invalid
+			return
+		}
 		x.mode = value
 		x.typ = &Pointer{base: x.typ}
 		return
@@ -417,6 +422,9 @@ func (check *Checker) updateExprType(x ast.Expr, typ Type, final bool) {
 		if old.val != nil {
 			break // see comment for unary expressions
 		}
+		if x.Op == token.PIPE {
+			break // see comment for calls
+		}
 		if isComparison(x.Op) {
 			// The result type is independent of operand types
 			// and the operand types must have final types.
@@ -500,6 +508,25 @@ func (check *Checker) convertUntyped(x *operand, target Type) {
 	}
 
 	// typed target
+	if tp, _ := target.(*TypeParam); tp != nil && len(tp.TypeList()) > 0 {
+		// An untyped value can be converted to a constrained type parameter
+		// if it can be converted to every type in the type list. The result is
+		// not a constant since its type is not known until monomorphization,
+		// so untyped constants keep their default type (as in conversions).
+		for _, t := range tp.TypeList() {
+			if !check.untypedConvertible(x, t) {
+				goto Error
+			}
+		}
+		final := target
+		if x.mode == constant_ {
+			final = Default(x.typ)
+			x.mode = value
+		}
+		x.typ = target
+		check.updateExprType(x.expr, final, true)
+		return
+	}
 	switch t := target.Underlying().(type) {
 	case *Basic:
 		if x.mode == constant_ {
@@ -574,6 +601,27 @@ Error:
 	x.mode = invalid
 }
 
+// untypedConvertible reports whether the untyped operand x can be converted to
+// typ without reporting any errors.
+func (check *Checker) untypedConvertible(x *operand, typ Type) bool {
+	t, ok := typ.Underlying().(*Basic)
+	if !ok {
+		return x.isNil() && hasNil(typ)
+	}
+	if x.mode == constant_ {
+		return representableConst(x.val, check.conf, t, nil)
+	}
+	switch x.typ.(*Basic).kind {
+	case UntypedBool:
+		return isBoolean(t)
+	case UntypedInt, UntypedRune, UntypedFloat, UntypedComplex:
+		return isNumeric(t)
+	case UntypedNil:
+		return hasNil(t)
+	}
+	return false
+}
+
 func (check *Checker) comparison(x, y *operand, op token.Token) {
 	// spec: "In any comparison, the first operand must be assignable
 	// to the type of the second operand, or vice versa."
@@ -746,7 +794,7 @@ func (check *Checker) shift(x, y *operand, e *ast.BinaryExpr, op token.Token) {
 }
 
 var binaryOpPredicates = opPredicates{
-	token.ADD: func(typ Type) bool { return isNumeric(typ) || isString(typ) },
+	token.ADD: isNumericOrString,
 	token.SUB: isNumeric,
 	token.MUL: isNumeric,
 	token.QUO: isNumeric,
@@ -940,6 +988,7 @@ func (check *Checker) indexedElts(elts []ast.Expr, typ Type, length int64) int64
 		var x operand
 		check.exprWithHint(&x, eval, typ)
 		check.assignment(&x, typ, "array or slice literal")
+		check.readOnlyCopy(&x, typ, "array or slice literal")
 	}
 	return max
 }
@@ -1041,7 +1090,9 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 			//           the exact position where the closure appears
 			//           in the source; e.g., variables declared below
 			//           must not be visible).
-			check.funcBody(check.decl, "", sig, nil, e.Body)
+			// Generic usages inside of the function literal depend on the
+			// enclosing generic function (if any).
+			check.funcBody(check.decl, "", sig, check.genSig, e.Body)
 			x.mode = value
 			x.typ = sig
 		} else {
@@ -1120,6 +1171,7 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 					check.expr(x, kv.Value)
 					etyp := fld.typ
 					check.assignment(x, etyp, "struct literal")
+					check.readOnlyCopy(x, etyp, "struct literal")
 				}
 			} else {
 				// no element must have a key
@@ -1141,6 +1193,7 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 					}
 					etyp := fld.typ
 					check.assignment(x, etyp, "struct literal")
+					check.readOnlyCopy(x, etyp, "struct literal")
 				}
 				if len(e.Elts) < len(fields) {
 					check.error(e.Rbrace, "too few values in struct literal")
@@ -1163,7 +1216,7 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 			// If we have an "open" [...]T array, set the length now that we know it
 			// and record the type for [...] (usually done by check.typExpr which is
 			// not called for [...]).
-			if utyp.len < 0 {
+			if utyp.len < 0 && utyp.lenParam == nil {
 				utyp.len = n
 				check.recordTypeAndValue(e.Type, typexpr, utyp, nil)
 			}
@@ -1193,6 +1246,7 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 				}
 				check.exprWithHint(x, kv.Key, utyp.key)
 				check.assignment(x, utyp.key, "map literal")
+				check.readOnlyCopy(x, utyp.key, "map literal")
 				if x.mode == invalid {
 					continue
 				}
@@ -1219,6 +1273,7 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 				}
 				check.exprWithHint(x, kv.Value, utyp.elem)
 				check.assignment(x, utyp.elem, "map literal")
+				check.readOnlyCopy(x, utyp.elem, "map literal")
 			}
 
 		default:
@@ -1248,6 +1303,12 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 		x.expr = e
 		return kind
 
+	case *ast.TupleExpr:
+		check.tupleLit(x, e, hint)
+		if x.mode == invalid {
+			goto Error
+		}
+
 	case *ast.SelectorExpr:
 		check.selector(x, e)
 
@@ -1267,12 +1328,12 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 		// *ast.TypeArgExpr with only one type parameter. We resolve the ambiguity
 		// by observing the type of e.X.
 		if genType, ok := x.typ.(GenericType); ok {
-			if conType, ok := genType.(ConcreteType); ok && len(conType.TypeMap()) == len(conType.GenericType().TypeParams()) {
+			if conType, ok := genType.(ConcreteType); ok && hasOwnTypeArgs(conType) {
 				// We have a partial generic type where each type arg is accounted for
 				// by an inherited type parameter. (e.g. method receiver A[T] being
 				// used in the body of the method). Do nothing.
 			} else {
-				if len(genType.TypeParams()) > 1 {
+				if requiredTypeArgs(genType.TypeParams()) > 1 {
 					check.errorf(check.pos, "wrong number of type arguments for %s (expected %d but got 1)", e.X, len(genType.TypeParams()))
 				}
 				typeArgExpr := &ast.TypeArgExpr{
@@ -1282,6 +1343,9 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 					Rbrack: e.Rbrack,
 				}
 				x.typ = check.concreteType(typeArgExpr, genType)
+				if x.typ == Typ[Invalid] {
+					x.mode = invalid
+				}
 				return expression
 			}
 		}
@@ -1461,6 +1525,9 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 			check.errorf(e.Pos(), "type arguments provided for non-generic type %s", x.typ)
 		} else {
 			x.typ = check.concreteType(e, genType)
+			if x.typ == Typ[Invalid] {
+				x.mode = invalid
+			}
 			return expression
 		}
 
@@ -1524,6 +1591,9 @@ func (check *Checker) exprInternal(x *operand, e ast.Expr, hint Type) exprKind {
 		}
 
 	case *ast.BinaryExpr:
+		if e.Op == token.PIPE {
+			return check.pipe(x, e)
+		}
 		check.binary(x, e, e.X, e.Y, e.Op)
 		if x.mode == invalid {
 			goto Error
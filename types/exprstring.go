@@ -126,6 +126,16 @@ func WriteExpr(buf *bytes.Buffer, x ast.Expr) {
 		buf.WriteByte('*')
 		WriteExpr(buf, x.X)
 
+	case *ast.TupleExpr:
+		buf.WriteByte('(')
+		for i, elt := range x.Elts {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			WriteExpr(buf, elt)
+		}
+		buf.WriteByte(')')
+
 	case *ast.UnaryExpr:
 		buf.WriteString(x.Op.String())
 		WriteExpr(buf, x.X)
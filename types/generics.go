@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/constant"
 	"github.com/albrow/fo/token"
 )
 
@@ -42,18 +44,70 @@ func (tc typeCache) get(genType GenericType, typeMap map[string]Type) ConcreteTy
 	return entry[uk]
 }
 
-var cache = typeCache{}
-
 type typeArg struct {
 	name string
 	typ  Type
 }
 
 type GenericDecl struct {
-	Name       string
-	Type       GenericType
-	Usages     []ConcreteType
-	seenUsages map[string]struct{}
+	Name            string
+	Type            GenericType
+	Usages          []ConcreteType
+	seenUsages      map[string]struct{}
+	sites           map[string][]token.Pos
+	specializations map[string]*Func
+	specs           []*Func
+}
+
+// Local returns true if decl is declared inside of a function body. Local
+// declarations are scoped to the innermost containing block, so more than one
+// of them can have the same name.
+func (decl *GenericDecl) Local() bool {
+	return isLocal(decl.Type.Object())
+}
+
+func isLocal(obj Object) bool {
+	return obj.Parent() != nil && obj.Pkg() != nil && obj.Parent() != obj.Pkg().Scope()
+}
+
+// Sites returns the positions of the type argument expressions which caused
+// the given usage, in the order they were encountered. For usages which were
+// caused by another usage (e.g. a generic function calling another generic
+// function with its own type parameters), the positions are inside of the
+// body of the generic declaration.
+func (decl *GenericDecl) Sites(usage ConcreteType) []token.Pos {
+	return decl.sites[usageKey(usage.TypeMap())]
+}
+
+// Specialization returns the function or method which replaces the
+// instantiation of decl for the given usage, or nil if there is none. For
+// generic types, method is the name of a method declared without type
+// arguments in its receiver (e.g. func (s Set) Has). Otherwise it should be
+// empty.
+func (decl *GenericDecl) Specialization(usage ConcreteType, method string) *Func {
+	return decl.specializations[method+":"+usageKey(usage.TypeMap())]
+}
+
+// Specializations returns all the specializations of decl in the order they
+// were declared.
+func (decl *GenericDecl) Specializations() []*Func {
+	return decl.specs
+}
+
+// addSpecialization records fn as the specialization of decl (or the method of
+// decl with the given name) for typeMap. If there already is a specialization
+// for typeMap, it is returned instead.
+func (decl *GenericDecl) addSpecialization(method string, typeMap map[string]Type, fn *Func) *Func {
+	if decl.specializations == nil {
+		decl.specializations = map[string]*Func{}
+	}
+	key := method + ":" + usageKey(typeMap)
+	if alt, found := decl.specializations[key]; found {
+		return alt
+	}
+	decl.specializations[key] = fn
+	decl.specs = append(decl.specs, fn)
+	return nil
 }
 
 func addGenericDecl(obj Object, typ GenericType) {
@@ -68,7 +122,7 @@ func addGenericDecl(obj Object, typ GenericType) {
 	}
 }
 
-func addGenericUsage(genObj Object, typ ConcreteType) {
+func (check *Checker) addGenericUsage(genObj Object, typ ConcreteType) {
 	pkg := genObj.Pkg()
 	if pkg.generics == nil {
 		pkg.generics = map[string]*GenericDecl{}
@@ -86,9 +140,33 @@ func addGenericUsage(genObj Object, typ ConcreteType) {
 	if _, seen := genDecl.seenUsages[uk]; !seen {
 		genDecl.Usages = append(genDecl.Usages, typ)
 		genDecl.seenUsages[uk] = struct{}{}
+		check.recordInstance(typ)
 	}
 }
 
+// addGenericSite records pos as one of the positions which caused the usage
+// typ.
+func addGenericSite(typ ConcreteType, pos token.Pos) {
+	if !pos.IsValid() {
+		return
+	}
+	genObj := typ.GenericType().Object()
+	genDecl, found := genObj.Pkg().generics[declKey(typ.GenericType())]
+	if !found {
+		return
+	}
+	if genDecl.sites == nil {
+		genDecl.sites = map[string][]token.Pos{}
+	}
+	uk := usageKey(typ.TypeMap())
+	for _, site := range genDecl.sites[uk] {
+		if site == pos {
+			return
+		}
+	}
+	genDecl.sites[uk] = append(genDecl.sites[uk], pos)
+}
+
 func declKey(typ GenericType) string {
 	key := ""
 	if sig, ok := typ.(*GenericSignature); ok {
@@ -109,6 +187,11 @@ func declKey(typ GenericType) string {
 		}
 	}
 	key += typ.Object().Name()
+	if obj := typ.Object(); isLocal(obj) {
+		// Include the position to distinguish between local declarations with the
+		// same name.
+		key += "@" + strconv.Itoa(int(obj.Pos()))
+	}
 	return key
 }
 
@@ -133,18 +216,125 @@ func usageKey(typeMap map[string]Type) string {
 	return strings.Join(stringParams, ";")
 }
 
+// checkIsPartial returns true if any of the types in typeMap is or contains a
+// type parameter (e.g. T, []T, or map[string]T).
 func checkIsPartial(typeMap map[string]Type) bool {
 	for _, typ := range typeMap {
-		if _, ok := typ.(*TypeParam); ok {
+		if containsTypeParam(typ) {
 			return true
 		}
 	}
 	return false
 }
 
+// containsTypeParam returns true if typ is a type parameter or a compound type
+// which contains one.
+func containsTypeParam(typ Type) bool {
+	switch t := typ.(type) {
+	case *TypeParam:
+		return true
+	case *Pointer:
+		return containsTypeParam(t.base)
+	case *Slice:
+		return containsTypeParam(t.elem)
+	case *Array:
+		return t.lenParam != nil || containsTypeParam(t.elem)
+	case *Chan:
+		return containsTypeParam(t.elem)
+	case *Map:
+		return containsTypeParam(t.key) || containsTypeParam(t.elem)
+	case *Struct:
+		for _, field := range t.fields {
+			if containsTypeParam(field.typ) {
+				return true
+			}
+		}
+	case *Tuple:
+		if t != nil {
+			for _, v := range t.vars {
+				if containsTypeParam(v.typ) {
+					return true
+				}
+			}
+		}
+	case *TupleType:
+		for _, v := range t.elems {
+			if containsTypeParam(v.typ) {
+				return true
+			}
+		}
+	case *Signature:
+		return containsTypeParam(t.params) || containsTypeParam(t.results)
+	case PartialGenericType:
+		return true
+	}
+	return false
+}
+
+// hasAllTypeArgs returns true if typ has a type argument for each of the type
+// parameters of its generic type.
+func hasAllTypeArgs(typ ConcreteType) bool {
+	for _, tp := range typ.GenericType().TypeParams() {
+		if _, found := typ.TypeMap()[tp.String()]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hasOwnTypeArgs is like hasAllTypeArgs, except that type parameters of a
+// method which are mapped to themselves are not counted. The methods of a
+// concrete named type have such a mapping (e.g. U for the method value a.F,
+// given func (a A[T]) F[U]) until type arguments are applied.
+func hasOwnTypeArgs(typ ConcreteType) bool {
+	if !hasAllTypeArgs(typ) {
+		return false
+	}
+	if _, ok := typ.GenericType().(*GenericSignature); !ok {
+		return true
+	}
+	for _, tp := range typ.GenericType().TypeParams() {
+		if param, ok := typ.TypeMap()[tp.String()].(*TypeParam); ok && param == tp {
+			return false
+		}
+	}
+	return true
+}
+
 // concreteType returns a new type with the concrete type arguments of e
 // applied.
 func (check *Checker) concreteType(expr *ast.TypeArgExpr, genType GenericType) Type {
+	typ := check.instantiate(expr, genType)
+	if conType, ok := typ.(ConcreteType); ok {
+		addGenericSite(conType, expr.X.Pos())
+	}
+	if partial, ok := genType.(*PartialGenericSignature); ok && partial.methodExprRecv != nil {
+		return check.methodExprType(partial.methodExprRecv, typ)
+	}
+	return typ
+}
+
+// methodExprType converts the type of a generic method with type arguments
+// applied to the type of a method expression (e.g. A[string].F[int]).
+func (check *Checker) methodExprType(recv Type, typ Type) Type {
+	switch t := typ.(type) {
+	case *ConcreteSignature:
+		return &ConcreteSignature{
+			Signature: check.methodExprSignature(recv, t.Signature),
+			genType:   t.genType,
+			typeMap:   t.typeMap,
+		}
+	case *PartialGenericSignature:
+		return &PartialGenericSignature{
+			Signature: check.methodExprSignature(recv, t.Signature),
+			genType:   t.genType,
+			typeMap:   t.typeMap,
+		}
+	}
+	return typ
+}
+
+func (check *Checker) instantiate(expr *ast.TypeArgExpr, genType GenericType) Type {
 	// buf := &bytes.Buffer{}
 	// if err := printer.Fprint(buf, token.NewFileSet(), expr); err != nil {
 	// 	panic(err)
@@ -154,7 +344,12 @@ func (check *Checker) concreteType(expr *ast.TypeArgExpr, genType GenericType) T
 	if typeMap == nil {
 		return Typ[Invalid]
 	}
-	if cachedType := cache.get(genType, typeMap); cachedType != nil {
+	for _, tp := range outerTypeParams(genType) {
+		// Local declarations inherit the type parameters of the enclosing generic
+		// function, which are not known until it is used.
+		typeMap[tp.String()] = tp
+	}
+	if cachedType := check.cache.get(genType, typeMap); cachedType != nil {
 		return cachedType
 	}
 	isPartial := checkIsPartial(typeMap)
@@ -166,11 +361,12 @@ func (check *Checker) concreteType(expr *ast.TypeArgExpr, genType GenericType) T
 				genType: genType,
 				typeMap: typeMap,
 			}
-			if check.genSig != nil {
-				check.genSig.dependents = append(check.genSig.dependents, partial)
-			}
+			check.addDependent(partial, expr.X.Pos())
 			return partial
 		}
+		if !check.pushInstance(genType, typeMap, expr.X.Pos()) {
+			return Typ[Invalid]
+		}
 		newNamed := check.replaceTypesInNamed(genType.Named, typeMap)
 		newType := &ConcreteNamed{
 			Named:   newNamed,
@@ -178,12 +374,13 @@ func (check *Checker) concreteType(expr *ast.TypeArgExpr, genType GenericType) T
 			typeMap: typeMap,
 		}
 		newType.methods = check.replaceTypesInMethods(genType.methods, typeMap)
-		cache.add(newType)
-		addGenericUsage(genType.Object(), newType)
+		check.popInstance()
+		check.cache.add(newType)
+		check.addGenericUsage(genType.Object(), newType)
 		return newType
 
 	case *PartialGenericNamed:
-		if cachedType := cache.get(genType.genType, typeMap); cachedType != nil {
+		if cachedType := check.cache.get(genType.genType, typeMap); cachedType != nil {
 			return cachedType
 		}
 		if isPartial {
@@ -192,33 +389,34 @@ func (check *Checker) concreteType(expr *ast.TypeArgExpr, genType GenericType) T
 				genType: genType.genType,
 				typeMap: typeMap,
 			}
-			if check.genSig != nil {
-				check.genSig.dependents = append(check.genSig.dependents, partial)
-			}
+			check.addDependent(partial, expr.X.Pos())
 			return partial
 		}
 		newTypeMap := mergeTypeMap(genType.typeMap, typeMap)
-		newNamed := check.replaceTypesInNamed(genType.Named, newTypeMap)
+		if !check.pushInstance(genType.genType, newTypeMap, expr.X.Pos()) {
+			return Typ[Invalid]
+		}
+		newNamed := check.replaceTypesInNamed(genType.genType.Named, newTypeMap)
 		newType := &ConcreteNamed{
 			Named:   newNamed,
 			genType: genType.genType,
 			typeMap: newTypeMap,
 		}
 		newType.methods = check.replaceTypesInMethods(genType.methods, typeMap)
-		cache.add(newType)
-		addGenericUsage(genType.Object(), newType)
+		check.popInstance()
+		check.cache.add(newType)
+		check.addGenericUsage(genType.Object(), newType)
 		return newType
 
 	case *GenericSignature:
+		defer check.setInstPos(expr.X.Pos())()
 		if isPartial {
 			partial := &PartialGenericSignature{
-				Signature: genType.Signature,
+				Signature: check.replaceTypesInSignature(genType.Signature, typeMap),
 				genType:   genType,
 				typeMap:   typeMap,
 			}
-			if check.genSig != nil {
-				check.genSig.dependents = append(check.genSig.dependents, partial)
-			}
+			check.addDependent(partial, expr.X.Pos())
 			return partial
 		}
 		newSig := check.replaceTypesInSignature(genType.Signature, typeMap)
@@ -227,34 +425,37 @@ func (check *Checker) concreteType(expr *ast.TypeArgExpr, genType GenericType) T
 			genType:   genType,
 			typeMap:   typeMap,
 		}
-		cache.add(newType)
-		addGenericUsage(genType.Object(), newType)
+		check.cache.add(newType)
+		check.addGenericUsage(genType.Object(), newType)
 		return newType
 
 	case *PartialGenericSignature:
-		if cachedType := cache.get(genType.genType, typeMap); cachedType != nil {
+		defer check.setInstPos(expr.X.Pos())()
+		// The inherited type arguments (e.g. for the receiver type of a method)
+		// might also be partial.
+		newTypeMap := mergeTypeMap(genType.typeMap, typeMap)
+		if cachedType := check.cache.get(genType.genType, newTypeMap); cachedType != nil {
 			return cachedType
 		}
-		if isPartial {
+		if checkIsPartial(newTypeMap) {
+			// Replace the type parameters which are known so that the signature
+			// can be used inside of the generic declaration.
 			partial := &PartialGenericSignature{
-				Signature: genType.Signature,
+				Signature: check.replaceTypesInSignature(genType.Signature, typeMap),
 				genType:   genType.genType,
-				typeMap:   typeMap,
-			}
-			if check.genSig != nil {
-				check.genSig.dependents = append(check.genSig.dependents, partial)
+				typeMap:   newTypeMap,
 			}
+			check.addDependent(partial, expr.X.Pos())
 			return partial
 		}
-		newTypeMap := mergeTypeMap(genType.typeMap, typeMap)
-		newSig := check.replaceTypesInSignature(genType.Signature, newTypeMap)
+		newSig := check.replaceTypesInSignature(genType.genType.Signature, newTypeMap)
 		newType := &ConcreteSignature{
 			Signature: newSig,
 			genType:   genType.genType,
 			typeMap:   newTypeMap,
 		}
-		cache.add(newType)
-		addGenericUsage(genType.Object(), newType)
+		check.cache.add(newType)
+		check.addGenericUsage(genType.Object(), newType)
 		return newType
 	}
 
@@ -273,9 +474,14 @@ func mergeTypeMap(a, b map[string]Type) map[string]Type {
 	return result
 }
 
-func remapTypes(partial, incoming map[string]Type) map[string]Type {
+func (check *Checker) remapTypes(partial, incoming map[string]Type) map[string]Type {
 	result := map[string]Type{}
 	for key, typ := range partial {
+		if _, ok := typ.(*TypeParam); !ok && containsTypeParam(typ) {
+			// A compound type such as []T. Replace any type parameters inside of it.
+			result[key] = check.replaceTypes(typ, incoming)
+			continue
+		}
 		for inc, newTyp := range incoming {
 			if typ.String() == inc {
 				result[key] = newTyp
@@ -289,24 +495,226 @@ func remapTypes(partial, incoming map[string]Type) map[string]Type {
 	return result
 }
 
-// TODO(albrow): test case with wrong number of type arguments.
+// createTypeMap returns the type arguments in typeArgExpr keyed by the names
+// of typeParams. Omitted trailing type arguments are filled in with the
+// defaults of the corresponding type parameters.
 func (check *Checker) createTypeMap(typeArgExpr *ast.TypeArgExpr, typeParams []*TypeParam) map[string]Type {
 	typeArgs := typeArgExpr.Types
-	if len(typeArgs) != len(typeParams) {
-		check.errorf(typeArgExpr.Pos(), "wrong number of type arguments (expected %d but got %d)", len(typeParams), len(typeArgs))
+	if min := requiredTypeArgs(typeParams); len(typeArgs) < min || len(typeArgs) > len(typeParams) {
+		if min == len(typeParams) {
+			check.errorf(typeArgExpr.Pos(), "wrong number of type arguments (expected %d but got %d)", len(typeParams), len(typeArgs))
+		} else {
+			check.errorf(typeArgExpr.Pos(), "wrong number of type arguments (expected %d to %d but got %d)", min, len(typeParams), len(typeArgs))
+		}
 		return nil
 	}
 	typeMap := map[string]Type{}
 	for i, typ := range typeArgs {
+		if typeParams[i].constType != nil {
+			arg := check.constTypeArg(typ, typeParams[i])
+			if arg == nil {
+				// The error was reported by constTypeArg.
+				return nil
+			}
+			typeMap[typeParams[i].String()] = arg
+			continue
+		}
 		var x operand
 		check.rawExpr(&x, typ, nil)
 		if x.typ != nil {
+			if isConstraint(x.typ) {
+				check.errorf(typ.Pos(), "%s has a type list and can only be used as a constraint", x.typ)
+				return nil
+			}
+			if !check.satisfies(typ.Pos(), x.typ, typeParams[i]) {
+				return nil
+			}
 			typeMap[typeParams[i].String()] = x.typ
 		}
 	}
+	for _, tp := range typeParams[len(typeArgs):] {
+		typeMap[tp.String()] = tp.dflt
+	}
 	return typeMap
 }
 
+// satisfies reports whether typ satisfies the constraint of the type parameter
+// tp, and reports an error at pos if it does not. If the constraint has a type
+// list, the underlying type of typ must be one of the types in it, or typ must
+// be a type parameter whose own type list only contains such types.
+func (check *Checker) satisfies(pos token.Pos, typ Type, tp *TypeParam) bool {
+	if tp.bound == nil || typ == Typ[Invalid] {
+		return true
+	}
+	iface, _ := tp.bound.Underlying().(*Interface)
+	if iface == nil {
+		return true
+	}
+	if len(iface.types) > 0 {
+		var ok bool
+		if arg, isTypeParam := typ.(*TypeParam); isTypeParam {
+			ok = len(arg.TypeList()) > 0
+			for _, t := range arg.TypeList() {
+				if !inTypeList(t.Underlying(), iface.types) {
+					ok = false
+				}
+			}
+		} else {
+			ok = inTypeList(typ.Underlying(), iface.types)
+		}
+		if !ok {
+			check.errorf(pos, "%s does not satisfy %s", typ, tp.bound)
+			return false
+		}
+	}
+	if m, _ := MissingMethod(typ, iface, true); m != nil {
+		check.errorf(pos, "%s does not satisfy %s (missing method %s)", typ, tp.bound, m.name)
+		return false
+	}
+	return true
+}
+
+// requiredTypeArgs returns the number of type arguments which must be
+// provided for typeParams, i.e. the number of type parameters without a
+// default.
+func requiredTypeArgs(typeParams []*TypeParam) int {
+	n := len(typeParams)
+	for n > 0 && typeParams[n-1].dflt != nil {
+		n--
+	}
+	return n
+}
+
+// typeParamDefaults type-checks the default type arguments in tpDecl and sets
+// them on the corresponding type parameters. The defaults are evaluated in the
+// current scope, so they cannot refer to the type parameters in tpDecl.
+func (check *Checker) typeParamDefaults(tpDecl *ast.TypeParamDecl, typeParams []*TypeParam) {
+	if tpDecl == nil || len(tpDecl.Defaults) == 0 {
+		return
+	}
+	first := len(typeParams) - len(tpDecl.Defaults)
+	for i, e := range tpDecl.Defaults {
+		if tp := typeParams[first+i]; tp.constType != nil {
+			if arg, ok := check.constTypeArg(e, tp).(*ConstArg); ok {
+				tp.dflt = arg
+			}
+			continue
+		}
+		typ := check.typ(e)
+		check.typeArgsRequired(e.Pos(), typ)
+		if typ == Typ[Invalid] || !check.satisfies(e.Pos(), typ, typeParams[first+i]) {
+			continue
+		}
+		typeParams[first+i].dflt = typ
+	}
+}
+
+// newTypeParam returns a new type parameter for name, which is declared in
+// tpDecl. The type of a constant type parameter is evaluated in the current
+// scope and must be an integer type. Likewise the constraint of a constrained
+// type parameter is evaluated in the current scope and must be an interface.
+func (check *Checker) newTypeParam(tpDecl *ast.TypeParamDecl, name *ast.Ident) *TypeParam {
+	if e := tpDecl.Constraint(name); e != nil {
+		bound := check.typExpr(e, nil, nil)
+		check.typeArgsRequired(e.Pos(), bound)
+		if bound == Typ[Invalid] {
+			return NewTypeParam(name.Name)
+		}
+		if !IsInterface(bound) {
+			check.errorf(e.Pos(), "%s is not an interface", bound)
+			return NewTypeParam(name.Name)
+		}
+		return NewConstrainedTypeParam(name.Name, bound)
+	}
+	e := tpDecl.ConstType(name)
+	if e == nil {
+		return NewTypeParam(name.Name)
+	}
+	typ := check.typ(e)
+	if typ != Typ[Invalid] && !isInteger(typ) {
+		check.errorf(e.Pos(), "constant type parameter %s must have integer type (got %s)", name.Name, typ)
+		typ = Typ[Invalid]
+	}
+	return NewConstTypeParam(name.Name, typ)
+}
+
+// constTypeParam returns the constant type parameter denoted by e, or nil if e
+// does not denote one.
+func (check *Checker) constTypeParam(e ast.Expr) *TypeParam {
+	ident, ok := e.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	_, obj := check.scope.LookupParent(ident.Name, check.pos)
+	if obj == nil {
+		return nil
+	}
+	if tp, ok := obj.Type().(*TypeParam); ok && tp.constType != nil {
+		return tp
+	}
+	return nil
+}
+
+// constTypeArg type-checks e as the type argument for the constant type
+// parameter tp. It returns a *ConstArg, or the constant type parameter which e
+// refers to (e.g. N in Vec[T, N] inside of a generic declaration), or nil if e
+// is not a valid argument for tp.
+func (check *Checker) constTypeArg(e ast.Expr, tp *TypeParam) Type {
+	var x operand
+	check.expr(&x, e)
+	if x.mode == invalid {
+		return nil
+	}
+	if x.mode != constant_ {
+		check.errorf(x.pos(), "type argument %s for %s must be constant", &x, tp)
+		return nil
+	}
+	if param := check.constTypeParam(e); param != nil {
+		if !Identical(param.constType, tp.constType) {
+			check.errorf(x.pos(), "cannot use %s as %s value in type argument for %s", &x, tp.constType, tp)
+			return nil
+		}
+		return param
+	}
+	if x.val.Kind() == constant.Unknown {
+		// The value depends on a constant type parameter (or an error was
+		// reported before).
+		check.errorf(x.pos(), "type argument %s for %s must be a constant or a constant type parameter", &x, tp)
+		return nil
+	}
+	check.assignment(&x, tp.constType, "type argument")
+	if x.mode == invalid {
+		return nil
+	}
+	return NewConstArg(tp.constType, x.val)
+}
+
+// constArrayLength returns the array length for the constant type argument
+// arg, which replaces the constant type parameter tp. Lengths which are
+// negative or not representable as an int are reported at the position where
+// the current instantiation was written, and treated as 0.
+func (check *Checker) constArrayLength(arg *ConstArg, tp *TypeParam) int64 {
+	if val := constant.ToInt(arg.val); representableConst(val, check.conf, Typ[Int], nil) {
+		if n, ok := constant.Int64Val(val); ok && n >= 0 {
+			return n
+		}
+	}
+	check.errorf(check.instSite(), "invalid array length %s for %s", arg, tp)
+	return 0
+}
+
+// instSite returns the position of the outermost instantiation currently
+// being expanded, i.e. the position in the code being checked which caused
+// it.
+func (check *Checker) instSite() token.Pos {
+	for _, inst := range check.instChain {
+		if inst.pos.IsValid() {
+			return inst.pos
+		}
+	}
+	return check.instPos
+}
+
 func createMethodTypeMap(recvType Type, typeMap map[string]Type) map[string]Type {
 	recvType, _ = deref(recvType)
 	if recvType, ok := recvType.(ConcreteType); ok {
@@ -369,6 +777,11 @@ func (check *Checker) replaceTypes(root Type, typeMap map[string]Type) Type {
 		return &newMap
 	case *Array:
 		newArray := *t
+		if t.lenParam != nil {
+			if arg, ok := typeMap[t.lenParam.String()].(*ConstArg); ok {
+				newArray.len, newArray.lenParam = check.constArrayLength(arg, t.lenParam), nil
+			}
+		}
 		newArray.elem = check.replaceTypes(t.elem, typeMap)
 		return &newArray
 	case *Chan:
@@ -377,6 +790,12 @@ func (check *Checker) replaceTypes(root Type, typeMap map[string]Type) Type {
 		return &newChan
 	case *Struct:
 		return check.replaceTypesInStruct(t, typeMap)
+	case *TupleType:
+		elems := make([]Type, len(t.elems))
+		for i, v := range t.elems {
+			elems[i] = check.replaceTypes(v.typ, typeMap)
+		}
+		return NewTupleType(elems...)
 	case *Signature:
 		return check.replaceTypesInSignature(t, typeMap)
 	case *Named:
@@ -442,7 +861,7 @@ func (check *Checker) replaceTypesInSignature(root *Signature, typeMap map[strin
 }
 
 func (check *Checker) replaceTypesInGenericSignature(root *GenericSignature, typeMap map[string]Type) Type {
-	if cachedType := cache.get(root, typeMap); cachedType != nil {
+	if cachedType := check.cache.get(root, typeMap); cachedType != nil {
 		return cachedType
 	}
 	if checkIsPartial(typeMap) {
@@ -451,9 +870,7 @@ func (check *Checker) replaceTypesInGenericSignature(root *GenericSignature, typ
 			genType:   root,
 			typeMap:   typeMap,
 		}
-		if check.genSig != nil {
-			check.genSig.dependents = append(check.genSig.dependents, partial)
-		}
+		check.addDependent(partial, token.NoPos)
 		return partial
 	}
 	newSig := check.replaceTypesInSignature(root.Signature, typeMap)
@@ -462,12 +879,50 @@ func (check *Checker) replaceTypesInGenericSignature(root *GenericSignature, typ
 		genType:   root,
 		typeMap:   typeMap,
 	}
-	cache.add(newType)
-	addGenericUsage(root.obj, newType)
+	check.cache.add(newType)
+	check.addGenericUsage(root.obj, newType)
 	return newType
 }
 
+// A replacement is a named type which is being copied by replaceTypesInNamed
+// together with the type arguments which are applied to it.
+type replacement struct {
+	named   *Named
+	typeMap map[string]Type
+}
+
+// sameTypeMap reports whether a and b map the same type parameters to the same
+// types.
+func sameTypeMap(a, b map[string]Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, typ := range a {
+		if b[name] != typ {
+			return false
+		}
+	}
+	return true
+}
+
 func (check *Checker) replaceTypesInNamed(root *Named, typeMap map[string]Type) *Named {
+	if root.obj != nil {
+		if _, isGeneric := root.obj.typ.(*GenericNamed); !isGeneric {
+			// A recursive type (e.g. type node struct{ next *node }) refers to
+			// itself, so stop when we get back to a type which is already being
+			// copied with the same type arguments. Recursive generic types are
+			// expanded via their instantiations instead.
+			for _, r := range check.replacing {
+				if r.named == root && sameTypeMap(r.typeMap, typeMap) {
+					return root
+				}
+			}
+			check.replacing = append(check.replacing, replacement{root, typeMap})
+			defer func() {
+				check.replacing = check.replacing[:len(check.replacing)-1]
+			}()
+		}
+	}
 	newUnderlying := check.replaceTypes(root.underlying, typeMap)
 	newNamed := *root
 	newNamed.underlying = newUnderlying
@@ -475,57 +930,57 @@ func (check *Checker) replaceTypesInNamed(root *Named, typeMap map[string]Type)
 }
 
 func (check *Checker) replaceTypesInPartialGenericNamed(root *PartialGenericNamed, typeMap map[string]Type) Type {
-	if cachedType := cache.get(root.genType, typeMap); cachedType != nil {
+	newTypeMap := check.remapTypes(root.typeMap, typeMap)
+	if cachedType := check.cache.get(root.genType, newTypeMap); cachedType != nil {
 		return cachedType
 	}
-	newTypeMap := remapTypes(root.typeMap, typeMap)
 	if checkIsPartial(newTypeMap) {
 		partial := &PartialGenericNamed{
 			Named:   root.Named,
 			genType: root.genType,
 			typeMap: newTypeMap,
 		}
-		if check.genSig != nil {
-			check.genSig.dependents = append(check.genSig.dependents, partial)
-		}
+		check.addDependent(partial, token.NoPos)
 		return partial
 	}
+	if !check.pushInstance(root.genType, newTypeMap, check.instPos) {
+		return Typ[Invalid]
+	}
 	newType := &ConcreteNamed{
 		genType: root.genType,
 		typeMap: newTypeMap,
 	}
-	cache.add(newType)
-	newNamed := check.replaceTypesInNamed(root.Named, newTypeMap)
+	check.cache.add(newType)
+	newNamed := check.replaceTypesInNamed(root.genType.Named, newTypeMap)
 	newType.Named = newNamed
 	newType.methods = check.replaceTypesInMethods(root.methods, newTypeMap)
-	addGenericUsage(root.obj, newType)
+	check.popInstance()
+	check.addGenericUsage(root.obj, newType)
 	return newType
 }
 
 func (check *Checker) replaceTypesInPartialGenericSignature(root *PartialGenericSignature, typeMap map[string]Type) Type {
-	if cachedType := cache.get(root.genType, typeMap); cachedType != nil {
+	newTypeMap := check.remapTypes(root.typeMap, typeMap)
+	if cachedType := check.cache.get(root.genType, newTypeMap); cachedType != nil {
 		return cachedType
 	}
-	newTypeMap := remapTypes(root.typeMap, typeMap)
 	if checkIsPartial(newTypeMap) {
 		partial := &PartialGenericSignature{
-			Signature: root.Signature,
+			Signature: check.replaceTypesInSignature(root.genType.Signature, newTypeMap),
 			genType:   root.genType,
 			typeMap:   newTypeMap,
 		}
-		if check.genSig != nil {
-			check.genSig.dependents = append(check.genSig.dependents, partial)
-		}
+		check.addDependent(partial, token.NoPos)
 		return partial
 	}
 	newType := &ConcreteSignature{
 		genType: root.genType,
 		typeMap: newTypeMap,
 	}
-	cache.add(newType)
-	newSig := check.replaceTypesInSignature(root.Signature, newTypeMap)
+	check.cache.add(newType)
+	newSig := check.replaceTypesInSignature(root.genType.Signature, newTypeMap)
 	newType.Signature = newSig
-	addGenericUsage(root.genType.obj, newType)
+	check.addGenericUsage(root.genType.obj, newType)
 	return newType
 }
 
@@ -586,7 +1041,7 @@ func replaceFuncType(f *Func, newType Type) *Func {
 func (check *Checker) typeArgsRequired(pos token.Pos, typ Type) {
 	switch t := typ.(type) {
 	case PartialGenericType:
-		if len(t.TypeParams()) != len(t.TypeMap()) {
+		if !hasOwnTypeArgs(t) {
 			check.errorf(
 				pos,
 				"wrong number of type arguments for type %s (expected %d but got %d, including implicit type arguments)",
@@ -601,20 +1056,256 @@ func (check *Checker) typeArgsRequired(pos token.Pos, typ Type) {
 }
 
 // genericDependents adds usage for each dependent of all declared generic
-// signatures.
+// signatures, including those declared in imported packages. Each new usage
+// can have dependents of its own (e.g. if a generic function calls another
+// generic function), so genericDependents keeps going until no new usages are
+// found.
 func (check *Checker) genericDependents() {
-	for _, genDecl := range check.pkg.generics {
-		if genSig, ok := genDecl.Type.(*GenericSignature); ok {
-			for _, usage := range genDecl.Usages {
-				for _, dep := range genSig.dependents {
-					switch partialType := dep.(type) {
-					case *PartialGenericNamed:
-						check.replaceTypesInPartialGenericNamed(partialType, usage.TypeMap())
-					case *PartialGenericSignature:
-						check.replaceTypesInPartialGenericSignature(partialType, usage.TypeMap())
-					}
-				}
+	// done is the number of usages of each declaration whose dependents have
+	// already been added.
+	done := map[*GenericDecl]int{}
+	for progress := true; progress; {
+		progress = false
+		for _, genDecl := range check.genericDecls() {
+			genSig, ok := genDecl.Type.(*GenericSignature)
+			if !ok {
+				continue
+			}
+			for ; done[genDecl] < len(genDecl.Usages); done[genDecl]++ {
+				check.addDependentUsages(genSig, genDecl.Usages[done[genDecl]])
+				progress = true
+			}
+		}
+	}
+}
+
+// addDependentUsages adds a usage for each dependent of genSig by applying the
+// type arguments of usage.
+func (check *Checker) addDependentUsages(genSig *GenericSignature, usage ConcreteType) {
+	chain, found := check.instChains[usage]
+	if !found {
+		// The usage was written in the code being checked.
+		pos := token.NoPos
+		if genDecl, found := usage.GenericType().Object().Pkg().generics[declKey(usage.GenericType())]; found {
+			if sites := genDecl.Sites(usage); len(sites) > 0 {
+				pos = sites[0]
+			}
+		}
+		chain = []instance{newInstance(usage.GenericType(), usage.TypeMap(), pos)}
+	}
+	if len(chain) > check.maxInstantiationDepth() {
+		check.instantiationCycle(chain)
+		return
+	}
+	check.instChain = chain
+	defer func() {
+		check.instChain = nil
+		check.instPos = token.NoPos
+	}()
+	for _, dep := range genSig.dependents {
+		check.instPos = dep.pos
+		var typ Type
+		switch partialType := dep.typ.(type) {
+		case *PartialGenericNamed:
+			typ = check.replaceTypesInPartialGenericNamed(partialType, usage.TypeMap())
+		case *PartialGenericSignature:
+			typ = check.replaceTypesInPartialGenericSignature(partialType, usage.TypeMap())
+		}
+		if conType, ok := typ.(ConcreteType); ok {
+			addGenericSite(conType, dep.pos)
+		}
+	}
+}
+
+// enclosingTypeParams returns all of the type parameters which are in scope
+// for the body of the generic function being checked (if any).
+func (check *Checker) enclosingTypeParams() []*TypeParam {
+	if check.genSig == nil {
+		return nil
+	}
+	var result []*TypeParam
+	result = append(result, check.genSig.outerTypeParams...)
+	result = append(result, check.genSig.recvTypeParams...)
+	result = append(result, check.genSig.typeParams...)
+	return result
+}
+
+// outerTypeParams returns the type parameters which genType inherits from an
+// enclosing generic function.
+func outerTypeParams(genType GenericType) []*TypeParam {
+	switch t := genType.(type) {
+	case *GenericNamed:
+		return t.outerTypeParams
+	case *GenericSignature:
+		return t.outerTypeParams
+	}
+	return nil
+}
+
+// checkTypeParamShadowing reports an error if the type parameter declared by
+// ident has the same name as one of the type parameters of an enclosing generic
+// function. Type arguments are identified by name, so they would be
+// ambiguous.
+func (check *Checker) checkTypeParamShadowing(ident *ast.Ident) {
+	for _, tp := range check.enclosingTypeParams() {
+		if tp.String() == ident.Name {
+			check.errorf(ident.Pos(), "type parameter %s shadows a type parameter of the enclosing function", ident.Name)
+			return
+		}
+	}
+}
+
+func (check *Checker) addDependent(partial PartialGenericType, pos token.Pos) {
+	if check.genSig != nil {
+		check.genSig.dependents = append(check.genSig.dependents, dependent{
+			typ: partial,
+			pos: pos,
+		})
+	}
+}
+
+// genericDecls returns the generic declarations for the package being checked
+// and all of its imports in a deterministic order.
+func (check *Checker) genericDecls() []*GenericDecl {
+	var result []*GenericDecl
+	for _, pkg := range check.pkgAndImports() {
+		keys := make([]string, 0, len(pkg.generics))
+		for key := range pkg.generics {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			result = append(result, pkg.generics[key])
+		}
+	}
+	return result
+}
+
+// pkgAndImports returns the package being checked followed by all of the
+// packages that it imports, directly or indirectly.
+func (check *Checker) pkgAndImports() []*Package {
+	result := []*Package{check.pkg}
+	seen := map[*Package]bool{check.pkg: true}
+	for i := 0; i < len(result); i++ {
+		for _, imp := range result[i].imports {
+			if !seen[imp] {
+				seen[imp] = true
+				result = append(result, imp)
+			}
+		}
+	}
+	return result
+}
+
+// defaultMaxInstantiationDepth is used when Config.MaxInstantiationDepth is
+// not set.
+const defaultMaxInstantiationDepth = 100
+
+// An instance is a single step in a chain of instantiations.
+type instance struct {
+	name    string    // e.g. F[[]int]
+	generic string    // name of the generic declaration, e.g. F
+	pos     token.Pos // position where the instantiation occurred
+}
+
+func newInstance(genType GenericType, typeMap map[string]Type, pos token.Pos) instance {
+	if !pos.IsValid() {
+		pos = genType.Object().Pos()
+	}
+	return instance{
+		name:    instanceName(genType, typeMap),
+		generic: declKey(genType),
+		pos:     pos,
+	}
+}
+
+// instanceName returns a human-readable name for genType with the type
+// arguments in typeMap applied, e.g. F[[]int].
+func instanceName(genType GenericType, typeMap map[string]Type) string {
+	typeParams := genType.TypeParams()
+	if sig, ok := genType.(*GenericSignature); ok {
+		typeParams = append(append([]*TypeParam{}, sig.recvTypeParams...), typeParams...)
+	}
+	name := declKey(genType)
+	if len(typeParams) == 0 {
+		return name
+	}
+	typeArgs := make([]string, len(typeParams))
+	for i, tp := range typeParams {
+		if typ, found := typeMap[tp.String()]; found {
+			typeArgs[i] = typ.String()
+		} else {
+			typeArgs[i] = tp.String()
+		}
+	}
+	return name + "[" + strings.Join(typeArgs, ", ") + "]"
+}
+
+func (check *Checker) maxInstantiationDepth() int {
+	if check.conf.MaxInstantiationDepth > 0 {
+		return check.conf.MaxInstantiationDepth
+	}
+	return defaultMaxInstantiationDepth
+}
+
+// pushInstance adds the instantiation of genType to the current chain of
+// instantiations. It reports an error and returns false if the chain is longer
+// than the maximum instantiation depth, which means that the instantiation
+// would never terminate (e.g. type List[T] struct { next *List[[]T] }).
+func (check *Checker) pushInstance(genType GenericType, typeMap map[string]Type, pos token.Pos) bool {
+	n := len(check.instChain)
+	chain := append(check.instChain[:n:n], newInstance(genType, typeMap, pos))
+	if len(chain) > check.maxInstantiationDepth() {
+		check.instantiationCycle(chain)
+		return false
+	}
+	check.instChain = chain
+	return true
+}
+
+func (check *Checker) popInstance() {
+	check.instChain = check.instChain[:len(check.instChain)-1]
+}
+
+// setInstPos sets the position of the instantiation currently being expanded
+// to pos, unless it is already set, and returns a function which restores it.
+func (check *Checker) setInstPos(pos token.Pos) func() {
+	old := check.instPos
+	if !old.IsValid() {
+		check.instPos = pos
+	}
+	return func() { check.instPos = old }
+}
+
+// recordInstance records the chain of instantiations which led to the new
+// usage typ, if any.
+func (check *Checker) recordInstance(typ ConcreteType) {
+	if len(check.instChain) == 0 {
+		return
+	}
+	if check.instChains == nil {
+		check.instChains = map[ConcreteType][]instance{}
+	}
+	n := len(check.instChain)
+	check.instChains[typ] = append(check.instChain[:n:n], newInstance(typ.GenericType(), typ.TypeMap(), check.instPos))
+}
+
+// instantiationCycle reports an error for a chain of instantiations which
+// exceeds the maximum depth. Since the chain is usually very long, only the
+// first steps up to and including the first repeated generic declaration are
+// shown.
+func (check *Checker) instantiationCycle(chain []instance) {
+	last := chain[len(chain)-1]
+	check.errorf(last.pos, "instantiation cycle: %s is instantiated with infinitely expanding type arguments (exceeded maximum depth of %d)", last.generic, check.maxInstantiationDepth())
+	seen := map[string]bool{chain[0].generic: true}
+	for i := 1; i < len(chain); i++ {
+		check.errorf(chain[i].pos, "\t%s instantiates %s", chain[i-1].name, chain[i].name)
+		if seen[chain[i].generic] {
+			if i < len(chain)-1 {
+				check.errorf(chain[i].pos, "\t...")
 			}
+			break
 		}
+		seen[chain[i].generic] = true
 	}
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/albrow/fo/ast"
@@ -227,3 +228,94 @@ func main() {
 		}
 	}
 }
+
+func TestGenericsMaxInstantiationDepth(t *testing.T) {
+	var src = `package genericstest
+
+func F[T]() {
+	F[[]T]()
+}
+
+func main() {
+	F[int]()
+}
+`
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "genericstest.go", src, parser.AllErrors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs []string
+	conf := Config{
+		MaxInstantiationDepth: 5,
+		Error: func(err error) {
+			errs = append(errs, err.Error())
+		},
+	}
+	pkg, _ := conf.Check("genericstest", fset, []*ast.File{f}, nil)
+	expectedErrs := []string{
+		"genericstest.go:4:2: instantiation cycle: F is instantiated with infinitely expanding type arguments (exceeded maximum depth of 5)",
+		"genericstest.go:4:2: \tF[int] instantiates F[[]int]",
+		"genericstest.go:4:2: \t...",
+	}
+	if len(errs) != len(expectedErrs) {
+		t.Fatalf("wrong errors.\nexpected: %q\ngot:      %q", expectedErrs, errs)
+	}
+	for i, err := range errs {
+		if err != expectedErrs[i] {
+			t.Errorf("wrong error.\nexpected: %q\ngot:      %q", expectedErrs[i], err)
+		}
+	}
+	if usages := len(pkg.generics["F"].Usages); usages != 6 {
+		t.Errorf("wrong number of usages for F (expected 6 but got %d)", usages)
+	}
+}
+
+func TestGenericsSites(t *testing.T) {
+	var src = `package genericstest
+
+func F[T]() {
+	G[[]T]()
+}
+
+func G[T]() {}
+
+func main() {
+	F[int]()
+	F[int]()
+	G[[]int]()
+}
+`
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "genericstest.go", src, parser.AllErrors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conf Config
+	pkg, err := conf.Check("genericstest", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		decl     string
+		expected []string
+	}{
+		{"F", []string{"genericstest.go:10:2", "genericstest.go:11:2"}},
+		{"G", []string{"genericstest.go:12:2", "genericstest.go:4:2"}},
+	}
+	for _, tc := range testCases {
+		decl := pkg.generics[tc.decl]
+		if len(decl.Usages) != 1 {
+			t.Fatalf("wrong number of usages for %s (expected 1 but got %d)", tc.decl, len(decl.Usages))
+		}
+		var sites []string
+		for _, pos := range decl.Sites(decl.Usages[0]) {
+			sites = append(sites, fset.Position(pos).String())
+		}
+		if strings.Join(sites, " ") != strings.Join(tc.expected, " ") {
+			t.Errorf("wrong sites for %s.\nexpected: %v\ngot:      %v", tc.decl, tc.expected, sites)
+		}
+	}
+}
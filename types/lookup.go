@@ -222,6 +222,10 @@ func lookupFieldOrMethod(T Type, addressable bool, pkg *Package, name string) (o
 
 				// continue with underlying type
 				typ = named.underlying
+			} else if tp, _ := typ.(*TypeParam); tp != nil {
+				// continue with the constraint (the methods of which can be
+				// called on values of tp)
+				typ = tp.Underlying()
 			}
 
 			switch t := typ.(type) {
@@ -256,6 +260,21 @@ func lookupFieldOrMethod(T Type, addressable bool, pkg *Package, name string) (o
 					}
 				}
 
+			case *TupleType:
+				// look for a matching element; the names of elements are
+				// never exported but they can be used from any package
+				for i, v := range t.elems {
+					if v.name == name {
+						index = concat(e.index, i)
+						if obj != nil || e.multiples {
+							return nil, index, false // collision
+						}
+						obj = v
+						indirect = e.indirect
+						break
+					}
+				}
+
 			case *Interface:
 				// look for a matching method
 				// TODO(gri) t.allMethods is sorted - use binary search
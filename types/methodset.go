@@ -258,6 +258,12 @@ func (s methodSet) add(list []*Func, index []int, indirect bool, multiples bool)
 // ptrRecv reports whether the receiver is of the form *T.
 // The receiver must exist.
 func ptrRecv(f *Func) bool {
+	_, isPtr := deref(recvVar(f).typ)
+	return isPtr
+}
+
+// recvVar returns the receiver of f. The receiver must exist.
+func recvVar(f *Func) *Var {
 	var sig *Signature
 	switch t := f.typ.(type) {
 	case *Signature:
@@ -271,6 +277,5 @@ func ptrRecv(f *Func) bool {
 	default:
 		panic(fmt.Errorf("unexpected Func type: %T", f.typ))
 	}
-	_, isPtr := deref(sig.recv.typ)
-	return isPtr
+	return sig.recv
 }
@@ -206,6 +206,7 @@ type Var struct {
 	visited   bool // for initialization cycle detection
 	isField   bool // var is struct field
 	used      bool // set if the variable was used
+	readOnly  bool // set if the variable was declared with val
 }
 
 // NewVar returns a new variable.
@@ -232,6 +233,10 @@ func (obj *Var) Anonymous() bool { return obj.anonymous }
 // IsField reports whether the variable is a struct field.
 func (obj *Var) IsField() bool { return obj.isField }
 
+// ReadOnly reports whether the variable was declared with val, either as a
+// read-only variable or as a read-only parameter or receiver.
+func (obj *Var) ReadOnly() bool { return obj.readOnly }
+
 func (*Var) isDependency() {} // a variable may be a dependency of an initialization expression
 
 type BaseFunc interface {
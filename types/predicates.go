@@ -9,8 +9,28 @@ package types
 
 import (
 	"sort"
+
+	"github.com/albrow/fo/constant"
+	"github.com/albrow/fo/token"
 )
 
+// typeListAll reports whether typ is a type parameter whose constraint has a
+// type list (ok), and if so, whether pred holds for every type in the list
+// (all). Operations are permitted on values of a constrained type parameter
+// exactly when they are permitted on all of the types in its type list.
+func typeListAll(typ Type, pred func(Type) bool) (all, ok bool) {
+	tp, _ := typ.(*TypeParam)
+	if tp == nil || len(tp.TypeList()) == 0 {
+		return false, false
+	}
+	for _, t := range tp.TypeList() {
+		if !pred(t) {
+			return false, true
+		}
+	}
+	return true, true
+}
+
 func isNamed(typ Type) bool {
 	if _, ok := typ.(*Basic); ok {
 		return ok
@@ -20,40 +40,68 @@ func isNamed(typ Type) bool {
 }
 
 func isBoolean(typ Type) bool {
+	if all, ok := typeListAll(typ, isBoolean); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsBoolean != 0
 }
 
 func isInteger(typ Type) bool {
+	if all, ok := typeListAll(typ, isInteger); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsInteger != 0
 }
 
 func isUnsigned(typ Type) bool {
+	if all, ok := typeListAll(typ, isUnsigned); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsUnsigned != 0
 }
 
 func isFloat(typ Type) bool {
+	if all, ok := typeListAll(typ, isFloat); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsFloat != 0
 }
 
 func isComplex(typ Type) bool {
+	if all, ok := typeListAll(typ, isComplex); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsComplex != 0
 }
 
 func isNumeric(typ Type) bool {
+	if all, ok := typeListAll(typ, isNumeric); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsNumeric != 0
 }
 
 func isString(typ Type) bool {
+	if all, ok := typeListAll(typ, isString); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsString != 0
 }
 
+func isNumericOrString(typ Type) bool {
+	if all, ok := typeListAll(typ, isNumericOrString); ok {
+		return all
+	}
+	return isNumeric(typ) || isString(typ)
+}
+
 func isTyped(typ Type) bool {
 	t, ok := typ.Underlying().(*Basic)
 	return !ok || t.info&IsUntyped == 0
@@ -65,6 +113,9 @@ func isUntyped(typ Type) bool {
 }
 
 func isOrdered(typ Type) bool {
+	if all, ok := typeListAll(typ, isOrdered); ok {
+		return all
+	}
 	t, ok := typ.Underlying().(*Basic)
 	return ok && t.info&IsOrdered != 0
 }
@@ -74,6 +125,16 @@ func isConstType(typ Type) bool {
 	return ok && t.info&IsConstType != 0
 }
 
+// isConstraint reports whether typ is an interface with a type list. Such
+// interfaces can only be used as constraints for type parameters.
+func isConstraint(typ Type) bool {
+	if _, ok := typ.(*TypeParam); ok || typ == nil {
+		return false
+	}
+	t, ok := typ.Underlying().(*Interface)
+	return ok && len(t.types) > 0
+}
+
 // IsInterface reports whether typ is an interface type.
 func IsInterface(typ Type) bool {
 	_, ok := typ.Underlying().(*Interface)
@@ -82,6 +143,9 @@ func IsInterface(typ Type) bool {
 
 // Comparable reports whether values of type T are comparable.
 func Comparable(T Type) bool {
+	if all, ok := typeListAll(T, Comparable); ok {
+		return all
+	}
 	switch t := T.Underlying().(type) {
 	case *Basic:
 		// assume invalid types to be comparable
@@ -98,12 +162,22 @@ func Comparable(T Type) bool {
 		return true
 	case *Array:
 		return Comparable(t.elem)
+	case *TupleType:
+		for _, v := range t.elems {
+			if !Comparable(v.typ) {
+				return false
+			}
+		}
+		return true
 	}
 	return false
 }
 
 // hasNil reports whether a type includes the nil value.
 func hasNil(typ Type) bool {
+	if all, ok := typeListAll(typ, hasNil); ok {
+		return all
+	}
 	switch t := typ.Underlying().(type) {
 	case *Basic:
 		return t.kind == UnsafePointer
@@ -113,6 +187,30 @@ func hasNil(typ Type) bool {
 	return false
 }
 
+// identicalTypeLists reports whether the type lists x and y contain the same
+// types, in any order.
+func identicalTypeLists(x, y []Type) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for _, t := range x {
+		if !inTypeList(t, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// inTypeList reports whether typ is identical to one of the types in list.
+func inTypeList(typ Type, list []Type) bool {
+	for _, t := range list {
+		if Identical(typ, t) {
+			return true
+		}
+	}
+	return false
+}
+
 // Identical reports whether x and y are identical types.
 // Receivers of Signature types are ignored.
 func Identical(x, y Type) bool {
@@ -153,6 +251,11 @@ func identical(x, y Type, cmpTags bool, p *ifacePair) bool {
 		// Two array types are identical if they have identical element types
 		// and the same array length.
 		if y, ok := y.(*Array); ok {
+			if (x.lenParam == nil) != (y.lenParam == nil) {
+				return false
+			} else if x.lenParam != nil && !identical(x.lenParam, y.lenParam, cmpTags, p) {
+				return false
+			}
 			return x.len == y.len && identical(x.elem, y.elem, cmpTags, p)
 		}
 
@@ -188,6 +291,20 @@ func identical(x, y Type, cmpTags bool, p *ifacePair) bool {
 			return identical(x.base, y.base, cmpTags, p)
 		}
 
+	case *TupleType:
+		// Two tuple types are identical if they have the same number of
+		// elements and corresponding elements have identical types.
+		if y, ok := y.(*TupleType); ok {
+			if x.Len() == y.Len() {
+				for i, v := range x.elems {
+					if !identical(v.typ, y.elems[i].typ, cmpTags, p) {
+						return false
+					}
+				}
+				return true
+			}
+		}
+
 	case *Tuple:
 		// Two tuples types are identical if they have the same number of elements
 		// and corresponding elements have identical types.
@@ -270,6 +387,9 @@ func identical(x, y Type, cmpTags bool, p *ifacePair) bool {
 		// the same names and identical function types. Lower-case method names from
 		// different packages are always different. The order of the methods is irrelevant.
 		if y, ok := y.(*Interface); ok {
+			if !identicalTypeLists(x.types, y.types) {
+				return false
+			}
 			a := x.allMethods
 			b := y.allMethods
 			if len(a) == len(b) {
@@ -402,6 +522,11 @@ func identical(x, y Type, cmpTags bool, p *ifacePair) bool {
 			return x.String() == y.String()
 		}
 
+	case *ConstArg:
+		if y, ok := y.(*ConstArg); ok {
+			return identical(x.typ, y.typ, cmpTags, p) && constant.Compare(x.val, token.EQL, y.val)
+		}
+
 	case nil:
 
 	default:
@@ -219,6 +219,8 @@ func (check *Checker) collectObjects() {
 		pkgImports[imp] = true
 	}
 
+	specs := check.specializationDecls()
+
 	for fileNo, file := range check.files {
 		// The package identifier denotes the current package,
 		// but there is no corresponding package object.
@@ -349,7 +351,7 @@ func (check *Checker) collectObjects() {
 
 							check.arityMatch(s, last)
 
-						case token.VAR:
+						case token.VAR, token.VAL:
 							lhs := make([]*Var, len(s.Names))
 							// If there's exactly one rhs initializer, use
 							// the same declInfo d1 for all lhs variables
@@ -366,6 +368,7 @@ func (check *Checker) collectObjects() {
 							// declare all variables
 							for i, name := range s.Names {
 								obj := NewVar(name.Pos(), pkg, name.Name, nil)
+								obj.readOnly = d.Tok == token.VAL
 								lhs[i] = obj
 
 								d := d1
@@ -399,6 +402,13 @@ func (check *Checker) collectObjects() {
 			case *ast.FuncDecl:
 				name := d.Name.Name
 				obj := NewFunc(d.Name.Pos(), pkg, name, nil)
+				if specs[d] {
+					// specializations are checked after the generic functions and
+					// methods that they specialize
+					check.recordDef(d.Name, obj)
+					check.specs = append(check.specs, specInfo{obj, &declInfo{file: fileScope, fdecl: d}})
+					break
+				}
 				if d.Recv == nil {
 					// regular function
 					if name == "init" {
@@ -459,6 +469,101 @@ func (check *Checker) collectObjects() {
 	}
 }
 
+// specializationDecls returns the set of function and method declarations in
+// the package files which specialize a generic function or method (e.g. func
+// Sum[int] alongside func Sum[T]). A declaration is a specialization if another
+// function or method with the same name is declared and all of its type
+// arguments denote types instead of new type parameters.
+func (check *Checker) specializationDecls() map[*ast.FuncDecl]bool {
+	typeNames := make(map[string]bool)
+	funcs := make(map[string][]*ast.FuncDecl)
+	for _, file := range check.files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					if s, ok := spec.(*ast.TypeSpec); ok {
+						typeNames[s.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				key := d.Name.Name
+				if base, _ := specializationArgs(d); base != "" {
+					key = base + "." + key
+				}
+				funcs[key] = append(funcs[key], d)
+			}
+		}
+	}
+
+	isType := func(e ast.Expr) bool {
+		ident, ok := e.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if typeNames[ident.Name] {
+			return true
+		}
+		_, ok = Universe.Lookup(ident.Name).(*TypeName)
+		return ok
+	}
+
+	var specs map[*ast.FuncDecl]bool
+	for _, decls := range funcs {
+		if len(decls) < 2 {
+			continue
+		}
+		for _, d := range decls {
+			_, args := specializationArgs(d)
+			if len(args) == 0 {
+				continue
+			}
+			all := true
+			for _, arg := range args {
+				if !isType(arg) {
+					all = false
+					break
+				}
+			}
+			if all {
+				if specs == nil {
+					specs = make(map[*ast.FuncDecl]bool)
+				}
+				specs[d] = true
+			}
+		}
+	}
+	return specs
+}
+
+// specializationArgs returns the name of the receiver base type of d (if d is
+// a method) and the expressions in brackets after the receiver base type and
+// function name of d, which are type arguments if d is a specialization.
+func specializationArgs(d *ast.FuncDecl) (base string, args []ast.Expr) {
+	if d.Recv != nil && len(d.Recv.List) > 0 {
+		typ := unparen(d.Recv.List[0].Type)
+		if ptr, _ := typ.(*ast.StarExpr); ptr != nil {
+			typ = unparen(ptr.X)
+		}
+		if tpe, _ := typ.(*ast.TypeArgExpr); tpe != nil {
+			args = append(args, tpe.Types...)
+			typ = unparen(tpe.X)
+		}
+		if ident, _ := typ.(*ast.Ident); ident != nil {
+			base = ident.Name
+		}
+	}
+	if d.TypeParams != nil {
+		for _, name := range d.TypeParams.Names {
+			args = append(args, name)
+		}
+	}
+	return base, args
+}
+
 // packageObjects typechecks all package objects in objList, but not function bodies.
 func (check *Checker) packageObjects(objList []Object) {
 	// add new methods to already type-checked types (from a prior Checker.Files call)
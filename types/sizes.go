@@ -65,6 +65,15 @@ func (s *StdSizes) Alignof(T Type) int64 {
 			}
 		}
 		return max
+	case *TupleType:
+		// Tuples are laid out like structs.
+		max := int64(1)
+		for _, v := range t.elems {
+			if a := s.Alignof(v.typ); a > max {
+				max = a
+			}
+		}
+		return max
 	case *Slice, *Interface:
 		// Multiword data structures are effectively structs
 		// in which each element has size WordSize.
@@ -148,6 +157,10 @@ func (s *StdSizes) Sizeof(T Type) int64 {
 		}
 		offsets := s.Offsetsof(t.fields)
 		return offsets[n-1] + s.Sizeof(t.fields[n-1].typ)
+	case *TupleType:
+		n := t.Len()
+		offsets := s.Offsetsof(t.elems)
+		return offsets[n-1] + s.Sizeof(t.elems[n-1].typ)
 	case *Interface:
 		return s.WordSize * 2
 	}
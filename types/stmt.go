@@ -365,6 +365,7 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 		}
 
 		check.assignment(&x, tch.elem, "send")
+		check.readOnlyCopy(&x, tch.elem, "send")
 
 	case *ast.IncDecStmt:
 		var op token.Token
@@ -800,6 +801,13 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 		lhs := [2]ast.Expr{s.Key, s.Value}
 		rhs := [2]Type{key, val} // key, val may be nil
 
+		// The iteration values of a read-only slice, array or map share memory
+		// with it if their type does (see sharesData).
+		var readOnlyX *Var
+		if _, isChan := x.typ.Underlying().(*Chan); !isChan && val != nil && sharesData(val) {
+			readOnlyX = check.readOnlySource(s.X)
+		}
+
 		if s.Tok == token.DEFINE {
 			// short variable declaration; variable scope starts after the range clause
 			// (the for loop opens a new scope, so variables on the lhs never redeclare
@@ -831,7 +839,11 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 					x.mode = value
 					x.expr = lhs // we don't have a better rhs expression to use here
 					x.typ = typ
+					// x.expr is not the value the variable is initialized
+					// with, so initVar must not check where it comes from.
+					obj.readOnly = true
 					check.initVar(obj, &x, "range clause")
+					obj.readOnly = i == 1 && readOnlyX != nil
 				} else {
 					obj.typ = Typ[Invalid]
 					obj.used = true // don't complain about unused variable
@@ -861,7 +873,9 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 					x.mode = value
 					x.expr = lhs // we don't have a better rhs expression to use here
 					x.typ = typ
-					check.assignVar(lhs, &x)
+					if check.assignVar(lhs, &x) != nil && i == 1 && readOnlyX != nil {
+						check.errorf(lhs.Pos(), "cannot assign elements of %s to %s (%s is read-only)", s.X, lhs, readOnlyX.name)
+					}
 				}
 			}
 		}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/albrow/fo/ast"
+	"github.com/albrow/fo/token"
+)
+
+// tupleElems returns the elements for a tuple type with the given element
+// types.
+func tupleElems(types []Type) []*Var {
+	elems := make([]*Var, len(types))
+	for i, typ := range types {
+		elems[i] = NewField(token.NoPos, nil, strconv.Itoa(i), typ, false)
+	}
+	return elems
+}
+
+// tupleType type-checks the tuple type e.
+func (check *Checker) tupleType(e *ast.TupleExpr, def *Named, path []*TypeName) *TupleType {
+	typ := new(TupleType)
+	def.setUnderlying(typ)
+	types := make([]Type, len(e.Elts))
+	for i, elt := range e.Elts {
+		types[i] = check.typExpr(elt, nil, path)
+		check.typeArgsRequired(elt.Pos(), types[i])
+		check.notConstraint(elt.Pos(), types[i])
+	}
+	typ.elems = tupleElems(types)
+	return typ
+}
+
+// tupleLit type-checks the tuple literal e. If hint is a tuple type with the
+// same number of elements, each element of e is assigned to the corresponding
+// element type of hint. Otherwise untyped constants are converted to their
+// default types. A tuple of types is a tuple type (e.g. in a conversion).
+func (check *Checker) tupleLit(x *operand, e *ast.TupleExpr, hint Type) {
+	var hintElems []*Var
+	if hint != nil {
+		if t, _ := hint.Underlying().(*TupleType); t != nil && t.Len() == len(e.Elts) {
+			hintElems = t.elems
+		}
+	}
+	types := make([]Type, len(e.Elts))
+	for i, elt := range e.Elts {
+		var T Type
+		switch {
+		case hintElems != nil:
+			T = hintElems[i].typ
+			check.exprWithHint(x, elt, T)
+		case i == 0:
+			check.exprOrType(x, elt)
+			if x.mode == typexpr {
+				x.typ = check.typ(e)
+				x.expr = e
+				return
+			}
+		default:
+			check.expr(x, elt)
+		}
+		check.assignment(x, T, "tuple literal")
+		check.readOnlyCopy(x, T, "tuple literal")
+		if x.mode == invalid {
+			check.use(e.Elts[i+1:]...)
+			return
+		}
+		if T != nil {
+			types[i] = T
+		} else {
+			types[i] = x.typ
+		}
+	}
+	x.mode = value
+	x.typ = &TupleType{elems: tupleElems(types)}
+	x.expr = e
+}
+
+// assignedExpr type-checks the expression e, which is assigned to a variable
+// of type T (or nil if T is not known). A tuple literal uses T as a hint, and
+// the result of a multi-valued function call becomes a tuple if T is a tuple
+// type with the same number of elements.
+func (check *Checker) assignedExpr(x *operand, e ast.Expr, T Type) {
+	if _, ok := e.(*ast.TupleExpr); ok && T != nil {
+		check.exprWithHint(x, e, T)
+		return
+	}
+	check.multiExpr(x, e)
+	check.packTuple(x, T)
+}
+
+// packTuple turns x into a tuple value if it is the result of a multi-valued
+// function call and T is a tuple type with the same number of elements.
+func (check *Checker) packTuple(x *operand, T Type) {
+	if x.mode != value || T == nil {
+		return
+	}
+	t, ok := x.typ.(*Tuple)
+	if !ok {
+		return
+	}
+	if u, ok := T.Underlying().(*TupleType); !ok || u.Len() != t.Len() {
+		return
+	}
+	types := make([]Type, t.Len())
+	for i, v := range t.vars {
+		types[i] = v.typ
+	}
+	x.typ = &TupleType{elems: tupleElems(types)}
+	check.recordTypeAndValue(x.expr, x.mode, x.typ, nil)
+}
+
+// unpackTuple is like unpack, but for the destructuring assignment of a
+// tuple value to multiple variables (e.g. a, b := t). If get and n stand for
+// a single tuple value, the resulting getter provides access to its elements.
+// Otherwise get and n are returned unchanged.
+func unpackTuple(get getter, n int) (getter, int) {
+	if n != 1 {
+		return get, n
+	}
+	var x0 operand
+	get(&x0, 0)
+	if x0.mode == invalid {
+		return get, n
+	}
+	t, ok := x0.typ.Underlying().(*TupleType)
+	if !ok {
+		return get, n
+	}
+	return func(x *operand, i int) {
+		x.mode = value
+		x.expr = x0.expr
+		x.typ = t.elems[i].typ
+	}, t.Len()
+}
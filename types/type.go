@@ -5,7 +5,12 @@
 
 package types
 
-import "sort"
+import (
+	"sort"
+
+	"github.com/albrow/fo/constant"
+	"github.com/albrow/fo/token"
+)
 
 // A Type represents a type of Go.
 // All types implement the Type interface.
@@ -113,16 +118,21 @@ func (b *Basic) Name() string { return b.name }
 
 // An Array represents an array type.
 type Array struct {
-	len  int64
-	elem Type
+	len      int64
+	lenParam *TypeParam // constant type parameter used as the length (len is -1); or nil
+	elem     Type
 }
 
 // NewArray returns a new array type for the given element type and length.
-func NewArray(elem Type, len int64) *Array { return &Array{len, elem} }
+func NewArray(elem Type, len int64) *Array { return &Array{len: len, elem: elem} }
 
 // Len returns the length of array a.
 func (a *Array) Len() int64 { return a.len }
 
+// LenParam returns the constant type parameter used as the length of array a
+// (e.g. N in [N]T), or nil if a has a constant length.
+func (a *Array) LenParam() *TypeParam { return a.lenParam }
+
 // Elem returns element type of array a.
 func (a *Array) Elem() Type { return a.elem }
 
@@ -139,24 +149,100 @@ func (s *Slice) Elem() Type { return s.elem }
 
 // TypeParam is an identifier for a type used in generic data structures and
 // functions.
-type TypeParam string
+type TypeParam struct {
+	name      string
+	dflt      Type // default type argument; or nil
+	constType Type // type of the value for constant type parameters; or nil
+	bound     Type // constraint of the type parameter (an interface); or nil
+}
 
 // NewTypeParam returns a new type parameter with the given name.
 func NewTypeParam(name string) *TypeParam {
-	tp := TypeParam(name)
-	return &tp
+	return &TypeParam{name: name}
+}
+
+// NewConstTypeParam returns a new constant type parameter with the given name
+// and type. Its type arguments are constants of typ instead of types.
+func NewConstTypeParam(name string, typ Type) *TypeParam {
+	return &TypeParam{name: name, constType: typ}
+}
+
+// NewConstrainedTypeParam returns a new type parameter with the given name
+// whose type arguments must satisfy bound, which must be an interface.
+func NewConstrainedTypeParam(name string, bound Type) *TypeParam {
+	return &TypeParam{name: name, bound: bound}
+}
+
+// Default returns the type argument which is used for tp if it is omitted, or
+// nil if tp has no default.
+func (tp *TypeParam) Default() Type {
+	return tp.dflt
 }
 
-// Underlying for type parameters always returns the empty interface. The
-// compiler can make no assumptions about the underlying type.
+// ConstType returns the type of the constant value of tp, or nil if tp is not
+// a constant type parameter.
+func (tp *TypeParam) ConstType() Type {
+	return tp.constType
+}
+
+// Constraint returns the interface which constrains the type arguments of tp,
+// or nil if tp is unconstrained.
+func (tp *TypeParam) Constraint() Type {
+	return tp.bound
+}
+
+// TypeList returns the types permitted by the constraint of tp, or nil if any
+// type is permitted.
+func (tp *TypeParam) TypeList() []Type {
+	if tp.bound == nil {
+		return nil
+	}
+	if it, ok := tp.bound.Underlying().(*Interface); ok {
+		return it.types
+	}
+	return nil
+}
+
+// Underlying for type parameters returns the interface which constrains them,
+// or the empty interface if they are unconstrained. The compiler can make no
+// other assumptions about the underlying type.
 func (tp *TypeParam) Underlying() Type {
+	if tp.bound != nil {
+		if it, ok := tp.bound.Underlying().(*Interface); ok {
+			return it
+		}
+	}
 	return NewInterface(nil, nil)
 }
 
 func (tp TypeParam) String() string {
-	return string(tp)
+	return tp.name
 }
 
+// A ConstArg is a constant used as the type argument for a constant type
+// parameter (e.g. 3 in Vec[float64, 3]). It only appears in type maps and is
+// not the type of any expression.
+type ConstArg struct {
+	typ Type
+	val constant.Value
+}
+
+// NewConstArg returns a new constant type argument with the given type and
+// value.
+func NewConstArg(typ Type, val constant.Value) *ConstArg {
+	return &ConstArg{typ: typ, val: val}
+}
+
+// Type returns the type of the constant.
+func (c *ConstArg) Type() Type { return c.typ }
+
+// Val returns the value of the constant.
+func (c *ConstArg) Val() constant.Value { return c.val }
+
+func (c *ConstArg) Underlying() Type { return c }
+
+func (c *ConstArg) String() string { return c.val.ExactString() }
+
 // A Struct represents a struct type.
 type Struct struct {
 	fields []*Var
@@ -231,6 +317,37 @@ func (t *Tuple) Len() int {
 // At returns the i'th variable of tuple t.
 func (t *Tuple) At(i int) *Var { return t.vars[i] }
 
+// A TupleType represents a tuple type such as (int, string). Unlike a Tuple,
+// a TupleType is a first class type. Its elements are accessed like struct
+// fields named 0, 1, and so on.
+type TupleType struct {
+	elems []*Var
+}
+
+// NewTupleType returns a new tuple type with the given element types. There
+// must be at least two elements.
+func NewTupleType(elems ...Type) *TupleType {
+	if len(elems) < 2 {
+		panic("tuple type with less than two elements")
+	}
+	return &TupleType{elems: tupleElems(elems)}
+}
+
+// Len returns the number of elements of t.
+func (t *TupleType) Len() int { return len(t.elems) }
+
+// At returns the i'th element of t.
+func (t *TupleType) At(i int) *Var { return t.elems[i] }
+
+// Elems returns the element types of t.
+func (t *TupleType) Elems() []Type {
+	elems := make([]Type, len(t.elems))
+	for i, v := range t.elems {
+		elems[i] = v.typ
+	}
+	return elems
+}
+
 type BaseSignature interface {
 	Recv() *Var
 	Params() *Tuple
@@ -298,9 +415,18 @@ type GenericSignature struct {
 	typeParams     []*TypeParam // generic type parameters (if any)
 	recvTypeParams []*TypeParam // type parameters of the receiver type (if any)
 	obj            *Func        // obj points to the corresponding declaration
+	// outerTypeParams are the type parameters of the enclosing generic function
+	// for local declarations (if any). See GenericNamed.
+	outerTypeParams []*TypeParam
 	// dependents are generic usages inside the function body which inherit
 	// type parameters from the function declaration.
-	dependents []PartialGenericType
+	dependents []dependent
+}
+
+// dependent is a generic usage inside the body of a generic function.
+type dependent struct {
+	typ PartialGenericType
+	pos token.Pos // position of the type argument expression (if known)
 }
 
 func NewGenericSignature(recv *Var, params, results *Tuple, variadic bool, typeParams, recvTypeParams []*TypeParam) *GenericSignature {
@@ -362,6 +488,9 @@ type PartialGenericSignature struct {
 	*Signature
 	genType *GenericSignature
 	typeMap map[string]Type // map of type parameter name to concrete type
+	// methodExprRecv is the receiver type if the signature is for a method
+	// expression (e.g. A[string].F) and nil otherwise.
+	methodExprRecv Type
 }
 
 func (pgs *PartialGenericSignature) TypeParams() []*TypeParam {
@@ -384,6 +513,7 @@ func (pgs *PartialGenericSignature) TypeMap() map[string]Type {
 type Interface struct {
 	methods   []*Func  // ordered list of explicitly declared methods
 	embeddeds []*Named // ordered list of explicitly embedded types
+	types     []Type   // type list of interfaces used as constraints; or nil
 
 	allMethods []*Func // ordered list of methods declared with or embedded in this interface (TODO(gri): replace with mset)
 }
@@ -439,6 +569,11 @@ func (t *Interface) NumEmbeddeds() int { return len(t.embeddeds) }
 // The types are ordered by the corresponding TypeName's unique Id.
 func (t *Interface) Embedded(i int) *Named { return t.embeddeds[i] }
 
+// TypeList returns the type list of interface t, or nil if t has no type list.
+// Interfaces with a type list can only be used as constraints for type
+// parameters.
+func (t *Interface) TypeList() []Type { return t.types }
+
 // NumMethods returns the total number of methods of interface t.
 func (t *Interface) NumMethods() int { return len(t.allMethods) }
 
@@ -588,6 +723,11 @@ func (t *Named) AddMethod(m *Func) {
 type GenericNamed struct {
 	*Named
 	typeParams []*TypeParam
+	// outerTypeParams are the type parameters of the enclosing generic function
+	// if the type is declared inside of its body. They are inherited in the same
+	// way that methods inherit the type parameters of the receiver type, so each
+	// usage of the type includes a type argument for each of them.
+	outerTypeParams []*TypeParam
 }
 
 func NewGenericNamed(obj *TypeName, underlying Type, methods []*Func, typeParams []*TypeParam) *GenericNamed {
@@ -669,6 +809,7 @@ func (t *Slice) Underlying() Type     { return t }
 func (t *Struct) Underlying() Type    { return t }
 func (t *Pointer) Underlying() Type   { return t }
 func (t *Tuple) Underlying() Type     { return t }
+func (t *TupleType) Underlying() Type { return t }
 func (t *Signature) Underlying() Type { return t }
 func (t *Interface) Underlying() Type { return t }
 func (t *Map) Underlying() Type       { return t }
@@ -681,6 +822,7 @@ func (t *Slice) String() string                   { return TypeString(t, nil) }
 func (t *Struct) String() string                  { return TypeString(t, nil) }
 func (t *Pointer) String() string                 { return TypeString(t, nil) }
 func (t *Tuple) String() string                   { return TypeString(t, nil) }
+func (t *TupleType) String() string               { return TypeString(t, nil) }
 func (t *Signature) String() string               { return TypeString(t, nil) }
 func (t *GenericSignature) String() string        { return TypeString(t, nil) }
 func (t *PartialGenericSignature) String() string { return TypeString(t, nil) }
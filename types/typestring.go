@@ -110,7 +110,11 @@ func writeType(buf *bytes.Buffer, typ Type, qf Qualifier, visited []Type) {
 		buf.WriteString(t.name)
 
 	case *Array:
-		fmt.Fprintf(buf, "[%d]", t.len)
+		if t.lenParam != nil {
+			fmt.Fprintf(buf, "[%s]", t.lenParam)
+		} else {
+			fmt.Fprintf(buf, "[%d]", t.len)
+		}
 		writeType(buf, t.elem, qf, visited)
 
 	case *Slice:
@@ -141,6 +145,16 @@ func writeType(buf *bytes.Buffer, typ Type, qf Qualifier, visited []Type) {
 	case *Tuple:
 		writeTuple(buf, t, false, qf, visited)
 
+	case *TupleType:
+		buf.WriteByte('(')
+		for i, v := range t.elems {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeType(buf, v.typ, qf, visited)
+		}
+		buf.WriteByte(')')
+
 	case *Signature:
 		buf.WriteString("func")
 		writeSignature(buf, t, qf, visited)
@@ -204,6 +218,18 @@ func writeType(buf *bytes.Buffer, typ Type, qf Qualifier, visited []Type) {
 				empty = false
 			}
 		}
+		for i, typ := range t.types {
+			if i > 0 {
+				buf.WriteString(", ")
+			} else {
+				if !empty {
+					buf.WriteString("; ")
+				}
+				buf.WriteString("type ")
+			}
+			writeType(buf, typ, qf, visited)
+			empty = false
+		}
 		if t.allMethods == nil || len(t.methods) > len(t.allMethods) {
 			if !empty {
 				buf.WriteByte(' ')
@@ -70,6 +70,17 @@ func (check *Checker) ident(x *operand, e *ast.Ident, def *Named, path []*TypeNa
 		x.mode = constant_
 
 	case *TypeName:
+		if tp, _ := typ.(*TypeParam); tp != nil && tp.constType != nil {
+			// A constant type parameter is a constant whose value is unknown until
+			// the type parameter is replaced.
+			if tp.constType == Typ[Invalid] {
+				return
+			}
+			x.mode = constant_
+			x.typ = tp.constType
+			x.val = constant.MakeUnknown()
+			return
+		}
 		x.mode = typexpr
 		// check for cycle
 		// (it's ok to iterate forward because each named type appears at most once in path)
@@ -142,7 +153,17 @@ func (check *Checker) typExpr(e ast.Expr, def *Named, path []*TypeName) (T Type)
 }
 
 func (check *Checker) typ(e ast.Expr) Type {
-	return check.typExpr(e, nil, nil)
+	typ := check.typExpr(e, nil, nil)
+	check.notConstraint(e.Pos(), typ)
+	return typ
+}
+
+// notConstraint reports an error if typ is an interface with a type list,
+// which can only be used as the constraint of a type parameter.
+func (check *Checker) notConstraint(pos token.Pos, typ Type) {
+	if isConstraint(typ) {
+		check.errorf(pos, "%s has a type list and can only be used as a constraint", typ)
+	}
 }
 
 // funcType type-checks a function or method type.
@@ -183,12 +204,14 @@ func (check *Checker) genericFuncType(sig *GenericSignature, recvPar *ast.FieldL
 	}
 	if tpList != nil {
 		for _, ident := range tpList.Names {
-			tp := NewTypeParam(ident.Name)
+			check.checkTypeParamShadowing(ident)
+			tp := check.newTypeParam(tpList, ident)
 			typeParams = append(typeParams, tp)
 			obj := NewTypeName(ident.Pos(), check.pkg, ident.Name, tp)
 			scopePos := ident.Pos()
 			check.declare(tpScope, ident, obj, scopePos)
 		}
+		check.typeParamDefaults(tpList, typeParams)
 	}
 
 	// Set check.scope to the type parameter scope (and unset it when we return)
@@ -299,13 +322,23 @@ func (check *Checker) typExprInternal(e ast.Expr, def *Named, path []*TypeName)
 	case *ast.ParenExpr:
 		return check.typExpr(e.X, def, path)
 
+	case *ast.TupleExpr:
+		return check.tupleType(e, def, path)
+
 	case *ast.ArrayType:
 		if e.Len != nil {
 			typ := new(Array)
 			def.setUnderlying(typ)
-			typ.len = check.arrayLength(e.Len)
+			if tp := check.constTypeParam(e.Len); tp != nil {
+				var x operand
+				check.expr(&x, e.Len)
+				typ.len, typ.lenParam = -1, tp
+			} else {
+				typ.len = check.arrayLength(e.Len)
+			}
 			typ.elem = check.typExpr(e.Elt, nil, path)
 			check.typeArgsRequired(e.Elt.Pos(), typ.elem)
+			check.notConstraint(e.Elt.Pos(), typ.elem)
 			return typ
 		} else {
 			typ := new(Slice)
@@ -439,6 +472,10 @@ func (check *Checker) arrayLength(e ast.Expr) int64 {
 		}
 		return 0
 	}
+	if x.val.Kind() == constant.Unknown {
+		check.errorf(x.pos(), "array length %s must be a constant or a constant type parameter", &x)
+		return 0
+	}
 	if isUntyped(x.typ) || isInteger(x.typ) {
 		if val := constant.ToInt(x.val); val.Kind() == constant.Int {
 			if representableConst(val, check.conf, Typ[Int], nil) {
@@ -470,7 +507,8 @@ func (check *Checker) recvTypeParams(recvPar *ast.FieldList) ([]*TypeParam, *Sco
 	}
 	if x, ok := typ.(*ast.TypeArgExpr); ok {
 		tpScope = NewScope(check.scope, check.scope.Pos(), check.scope.End(), "function type parameters")
-		for _, expr := range x.Types {
+		baseParams := check.recvBaseTypeParams(x.X)
+		for i, expr := range x.Types {
 			ident, ok := expr.(*ast.Ident)
 			if !ok {
 				check.error(expr.Pos(), "type parameters in method receiver must be identifiers")
@@ -482,6 +520,12 @@ func (check *Checker) recvTypeParams(recvPar *ast.FieldList) ([]*TypeParam, *Sco
 				}
 			}
 			tp := NewTypeParam(ident.Name)
+			if i < len(baseParams) {
+				// The receiver type parameter stands for a type parameter of the
+				// receiver base type and has the same constant type or constraint.
+				tp.constType = baseParams[i].constType
+				tp.bound = baseParams[i].bound
+			}
 			typeParams = append(typeParams, tp)
 			obj := NewTypeName(ident.Pos(), check.pkg, ident.Name, tp)
 			scopePos := ident.Pos()
@@ -492,6 +536,27 @@ func (check *Checker) recvTypeParams(recvPar *ast.FieldList) ([]*TypeParam, *Sco
 	return typeParams, tpScope
 }
 
+// recvBaseTypeParams returns the type parameters of the generic receiver base
+// type e, or nil if e does not denote a generic type.
+func (check *Checker) recvBaseTypeParams(e ast.Expr) []*TypeParam {
+	ident, ok := e.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	_, obj := check.scope.LookupParent(ident.Name, check.pos)
+	tname, ok := obj.(*TypeName)
+	if !ok {
+		return nil
+	}
+	if tname.typ == nil {
+		check.objDecl(tname, nil, nil)
+	}
+	if genType, ok := tname.typ.(*GenericNamed); ok {
+		return genType.typeParams
+	}
+	return nil
+}
+
 func (check *Checker) methodReceiver(scope *Scope, list *ast.FieldList) *Var {
 	if list == nil {
 		return nil
@@ -547,6 +612,7 @@ func (check *Checker) methodReceiver(scope *Scope, list *ast.FieldList) *Var {
 			// ok to continue
 		}
 		recvVar = NewParam(name.Pos(), check.pkg, name.Name, typ)
+		recvVar.readOnly = recv.Val.IsValid()
 		check.declare(scope, name, recvVar, scope.pos)
 	}
 
@@ -582,6 +648,7 @@ func (check *Checker) collectParams(scope *Scope, list *ast.FieldList, variadicO
 					// ok to continue
 				}
 				par := NewParam(name.Pos(), check.pkg, name.Name, typ)
+				par.readOnly = field.Val.IsValid()
 				check.declare(scope, name, par, scope.pos)
 				params = append(params, par)
 			}
@@ -643,9 +710,13 @@ func (check *Checker) interfaceType(iface *Interface, ityp *ast.InterfaceType, d
 		mset       objset
 		signatures []ast.Expr // list of corresponding method signatures
 		embedded   []ast.Expr // list of embedded types
+		typeList   []ast.Expr // list of types in the type list
 	)
 	for _, f := range ityp.Methods.List {
-		if len(f.Names) > 0 {
+		if len(f.Names) == 1 && f.Names[0].Name == "type" {
+			// type list entry
+			typeList = append(typeList, f.Type)
+		} else if len(f.Names) > 0 {
 			// The parser ensures that there's only one method
 			// and we don't care if a constructed AST has more.
 			name := f.Names[0]
@@ -704,6 +775,10 @@ func (check *Checker) interfaceType(iface *Interface, ityp *ast.InterfaceType, d
 			}
 			continue
 		}
+		if len(embed.types) > 0 {
+			check.errorf(pos, "cannot embed %s which has a type list", typ)
+			continue
+		}
 		iface.embeddeds = append(iface.embeddeds, named)
 		// collect embedded methods
 		if embed.allMethods == nil {
@@ -716,6 +791,22 @@ func (check *Checker) interfaceType(iface *Interface, ityp *ast.InterfaceType, d
 		}
 	}
 
+	// Resolve the type list. Like embedded interfaces, the types in it cannot
+	// depend on the methods of this interface.
+
+	for _, e := range typeList {
+		typ := check.typ(e)
+		check.typeArgsRequired(e.Pos(), typ)
+		if typ == Typ[Invalid] {
+			continue
+		}
+		if inTypeList(typ, iface.types) {
+			check.errorf(e.Pos(), "duplicate type %s in type list", typ)
+			continue
+		}
+		iface.types = append(iface.types, typ)
+	}
+
 	// Phase 3: At this point all methods have been collected for this interface.
 	//          It is now safe to type-check the signatures of all explicitly
 	//          declared methods, even if they refer to this interface via a cycle
@@ -818,6 +909,7 @@ func (check *Checker) structType(styp *Struct, e *ast.StructType, path []*TypeNa
 	for _, f := range list.List {
 		typ = check.typExpr(f.Type, nil, path)
 		check.typeArgsRequired(f.Type.Pos(), typ)
+		check.notConstraint(f.Type.Pos(), typ)
 		tag = check.tag(f.Tag)
 		if len(f.Names) > 0 {
 			// named fields
@@ -81,6 +81,34 @@ func defPredeclaredTypes() {
 	def(NewTypeName(token.NoPos, nil, "error", typ))
 }
 
+// predeclaredConstraints are the interfaces which can be used as constraints
+// for type parameters (e.g. [T Number]). Unlike other exported predeclared
+// objects they are declared in the universe scope rather than package unsafe.
+var predeclaredConstraints = [...]struct {
+	name  string
+	kinds []BasicKind
+}{
+	{"Integer", []BasicKind{Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr}},
+	{"Float", []BasicKind{Float32, Float64}},
+	{"Number", []BasicKind{Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr, Float32, Float64, Complex64, Complex128}},
+	{"Ordered", []BasicKind{Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr, Float32, Float64, String}},
+}
+
+func defPredeclaredConstraints() {
+	for _, c := range predeclaredConstraints {
+		var types []Type
+		for _, kind := range c.kinds {
+			types = append(types, Typ[kind])
+		}
+		typ := &Named{underlying: &Interface{types: types, allMethods: markComplete}}
+		obj := NewTypeName(token.NoPos, nil, c.name, typ)
+		typ.obj = obj
+		if Universe.Insert(obj) != nil {
+			panic("internal error: double declaration")
+		}
+	}
+}
+
 var predeclaredConsts = [...]struct {
 	name string
 	kind BasicKind
@@ -189,6 +217,7 @@ func init() {
 	Unsafe.complete = true
 
 	defPredeclaredTypes()
+	defPredeclaredConstraints()
 	defPredeclaredConsts()
 	defPredeclaredNil()
 	defPredeclaredFuncs()
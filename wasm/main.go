@@ -0,0 +1,78 @@
+//go:build js && wasm
+// +build js,wasm
+
+// Command wasm is the Fo front end compiled to WebAssembly, for converting Fo
+// into Go in a browser (e.g. in an offline playground). Build it with:
+//
+//	GOOS=js GOARCH=wasm go build -o fo.wasm github.com/albrow/fo/wasm
+//
+// and load it with the wasm_exec.js file which comes with Go (in
+// $(go env GOROOT)/lib/wasm). Once it is running, it defines a global fo
+// object with a single function:
+//
+//	fo.compile(source) -> {go, diagnostics}
+//
+// source is the content of a single Fo file. go is the Go code it converts to,
+// or null if there are any diagnostics, each of which is an object with the
+// fields file, line, col, end ({line, col}), severity, message, and code (see
+// compiler.Diagnostic). Imports are resolved with the type information for the
+// Go standard library which is embedded in the importer package, so no other
+// packages can be imported.
+package main
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/importer"
+)
+
+func main() {
+	fo := js.Global().Get("Object").New()
+	fo.Set("compile", js.FuncOf(compile))
+	js.Global().Set("fo", fo)
+	// The functions can only be called while the program is running.
+	select {}
+}
+
+func compile(this js.Value, args []js.Value) interface{} {
+	if len(args) != 1 || args[0].Type() != js.TypeString {
+		return result(nil, []compiler.Diagnostic{{Severity: "error", Message: "compile expects the source code as a string"}})
+	}
+	cfg := &compiler.Config{ImportPath: "main", Importer: importer.Embedded()}
+	compiled, err := compiler.Compile(cfg, []compiler.Input{{Name: "prog.fo", Src: []byte(args[0].String())}})
+	if err != nil {
+		return result(nil, []compiler.Diagnostic{{Severity: "error", Message: err.Error()}})
+	}
+	var output []byte
+	if len(compiled.Files) > 0 {
+		output = compiled.Files[0].Src
+	}
+	return result(output, compiled.Diagnostics)
+}
+
+// result converts the results of compile into a JavaScript object.
+func result(output []byte, diags []compiler.Diagnostic) interface{} {
+	// Round tripping the diagnostics through JSON keeps the field names in
+	// one place (the struct tags of compiler.Diagnostic).
+	data, err := json.Marshal(diags)
+	if err != nil {
+		panic(err)
+	}
+	var list interface{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		panic(err)
+	}
+	if list == nil {
+		list = []interface{}{}
+	}
+	var goSrc interface{}
+	if output != nil {
+		goSrc = string(output)
+	}
+	return map[string]interface{}{
+		"go":          goSrc,
+		"diagnostics": list,
+	}
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/albrow/fo/compiler"
+	"github.com/albrow/fo/foexport"
+	"github.com/albrow/fo/importer"
+	"github.com/albrow/fo/internal/buildcache"
+	"github.com/albrow/fo/parser"
+	"github.com/albrow/fo/token"
+	"github.com/albrow/fo/types"
+	"github.com/urfave/cli"
+)
+
+// watcher keeps every Fo package in the main module type-checked and converted
+// into Go. Unlike the other commands, it holds on to the export data of the
+// packages and the imported Go packages between rebuilds, so that only the
+// packages which changed (and the packages which import them) need to be
+// checked again.
+type watcher struct {
+	modPath   string
+	modDir    string
+	typeNames bool
+
+	// goImporter holds the imported Go packages, including the standard
+	// library and the Go packages in the module. They are imported once and
+	// assumed not to change while watching, since only .fo files are watched.
+	goImporter types.Importer
+	packages   map[string]*watchedPackage
+
+	// cacheDir holds the generated files which are overlaid onto the source
+	// tree when running the program or the tests.
+	cacheDir string
+	out      io.Writer
+	// checkOnly is set if the packages are only type-checked and not
+	// converted into Go.
+	checkOnly bool
+}
+
+// watchedPackage is a Fo package in the main module along with the result of
+// compiling it the last time it changed.
+type watchedPackage struct {
+	*foPackage
+	sum buildcache.Key
+	// diags holds the errors from the last rebuild. The other fields are
+	// only set if there were none.
+	diags       []compiler.Diagnostic
+	exportData  []byte
+	outputs     [][]byte
+	testOutputs [][]byte
+	replace     map[string]string
+}
+
+func watch(c *cli.Context) error {
+	if len(c.Args()) > 1 {
+		return errors.New("watch expects at most one argument: the directory containing the package to run or test")
+	}
+	modPath, modDir, err := goModule()
+	if err != nil {
+		return err
+	}
+	cacheDir, err := overlayCacheDir("watch", modDir)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(cacheDir); err != nil {
+		return err
+	}
+	target := ""
+	if c.Args().Present() || c.Bool("run") {
+		dir, err := filepath.Abs(c.Args().First())
+		if err != nil {
+			return err
+		}
+		target, err = moduleImportPath(modPath, modDir, dir)
+		if err != nil {
+			return err
+		}
+	}
+	w := &watcher{
+		modPath:    modPath,
+		modDir:     modDir,
+		typeNames:  c.Bool(typeNamesFlag.Name),
+		goImporter: importer.Default(),
+		packages:   map[string]*watchedPackage{},
+		cacheDir:   cacheDir,
+		out:        os.Stdout,
+	}
+	dirs, err := newDirWatcher()
+	if err != nil {
+		return err
+	}
+	for {
+		// Watch the directories before reading the files, so that changes
+		// made while rebuilding are not missed.
+		if err := walkModule(modDir, dirs.add); err != nil {
+			return err
+		}
+		rebuilt, err := w.rebuild()
+		if err != nil {
+			fmt.Fprintf(w.out, "fo watch: %s\n", err)
+		} else if len(rebuilt) > 0 {
+			if err := w.runAfterRebuild(c, target, rebuilt); err != nil {
+				return err
+			}
+		}
+		if err := dirs.wait(); err != nil {
+			return err
+		}
+	}
+}
+
+// lookup finds the export data for Fo packages in the module in memory.
+func (w *watcher) lookup(path string) (io.ReadCloser, error) {
+	if pkg, found := w.packages[path]; found {
+		if pkg.exportData == nil {
+			return nil, errors.New("the package has errors")
+		}
+		return ioutil.NopCloser(bytes.NewReader(pkg.exportData)), nil
+	}
+	return foexport.FindExportData(path, w.modDir)
+}
+
+// rebuild compiles the packages which changed since the last rebuild, along
+// with the packages which import them, and prints their diagnostics. It
+// returns the packages which were compiled without errors.
+func (w *watcher) rebuild() ([]*watchedPackage, error) {
+	pkgs, err := findFoPackages(w.modPath, w.modDir)
+	if err != nil {
+		return nil, err
+	}
+	sums := map[*foPackage]buildcache.Key{}
+	scratch := token.NewFileSet()
+	for _, pkg := range pkgs {
+		h := buildcache.NewHash()
+		for _, filename := range pkg.filenames {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			h.Add(filename, src)
+			// Syntax errors are reported when the package is compiled.
+			if f, _ := parser.ParseFile(scratch, filename, src, parser.ImportsOnly); f != nil {
+				pkg.files = append(pkg.files, f)
+			}
+		}
+		for _, filename := range pkg.testFilenames {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			h.Add(filename, src)
+			if f, _ := parser.ParseFile(scratch, filename, src, parser.ImportsOnly); f != nil {
+				pkg.testFiles = append(pkg.testFiles, f)
+			}
+		}
+		sums[pkg] = h.Sum()
+	}
+	pkgs, err = sortFoPackages(pkgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// A package needs to be rebuilt if it changed or if it (or one of its
+	// tests) imports a package which was rebuilt or removed.
+	stale := map[string]bool{}
+	for path := range w.packages {
+		stale[path] = true
+	}
+	for _, pkg := range pkgs {
+		delete(stale, pkg.importPath)
+	}
+	var dirty []*foPackage
+	for _, pkg := range pkgs {
+		old, found := w.packages[pkg.importPath]
+		changed := !found || old.sum != sums[pkg]
+		imports, err := pkg.imports()
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range imports {
+			changed = changed || stale[path]
+		}
+		if changed {
+			stale[pkg.importPath] = true
+			dirty = append(dirty, pkg)
+		}
+	}
+	for path := range stale {
+		delete(w.packages, path)
+	}
+
+	var rebuilt []*watchedPackage
+	for _, pkg := range dirty {
+		wp := &watchedPackage{foPackage: pkg, sum: sums[pkg]}
+		w.packages[pkg.importPath] = wp
+		wp.diags = w.compile(wp)
+		if len(wp.diags) > 0 {
+			fmt.Fprintf(w.out, "# %s\n", pkg.importPath)
+			for _, d := range relativeDiagnostics(wp.diags) {
+				fmt.Fprintln(w.out, d)
+			}
+			continue
+		}
+		rebuilt = append(rebuilt, wp)
+	}
+	if len(dirty) > 0 {
+		fmt.Fprintf(w.out, "fo watch: rebuilt %d of %d packages (%d with errors)\n", len(dirty), len(pkgs), len(dirty)-len(rebuilt))
+	}
+	return rebuilt, nil
+}
+
+// compile type-checks pkg and its tests and converts them into Go, returning
+// every error it finds. If the watcher only checks packages, the conversion is
+// skipped.
+func (w *watcher) compile(pkg *watchedPackage) []compiler.Diagnostic {
+	inputs, err := readInputs(pkg.filenames, pkg.testFilenames)
+	if err != nil {
+		return []compiler.Diagnostic{{Severity: "error", Message: err.Error()}}
+	}
+	cfg := &compiler.Config{
+		ImportPath:       pkg.importPath,
+		Lookup:           w.lookup,
+		Importer:         w.goImporter,
+		RuntimeTypeNames: w.typeNames,
+	}
+	if w.checkOnly {
+		cfg.Output = compiler.ExportDataOnly
+	}
+	result, err := compiler.Compile(cfg, inputs)
+	if err != nil {
+		return []compiler.Diagnostic{{Severity: "error", Message: err.Error()}}
+	}
+	if len(result.Diagnostics) > 0 {
+		return result.Diagnostics
+	}
+	pkg.exportData = result.ExportData
+	pkg.outputs = goSources(result.Files)
+	pkg.testOutputs = goSources(result.TestFiles)
+	return nil
+}
+
+// runAfterRebuild runs the tests of the rebuilt packages and the program in
+// the target package, as requested by the flags. If target is not empty, only
+// its tests are run.
+func (w *watcher) runAfterRebuild(c *cli.Context, target string, rebuilt []*watchedPackage) error {
+	if !c.Bool("run") && !c.Bool("test") {
+		return nil
+	}
+	overlayName, err := w.writeOverlay(rebuilt)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range w.packages {
+		if len(pkg.diags) > 0 {
+			// The go tool would see the .fo files without the Go code for
+			// them.
+			return nil
+		}
+	}
+	var paths []string
+	targetRebuilt := false
+	for _, pkg := range rebuilt {
+		if target == "" || pkg.importPath == target {
+			paths = append(paths, pkg.importPath)
+		}
+		targetRebuilt = targetRebuilt || pkg.importPath == target
+	}
+	if c.Bool("test") && len(paths) > 0 {
+		args := append([]string{"test", "-overlay", overlayName}, paths...)
+		if err := w.runGo(args...); err != nil {
+			return err
+		}
+	}
+	if c.Bool("run") && targetRebuilt {
+		if err := w.runGo("run", "-overlay", overlayName, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeOverlay writes the Go code for the rebuilt packages to the cache
+// directory, followed by the overlay file for all of the packages.
+func (w *watcher) writeOverlay(rebuilt []*watchedPackage) (string, error) {
+	for _, pkg := range rebuilt {
+		pkg.replace = map[string]string{}
+		if err := writeOverlay(w.cacheDir, w.modDir, pkg.filenames, pkg.outputs, pkg.replace); err != nil {
+			return "", err
+		}
+		if err := writeOverlay(w.cacheDir, w.modDir, pkg.testFilenames, pkg.testOutputs, pkg.replace); err != nil {
+			return "", err
+		}
+	}
+	replace := map[string]string{}
+	for _, pkg := range w.packages {
+		for goName, cacheName := range pkg.replace {
+			replace[goName] = cacheName
+		}
+	}
+	if err := os.MkdirAll(w.cacheDir, 0755); err != nil {
+		return "", err
+	}
+	return writeOverlayFile(w.cacheDir, replace)
+}
+
+// runGo runs the go tool with args and reports how it exited. A failure is
+// reported rather than returned, since the watcher keeps going.
+func (w *watcher) runGo(args ...string) error {
+	code, err := runCommand(exec.Command("go", args...))
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		fmt.Fprintf(w.out, "fo watch: go %s exited with status %d\n", args[0], code)
+	}
+	return nil
+}
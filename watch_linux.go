@@ -0,0 +1,110 @@
+//go:build linux
+// +build linux
+
+package main
+
+import (
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// settleTime is how long dirWatcher.wait waits after the first change for
+// more changes, since editors often save a file in several steps.
+const settleTime = 50 * time.Millisecond
+
+// dirWatcher uses inotify to wait for changes to the .fo files in a set of
+// directories.
+type dirWatcher struct {
+	fd   int
+	dirs map[string]int
+	// watches maps each watch descriptor to its directory.
+	watches map[int]string
+}
+
+func newDirWatcher() (*dirWatcher, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	return &dirWatcher{
+		fd:      fd,
+		dirs:    map[string]int{},
+		watches: map[int]string{},
+	}, nil
+}
+
+// add starts watching dir if it isn't watched already.
+func (w *dirWatcher) add(dir string) error {
+	if _, found := w.dirs[dir]; found {
+		return nil
+	}
+	const mask = syscall.IN_CLOSE_WRITE | syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+	wd, err := syscall.InotifyAddWatch(w.fd, dir, mask)
+	if err != nil {
+		return err
+	}
+	w.dirs[dir] = wd
+	w.watches[wd] = dir
+	return nil
+}
+
+// wait blocks until a .fo file is changed, created, or removed in one of the
+// watched directories, or a directory is created or removed in one of them.
+func (w *dirWatcher) wait() error {
+	for {
+		changed, err := w.read()
+		if err != nil {
+			return err
+		}
+		if changed {
+			break
+		}
+	}
+
+	// Skip the changes which follow right after the first one.
+	time.Sleep(settleTime)
+	if err := syscall.SetNonblock(w.fd, true); err != nil {
+		return err
+	}
+	defer syscall.SetNonblock(w.fd, false)
+	for {
+		if _, err := w.read(); err == syscall.EAGAIN {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// read reads the next batch of events and reports whether any of them is a
+// change which wait is waiting for.
+func (w *dirWatcher) read() (bool, error) {
+	var buf [64 * (syscall.SizeofInotifyEvent + syscall.NAME_MAX + 1)]byte
+	n, err := syscall.Read(w.fd, buf[:])
+	if err == syscall.EINTR {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	changed := false
+	for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+		event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+		nameStart := offset + syscall.SizeofInotifyEvent
+		name := strings.TrimRight(string(buf[nameStart:nameStart+int(event.Len)]), "\x00")
+		offset = nameStart + int(event.Len)
+
+		if event.Mask&syscall.IN_IGNORED != 0 {
+			// The directory was removed, so it has to be added again if it
+			// is created again.
+			delete(w.dirs, w.watches[int(event.Wd)])
+			delete(w.watches, int(event.Wd))
+			continue
+		}
+		if event.Mask&syscall.IN_ISDIR != 0 || strings.HasSuffix(name, ".fo") {
+			changed = true
+		}
+	}
+	return changed, nil
+}
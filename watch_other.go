@@ -0,0 +1,98 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// pollInterval is how often dirWatcher.wait checks for changes.
+const pollInterval = 500 * time.Millisecond
+
+// dirWatcher waits for changes to the .fo files in a set of directories by
+// checking their modification times periodically.
+type dirWatcher struct {
+	dirs map[string]bool
+	// files holds the modification time of each .fo file at the time the
+	// watcher last looked.
+	files map[string]time.Time
+}
+
+func newDirWatcher() (*dirWatcher, error) {
+	return &dirWatcher{
+		dirs:  map[string]bool{},
+		files: map[string]time.Time{},
+	}, nil
+}
+
+// add starts watching dir if it isn't watched already.
+func (w *dirWatcher) add(dir string) error {
+	if w.dirs[dir] {
+		return nil
+	}
+	w.dirs[dir] = true
+	files, err := w.scan(dir)
+	if err != nil {
+		return err
+	}
+	for name, modTime := range files {
+		w.files[name] = modTime
+	}
+	return nil
+}
+
+// wait blocks until a .fo file is changed, created, or removed in one of the
+// watched directories, or one of the directories is removed.
+func (w *dirWatcher) wait() error {
+	for {
+		time.Sleep(pollInterval)
+		files := map[string]time.Time{}
+		removed := false
+		for dir := range w.dirs {
+			dirFiles, err := w.scan(dir)
+			if os.IsNotExist(err) {
+				delete(w.dirs, dir)
+				removed = true
+				continue
+			} else if err != nil {
+				return err
+			}
+			for name, modTime := range dirFiles {
+				files[name] = modTime
+			}
+		}
+		changed := removed || len(files) != len(w.files)
+		for name, modTime := range files {
+			if old, found := w.files[name]; !found || !old.Equal(modTime) {
+				changed = true
+			}
+		}
+		w.files = files
+		if changed {
+			return nil
+		}
+	}
+}
+
+// scan returns the modification times of the .fo files in dir.
+func (w *dirWatcher) scan(dir string) (map[string]time.Time, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	names, err := filepath.Glob(filepath.Join(dir, "*.fo"))
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]time.Time{}
+	for _, name := range names {
+		info, err := os.Stat(name)
+		if err != nil {
+			continue
+		}
+		files[name] = info.ModTime()
+	}
+	return files, nil
+}
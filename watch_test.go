@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/albrow/fo/importer"
+)
+
+func TestWatcherRebuild(t *testing.T) {
+	modDir, err := ioutil.TempDir("", "fo-watch-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(modDir)
+	writeFile := func(name, src string) {
+		name = filepath.Join(modDir, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("a/a.fo", "package a\n\nfunc A() int { return 1 }\n")
+	writeFile("b/b.fo", "package b\n\nfunc B() int { return 2 }\n")
+	writeFile("b/b_test.fo", "package b_test\n\nimport (\n\t\"m/a\"\n\t\"m/b\"\n)\n\nvar _ = a.A() + b.B()\n")
+	writeFile("c/c.fo", "package c\n\nfunc C() int { return 3 }\n")
+
+	out := &bytes.Buffer{}
+	w := &watcher{
+		modPath:    "m",
+		modDir:     modDir,
+		goImporter: importer.Default(),
+		packages:   map[string]*watchedPackage{},
+		out:        out,
+		checkOnly:  true,
+	}
+	rebuild := func() []string {
+		rebuilt, err := w.rebuild()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var paths []string
+		for _, pkg := range rebuilt {
+			paths = append(paths, pkg.importPath)
+		}
+		sort.Strings(paths)
+		return paths
+	}
+	if got, expected := rebuild(), []string{"m/a", "m/b", "m/c"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v to be built but got %v\n%s", expected, got, out)
+	}
+	if got := rebuild(); len(got) != 0 {
+		t.Errorf("expected nothing to be rebuilt without changes but got %v", got)
+	}
+
+	// m/b is rebuilt because its tests import m/a.
+	writeFile("a/a.fo", "package a\n\nfunc A() int { return 4 }\n")
+	if got, expected := rebuild(), []string{"m/a", "m/b"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v to be rebuilt but got %v\n%s", expected, got, out)
+	}
+}